@@ -0,0 +1,92 @@
+// Package amtest provides test helpers for asserting Alertmanager routing and
+// inhibition behavior.
+package amtest
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lex00/wetwire-observability-go/alertmanager"
+)
+
+// Labels builds a label set from alternating name/value pairs.
+// It panics if given an odd number of arguments.
+func Labels(pairs ...string) map[string]string {
+	if len(pairs)%2 != 0 {
+		panic("amtest.Labels: odd number of arguments")
+	}
+	labels := make(map[string]string, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		labels[pairs[i]] = pairs[i+1]
+	}
+	return labels
+}
+
+// AssertReceivers fails the test if an alert with the given labels is not
+// routed to exactly the wanted receivers, in order.
+func AssertReceivers(t testing.TB, route *alertmanager.Route, labels map[string]string, want ...string) {
+	t.Helper()
+	got := route.Receivers(labels)
+	if !slices.Equal(got, want) {
+		t.Errorf("receivers for %v = %v, want %v", labels, got, want)
+	}
+}
+
+// AssertReceiversAt fails the test if an alert with the given labels is not
+// delivered to exactly the wanted receivers at time at, in order.
+// Routes muted by their time intervals at that time are excluded.
+func AssertReceiversAt(t testing.TB, config *alertmanager.AlertmanagerConfig, labels map[string]string, at time.Time, want ...string) {
+	t.Helper()
+	results, err := config.RouteAt(labels, at)
+	if err != nil {
+		t.Fatalf("routing %v: %v", labels, err)
+	}
+	got := []string{}
+	for _, r := range results {
+		if !r.Muted {
+			got = append(got, r.Receiver)
+		}
+	}
+	if want == nil {
+		want = []string{}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("receivers for %v at %s = %v, want %v", labels, at.Format(time.RFC3339), got, want)
+	}
+}
+
+// AssertInhibited fails the test if target, firing alongside the other
+// alerts, is not inhibited by any of the config's inhibit rules.
+// target is included among the firing alerts if not already present.
+func AssertInhibited(t testing.TB, config *alertmanager.AlertmanagerConfig, alerts []map[string]string, target map[string]string) {
+	t.Helper()
+	if findInhibition(config, alerts, target) == nil {
+		t.Errorf("alert %v is not inhibited by any of %d firing alerts", target, len(alerts))
+	}
+}
+
+// AssertNotInhibited fails the test if target, firing alongside the other
+// alerts, is inhibited by any of the config's inhibit rules.
+// target is included among the firing alerts if not already present.
+func AssertNotInhibited(t testing.TB, config *alertmanager.AlertmanagerConfig, alerts []map[string]string, target map[string]string) {
+	t.Helper()
+	if inh := findInhibition(config, alerts, target); inh != nil {
+		t.Errorf("alert %v is inhibited by %v (inhibit rule %d)", target, inh.Source, inh.RuleIndex)
+	}
+}
+
+// findInhibition returns the inhibition suppressing target, or nil.
+func findInhibition(config *alertmanager.AlertmanagerConfig, alerts []map[string]string, target map[string]string) *alertmanager.Inhibition {
+	firing := alerts
+	if !slices.ContainsFunc(alerts, func(a map[string]string) bool { return maps.Equal(a, target) }) {
+		firing = append(slices.Clip(alerts), target)
+	}
+	for _, inh := range config.Inhibited(firing) {
+		if maps.Equal(inh.Target, target) {
+			return inh
+		}
+	}
+	return nil
+}
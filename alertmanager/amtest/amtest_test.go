@@ -0,0 +1,90 @@
+package amtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lex00/wetwire-observability-go/alertmanager"
+)
+
+func TestLabels(t *testing.T) {
+	labels := Labels("severity", "critical", "team", "platform")
+	if len(labels) != 2 || labels["severity"] != "critical" || labels["team"] != "platform" {
+		t.Errorf("Labels() = %v", labels)
+	}
+}
+
+func TestLabels_OddPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for odd number of arguments")
+		}
+	}()
+	Labels("severity")
+}
+
+func TestAssertReceivers(t *testing.T) {
+	route := alertmanager.NewRoute("default").WithRoutes(
+		alertmanager.NewRoute("pagerduty").Severity("critical").WithContinue(true),
+		alertmanager.NewRoute("platform-slack").Team("platform"),
+	)
+
+	AssertReceivers(t, route, Labels("severity", "critical", "team", "platform"), "pagerduty", "platform-slack")
+	AssertReceivers(t, route, Labels("team", "database"), "default")
+
+	ft := &fakeT{}
+	AssertReceivers(ft, route, Labels("team", "platform"), "default")
+	if !ft.failed {
+		t.Error("expected AssertReceivers to fail on mismatch")
+	}
+}
+
+func TestAssertReceiversAt(t *testing.T) {
+	config := alertmanager.NewAlertmanagerConfig().
+		WithRoute(alertmanager.NewRoute("default").WithRoutes(
+			alertmanager.NewRoute("oncall").
+				Severity("warning").
+				WithMuteTimeIntervals("weekends"),
+		)).
+		WithMuteTimeIntervals(alertmanager.WeekendsMuteInterval())
+
+	monday := time.Date(2026, time.January, 5, 10, 0, 0, 0, time.UTC)
+	saturday := time.Date(2026, time.January, 10, 10, 0, 0, 0, time.UTC)
+
+	AssertReceiversAt(t, config, Labels("severity", "warning"), monday, "oncall")
+	AssertReceiversAt(t, config, Labels("severity", "warning"), saturday)
+}
+
+// fakeT records failures without failing the enclosing test.
+type fakeT struct {
+	testing.TB
+	failed bool
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...any) {
+	f.failed = true
+}
+
+func TestAssertInhibited(t *testing.T) {
+	config := alertmanager.NewAlertmanagerConfig().
+		WithInhibitRules(alertmanager.CriticalInhibitsWarning())
+
+	firing := []map[string]string{Labels("alertname", "HighCPU", "severity", "critical")}
+
+	AssertInhibited(t, config, firing, Labels("alertname", "HighCPU", "severity", "warning"))
+	AssertNotInhibited(t, config, firing, Labels("alertname", "DiskFull", "severity", "warning"))
+
+	ft := &fakeT{}
+	AssertInhibited(ft, config, firing, Labels("alertname", "DiskFull", "severity", "warning"))
+	if !ft.failed {
+		t.Error("expected AssertInhibited to fail for uninhibited alert")
+	}
+
+	ft = &fakeT{}
+	AssertNotInhibited(ft, config, firing, Labels("alertname", "HighCPU", "severity", "warning"))
+	if !ft.failed {
+		t.Error("expected AssertNotInhibited to fail for inhibited alert")
+	}
+}
@@ -29,8 +29,13 @@ type AlertmanagerConfig struct {
 	InhibitRules []*InhibitRule `yaml:"inhibit_rules,omitempty"`
 
 	// MuteTimeIntervals defines named time intervals for muting.
+	// Alertmanager deprecates this section in favor of TimeIntervals.
 	MuteTimeIntervals []*MuteTimeInterval `yaml:"mute_time_intervals,omitempty"`
 
+	// TimeIntervals defines named time intervals referenced by routes'
+	// mute_time_intervals and active_time_intervals.
+	TimeIntervals []*MuteTimeInterval `yaml:"time_intervals,omitempty"`
+
 	// Templates defines paths to template files.
 	Templates []string `yaml:"templates,omitempty"`
 }
@@ -49,6 +54,9 @@ type GlobalConfig struct {
 	// SMTPAuthPassword is the SMTP auth password.
 	SMTPAuthPassword string `yaml:"smtp_auth_password,omitempty"`
 
+	// SMTPAuthPasswordFile is a file containing the SMTP auth password.
+	SMTPAuthPasswordFile string `yaml:"smtp_auth_password_file,omitempty"`
+
 	// SMTPAuthSecret is the SMTP auth secret.
 	SMTPAuthSecret string `yaml:"smtp_auth_secret,omitempty"`
 
@@ -61,6 +69,9 @@ type GlobalConfig struct {
 	// SlackAPIURL is the default Slack API URL.
 	SlackAPIURL string `yaml:"slack_api_url,omitempty"`
 
+	// SlackAPIURLFile is a file containing the default Slack API URL.
+	SlackAPIURLFile string `yaml:"slack_api_url_file,omitempty"`
+
 	// PagerDutyURL is the PagerDuty URL.
 	PagerDutyURL string `yaml:"pagerduty_url,omitempty"`
 
@@ -70,6 +81,45 @@ type GlobalConfig struct {
 	// OpsGenieAPIKey is the OpsGenie API key.
 	OpsGenieAPIKey string `yaml:"opsgenie_api_key,omitempty"`
 
+	// OpsGenieAPIKeyFile is a file containing the OpsGenie API key.
+	OpsGenieAPIKeyFile string `yaml:"opsgenie_api_key_file,omitempty"`
+
+	// TelegramAPIURL is the Telegram API URL.
+	TelegramAPIURL string `yaml:"telegram_api_url,omitempty"`
+
+	// VictorOpsAPIURL is the VictorOps API URL.
+	VictorOpsAPIURL string `yaml:"victorops_api_url,omitempty"`
+
+	// VictorOpsAPIKey is the VictorOps API key.
+	VictorOpsAPIKey Secret `yaml:"victorops_api_key,omitempty"`
+
+	// VictorOpsAPIKeyFile is a file containing the VictorOps API key.
+	VictorOpsAPIKeyFile string `yaml:"victorops_api_key_file,omitempty"`
+
+	// WeChatAPIURL is the WeChat API URL.
+	WeChatAPIURL string `yaml:"wechat_api_url,omitempty"`
+
+	// WeChatAPISecret is the WeChat API secret.
+	WeChatAPISecret Secret `yaml:"wechat_api_secret,omitempty"`
+
+	// WeChatAPICorpID is the WeChat corporate ID.
+	WeChatAPICorpID string `yaml:"wechat_api_corp_id,omitempty"`
+
+	// WebexAPIURL is the Webex API URL.
+	WebexAPIURL string `yaml:"webex_api_url,omitempty"`
+
+	// JiraAPIURL is the Jira API URL.
+	JiraAPIURL string `yaml:"jira_api_url,omitempty"`
+
+	// RocketChatAPIURL is the Rocket.Chat API URL.
+	RocketChatAPIURL string `yaml:"rocketchat_api_url,omitempty"`
+
+	// RocketChatToken is the Rocket.Chat personal access token.
+	RocketChatToken Secret `yaml:"rocketchat_token,omitempty"`
+
+	// RocketChatTokenID is the Rocket.Chat token user ID.
+	RocketChatTokenID Secret `yaml:"rocketchat_token_id,omitempty"`
+
 	// HTTPConfig sets HTTP client configuration.
 	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
 
@@ -88,11 +138,30 @@ type HTTPConfig struct {
 	// BearerTokenFile is the path to a file containing the bearer token.
 	BearerTokenFile string `yaml:"bearer_token_file,omitempty"`
 
+	// Authorization configures the Authorization header.
+	Authorization *Authorization `yaml:"authorization,omitempty"`
+
+	// OAuth2 configures OAuth 2.0 client credentials authentication.
+	OAuth2 *OAuth2 `yaml:"oauth2,omitempty"`
+
 	// TLSConfig configures TLS settings.
 	TLSConfig *TLSConfig `yaml:"tls_config,omitempty"`
 
 	// ProxyURL is the HTTP proxy URL.
 	ProxyURL string `yaml:"proxy_url,omitempty"`
+
+	// NoProxy is a comma-separated list of hosts excluded from proxying.
+	NoProxy string `yaml:"no_proxy,omitempty"`
+
+	// ProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+	// environment variables.
+	ProxyFromEnvironment bool `yaml:"proxy_from_environment,omitempty"`
+
+	// FollowRedirects determines whether HTTP redirects are followed.
+	FollowRedirects *bool `yaml:"follow_redirects,omitempty"`
+
+	// EnableHTTP2 determines whether HTTP/2 is enabled.
+	EnableHTTP2 *bool `yaml:"enable_http2,omitempty"`
 }
 
 // BasicAuth configures basic HTTP authentication.
@@ -131,6 +200,39 @@ type Receiver struct {
 
 	// OpsGenieConfigs defines OpsGenie notification targets.
 	OpsGenieConfigs []*OpsGenieConfig `yaml:"opsgenie_configs,omitempty"`
+
+	// MSTeamsConfigs defines Microsoft Teams notification targets.
+	MSTeamsConfigs []*MSTeamsConfig `yaml:"msteams_configs,omitempty"`
+
+	// MSTeamsV2Configs defines Microsoft Teams (Power Automate) notification targets.
+	MSTeamsV2Configs []*MSTeamsV2Config `yaml:"msteamsv2_configs,omitempty"`
+
+	// TelegramConfigs defines Telegram notification targets.
+	TelegramConfigs []*TelegramConfig `yaml:"telegram_configs,omitempty"`
+
+	// DiscordConfigs defines Discord notification targets.
+	DiscordConfigs []*DiscordConfig `yaml:"discord_configs,omitempty"`
+
+	// SNSConfigs defines AWS SNS notification targets.
+	SNSConfigs []*SNSConfig `yaml:"sns_configs,omitempty"`
+
+	// WebexConfigs defines Webex notification targets.
+	WebexConfigs []*WebexConfig `yaml:"webex_configs,omitempty"`
+
+	// VictorOpsConfigs defines VictorOps notification targets.
+	VictorOpsConfigs []*VictorOpsConfig `yaml:"victorops_configs,omitempty"`
+
+	// PushoverConfigs defines Pushover notification targets.
+	PushoverConfigs []*PushoverConfig `yaml:"pushover_configs,omitempty"`
+
+	// WeChatConfigs defines WeChat notification targets.
+	WeChatConfigs []*WeChatConfig `yaml:"wechat_configs,omitempty"`
+
+	// JiraConfigs defines Jira notification targets.
+	JiraConfigs []*JiraConfig `yaml:"jira_configs,omitempty"`
+
+	// RocketChatConfigs defines Rocket.Chat notification targets.
+	RocketChatConfigs []*RocketChatConfig `yaml:"rocketchat_configs,omitempty"`
 }
 
 // InhibitRule defines a rule for muting alerts.
@@ -143,8 +245,8 @@ type InhibitRule struct {
 	Equal         []string          `yaml:"equal,omitempty"`
 }
 
-// MuteTimeInterval defines a named time interval for muting.
-// Placeholder - will be expanded later.
+// MuteTimeInterval defines a named set of time intervals. It is used for both
+// the mute_time_intervals and time_intervals sections.
 type MuteTimeInterval struct {
 	Name          string         `yaml:"name"`
 	TimeIntervals []TimeInterval `yaml:"time_intervals,omitempty"`
@@ -157,6 +259,10 @@ type TimeInterval struct {
 	DaysOfMonth []DayOfMonthRange `yaml:"days_of_month,omitempty"`
 	Months      []MonthRange      `yaml:"months,omitempty"`
 	Years       []YearRange       `yaml:"years,omitempty"`
+
+	// Location is the IANA time zone the interval is evaluated in
+	// (e.g., "Europe/Berlin"). Defaults to UTC.
+	Location string `yaml:"location,omitempty"`
 }
 
 // Placeholder range types - will be expanded later.
@@ -205,6 +311,12 @@ func (c *AlertmanagerConfig) WithMuteTimeIntervals(intervals ...*MuteTimeInterva
 	return c
 }
 
+// WithTimeIntervals sets the named time intervals.
+func (c *AlertmanagerConfig) WithTimeIntervals(intervals ...*MuteTimeInterval) *AlertmanagerConfig {
+	c.TimeIntervals = intervals
+	return c
+}
+
 // WithTemplates sets the template paths.
 func (c *AlertmanagerConfig) WithTemplates(templates ...string) *AlertmanagerConfig {
 	c.Templates = templates
@@ -149,6 +149,39 @@ receivers:
 	}
 }
 
+func TestAlertmanagerConfig_UnmarshalTimeIntervals(t *testing.T) {
+	input := `
+route:
+  receiver: default
+  active_time_intervals:
+    - office
+receivers:
+  - name: default
+time_intervals:
+  - name: office
+    time_intervals:
+      - weekdays: ['monday:friday']
+        times:
+          - start_time: '09:00'
+            end_time: '17:00'
+        location: Europe/London
+`
+	var config AlertmanagerConfig
+	if err := yaml.Unmarshal([]byte(input), &config); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+
+	if len(config.TimeIntervals) != 1 {
+		t.Fatalf("len(TimeIntervals) = %d, want 1", len(config.TimeIntervals))
+	}
+	if loc := config.TimeIntervals[0].TimeIntervals[0].Location; loc != "Europe/London" {
+		t.Errorf("Location = %v, want Europe/London", loc)
+	}
+	if config.LookupTimeInterval("office") == nil {
+		t.Error("LookupTimeInterval(office) = nil")
+	}
+}
+
 func TestCompleteAlertmanagerConfig(t *testing.T) {
 	// This test verifies a complete alertmanager.yml configuration
 	config := &AlertmanagerConfig{
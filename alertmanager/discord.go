@@ -0,0 +1,90 @@
+package alertmanager
+
+// DiscordConfig configures notifications to Discord.
+type DiscordConfig struct {
+	// SendResolved determines if resolved alerts should be sent.
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// WebhookURL is the Discord webhook URL.
+	WebhookURL Secret `yaml:"webhook_url,omitempty"`
+
+	// WebhookURLFile is a file containing the Discord webhook URL.
+	WebhookURLFile string `yaml:"webhook_url_file,omitempty"`
+
+	// Title is the message title.
+	Title string `yaml:"title,omitempty"`
+
+	// Message is the message body.
+	Message string `yaml:"message,omitempty"`
+
+	// Content is plain text content sent outside the embed.
+	Content string `yaml:"content,omitempty"`
+
+	// Username overrides the webhook's default username.
+	Username string `yaml:"username,omitempty"`
+
+	// AvatarURL overrides the webhook's default avatar.
+	AvatarURL string `yaml:"avatar_url,omitempty"`
+
+	// HTTPConfig configures HTTP client settings.
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// NewDiscordConfig creates a new DiscordConfig.
+func NewDiscordConfig() *DiscordConfig {
+	return &DiscordConfig{}
+}
+
+// WithSendResolved sets whether to send resolved alerts.
+func (d *DiscordConfig) WithSendResolved(send bool) *DiscordConfig {
+	d.SendResolved = &send
+	return d
+}
+
+// WithWebhookURL sets the Discord webhook URL.
+func (d *DiscordConfig) WithWebhookURL(url Secret) *DiscordConfig {
+	d.WebhookURL = url
+	return d
+}
+
+// WithWebhookURLFile sets the file containing the Discord webhook URL.
+func (d *DiscordConfig) WithWebhookURLFile(path string) *DiscordConfig {
+	d.WebhookURLFile = path
+	return d
+}
+
+// WithTitle sets the message title.
+func (d *DiscordConfig) WithTitle(title string) *DiscordConfig {
+	d.Title = title
+	return d
+}
+
+// WithMessage sets the message body.
+func (d *DiscordConfig) WithMessage(message string) *DiscordConfig {
+	d.Message = message
+	return d
+}
+
+// WithContent sets the plain text content.
+func (d *DiscordConfig) WithContent(content string) *DiscordConfig {
+	d.Content = content
+	return d
+}
+
+// WithUsername sets the webhook username.
+func (d *DiscordConfig) WithUsername(username string) *DiscordConfig {
+	d.Username = username
+	return d
+}
+
+// WithAvatarURL sets the webhook avatar URL.
+func (d *DiscordConfig) WithAvatarURL(url string) *DiscordConfig {
+	d.AvatarURL = url
+	return d
+}
+
+// WithHTTPConfig sets HTTP client configuration.
+func (d *DiscordConfig) WithHTTPConfig(config *HTTPConfig) *DiscordConfig {
+	d.HTTPConfig = config
+	return d
+}
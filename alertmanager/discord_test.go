@@ -0,0 +1,59 @@
+package alertmanager
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewDiscordConfig(t *testing.T) {
+	config := NewDiscordConfig()
+	if config == nil {
+		t.Error("NewDiscordConfig() returned nil")
+	}
+}
+
+func TestDiscordConfig_FluentAPI(t *testing.T) {
+	config := NewDiscordConfig().
+		WithWebhookURL(NewSecret("https://discord.com/api/webhooks/1/abc")).
+		WithTitle("{{ .CommonLabels.alertname }}").
+		WithMessage("{{ .CommonAnnotations.description }}").
+		WithUsername("alertmanager").
+		WithSendResolved(true)
+
+	if string(config.WebhookURL) != "https://discord.com/api/webhooks/1/abc" {
+		t.Errorf("WebhookURL = %v", config.WebhookURL)
+	}
+	if config.Username != "alertmanager" {
+		t.Errorf("Username = %v", config.Username)
+	}
+	if config.SendResolved == nil || !*config.SendResolved {
+		t.Error("SendResolved should be true")
+	}
+}
+
+func TestDiscordConfig_Serialize(t *testing.T) {
+	config := NewDiscordConfig().
+		WithWebhookURLFile("/etc/alertmanager/secrets/discord-url").
+		WithContent("<@&12345>").
+		WithAvatarURL("https://example.com/avatar.png")
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"webhook_url_file: /etc/alertmanager/secrets/discord-url",
+		"content: <@&12345>",
+		"avatar_url: https://example.com/avatar.png",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
@@ -23,6 +23,9 @@ type EmailConfig struct {
 	// AuthPassword is the SMTP AUTH password.
 	AuthPassword Secret `yaml:"auth_password,omitempty"`
 
+	// AuthPasswordFile is a file containing the SMTP AUTH password.
+	AuthPasswordFile string `yaml:"auth_password_file,omitempty"`
+
 	// AuthSecret is the SMTP AUTH secret.
 	AuthSecret Secret `yaml:"auth_secret,omitempty"`
 
@@ -92,6 +95,12 @@ func (e *EmailConfig) WithAuthPassword(password Secret) *EmailConfig {
 	return e
 }
 
+// WithAuthPasswordFile sets a file containing the SMTP AUTH password.
+func (e *EmailConfig) WithAuthPasswordFile(path string) *EmailConfig {
+	e.AuthPasswordFile = path
+	return e
+}
+
 // WithAuthSecret sets the SMTP AUTH secret.
 func (e *EmailConfig) WithAuthSecret(secret Secret) *EmailConfig {
 	e.AuthSecret = secret
@@ -124,6 +124,25 @@ func TestEmailConfig_SerializeWithAuth(t *testing.T) {
 	}
 }
 
+func TestEmailConfig_AuthPasswordFile(t *testing.T) {
+	config := NewEmailConfig().
+		WithTo("team@example.com").
+		WithAuthPasswordFile("/etc/alertmanager/secrets/smtp/password")
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	if !strings.Contains(yamlStr, "auth_password_file: /etc/alertmanager/secrets/smtp/password") {
+		t.Errorf("yaml.Marshal() missing auth_password_file\nGot:\n%s", yamlStr)
+	}
+	if strings.Contains(yamlStr, "auth_password:") {
+		t.Errorf("yaml.Marshal() should omit empty auth_password\nGot:\n%s", yamlStr)
+	}
+}
+
 func TestEmailConfig_Unmarshal(t *testing.T) {
 	input := `
 send_resolved: true
@@ -0,0 +1,139 @@
+package alertmanager
+
+// Authorization configures the HTTP Authorization header.
+type Authorization struct {
+	// Type is the authentication scheme. Defaults to "Bearer".
+	Type string `yaml:"type,omitempty"`
+
+	// Credentials are the credentials sent in the header.
+	Credentials Secret `yaml:"credentials,omitempty"`
+
+	// CredentialsFile is a file containing the credentials.
+	CredentialsFile string `yaml:"credentials_file,omitempty"`
+}
+
+// OAuth2 configures OAuth 2.0 client credentials authentication.
+type OAuth2 struct {
+	// ClientID is the OAuth 2.0 client ID.
+	ClientID string `yaml:"client_id"`
+
+	// ClientSecret is the OAuth 2.0 client secret.
+	ClientSecret Secret `yaml:"client_secret,omitempty"`
+
+	// ClientSecretFile is a file containing the client secret.
+	ClientSecretFile string `yaml:"client_secret_file,omitempty"`
+
+	// Scopes are the scopes requested for the token.
+	Scopes []string `yaml:"scopes,omitempty"`
+
+	// TokenURL is the URL tokens are fetched from.
+	TokenURL string `yaml:"token_url"`
+
+	// EndpointParams are additional parameters sent to the token URL.
+	EndpointParams map[string]string `yaml:"endpoint_params,omitempty"`
+
+	// TLSConfig configures TLS for token requests.
+	TLSConfig *TLSConfig `yaml:"tls_config,omitempty"`
+
+	// ProxyURL is the HTTP proxy URL for token requests.
+	ProxyURL string `yaml:"proxy_url,omitempty"`
+}
+
+// NewHTTPConfig creates a new HTTPConfig.
+func NewHTTPConfig() *HTTPConfig {
+	return &HTTPConfig{}
+}
+
+// WithBasicAuth sets basic authentication.
+func (h *HTTPConfig) WithBasicAuth(username, password string) *HTTPConfig {
+	h.BasicAuth = &BasicAuth{Username: username, Password: password}
+	return h
+}
+
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (h *HTTPConfig) WithAuthorization(credentials Secret) *HTTPConfig {
+	h.Authorization = &Authorization{Type: "Bearer", Credentials: credentials}
+	return h
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (h *HTTPConfig) WithAuthorizationFile(path string) *HTTPConfig {
+	h.Authorization = &Authorization{Type: "Bearer", CredentialsFile: path}
+	return h
+}
+
+// WithAuthorizationType sets credentials for a custom authentication scheme.
+func (h *HTTPConfig) WithAuthorizationType(authType string, credentials Secret) *HTTPConfig {
+	h.Authorization = &Authorization{Type: authType, Credentials: credentials}
+	return h
+}
+
+// WithOAuth2 sets OAuth 2.0 authentication.
+func (h *HTTPConfig) WithOAuth2(oauth2 *OAuth2) *HTTPConfig {
+	h.OAuth2 = oauth2
+	return h
+}
+
+// WithTLSConfig sets TLS settings.
+func (h *HTTPConfig) WithTLSConfig(tls *TLSConfig) *HTTPConfig {
+	h.TLSConfig = tls
+	return h
+}
+
+// WithProxyURL sets the HTTP proxy URL.
+func (h *HTTPConfig) WithProxyURL(url string) *HTTPConfig {
+	h.ProxyURL = url
+	return h
+}
+
+// WithNoProxy sets hosts excluded from proxying.
+func (h *HTTPConfig) WithNoProxy(hosts string) *HTTPConfig {
+	h.NoProxy = hosts
+	return h
+}
+
+// WithProxyFromEnvironment sets whether proxy settings come from the environment.
+func (h *HTTPConfig) WithProxyFromEnvironment(enabled bool) *HTTPConfig {
+	h.ProxyFromEnvironment = enabled
+	return h
+}
+
+// WithFollowRedirects sets whether HTTP redirects are followed.
+func (h *HTTPConfig) WithFollowRedirects(follow bool) *HTTPConfig {
+	h.FollowRedirects = &follow
+	return h
+}
+
+// WithEnableHTTP2 sets whether HTTP/2 is enabled.
+func (h *HTTPConfig) WithEnableHTTP2(enable bool) *HTTPConfig {
+	h.EnableHTTP2 = &enable
+	return h
+}
+
+// NewOAuth2 creates a new OAuth2 configuration.
+func NewOAuth2(clientID string, clientSecret Secret, tokenURL string) *OAuth2 {
+	return &OAuth2{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+	}
+}
+
+// WithClientSecretFile reads the client secret from a file instead.
+func (o *OAuth2) WithClientSecretFile(path string) *OAuth2 {
+	o.ClientSecret = ""
+	o.ClientSecretFile = path
+	return o
+}
+
+// WithScopes sets the requested scopes.
+func (o *OAuth2) WithScopes(scopes ...string) *OAuth2 {
+	o.Scopes = scopes
+	return o
+}
+
+// WithEndpointParams sets additional token request parameters.
+func (o *OAuth2) WithEndpointParams(params map[string]string) *OAuth2 {
+	o.EndpointParams = params
+	return o
+}
@@ -0,0 +1,80 @@
+package alertmanager
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestHTTPConfig_FluentAPI(t *testing.T) {
+	config := NewHTTPConfig().
+		WithAuthorization(NewSecret("token")).
+		WithProxyURL("http://proxy:3128").
+		WithFollowRedirects(false).
+		WithEnableHTTP2(true)
+
+	if config.Authorization == nil || config.Authorization.Type != "Bearer" {
+		t.Errorf("Authorization = %+v", config.Authorization)
+	}
+	if string(config.Authorization.Credentials) != "token" {
+		t.Errorf("Credentials = %v", config.Authorization.Credentials)
+	}
+	if config.FollowRedirects == nil || *config.FollowRedirects {
+		t.Error("FollowRedirects should be false")
+	}
+	if config.EnableHTTP2 == nil || !*config.EnableHTTP2 {
+		t.Error("EnableHTTP2 should be true")
+	}
+}
+
+func TestHTTPConfig_WithOAuth2(t *testing.T) {
+	config := NewHTTPConfig().WithOAuth2(
+		NewOAuth2("client", NewSecret("secret"), "https://auth.example.com/token").
+			WithScopes("alerts.write").
+			WithClientSecretFile("/etc/secrets/client-secret"),
+	)
+
+	if config.OAuth2.ClientID != "client" {
+		t.Errorf("ClientID = %v", config.OAuth2.ClientID)
+	}
+	if config.OAuth2.ClientSecret != "" {
+		t.Errorf("ClientSecret = %v, want empty when file is set", config.OAuth2.ClientSecret)
+	}
+	if config.OAuth2.ClientSecretFile != "/etc/secrets/client-secret" {
+		t.Errorf("ClientSecretFile = %v", config.OAuth2.ClientSecretFile)
+	}
+}
+
+func TestHTTPConfig_Serialize(t *testing.T) {
+	config := NewHTTPConfig().
+		WithAuthorizationType("Token", NewSecret("abc")).
+		WithOAuth2(NewOAuth2("client", NewSecret("secret"), "https://auth.example.com/token")).
+		WithNoProxy("localhost").
+		WithFollowRedirects(true).
+		WithEnableHTTP2(false)
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"authorization:",
+		"type: Token",
+		"credentials: abc",
+		"oauth2:",
+		"client_id: client",
+		"token_url: https://auth.example.com/token",
+		"no_proxy: localhost",
+		"follow_redirects: true",
+		"enable_http2: false",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
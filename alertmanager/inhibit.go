@@ -79,3 +79,90 @@ func WarningInhibitsInfo() *InhibitRule {
 		WithTargetMatchers(Eq("severity", "info")).
 		WithEqual("alertname")
 }
+
+// SourceMatches reports whether an alert with the given labels matches the
+// rule's source side, including the deprecated SourceMatch map.
+func (i *InhibitRule) SourceMatches(labels map[string]string) bool {
+	for name, value := range i.SourceMatch {
+		if labels[name] != value {
+			return false
+		}
+	}
+	return matchAll(i.SourceMatchers, labels)
+}
+
+// TargetMatches reports whether an alert with the given labels matches the
+// rule's target side, including the deprecated TargetMatch map.
+func (i *InhibitRule) TargetMatches(labels map[string]string) bool {
+	for name, value := range i.TargetMatch {
+		if labels[name] != value {
+			return false
+		}
+	}
+	return matchAll(i.TargetMatchers, labels)
+}
+
+// Inhibits reports whether a firing source alert inhibits the target alert
+// under this rule. As in Alertmanager, labels listed in Equal must have the
+// same value on both alerts (missing labels count as empty), and an alert that
+// matches both sides of the rule cannot be inhibited by another such alert.
+func (i *InhibitRule) Inhibits(source, target map[string]string) bool {
+	if !i.SourceMatches(source) || !i.TargetMatches(target) {
+		return false
+	}
+	for _, name := range i.Equal {
+		if source[name] != target[name] {
+			return false
+		}
+	}
+	if i.SourceMatches(target) && i.TargetMatches(source) {
+		return false
+	}
+	return true
+}
+
+// Inhibition describes a firing alert that is suppressed by an inhibit rule.
+type Inhibition struct {
+	// Target is the labels of the inhibited alert.
+	Target map[string]string `json:"target"`
+
+	// Source is the labels of the firing alert that inhibits the target.
+	Source map[string]string `json:"source"`
+
+	// Rule is the inhibit rule responsible.
+	Rule *InhibitRule `json:"rule"`
+
+	// RuleIndex is the position of Rule in the config's InhibitRules.
+	RuleIndex int `json:"rule_index"`
+}
+
+// Inhibited evaluates the config's inhibit rules over a set of firing alerts
+// and returns an Inhibition for each alert that would be suppressed, in the
+// order the alerts were given. Each inhibited alert is reported once, with the
+// first matching rule and source alert.
+func (c *AlertmanagerConfig) Inhibited(alerts []map[string]string) []*Inhibition {
+	var inhibitions []*Inhibition
+	for _, target := range alerts {
+		if inh := c.inhibitionFor(target, alerts); inh != nil {
+			inhibitions = append(inhibitions, inh)
+		}
+	}
+	return inhibitions
+}
+
+// inhibitionFor returns the first inhibition suppressing target, or nil.
+func (c *AlertmanagerConfig) inhibitionFor(target map[string]string, alerts []map[string]string) *Inhibition {
+	for idx, rule := range c.InhibitRules {
+		for _, source := range alerts {
+			if rule.Inhibits(source, target) {
+				return &Inhibition{
+					Target:    target,
+					Source:    source,
+					Rule:      rule,
+					RuleIndex: idx,
+				}
+			}
+		}
+	}
+	return nil
+}
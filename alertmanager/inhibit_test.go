@@ -191,3 +191,97 @@ func TestInhibitRuleInConfig(t *testing.T) {
 		t.Errorf("yaml.Marshal() missing inhibit_rules:\nGot:\n%s", yamlStr)
 	}
 }
+
+func TestInhibitRule_Inhibits(t *testing.T) {
+	rule := CriticalInhibitsWarning()
+
+	critical := map[string]string{"alertname": "HighCPU", "severity": "critical"}
+	warning := map[string]string{"alertname": "HighCPU", "severity": "warning"}
+	otherWarning := map[string]string{"alertname": "HighMemory", "severity": "warning"}
+
+	if !rule.Inhibits(critical, warning) {
+		t.Error("critical should inhibit warning with same alertname")
+	}
+	if rule.Inhibits(critical, otherWarning) {
+		t.Error("critical should not inhibit warning with different alertname")
+	}
+	if rule.Inhibits(warning, critical) {
+		t.Error("warning should not inhibit critical")
+	}
+}
+
+func TestInhibitRule_Inhibits_MissingEqualLabel(t *testing.T) {
+	rule := NewInhibitRule().
+		WithSourceMatchers(Alertname("ClusterDown")).
+		WithTargetMatchers(NotEq("alertname", "ClusterDown")).
+		WithEqual("cluster")
+
+	source := map[string]string{"alertname": "ClusterDown"}
+	target := map[string]string{"alertname": "PodCrash"}
+	if !rule.Inhibits(source, target) {
+		t.Error("missing equal labels on both sides should compare equal")
+	}
+
+	target["cluster"] = "eu-1"
+	if rule.Inhibits(source, target) {
+		t.Error("differing equal label should not inhibit")
+	}
+}
+
+func TestInhibitRule_Inhibits_DeprecatedMatch(t *testing.T) {
+	rule := NewInhibitRule().
+		WithSourceMatch(map[string]string{"severity": "critical"}).
+		WithTargetMatch(map[string]string{"severity": "warning"})
+
+	if !rule.Inhibits(map[string]string{"severity": "critical"}, map[string]string{"severity": "warning"}) {
+		t.Error("deprecated match maps should be honored")
+	}
+}
+
+func TestInhibitRule_Inhibits_TwoSided(t *testing.T) {
+	// Both alerts match both sides, so neither may inhibit the other.
+	rule := NewInhibitRule().
+		WithSourceMatchers(Regex("severity", "critical|warning")).
+		WithTargetMatchers(Regex("severity", "critical|warning")).
+		WithEqual("alertname")
+
+	a := map[string]string{"alertname": "X", "severity": "critical"}
+	b := map[string]string{"alertname": "X", "severity": "warning"}
+	if rule.Inhibits(a, b) || rule.Inhibits(b, a) {
+		t.Error("alerts matching both sides should not inhibit each other")
+	}
+}
+
+func TestAlertmanagerConfig_Inhibited(t *testing.T) {
+	config := NewAlertmanagerConfig().WithInhibitRules(
+		CriticalInhibitsWarning(),
+		WarningInhibitsInfo(),
+	)
+
+	alerts := []map[string]string{
+		{"alertname": "HighCPU", "severity": "critical"},
+		{"alertname": "HighCPU", "severity": "warning"},
+		{"alertname": "HighCPU", "severity": "info"},
+		{"alertname": "DiskFull", "severity": "warning"},
+	}
+
+	inhibitions := config.Inhibited(alerts)
+	if len(inhibitions) != 2 {
+		t.Fatalf("len(Inhibited()) = %d, want 2", len(inhibitions))
+	}
+
+	if inhibitions[0].Target["severity"] != "warning" || inhibitions[0].Source["severity"] != "critical" {
+		t.Errorf("inhibitions[0] = %v by %v", inhibitions[0].Target, inhibitions[0].Source)
+	}
+	if inhibitions[0].RuleIndex != 0 {
+		t.Errorf("inhibitions[0].RuleIndex = %d, want 0", inhibitions[0].RuleIndex)
+	}
+
+	// Inhibited alerts still act as inhibition sources, as in Alertmanager.
+	if inhibitions[1].Target["severity"] != "info" || inhibitions[1].Source["severity"] != "warning" {
+		t.Errorf("inhibitions[1] = %v by %v", inhibitions[1].Target, inhibitions[1].Source)
+	}
+	if inhibitions[1].RuleIndex != 1 {
+		t.Errorf("inhibitions[1].RuleIndex = %d, want 1", inhibitions[1].RuleIndex)
+	}
+}
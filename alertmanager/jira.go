@@ -0,0 +1,131 @@
+package alertmanager
+
+// JiraConfig configures notifications that create and update Jira issues.
+type JiraConfig struct {
+	// SendResolved determines if resolved alerts should be sent.
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// APIURL is the Jira API URL, e.g. https://company.atlassian.net/rest/api/2/.
+	APIURL string `yaml:"api_url,omitempty"`
+
+	// Project is the project key where issues are created.
+	Project string `yaml:"project"`
+
+	// IssueType is the type of issue to create (e.g. Bug).
+	IssueType string `yaml:"issue_type"`
+
+	// Summary is the issue summary.
+	Summary string `yaml:"summary,omitempty"`
+
+	// Description is the issue description.
+	Description string `yaml:"description,omitempty"`
+
+	// Labels are labels added to the issue.
+	Labels []string `yaml:"labels,omitempty"`
+
+	// Priority is the issue priority.
+	Priority string `yaml:"priority,omitempty"`
+
+	// ReopenTransition is the transition used to reopen a resolved issue.
+	ReopenTransition string `yaml:"reopen_transition,omitempty"`
+
+	// ResolveTransition is the transition used to resolve an issue.
+	ResolveTransition string `yaml:"resolve_transition,omitempty"`
+
+	// WontFixResolution is the resolution that prevents an issue from being reopened.
+	WontFixResolution string `yaml:"wont_fix_resolution,omitempty"`
+
+	// ReopenDuration is how long after resolution an issue is reopened
+	// instead of creating a new one.
+	ReopenDuration Duration `yaml:"reopen_duration,omitempty"`
+
+	// Fields sets additional issue fields, such as custom fields.
+	Fields map[string]any `yaml:"fields,omitempty"`
+
+	// HTTPConfig configures HTTP client settings.
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// NewJiraConfig creates a new JiraConfig.
+func NewJiraConfig() *JiraConfig {
+	return &JiraConfig{}
+}
+
+// WithSendResolved sets whether to send resolved alerts.
+func (j *JiraConfig) WithSendResolved(send bool) *JiraConfig {
+	j.SendResolved = &send
+	return j
+}
+
+// WithAPIURL sets the Jira API URL.
+func (j *JiraConfig) WithAPIURL(url string) *JiraConfig {
+	j.APIURL = url
+	return j
+}
+
+// WithProject sets the project key where issues are created.
+func (j *JiraConfig) WithProject(project string) *JiraConfig {
+	j.Project = project
+	return j
+}
+
+// WithIssueType sets the type of issue to create.
+func (j *JiraConfig) WithIssueType(issueType string) *JiraConfig {
+	j.IssueType = issueType
+	return j
+}
+
+// WithSummary sets the issue summary.
+func (j *JiraConfig) WithSummary(summary string) *JiraConfig {
+	j.Summary = summary
+	return j
+}
+
+// WithDescription sets the issue description.
+func (j *JiraConfig) WithDescription(description string) *JiraConfig {
+	j.Description = description
+	return j
+}
+
+// WithLabels sets the issue labels.
+func (j *JiraConfig) WithLabels(labels ...string) *JiraConfig {
+	j.Labels = labels
+	return j
+}
+
+// WithPriority sets the issue priority.
+func (j *JiraConfig) WithPriority(priority string) *JiraConfig {
+	j.Priority = priority
+	return j
+}
+
+// WithTransitions sets the transitions used to reopen and resolve issues.
+func (j *JiraConfig) WithTransitions(reopen, resolve string) *JiraConfig {
+	j.ReopenTransition = reopen
+	j.ResolveTransition = resolve
+	return j
+}
+
+// WithWontFixResolution sets the resolution that prevents reopening.
+func (j *JiraConfig) WithWontFixResolution(resolution string) *JiraConfig {
+	j.WontFixResolution = resolution
+	return j
+}
+
+// WithReopenDuration sets how long after resolution issues are reopened.
+func (j *JiraConfig) WithReopenDuration(d Duration) *JiraConfig {
+	j.ReopenDuration = d
+	return j
+}
+
+// WithFields sets additional issue fields.
+func (j *JiraConfig) WithFields(fields map[string]any) *JiraConfig {
+	j.Fields = fields
+	return j
+}
+
+// WithHTTPConfig sets HTTP client configuration.
+func (j *JiraConfig) WithHTTPConfig(config *HTTPConfig) *JiraConfig {
+	j.HTTPConfig = config
+	return j
+}
@@ -0,0 +1,67 @@
+package alertmanager
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewJiraConfig(t *testing.T) {
+	config := NewJiraConfig().WithProject("OPS").WithIssueType("Bug")
+	if config.Project != "OPS" {
+		t.Errorf("Project = %v", config.Project)
+	}
+	if config.IssueType != "Bug" {
+		t.Errorf("IssueType = %v", config.IssueType)
+	}
+}
+
+func TestJiraConfig_FluentAPI(t *testing.T) {
+	config := NewJiraConfig().WithProject("OPS").WithIssueType("Bug").
+		WithLabels("alertmanager", "{{ .CommonLabels.team }}").
+		WithTransitions("To Do", "Done").
+		WithWontFixResolution("Won't Fix").
+		WithReopenDuration(24 * Hour).
+		WithFields(map[string]any{"customfield_10001": "platform"})
+
+	if len(config.Labels) != 2 {
+		t.Errorf("len(Labels) = %d, want 2", len(config.Labels))
+	}
+	if config.ReopenTransition != "To Do" || config.ResolveTransition != "Done" {
+		t.Errorf("transitions = %q, %q", config.ReopenTransition, config.ResolveTransition)
+	}
+	if config.ReopenDuration != 24*Hour {
+		t.Errorf("ReopenDuration = %v", config.ReopenDuration)
+	}
+	if config.Fields["customfield_10001"] != "platform" {
+		t.Errorf("Fields = %v", config.Fields)
+	}
+}
+
+func TestJiraConfig_Serialize(t *testing.T) {
+	config := NewJiraConfig().WithProject("OPS").WithIssueType("Bug").
+		WithAPIURL("https://example.atlassian.net/rest/api/2/").
+		WithSummary("{{ .CommonLabels.alertname }}").
+		WithReopenDuration(Hour)
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"api_url: https://example.atlassian.net/rest/api/2/",
+		"project: OPS",
+		"issue_type: Bug",
+		"summary: '{{ .CommonLabels.alertname }}'",
+		"reopen_duration: 1h",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
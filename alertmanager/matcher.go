@@ -1,6 +1,9 @@
 package alertmanager
 
-import "fmt"
+import (
+	"fmt"
+	"regexp"
+)
 
 // MatchOp represents a matcher operator.
 type MatchOp string
@@ -48,6 +51,36 @@ func (m *Matcher) String() string {
 	return fmt.Sprintf("%s%s%q", m.Label, m.Op, m.Value)
 }
 
+// Matches reports whether the matcher matches the given label set.
+// A missing label is treated as an empty value, and regular expressions are
+// fully anchored, as in Alertmanager.
+func (m *Matcher) Matches(labels map[string]string) bool {
+	value := labels[m.Label]
+	switch m.Op {
+	case MatchEqual:
+		return value == m.Value
+	case MatchNotEqual:
+		return value != m.Value
+	case MatchRegex, MatchNotRegex:
+		re, err := regexp.Compile("^(?:" + m.Value + ")$")
+		if err != nil {
+			return false
+		}
+		return re.MatchString(value) == (m.Op == MatchRegex)
+	}
+	return false
+}
+
+// matchAll reports whether all matchers match the given label set.
+func matchAll(matchers []*Matcher, labels map[string]string) bool {
+	for _, m := range matchers {
+		if !m.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
 // ParseMatcher parses a matcher string in Alertmanager format.
 // Supported formats: label="value", label!="value", label=~"regex", label!~"regex"
 func ParseMatcher(s string) (*Matcher, error) {
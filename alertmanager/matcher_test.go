@@ -235,3 +235,31 @@ func TestMatcherInSlice(t *testing.T) {
 		}
 	}
 }
+
+func TestMatcher_Matches(t *testing.T) {
+	labels := map[string]string{"severity": "critical", "team": "platform"}
+
+	tests := []struct {
+		name    string
+		matcher *Matcher
+		want    bool
+	}{
+		{"equal", Eq("severity", "critical"), true},
+		{"equal mismatch", Eq("severity", "warning"), false},
+		{"not equal", NotEq("team", "database"), true},
+		{"regex", Regex("team", "plat.*"), true},
+		{"regex is anchored", Regex("team", "plat"), false},
+		{"not regex", NotRegex("team", "data.*"), true},
+		{"missing label equals empty", Eq("env", ""), true},
+		{"missing label not equal", NotEq("env", "prod"), true},
+		{"invalid regex", Regex("team", "("), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.matcher.Matches(labels); got != tt.want {
+				t.Errorf("%s.Matches() = %v, want %v", tt.matcher, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package alertmanager
+
+// MSTeamsConfig configures notifications to Microsoft Teams via an
+// Office 365 connector webhook.
+type MSTeamsConfig struct {
+	// SendResolved determines if resolved alerts should be sent.
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// WebhookURL is the incoming webhook URL.
+	WebhookURL Secret `yaml:"webhook_url,omitempty"`
+
+	// WebhookURLFile is a file containing the incoming webhook URL.
+	WebhookURLFile string `yaml:"webhook_url_file,omitempty"`
+
+	// Title is the message title.
+	Title string `yaml:"title,omitempty"`
+
+	// Summary is the message summary shown in notifications.
+	Summary string `yaml:"summary,omitempty"`
+
+	// Text is the message body.
+	Text string `yaml:"text,omitempty"`
+
+	// HTTPConfig configures HTTP client settings.
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// MSTeamsV2Config configures notifications to Microsoft Teams via a
+// Power Automate workflow webhook.
+type MSTeamsV2Config struct {
+	// SendResolved determines if resolved alerts should be sent.
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// WebhookURL is the workflow webhook URL.
+	WebhookURL Secret `yaml:"webhook_url,omitempty"`
+
+	// WebhookURLFile is a file containing the workflow webhook URL.
+	WebhookURLFile string `yaml:"webhook_url_file,omitempty"`
+
+	// Title is the message title.
+	Title string `yaml:"title,omitempty"`
+
+	// Text is the message body.
+	Text string `yaml:"text,omitempty"`
+
+	// HTTPConfig configures HTTP client settings.
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// NewMSTeamsConfig creates a new MSTeamsConfig.
+func NewMSTeamsConfig() *MSTeamsConfig {
+	return &MSTeamsConfig{}
+}
+
+// WithSendResolved sets whether to send resolved alerts.
+func (m *MSTeamsConfig) WithSendResolved(send bool) *MSTeamsConfig {
+	m.SendResolved = &send
+	return m
+}
+
+// WithWebhookURL sets the incoming webhook URL.
+func (m *MSTeamsConfig) WithWebhookURL(url Secret) *MSTeamsConfig {
+	m.WebhookURL = url
+	return m
+}
+
+// WithWebhookURLFile sets the file containing the incoming webhook URL.
+func (m *MSTeamsConfig) WithWebhookURLFile(path string) *MSTeamsConfig {
+	m.WebhookURLFile = path
+	return m
+}
+
+// WithTitle sets the message title.
+func (m *MSTeamsConfig) WithTitle(title string) *MSTeamsConfig {
+	m.Title = title
+	return m
+}
+
+// WithSummary sets the message summary.
+func (m *MSTeamsConfig) WithSummary(summary string) *MSTeamsConfig {
+	m.Summary = summary
+	return m
+}
+
+// WithText sets the message body.
+func (m *MSTeamsConfig) WithText(text string) *MSTeamsConfig {
+	m.Text = text
+	return m
+}
+
+// WithHTTPConfig sets HTTP client configuration.
+func (m *MSTeamsConfig) WithHTTPConfig(config *HTTPConfig) *MSTeamsConfig {
+	m.HTTPConfig = config
+	return m
+}
+
+// NewMSTeamsV2Config creates a new MSTeamsV2Config.
+func NewMSTeamsV2Config() *MSTeamsV2Config {
+	return &MSTeamsV2Config{}
+}
+
+// WithSendResolved sets whether to send resolved alerts.
+func (m *MSTeamsV2Config) WithSendResolved(send bool) *MSTeamsV2Config {
+	m.SendResolved = &send
+	return m
+}
+
+// WithWebhookURL sets the workflow webhook URL.
+func (m *MSTeamsV2Config) WithWebhookURL(url Secret) *MSTeamsV2Config {
+	m.WebhookURL = url
+	return m
+}
+
+// WithWebhookURLFile sets the file containing the workflow webhook URL.
+func (m *MSTeamsV2Config) WithWebhookURLFile(path string) *MSTeamsV2Config {
+	m.WebhookURLFile = path
+	return m
+}
+
+// WithTitle sets the message title.
+func (m *MSTeamsV2Config) WithTitle(title string) *MSTeamsV2Config {
+	m.Title = title
+	return m
+}
+
+// WithText sets the message body.
+func (m *MSTeamsV2Config) WithText(text string) *MSTeamsV2Config {
+	m.Text = text
+	return m
+}
+
+// WithHTTPConfig sets HTTP client configuration.
+func (m *MSTeamsV2Config) WithHTTPConfig(config *HTTPConfig) *MSTeamsV2Config {
+	m.HTTPConfig = config
+	return m
+}
@@ -0,0 +1,85 @@
+package alertmanager
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewMSTeamsConfig(t *testing.T) {
+	config := NewMSTeamsConfig()
+	if config == nil {
+		t.Error("NewMSTeamsConfig() returned nil")
+	}
+}
+
+func TestMSTeamsConfig_FluentAPI(t *testing.T) {
+	config := NewMSTeamsConfig().
+		WithWebhookURL(NewSecret("https://outlook.office.com/webhook/xxx")).
+		WithTitle("{{ .CommonLabels.alertname }}").
+		WithSummary("{{ .CommonAnnotations.summary }}").
+		WithText("{{ .CommonAnnotations.description }}").
+		WithSendResolved(true)
+
+	if string(config.WebhookURL) != "https://outlook.office.com/webhook/xxx" {
+		t.Errorf("WebhookURL = %v", config.WebhookURL)
+	}
+	if config.Title != "{{ .CommonLabels.alertname }}" {
+		t.Errorf("Title = %v", config.Title)
+	}
+	if config.Summary != "{{ .CommonAnnotations.summary }}" {
+		t.Errorf("Summary = %v", config.Summary)
+	}
+	if config.SendResolved == nil || !*config.SendResolved {
+		t.Error("SendResolved should be true")
+	}
+}
+
+func TestMSTeamsConfig_Serialize(t *testing.T) {
+	config := NewMSTeamsConfig().
+		WithWebhookURLFile("/etc/alertmanager/secrets/teams-url").
+		WithTitle("Alert")
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"webhook_url_file: /etc/alertmanager/secrets/teams-url",
+		"title: Alert",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestMSTeamsV2Config_Serialize(t *testing.T) {
+	config := NewMSTeamsV2Config().
+		WithWebhookURL(NewSecret("https://prod.westus.logic.azure.com/workflows/xxx")).
+		WithText("{{ .CommonAnnotations.description }}").
+		WithSendResolved(false)
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"send_resolved: false",
+		"webhook_url: https://prod.westus.logic.azure.com/workflows/xxx",
+		"text: '{{ .CommonAnnotations.description }}'",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package alertmanager
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // Weekday constants.
 const (
@@ -107,6 +112,12 @@ func (t *TimeInterval) WithYearRange(start, end int) *TimeInterval {
 	return t
 }
 
+// WithLocation sets the IANA time zone the interval is evaluated in.
+func (t *TimeInterval) WithLocation(location string) *TimeInterval {
+	t.Location = location
+	return t
+}
+
 // NewTimeRange creates a new TimeRange.
 func NewTimeRange(start, end string) *TimeRange {
 	return &TimeRange{
@@ -178,3 +189,190 @@ func NightsMuteInterval() *MuteTimeInterval {
 				WithTimes(NewTimeRange("00:00", "06:00")),
 		)
 }
+
+// ContainsTime reports whether t falls within any of the interval's time intervals.
+func (m *MuteTimeInterval) ContainsTime(t time.Time) (bool, error) {
+	for i := range m.TimeIntervals {
+		ok, err := m.TimeIntervals[i].ContainsTime(t)
+		if err != nil {
+			return false, fmt.Errorf("time interval %q: %w", m.Name, err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ContainsTime reports whether t falls within the time interval.
+// Every populated field must match; empty fields match any time.
+// Times are compared in the interval's Location, or UTC if unset.
+func (t *TimeInterval) ContainsTime(tm time.Time) (bool, error) {
+	loc := time.UTC
+	if t.Location != "" {
+		var err error
+		loc, err = time.LoadLocation(t.Location)
+		if err != nil {
+			return false, fmt.Errorf("invalid location %q: %w", t.Location, err)
+		}
+	}
+	tm = tm.In(loc)
+
+	if len(t.Times) > 0 {
+		minute := tm.Hour()*60 + tm.Minute()
+		ok := false
+		for _, tr := range t.Times {
+			start, err := parseClock(tr.StartTime)
+			if err != nil {
+				return false, err
+			}
+			end, err := parseClock(tr.EndTime)
+			if err != nil {
+				return false, err
+			}
+			if minute >= start && minute < end {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+
+	if len(t.Weekdays) > 0 {
+		ok, err := inRanges(t.Weekdays, int(tm.Weekday()), parseWeekday)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	if len(t.DaysOfMonth) > 0 {
+		daysInMonth := time.Date(tm.Year(), tm.Month()+1, 0, 0, 0, 0, 0, tm.Location()).Day()
+		parseDay := func(s string) (int, error) {
+			day, err := strconv.Atoi(s)
+			if err != nil || day == 0 || day < -31 || day > 31 {
+				return 0, fmt.Errorf("invalid day of month: %q", s)
+			}
+			if day < 0 {
+				day = daysInMonth + day + 1
+			}
+			return day, nil
+		}
+		ok, err := inRanges(t.DaysOfMonth, tm.Day(), parseDay)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	if len(t.Months) > 0 {
+		ok, err := inRanges(t.Months, int(tm.Month()), parseMonth)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	if len(t.Years) > 0 {
+		parseYear := func(s string) (int, error) {
+			year, err := strconv.Atoi(s)
+			if err != nil {
+				return 0, fmt.Errorf("invalid year: %q", s)
+			}
+			return year, nil
+		}
+		ok, err := inRanges(t.Years, tm.Year(), parseYear)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// inRanges reports whether value falls within any of the given "a" or "a:b" ranges.
+func inRanges[T ~string](ranges []T, value int, parse func(string) (int, error)) (bool, error) {
+	for _, r := range ranges {
+		startStr, endStr, isRange := strings.Cut(string(r), ":")
+		start, err := parse(startStr)
+		if err != nil {
+			return false, err
+		}
+		end := start
+		if isRange {
+			if end, err = parse(endStr); err != nil {
+				return false, err
+			}
+		}
+		if value >= start && value <= end {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseClock parses an "HH:MM" time of day into minutes since midnight.
+func parseClock(s string) (int, error) {
+	hh, mm, ok := strings.Cut(s, ":")
+	if !ok {
+		return 0, fmt.Errorf("invalid time: %q", s)
+	}
+	h, err := strconv.Atoi(hh)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time: %q", s)
+	}
+	m, err := strconv.Atoi(mm)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time: %q", s)
+	}
+	minutes := h*60 + m
+	if h < 0 || m < 0 || m > 59 || minutes > 24*60 {
+		return 0, fmt.Errorf("invalid time: %q", s)
+	}
+	return minutes, nil
+}
+
+var weekdays = map[string]time.Weekday{
+	string(Sunday):    time.Sunday,
+	string(Monday):    time.Monday,
+	string(Tuesday):   time.Tuesday,
+	string(Wednesday): time.Wednesday,
+	string(Thursday):  time.Thursday,
+	string(Friday):    time.Friday,
+	string(Saturday):  time.Saturday,
+}
+
+// parseWeekday parses a weekday name.
+func parseWeekday(s string) (int, error) {
+	day, ok := weekdays[strings.ToLower(s)]
+	if !ok {
+		return 0, fmt.Errorf("invalid weekday: %q", s)
+	}
+	return int(day), nil
+}
+
+var months = map[string]time.Month{
+	string(January):   time.January,
+	string(February):  time.February,
+	string(March):     time.March,
+	string(April):     time.April,
+	string(May):       time.May,
+	string(June):      time.June,
+	string(July):      time.July,
+	string(August):    time.August,
+	string(September): time.September,
+	string(October):   time.October,
+	string(November):  time.November,
+	string(December):  time.December,
+}
+
+// parseMonth parses a month name or number (1-12).
+func parseMonth(s string) (int, error) {
+	if month, ok := months[strings.ToLower(s)]; ok {
+		return int(month), nil
+	}
+	month, err := strconv.Atoi(s)
+	if err != nil || month < 1 || month > 12 {
+		return 0, fmt.Errorf("invalid month: %q", s)
+	}
+	return month, nil
+}
@@ -3,6 +3,7 @@ package alertmanager
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -303,3 +304,83 @@ func TestMuteTimeIntervalInConfig(t *testing.T) {
 		t.Errorf("yaml.Marshal() missing business-hours\nGot:\n%s", yamlStr)
 	}
 }
+
+func TestTimeInterval_ContainsTime(t *testing.T) {
+	// 2026-01-05 is a Monday.
+	monday10 := time.Date(2026, time.January, 5, 10, 0, 0, 0, time.UTC)
+	monday18 := time.Date(2026, time.January, 5, 18, 0, 0, 0, time.UTC)
+	saturday := time.Date(2026, time.January, 10, 12, 0, 0, 0, time.UTC)
+	lastOfFeb := time.Date(2026, time.February, 28, 12, 0, 0, 0, time.UTC)
+
+	businessHours := NewTimeInterval().
+		WithWeekdayRange(Monday, Friday).
+		WithTimes(NewTimeRange("09:00", "17:00"))
+
+	tests := []struct {
+		name     string
+		interval *TimeInterval
+		time     time.Time
+		want     bool
+	}{
+		{"business hours inside", businessHours, monday10, true},
+		{"business hours evening", businessHours, monday18, false},
+		{"business hours weekend", businessHours, saturday, false},
+		{"weekends", NewTimeInterval().WithWeekdays(Saturday, Sunday), saturday, true},
+		{"empty matches all", NewTimeInterval(), monday10, true},
+		{"last day of month", NewTimeInterval().WithDaysOfMonth(DayOfMonthEnd(-1)), lastOfFeb, true},
+		{"first week", NewTimeInterval().WithDayOfMonthRange(1, 7), monday10, true},
+		{"month range", NewTimeInterval().WithMonthRange(February, March), monday10, false},
+		{"numeric month", NewTimeInterval().WithMonths("1"), monday10, true},
+		{"year range", NewTimeInterval().WithYearRange(2024, 2026), monday10, true},
+		{"end time exclusive", NewTimeInterval().WithTimes(NewTimeRange("08:00", "10:00")), monday10, false},
+		{"location", NewTimeInterval().WithTimes(NewTimeRange("10:30", "12:00")).WithLocation("Europe/Berlin"), monday10, true},
+		{"location defaults to UTC", NewTimeInterval().WithTimes(NewTimeRange("10:30", "12:00")), monday10.In(time.FixedZone("UTC+1", 3600)), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.interval.ContainsTime(tt.time)
+			if err != nil {
+				t.Fatalf("ContainsTime() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ContainsTime(%s) = %v, want %v", tt.time, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeInterval_ContainsTime_Invalid(t *testing.T) {
+	now := time.Date(2026, time.January, 5, 10, 0, 0, 0, time.UTC)
+	invalid := []*TimeInterval{
+		NewTimeInterval().WithTimes(NewTimeRange("9am", "17:00")),
+		NewTimeInterval().WithWeekdays("someday"),
+		NewTimeInterval().WithMonths("smarch"),
+		NewTimeInterval().WithDaysOfMonth("0"),
+		NewTimeInterval().WithLocation("Mars/Olympus_Mons"),
+	}
+	for _, ti := range invalid {
+		if _, err := ti.ContainsTime(now); err == nil {
+			t.Errorf("ContainsTime() with %+v expected error", ti)
+		}
+	}
+}
+
+func TestMuteTimeInterval_ContainsTime(t *testing.T) {
+	saturday := time.Date(2026, time.January, 10, 12, 0, 0, 0, time.UTC)
+	got, err := WeekendsMuteInterval().ContainsTime(saturday)
+	if err != nil {
+		t.Fatalf("ContainsTime() error = %v", err)
+	}
+	if !got {
+		t.Error("WeekendsMuteInterval should contain Saturday")
+	}
+
+	got, err = NightsMuteInterval().ContainsTime(saturday)
+	if err != nil {
+		t.Fatalf("ContainsTime() error = %v", err)
+	}
+	if got {
+		t.Error("NightsMuteInterval should not contain noon")
+	}
+}
@@ -0,0 +1,546 @@
+package alertmanager
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// EscalationChannel is a notification channel an escalation sends to.
+type EscalationChannel string
+
+// Escalation channels.
+const (
+	ChannelSlack     EscalationChannel = "slack"
+	ChannelPagerDuty EscalationChannel = "pagerduty"
+)
+
+// NullReceiver is the name of the receiver without integrations that
+// RoutingPolicy routes dropped alerts to.
+const NullReceiver = "null"
+
+// TeamDefinition describes a team's notification channels and schedule
+// for use in a RoutingPolicy.
+type TeamDefinition struct {
+	// Name is the team name, matched against the team label.
+	Name string
+
+	// Slack is the team's Slack integration.
+	Slack *SlackConfig
+
+	// PagerDuty is the team's PagerDuty integration.
+	PagerDuty *PagerDutyConfig
+
+	// BusinessHours is the team's working schedule, used by escalations
+	// restricted to business hours. Overrides RoutingPolicy.BusinessHours.
+	BusinessHours *MuteTimeInterval
+
+	// Matchers select the team's alerts. Defaults to team="<Name>".
+	Matchers []*Matcher
+
+	// Escalations overrides the policy's escalation matrix for this team.
+	Escalations []*Escalation
+}
+
+// NewTeamDefinition creates a TeamDefinition with the given name.
+func NewTeamDefinition(name string) *TeamDefinition {
+	return &TeamDefinition{Name: name}
+}
+
+// WithSlackChannel sets the team's Slack channel.
+func (t *TeamDefinition) WithSlackChannel(channel string) *TeamDefinition {
+	t.Slack = NewSlackConfig().WithChannel(channel).WithSendResolved(true)
+	return t
+}
+
+// WithSlack sets a fully configured Slack integration.
+func (t *TeamDefinition) WithSlack(config *SlackConfig) *TeamDefinition {
+	t.Slack = config
+	return t
+}
+
+// WithPagerDutyKey sets the team's PagerDuty routing key.
+func (t *TeamDefinition) WithPagerDutyKey(key Secret) *TeamDefinition {
+	t.PagerDuty = NewPagerDutyConfig().WithRoutingKey(key).WithSendResolved(true)
+	return t
+}
+
+// WithPagerDuty sets a fully configured PagerDuty integration.
+func (t *TeamDefinition) WithPagerDuty(config *PagerDutyConfig) *TeamDefinition {
+	t.PagerDuty = config
+	return t
+}
+
+// WithBusinessHours sets the team's business-hours schedule.
+func (t *TeamDefinition) WithBusinessHours(schedule *MuteTimeInterval) *TeamDefinition {
+	t.BusinessHours = schedule
+	return t
+}
+
+// WithMatchers sets the matchers selecting the team's alerts.
+func (t *TeamDefinition) WithMatchers(matchers ...*Matcher) *TeamDefinition {
+	t.Matchers = matchers
+	return t
+}
+
+// WithEscalations overrides the policy's escalation matrix for this team.
+func (t *TeamDefinition) WithEscalations(escalations ...*Escalation) *TeamDefinition {
+	t.Escalations = escalations
+	return t
+}
+
+// ReceiverName returns the name of the team's receiver for a channel.
+func (t *TeamDefinition) ReceiverName(channel EscalationChannel) string {
+	return t.Name + "-" + string(channel)
+}
+
+// Escalation is one cell of the escalation matrix: where alerts of a
+// severity, optionally in one environment, are sent.
+type Escalation struct {
+	// Severity is the severity label value this escalation applies to.
+	Severity string
+
+	// Environment restricts the escalation to one env label value.
+	// Empty matches any environment.
+	Environment string
+
+	// Channels are the channels notified. No channels drops the alerts.
+	Channels []EscalationChannel
+
+	// BusinessHoursOnly restricts notifications to the team's business hours.
+	BusinessHoursOnly bool
+
+	// GroupWait overrides the policy's group wait.
+	GroupWait Duration
+
+	// GroupInterval overrides the policy's group interval.
+	GroupInterval Duration
+
+	// RepeatInterval overrides the policy's repeat interval.
+	RepeatInterval Duration
+}
+
+// NewEscalation creates an Escalation for a severity.
+func NewEscalation(severity string) *Escalation {
+	return &Escalation{Severity: severity}
+}
+
+// InEnvironment restricts the escalation to an environment.
+func (e *Escalation) InEnvironment(env string) *Escalation {
+	e.Environment = env
+	return e
+}
+
+// ToSlack adds Slack to the escalation's channels.
+func (e *Escalation) ToSlack() *Escalation {
+	e.Channels = append(e.Channels, ChannelSlack)
+	return e
+}
+
+// ToPagerDuty adds PagerDuty to the escalation's channels.
+func (e *Escalation) ToPagerDuty() *Escalation {
+	e.Channels = append(e.Channels, ChannelPagerDuty)
+	return e
+}
+
+// DuringBusinessHours restricts notifications to the team's business hours.
+func (e *Escalation) DuringBusinessHours() *Escalation {
+	e.BusinessHoursOnly = true
+	return e
+}
+
+// WithGroupWait overrides the group wait duration.
+func (e *Escalation) WithGroupWait(d Duration) *Escalation {
+	e.GroupWait = d
+	return e
+}
+
+// WithGroupInterval overrides the group interval duration.
+func (e *Escalation) WithGroupInterval(d Duration) *Escalation {
+	e.GroupInterval = d
+	return e
+}
+
+// WithRepeatInterval overrides the repeat interval duration.
+func (e *Escalation) WithRepeatInterval(d Duration) *Escalation {
+	e.RepeatInterval = d
+	return e
+}
+
+func (e *Escalation) String() string {
+	if e.Environment == "" {
+		return fmt.Sprintf("severity=%s", e.Severity)
+	}
+	return fmt.Sprintf("severity=%s env=%s", e.Severity, e.Environment)
+}
+
+// RoutingPolicy generates a consistent routing tree, receivers, time
+// intervals and inhibit rules from team definitions and an escalation
+// matrix by severity and environment.
+//
+// Each team gets a route matching its alerts, with one child route per
+// escalation and channel. Alerts matching no escalation go to the team's
+// Slack receiver, or the default receiver if the team has none.
+type RoutingPolicy struct {
+	// DefaultReceiver receives alerts not matching any team.
+	DefaultReceiver *Receiver
+
+	// GroupBy is the root route's group_by.
+	GroupBy []string
+
+	// GroupWait is the root route's group wait.
+	GroupWait Duration
+
+	// GroupInterval is the root route's group interval.
+	GroupInterval Duration
+
+	// RepeatInterval is the root route's repeat interval.
+	RepeatInterval Duration
+
+	// BusinessHours is the default business-hours schedule for teams.
+	BusinessHours *MuteTimeInterval
+
+	// Teams are the teams alerts are routed to.
+	Teams []*TeamDefinition
+
+	// Escalations is the escalation matrix applied to every team.
+	Escalations []*Escalation
+
+	// SeverityOrder lists severities from most to least severe. Each
+	// severity inhibits the less severe ones for the same alert.
+	SeverityOrder []string
+
+	// InhibitEqual are the labels that must be equal for inhibition.
+	InhibitEqual []string
+}
+
+// NewRoutingPolicy creates a RoutingPolicy with a "default" receiver,
+// grouping by alertname, team and env, and critical > warning > info
+// inhibition.
+func NewRoutingPolicy() *RoutingPolicy {
+	return &RoutingPolicy{
+		DefaultReceiver: NewReceiver("default"),
+		GroupBy:         []string{"alertname", "team", "env"},
+		GroupWait:       30 * Second,
+		GroupInterval:   5 * Minute,
+		RepeatInterval:  4 * Hour,
+		SeverityOrder:   []string{"critical", "warning", "info"},
+		InhibitEqual:    []string{"alertname", "team", "env"},
+	}
+}
+
+// WithDefaultReceiver sets the receiver for alerts not matching any team.
+func (p *RoutingPolicy) WithDefaultReceiver(receiver *Receiver) *RoutingPolicy {
+	p.DefaultReceiver = receiver
+	return p
+}
+
+// WithGroupBy sets the root route's group_by.
+func (p *RoutingPolicy) WithGroupBy(labels ...string) *RoutingPolicy {
+	p.GroupBy = labels
+	return p
+}
+
+// WithTiming sets the root route's group wait, group interval and repeat interval.
+func (p *RoutingPolicy) WithTiming(groupWait, groupInterval, repeatInterval Duration) *RoutingPolicy {
+	p.GroupWait = groupWait
+	p.GroupInterval = groupInterval
+	p.RepeatInterval = repeatInterval
+	return p
+}
+
+// WithBusinessHours sets the default business-hours schedule.
+func (p *RoutingPolicy) WithBusinessHours(schedule *MuteTimeInterval) *RoutingPolicy {
+	p.BusinessHours = schedule
+	return p
+}
+
+// WithTeams adds teams.
+func (p *RoutingPolicy) WithTeams(teams ...*TeamDefinition) *RoutingPolicy {
+	p.Teams = append(p.Teams, teams...)
+	return p
+}
+
+// WithEscalations adds cells to the escalation matrix.
+func (p *RoutingPolicy) WithEscalations(escalations ...*Escalation) *RoutingPolicy {
+	p.Escalations = append(p.Escalations, escalations...)
+	return p
+}
+
+// WithSeverityOrder sets the severities from most to least severe.
+// Pass no severities to disable generated inhibit rules.
+func (p *RoutingPolicy) WithSeverityOrder(severities ...string) *RoutingPolicy {
+	p.SeverityOrder = severities
+	return p
+}
+
+// WithInhibitEqual sets the labels that must be equal for inhibition.
+func (p *RoutingPolicy) WithInhibitEqual(labels ...string) *RoutingPolicy {
+	p.InhibitEqual = labels
+	return p
+}
+
+// Validate reports teams and escalations that cannot be generated
+// consistently, such as an escalation to PagerDuty for a team with
+// neither a PagerDuty key nor another channel of the escalation.
+func (p *RoutingPolicy) Validate() error {
+	var errs []error
+	if p.DefaultReceiver == nil || p.DefaultReceiver.Name == "" {
+		errs = append(errs, errors.New("routing policy requires a default receiver"))
+	}
+
+	teams := make(map[string]bool)
+	schedules := make(map[string]*MuteTimeInterval)
+	for _, team := range p.Teams {
+		if team.Name == "" {
+			errs = append(errs, errors.New("team name is required"))
+			continue
+		}
+		if teams[team.Name] {
+			errs = append(errs, fmt.Errorf("team %q is defined more than once", team.Name))
+		}
+		teams[team.Name] = true
+
+		seen := make(map[string]bool)
+		for _, e := range p.escalationsFor(team) {
+			if seen[e.String()] {
+				errs = append(errs, fmt.Errorf("team %q: duplicate escalation for %s", team.Name, e))
+			}
+			seen[e.String()] = true
+
+			for _, ch := range e.Channels {
+				if ch != ChannelSlack && ch != ChannelPagerDuty {
+					errs = append(errs, fmt.Errorf("team %q: escalation %s uses unknown channel %q", team.Name, e, ch))
+				}
+			}
+			// Channels a team lacks are skipped, but an escalation left
+			// with none would silently drop the alerts.
+			if len(escalationReceivers(team, e)) == 0 {
+				errs = append(errs, fmt.Errorf("team %q: escalation %s has none of its channels %v configured", team.Name, e, e.Channels))
+			}
+
+			if e.BusinessHoursOnly {
+				schedule := p.scheduleFor(team)
+				if schedule == nil {
+					errs = append(errs, fmt.Errorf("team %q: escalation %s is restricted to business hours but no schedule is set", team.Name, e))
+				} else if other, ok := schedules[schedule.Name]; ok && other != schedule {
+					errs = append(errs, fmt.Errorf("time interval %q is defined by more than one schedule", schedule.Name))
+				} else {
+					schedules[schedule.Name] = schedule
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Route generates the routing tree.
+func (p *RoutingPolicy) Route() *Route {
+	root := NewRoute(p.defaultReceiverName()).
+		WithGroupBy(p.GroupBy...).
+		WithGroupWait(p.GroupWait).
+		WithGroupInterval(p.GroupInterval).
+		WithRepeatInterval(p.RepeatInterval)
+
+	for _, team := range p.Teams {
+		receiver := p.defaultReceiverName()
+		if team.Slack != nil {
+			receiver = team.ReceiverName(ChannelSlack)
+		}
+		teamRoute := NewRoute(receiver).WithMatchers(p.teamMatchers(team)...)
+
+		for _, e := range p.escalationsFor(team) {
+			teamRoute.Routes = append(teamRoute.Routes, p.escalationRoutes(team, e)...)
+		}
+		root.AddRoute(teamRoute)
+	}
+	return root
+}
+
+// escalationRoutes generates one route per channel of an escalation. All
+// but the last continue so that every channel is notified.
+func (p *RoutingPolicy) escalationRoutes(team *TeamDefinition, e *Escalation) []*Route {
+	matchers := []*Matcher{Severity(e.Severity)}
+	if e.Environment != "" {
+		matchers = append(matchers, Environment(e.Environment))
+	}
+
+	receivers := escalationReceivers(team, e)
+	routes := make([]*Route, len(receivers))
+	for i, receiver := range receivers {
+		route := NewRoute(receiver).
+			WithMatchers(matchers...).
+			WithGroupWait(e.GroupWait).
+			WithGroupInterval(e.GroupInterval).
+			WithRepeatInterval(e.RepeatInterval).
+			WithContinue(i < len(receivers)-1)
+		if schedule := p.scheduleFor(team); e.BusinessHoursOnly && schedule != nil {
+			route.WithActiveTimeIntervals(schedule.Name)
+		}
+		routes[i] = route
+	}
+	return routes
+}
+
+// escalationReceivers returns the receivers notified by an escalation,
+// skipping channels the team has not configured. Escalations without
+// channels go to the null receiver; escalations whose channels are all
+// unconfigured return none, leaving the alerts to the team's fallback.
+func escalationReceivers(team *TeamDefinition, e *Escalation) []string {
+	if len(e.Channels) == 0 {
+		return []string{NullReceiver}
+	}
+	var receivers []string
+	for _, ch := range e.Channels {
+		if (ch == ChannelSlack && team.Slack != nil) || (ch == ChannelPagerDuty && team.PagerDuty != nil) {
+			receivers = append(receivers, team.ReceiverName(ch))
+		}
+	}
+	return receivers
+}
+
+// Receivers generates the default receiver, each team's receivers and,
+// if any escalation drops alerts, the null receiver.
+func (p *RoutingPolicy) Receivers() []*Receiver {
+	var receivers []*Receiver
+	if p.DefaultReceiver != nil {
+		receivers = append(receivers, p.DefaultReceiver)
+	}
+
+	needsNull := false
+	for _, team := range p.Teams {
+		if team.Slack != nil {
+			receivers = append(receivers, NewReceiver(team.ReceiverName(ChannelSlack)).WithSlackConfigs(team.Slack))
+		}
+		if team.PagerDuty != nil {
+			receivers = append(receivers, NewReceiver(team.ReceiverName(ChannelPagerDuty)).WithPagerDutyConfigs(team.PagerDuty))
+		}
+		for _, e := range p.escalationsFor(team) {
+			if slices.Contains(escalationReceivers(team, e), NullReceiver) {
+				needsNull = true
+			}
+		}
+	}
+	if needsNull && p.defaultReceiverName() != NullReceiver {
+		receivers = append(receivers, NewReceiver(NullReceiver))
+	}
+	return receivers
+}
+
+// TimeIntervals generates the business-hours schedules referenced by
+// escalations, once per interval name.
+func (p *RoutingPolicy) TimeIntervals() []*MuteTimeInterval {
+	var intervals []*MuteTimeInterval
+	seen := make(map[string]bool)
+	for _, team := range p.Teams {
+		schedule := p.scheduleFor(team)
+		if schedule == nil || seen[schedule.Name] {
+			continue
+		}
+		for _, e := range p.escalationsFor(team) {
+			if e.BusinessHoursOnly {
+				intervals = append(intervals, schedule)
+				seen[schedule.Name] = true
+				break
+			}
+		}
+	}
+	return intervals
+}
+
+// InhibitRules generates rules where each severity inhibits the less
+// severe ones, following SeverityOrder.
+func (p *RoutingPolicy) InhibitRules() []*InhibitRule {
+	var rules []*InhibitRule
+	for i, source := range p.SeverityOrder {
+		for _, target := range p.SeverityOrder[i+1:] {
+			rules = append(rules, NewInhibitRule().
+				WithSourceMatchers(Severity(source)).
+				WithTargetMatchers(Severity(target)).
+				WithEqual(p.InhibitEqual...))
+		}
+	}
+	return rules
+}
+
+// Apply sets the generated route on config and adds the generated
+// receivers, time intervals and inhibit rules. Existing receivers and
+// time intervals with the same names are kept, and inhibit rules already
+// present are not added again.
+func (p *RoutingPolicy) Apply(config *AlertmanagerConfig) *AlertmanagerConfig {
+	config.Route = p.Route()
+
+	for _, r := range p.Receivers() {
+		if !slices.ContainsFunc(config.Receivers, func(existing *Receiver) bool { return existing.Name == r.Name }) {
+			config.Receivers = append(config.Receivers, r)
+		}
+	}
+	for _, ti := range p.TimeIntervals() {
+		if config.LookupTimeInterval(ti.Name) == nil {
+			config.TimeIntervals = append(config.TimeIntervals, ti)
+		}
+	}
+	for _, rule := range p.InhibitRules() {
+		if !slices.ContainsFunc(config.InhibitRules, rule.sameAs) {
+			config.InhibitRules = append(config.InhibitRules, rule)
+		}
+	}
+	return config
+}
+
+// sameAs reports whether other has the same matchers and equal labels.
+func (r *InhibitRule) sameAs(other *InhibitRule) bool {
+	sameMatcher := func(a, b *Matcher) bool { return *a == *b }
+	return maps.Equal(r.SourceMatch, other.SourceMatch) &&
+		maps.Equal(r.TargetMatch, other.TargetMatch) &&
+		slices.EqualFunc(r.SourceMatchers, other.SourceMatchers, sameMatcher) &&
+		slices.EqualFunc(r.TargetMatchers, other.TargetMatchers, sameMatcher) &&
+		slices.Equal(r.Equal, other.Equal)
+}
+
+// Config generates a complete AlertmanagerConfig from the policy.
+func (p *RoutingPolicy) Config() *AlertmanagerConfig {
+	return p.Apply(NewAlertmanagerConfig())
+}
+
+func (p *RoutingPolicy) defaultReceiverName() string {
+	if p.DefaultReceiver == nil {
+		return ""
+	}
+	return p.DefaultReceiver.Name
+}
+
+func (p *RoutingPolicy) teamMatchers(team *TeamDefinition) []*Matcher {
+	if len(team.Matchers) > 0 {
+		return team.Matchers
+	}
+	return []*Matcher{Team(team.Name)}
+}
+
+// escalationsFor returns the team's escalations with environment-specific
+// cells ahead of catch-all cells, so they take precedence.
+func (p *RoutingPolicy) escalationsFor(team *TeamDefinition) []*Escalation {
+	escalations := p.Escalations
+	if len(team.Escalations) > 0 {
+		escalations = team.Escalations
+	}
+	sorted := slices.Clone(escalations)
+	slices.SortStableFunc(sorted, func(a, b *Escalation) int {
+		switch {
+		case a.Environment != "" && b.Environment == "":
+			return -1
+		case a.Environment == "" && b.Environment != "":
+			return 1
+		}
+		return 0
+	})
+	return sorted
+}
+
+func (p *RoutingPolicy) scheduleFor(team *TeamDefinition) *MuteTimeInterval {
+	if team.BusinessHours != nil {
+		return team.BusinessHours
+	}
+	return p.BusinessHours
+}
@@ -0,0 +1,229 @@
+package alertmanager
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func testRoutingPolicy() *RoutingPolicy {
+	return NewRoutingPolicy().
+		WithBusinessHours(BusinessHoursMuteInterval()).
+		WithTeams(
+			NewTeamDefinition("platform").
+				WithSlackChannel("#platform-alerts").
+				WithPagerDutyKey(SecretFromEnv("PLATFORM_PD_KEY")),
+			NewTeamDefinition("data").
+				WithSlackChannel("#data-alerts"),
+		).
+		WithEscalations(
+			NewEscalation("critical").InEnvironment("prod").ToPagerDuty().ToSlack().WithRepeatInterval(1*Hour),
+			NewEscalation("critical").ToSlack(),
+			NewEscalation("warning").ToSlack().DuringBusinessHours(),
+			NewEscalation("info").InEnvironment("dev"),
+		)
+}
+
+func TestNewRoutingPolicy(t *testing.T) {
+	p := NewRoutingPolicy()
+	if p.DefaultReceiver == nil || p.DefaultReceiver.Name != "default" {
+		t.Errorf("DefaultReceiver = %v, want default", p.DefaultReceiver)
+	}
+	if p.GroupWait != 30*Second || p.GroupInterval != 5*Minute || p.RepeatInterval != 4*Hour {
+		t.Errorf("timing = %v/%v/%v", p.GroupWait, p.GroupInterval, p.RepeatInterval)
+	}
+	if !reflect.DeepEqual(p.SeverityOrder, []string{"critical", "warning", "info"}) {
+		t.Errorf("SeverityOrder = %v", p.SeverityOrder)
+	}
+}
+
+func TestRoutingPolicy_Routing(t *testing.T) {
+	config := testRoutingPolicy().Config()
+	monday10 := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	saturday := time.Date(2026, 1, 3, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		at     time.Time
+		want   []string
+		muted  []bool
+	}{
+		{"prod critical pages and posts", map[string]string{"team": "platform", "severity": "critical", "env": "prod"}, monday10, []string{"platform-pagerduty", "platform-slack"}, []bool{false, false}},
+		{"staging critical posts", map[string]string{"team": "platform", "severity": "critical", "env": "staging"}, monday10, []string{"platform-slack"}, []bool{false}},
+		{"team without pagerduty", map[string]string{"team": "data", "severity": "critical", "env": "prod"}, monday10, []string{"data-slack"}, []bool{false}},
+		{"warning in business hours", map[string]string{"team": "data", "severity": "warning"}, monday10, []string{"data-slack"}, []bool{false}},
+		{"warning on weekend", map[string]string{"team": "data", "severity": "warning"}, saturday, []string{"data-slack"}, []bool{true}},
+		{"dev info dropped", map[string]string{"team": "platform", "severity": "info", "env": "dev"}, monday10, []string{NullReceiver}, []bool{false}},
+		{"unmatched severity falls back to team", map[string]string{"team": "platform", "severity": "info"}, monday10, []string{"platform-slack"}, []bool{false}},
+		{"unknown team", map[string]string{"team": "unknown", "severity": "critical"}, monday10, []string{"default"}, []bool{false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := config.RouteAt(tt.labels, tt.at)
+			if err != nil {
+				t.Fatalf("RouteAt() error = %v", err)
+			}
+			var got []string
+			var muted []bool
+			for _, r := range results {
+				got = append(got, r.Receiver)
+				muted = append(muted, r.Muted)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("receivers = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(muted, tt.muted) {
+				t.Errorf("muted = %v, want %v", muted, tt.muted)
+			}
+		})
+	}
+
+	prod, _ := config.RouteAt(map[string]string{"team": "platform", "severity": "critical", "env": "prod"}, monday10)
+	if prod[0].Route.RepeatInterval != 1*Hour {
+		t.Errorf("RepeatInterval = %v, want 1h override", prod[0].Route.RepeatInterval)
+	}
+	if !reflect.DeepEqual(prod[0].Route.GroupBy, []string{"alertname", "team", "env"}) {
+		t.Errorf("GroupBy = %v, want inherited from root", prod[0].Route.GroupBy)
+	}
+}
+
+func TestRoutingPolicy_Receivers(t *testing.T) {
+	var names []string
+	for _, r := range testRoutingPolicy().Receivers() {
+		names = append(names, r.Name)
+	}
+	want := []string{"default", "platform-slack", "platform-pagerduty", "data-slack", NullReceiver}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Receivers() = %v, want %v", names, want)
+	}
+}
+
+func TestRoutingPolicy_TimeIntervals(t *testing.T) {
+	intervals := testRoutingPolicy().TimeIntervals()
+	if len(intervals) != 1 || intervals[0].Name != "business-hours" {
+		t.Errorf("TimeIntervals() = %v, want [business-hours]", intervals)
+	}
+
+	p := NewRoutingPolicy().
+		WithTeams(NewTeamDefinition("api").WithSlackChannel("#api")).
+		WithEscalations(NewEscalation("critical").ToSlack())
+	if got := p.TimeIntervals(); len(got) != 0 {
+		t.Errorf("TimeIntervals() = %v, want none without business-hours escalations", got)
+	}
+}
+
+func TestRoutingPolicy_InhibitRules(t *testing.T) {
+	rules := testRoutingPolicy().InhibitRules()
+	if len(rules) != 3 {
+		t.Fatalf("len(InhibitRules()) = %d, want 3", len(rules))
+	}
+
+	labels := func(severity string) map[string]string {
+		return map[string]string{"alertname": "HighLatency", "team": "platform", "env": "prod", "severity": severity}
+	}
+	if !rules[0].Inhibits(labels("critical"), labels("warning")) {
+		t.Error("critical should inhibit warning")
+	}
+	if !rules[2].Inhibits(labels("warning"), labels("info")) {
+		t.Error("warning should inhibit info")
+	}
+
+	if got := testRoutingPolicy().WithSeverityOrder().InhibitRules(); len(got) != 0 {
+		t.Errorf("InhibitRules() = %d rules, want none", len(got))
+	}
+}
+
+func TestRoutingPolicy_Apply(t *testing.T) {
+	existing := NewReceiver("default").WithWebhookConfigs(NewWebhookConfig().WithURL("http://example.com"))
+	config := NewAlertmanagerConfig().WithReceivers(existing)
+
+	testRoutingPolicy().Apply(config)
+
+	if config.Receivers[0] != existing {
+		t.Error("Apply() should keep existing receivers with the same name")
+	}
+	if len(config.Receivers) != 5 {
+		t.Errorf("len(Receivers) = %d, want 5", len(config.Receivers))
+	}
+	if undefined := config.UndefinedTimeIntervals(); len(undefined) != 0 {
+		t.Errorf("UndefinedTimeIntervals() = %v", undefined)
+	}
+}
+
+func TestRoutingPolicy_ApplyTwice(t *testing.T) {
+	p := testRoutingPolicy()
+	config := p.Apply(NewAlertmanagerConfig())
+	want := len(config.InhibitRules)
+	if want == 0 {
+		t.Fatal("Apply() should add inhibit rules")
+	}
+
+	p.Apply(config)
+
+	if len(config.InhibitRules) != want {
+		t.Errorf("len(InhibitRules) = %d after applying twice, want %d", len(config.InhibitRules), want)
+	}
+	if len(config.Receivers) != len(p.Receivers()) {
+		t.Errorf("len(Receivers) = %d after applying twice, want %d", len(config.Receivers), len(p.Receivers()))
+	}
+}
+
+func TestRoutingPolicy_Validate(t *testing.T) {
+	if err := testRoutingPolicy().Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	p := NewRoutingPolicy().
+		WithTeams(
+			NewTeamDefinition("api").WithSlackChannel("#api"),
+			NewTeamDefinition("api"),
+		).
+		WithEscalations(
+			NewEscalation("critical").ToPagerDuty(),
+			NewEscalation("critical").ToSlack(),
+			NewEscalation("warning").ToSlack().DuringBusinessHours(),
+		)
+	err := p.Validate()
+	if err == nil {
+		t.Fatal("Validate() should fail")
+	}
+	for _, want := range []string{
+		`team "api" is defined more than once`,
+		`team "api": escalation severity=critical has none of its channels [pagerduty] configured`,
+		`team "api": duplicate escalation for severity=critical`,
+		`team "api": escalation severity=warning is restricted to business hours but no schedule is set`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate() error missing %q\nGot: %v", want, err)
+		}
+	}
+}
+
+func TestRoutingPolicy_Serialize(t *testing.T) {
+	data, err := yaml.Marshal(testRoutingPolicy().Config())
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"receiver: default",
+		"receiver: platform-pagerduty",
+		"continue: true",
+		"active_time_intervals:",
+		"- business-hours",
+		"channel: '#platform-alerts'",
+		"routing_key: ${PLATFORM_PD_KEY}",
+		"time_intervals:",
+		"inhibit_rules:",
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
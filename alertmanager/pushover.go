@@ -0,0 +1,162 @@
+package alertmanager
+
+// PushoverConfig configures notifications to Pushover.
+type PushoverConfig struct {
+	// SendResolved determines if resolved alerts should be sent.
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// UserKey is the recipient user's key.
+	UserKey Secret `yaml:"user_key,omitempty"`
+
+	// UserKeyFile is a file containing the user key.
+	UserKeyFile string `yaml:"user_key_file,omitempty"`
+
+	// Token is the application API token.
+	Token Secret `yaml:"token,omitempty"`
+
+	// TokenFile is a file containing the application API token.
+	TokenFile string `yaml:"token_file,omitempty"`
+
+	// Title is the notification title.
+	Title string `yaml:"title,omitempty"`
+
+	// Message is the notification message.
+	Message string `yaml:"message,omitempty"`
+
+	// URL is a supplementary URL shown with the message.
+	URL string `yaml:"url,omitempty"`
+
+	// URLTitle is the title for the supplementary URL.
+	URLTitle string `yaml:"url_title,omitempty"`
+
+	// Device is the device name to send to, instead of all user devices.
+	Device string `yaml:"device,omitempty"`
+
+	// Sound is the notification sound.
+	Sound string `yaml:"sound,omitempty"`
+
+	// Priority is the notification priority (-2 to 2).
+	Priority string `yaml:"priority,omitempty"`
+
+	// Retry is how often emergency notifications are retried.
+	Retry Duration `yaml:"retry,omitempty"`
+
+	// Expire is how long emergency notifications are retried.
+	Expire Duration `yaml:"expire,omitempty"`
+
+	// TTL is how long the message is kept on devices.
+	TTL Duration `yaml:"ttl,omitempty"`
+
+	// HTML enables HTML formatting of the message.
+	HTML bool `yaml:"html,omitempty"`
+
+	// HTTPConfig configures HTTP client settings.
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// Pushover priority levels.
+const (
+	PushoverPriorityLowest    = "-2"
+	PushoverPriorityLow       = "-1"
+	PushoverPriorityNormal    = "0"
+	PushoverPriorityHigh      = "1"
+	PushoverPriorityEmergency = "2"
+)
+
+// NewPushoverConfig creates a new PushoverConfig.
+func NewPushoverConfig() *PushoverConfig {
+	return &PushoverConfig{}
+}
+
+// WithSendResolved sets whether to send resolved alerts.
+func (p *PushoverConfig) WithSendResolved(send bool) *PushoverConfig {
+	p.SendResolved = &send
+	return p
+}
+
+// WithUserKey sets the recipient user key.
+func (p *PushoverConfig) WithUserKey(key Secret) *PushoverConfig {
+	p.UserKey = key
+	return p
+}
+
+// WithUserKeyFile sets the file containing the user key.
+func (p *PushoverConfig) WithUserKeyFile(path string) *PushoverConfig {
+	p.UserKeyFile = path
+	return p
+}
+
+// WithToken sets the application API token.
+func (p *PushoverConfig) WithToken(token Secret) *PushoverConfig {
+	p.Token = token
+	return p
+}
+
+// WithTokenFile sets the file containing the application API token.
+func (p *PushoverConfig) WithTokenFile(path string) *PushoverConfig {
+	p.TokenFile = path
+	return p
+}
+
+// WithTitle sets the notification title.
+func (p *PushoverConfig) WithTitle(title string) *PushoverConfig {
+	p.Title = title
+	return p
+}
+
+// WithMessage sets the notification message.
+func (p *PushoverConfig) WithMessage(message string) *PushoverConfig {
+	p.Message = message
+	return p
+}
+
+// WithURL sets the supplementary URL and its title.
+func (p *PushoverConfig) WithURL(url, title string) *PushoverConfig {
+	p.URL = url
+	p.URLTitle = title
+	return p
+}
+
+// WithDevice sets the target device name.
+func (p *PushoverConfig) WithDevice(device string) *PushoverConfig {
+	p.Device = device
+	return p
+}
+
+// WithSound sets the notification sound.
+func (p *PushoverConfig) WithSound(sound string) *PushoverConfig {
+	p.Sound = sound
+	return p
+}
+
+// WithPriority sets the notification priority.
+func (p *PushoverConfig) WithPriority(priority string) *PushoverConfig {
+	p.Priority = priority
+	return p
+}
+
+// WithEmergencyRetry sets how often and for how long emergency
+// notifications are retried.
+func (p *PushoverConfig) WithEmergencyRetry(retry, expire Duration) *PushoverConfig {
+	p.Retry = retry
+	p.Expire = expire
+	return p
+}
+
+// WithTTL sets how long the message is kept on devices.
+func (p *PushoverConfig) WithTTL(ttl Duration) *PushoverConfig {
+	p.TTL = ttl
+	return p
+}
+
+// WithHTML sets whether the message uses HTML formatting.
+func (p *PushoverConfig) WithHTML(html bool) *PushoverConfig {
+	p.HTML = html
+	return p
+}
+
+// WithHTTPConfig sets HTTP client configuration.
+func (p *PushoverConfig) WithHTTPConfig(config *HTTPConfig) *PushoverConfig {
+	p.HTTPConfig = config
+	return p
+}
@@ -0,0 +1,67 @@
+package alertmanager
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewPushoverConfig(t *testing.T) {
+	config := NewPushoverConfig()
+	if config == nil {
+		t.Error("NewPushoverConfig() returned nil")
+	}
+}
+
+func TestPushoverConfig_FluentAPI(t *testing.T) {
+	config := NewPushoverConfig().
+		WithUserKey(NewSecret("user-key")).
+		WithToken(NewSecret("app-token")).
+		WithPriority(PushoverPriorityEmergency).
+		WithEmergencyRetry(30*Second, Hour).
+		WithURL("https://grafana.example.com", "Dashboard")
+
+	if string(config.UserKey) != "user-key" {
+		t.Errorf("UserKey = %v", config.UserKey)
+	}
+	if string(config.Token) != "app-token" {
+		t.Errorf("Token = %v", config.Token)
+	}
+	if config.Priority != "2" {
+		t.Errorf("Priority = %v", config.Priority)
+	}
+	if config.Retry != 30*Second || config.Expire != Hour {
+		t.Errorf("Retry = %v, Expire = %v", config.Retry, config.Expire)
+	}
+	if config.URLTitle != "Dashboard" {
+		t.Errorf("URLTitle = %v", config.URLTitle)
+	}
+}
+
+func TestPushoverConfig_Serialize(t *testing.T) {
+	config := NewPushoverConfig().
+		WithUserKeyFile("/etc/alertmanager/secrets/pushover-user").
+		WithTokenFile("/etc/alertmanager/secrets/pushover-token").
+		WithPriority(PushoverPriorityHigh).
+		WithTTL(Hour)
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"user_key_file: /etc/alertmanager/secrets/pushover-user",
+		"token_file: /etc/alertmanager/secrets/pushover-token",
+		`priority: "1"`,
+		"ttl: 1h",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
@@ -30,6 +30,72 @@ func (r *Receiver) WithOpsGenieConfigs(configs ...*OpsGenieConfig) *Receiver {
 	return r
 }
 
+// WithMSTeamsConfigs adds Microsoft Teams notification configurations.
+func (r *Receiver) WithMSTeamsConfigs(configs ...*MSTeamsConfig) *Receiver {
+	r.MSTeamsConfigs = configs
+	return r
+}
+
+// WithMSTeamsV2Configs adds Microsoft Teams (Power Automate) notification configurations.
+func (r *Receiver) WithMSTeamsV2Configs(configs ...*MSTeamsV2Config) *Receiver {
+	r.MSTeamsV2Configs = configs
+	return r
+}
+
+// WithTelegramConfigs adds Telegram notification configurations.
+func (r *Receiver) WithTelegramConfigs(configs ...*TelegramConfig) *Receiver {
+	r.TelegramConfigs = configs
+	return r
+}
+
+// WithDiscordConfigs adds Discord notification configurations.
+func (r *Receiver) WithDiscordConfigs(configs ...*DiscordConfig) *Receiver {
+	r.DiscordConfigs = configs
+	return r
+}
+
+// WithSNSConfigs adds AWS SNS notification configurations.
+func (r *Receiver) WithSNSConfigs(configs ...*SNSConfig) *Receiver {
+	r.SNSConfigs = configs
+	return r
+}
+
+// WithWebexConfigs adds Webex notification configurations.
+func (r *Receiver) WithWebexConfigs(configs ...*WebexConfig) *Receiver {
+	r.WebexConfigs = configs
+	return r
+}
+
+// WithVictorOpsConfigs adds VictorOps notification configurations.
+func (r *Receiver) WithVictorOpsConfigs(configs ...*VictorOpsConfig) *Receiver {
+	r.VictorOpsConfigs = configs
+	return r
+}
+
+// WithPushoverConfigs adds Pushover notification configurations.
+func (r *Receiver) WithPushoverConfigs(configs ...*PushoverConfig) *Receiver {
+	r.PushoverConfigs = configs
+	return r
+}
+
+// WithWeChatConfigs adds WeChat notification configurations.
+func (r *Receiver) WithWeChatConfigs(configs ...*WeChatConfig) *Receiver {
+	r.WeChatConfigs = configs
+	return r
+}
+
+// WithJiraConfigs adds Jira notification configurations.
+func (r *Receiver) WithJiraConfigs(configs ...*JiraConfig) *Receiver {
+	r.JiraConfigs = configs
+	return r
+}
+
+// WithRocketChatConfigs adds Rocket.Chat notification configurations.
+func (r *Receiver) WithRocketChatConfigs(configs ...*RocketChatConfig) *Receiver {
+	r.RocketChatConfigs = configs
+	return r
+}
+
 // SlackReceiver creates a Receiver with a single Slack configuration.
 func SlackReceiver(name, channel string) *Receiver {
 	return NewReceiver(name).WithSlackConfigs(
@@ -78,3 +144,66 @@ func OpsGenieReceiverFromFile(name, keyFile string) *Receiver {
 		NewOpsGenieConfig().WithAPIKeyFile(keyFile),
 	)
 }
+
+// MSTeamsReceiver creates a Receiver with a single Microsoft Teams configuration.
+func MSTeamsReceiver(name string, webhookURL Secret) *Receiver {
+	return NewReceiver(name).WithMSTeamsConfigs(
+		NewMSTeamsConfig().WithWebhookURL(webhookURL),
+	)
+}
+
+// TelegramReceiver creates a Receiver with a single Telegram configuration.
+func TelegramReceiver(name string, botToken Secret, chatID int64) *Receiver {
+	return NewReceiver(name).WithTelegramConfigs(
+		NewTelegramConfig().WithBotToken(botToken).WithChatID(chatID),
+	)
+}
+
+// DiscordReceiver creates a Receiver with a single Discord configuration.
+func DiscordReceiver(name string, webhookURL Secret) *Receiver {
+	return NewReceiver(name).WithDiscordConfigs(
+		NewDiscordConfig().WithWebhookURL(webhookURL),
+	)
+}
+
+// SNSReceiver creates a Receiver with a single AWS SNS configuration.
+func SNSReceiver(name, topicARN string) *Receiver {
+	return NewReceiver(name).WithSNSConfigs(
+		NewSNSConfig().WithTopicARN(topicARN),
+	)
+}
+
+// WebexReceiver creates a Receiver with a single Webex configuration.
+func WebexReceiver(name, roomID string) *Receiver {
+	return NewReceiver(name).WithWebexConfigs(
+		NewWebexConfig().WithRoomID(roomID),
+	)
+}
+
+// VictorOpsReceiver creates a Receiver with a single VictorOps configuration.
+func VictorOpsReceiver(name, routingKey string, apiKey Secret) *Receiver {
+	return NewReceiver(name).WithVictorOpsConfigs(
+		NewVictorOpsConfig().WithRoutingKey(routingKey).WithAPIKey(apiKey),
+	)
+}
+
+// PushoverReceiver creates a Receiver with a single Pushover configuration.
+func PushoverReceiver(name string, userKey, token Secret) *Receiver {
+	return NewReceiver(name).WithPushoverConfigs(
+		NewPushoverConfig().WithUserKey(userKey).WithToken(token),
+	)
+}
+
+// JiraReceiver creates a Receiver with a single Jira configuration.
+func JiraReceiver(name, project, issueType string) *Receiver {
+	return NewReceiver(name).WithJiraConfigs(
+		NewJiraConfig().WithProject(project).WithIssueType(issueType),
+	)
+}
+
+// RocketChatReceiver creates a Receiver with a single Rocket.Chat configuration.
+func RocketChatReceiver(name, channel string) *Receiver {
+	return NewReceiver(name).WithRocketChatConfigs(
+		NewRocketChatConfig().WithChannel(channel),
+	)
+}
@@ -0,0 +1,193 @@
+package alertmanager
+
+// RocketChatConfig configures notifications to Rocket.Chat.
+type RocketChatConfig struct {
+	// SendResolved determines if resolved alerts should be sent.
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// APIURL is the Rocket.Chat server URL.
+	APIURL string `yaml:"api_url,omitempty"`
+
+	// Channel is the channel or user to send messages to.
+	Channel string `yaml:"channel,omitempty"`
+
+	// Token is the personal access token.
+	Token Secret `yaml:"token,omitempty"`
+
+	// TokenFile is a file containing the personal access token.
+	TokenFile string `yaml:"token_file,omitempty"`
+
+	// TokenID is the user ID the access token belongs to.
+	TokenID Secret `yaml:"token_id,omitempty"`
+
+	// TokenIDFile is a file containing the token user ID.
+	TokenIDFile string `yaml:"token_id_file,omitempty"`
+
+	// Color is the message attachment color.
+	Color string `yaml:"color,omitempty"`
+
+	// Emoji is the emoji to use as the bot avatar.
+	Emoji string `yaml:"emoji,omitempty"`
+
+	// IconURL is a URL to an image to use as the bot avatar.
+	IconURL string `yaml:"icon_url,omitempty"`
+
+	// Title is the message title.
+	Title string `yaml:"title,omitempty"`
+
+	// TitleLink is a URL to link the title to.
+	TitleLink string `yaml:"title_link,omitempty"`
+
+	// Text is the main message text.
+	Text string `yaml:"text,omitempty"`
+
+	// Fields are additional fields to display.
+	Fields []*RocketChatField `yaml:"fields,omitempty"`
+
+	// ShortFields determines if fields should be displayed side by side.
+	ShortFields bool `yaml:"short_fields,omitempty"`
+
+	// ImageURL is a URL to an image to include in the message.
+	ImageURL string `yaml:"image_url,omitempty"`
+
+	// ThumbURL is a URL to a thumbnail image.
+	ThumbURL string `yaml:"thumb_url,omitempty"`
+
+	// LinkNames enables @mentions in the message.
+	LinkNames bool `yaml:"link_names,omitempty"`
+
+	// Actions are interactive buttons.
+	Actions []*RocketChatAction `yaml:"actions,omitempty"`
+
+	// HTTPConfig configures HTTP client settings.
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// RocketChatField represents a field in a Rocket.Chat message attachment.
+type RocketChatField struct {
+	// Title is the field title.
+	Title string `yaml:"title,omitempty"`
+
+	// Value is the field value.
+	Value string `yaml:"value,omitempty"`
+
+	// Short determines if the field should be displayed side by side.
+	Short *bool `yaml:"short,omitempty"`
+}
+
+// RocketChatAction represents an interactive button in a Rocket.Chat message.
+type RocketChatAction struct {
+	// Type is the action type (usually "button").
+	Type string `yaml:"type,omitempty"`
+
+	// Text is the button label.
+	Text string `yaml:"text,omitempty"`
+
+	// URL is the URL to open when clicked.
+	URL string `yaml:"url,omitempty"`
+
+	// Msg is the message to send when clicked.
+	Msg string `yaml:"msg,omitempty"`
+}
+
+// NewRocketChatConfig creates a new RocketChatConfig.
+func NewRocketChatConfig() *RocketChatConfig {
+	return &RocketChatConfig{}
+}
+
+// WithSendResolved sets whether to send resolved alerts.
+func (r *RocketChatConfig) WithSendResolved(send bool) *RocketChatConfig {
+	r.SendResolved = &send
+	return r
+}
+
+// WithAPIURL sets the Rocket.Chat server URL.
+func (r *RocketChatConfig) WithAPIURL(url string) *RocketChatConfig {
+	r.APIURL = url
+	return r
+}
+
+// WithChannel sets the channel to send messages to.
+func (r *RocketChatConfig) WithChannel(channel string) *RocketChatConfig {
+	r.Channel = channel
+	return r
+}
+
+// WithToken sets the personal access token and its user ID.
+func (r *RocketChatConfig) WithToken(tokenID, token Secret) *RocketChatConfig {
+	r.TokenID = tokenID
+	r.Token = token
+	return r
+}
+
+// WithTokenFiles sets the files containing the token user ID and access token.
+func (r *RocketChatConfig) WithTokenFiles(tokenIDFile, tokenFile string) *RocketChatConfig {
+	r.TokenIDFile = tokenIDFile
+	r.TokenFile = tokenFile
+	return r
+}
+
+// WithTitle sets the message title.
+func (r *RocketChatConfig) WithTitle(title string) *RocketChatConfig {
+	r.Title = title
+	return r
+}
+
+// WithText sets the message text.
+func (r *RocketChatConfig) WithText(text string) *RocketChatConfig {
+	r.Text = text
+	return r
+}
+
+// WithColor sets the attachment color.
+func (r *RocketChatConfig) WithColor(color string) *RocketChatConfig {
+	r.Color = color
+	return r
+}
+
+// WithEmoji sets the bot avatar emoji.
+func (r *RocketChatConfig) WithEmoji(emoji string) *RocketChatConfig {
+	r.Emoji = emoji
+	return r
+}
+
+// WithFields adds fields to the message.
+func (r *RocketChatConfig) WithFields(fields ...*RocketChatField) *RocketChatConfig {
+	r.Fields = fields
+	return r
+}
+
+// WithActions adds interactive actions (buttons).
+func (r *RocketChatConfig) WithActions(actions ...*RocketChatAction) *RocketChatConfig {
+	r.Actions = actions
+	return r
+}
+
+// WithHTTPConfig sets HTTP client configuration.
+func (r *RocketChatConfig) WithHTTPConfig(config *HTTPConfig) *RocketChatConfig {
+	r.HTTPConfig = config
+	return r
+}
+
+// NewRocketChatField creates a new RocketChatField.
+func NewRocketChatField(title, value string) *RocketChatField {
+	return &RocketChatField{
+		Title: title,
+		Value: value,
+	}
+}
+
+// WithShort sets whether the field should be displayed side by side.
+func (f *RocketChatField) WithShort(short bool) *RocketChatField {
+	f.Short = &short
+	return f
+}
+
+// NewRocketChatAction creates a new RocketChatAction button.
+func NewRocketChatAction(text, url string) *RocketChatAction {
+	return &RocketChatAction{
+		Type: "button",
+		Text: text,
+		URL:  url,
+	}
+}
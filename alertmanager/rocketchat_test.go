@@ -0,0 +1,63 @@
+package alertmanager
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewRocketChatConfig(t *testing.T) {
+	config := NewRocketChatConfig()
+	if config == nil {
+		t.Error("NewRocketChatConfig() returned nil")
+	}
+}
+
+func TestRocketChatConfig_FluentAPI(t *testing.T) {
+	config := NewRocketChatConfig().
+		WithChannel("#alerts").
+		WithToken(NewSecret("user-id"), NewSecret("token")).
+		WithTitle("{{ .CommonLabels.alertname }}").
+		WithFields(NewRocketChatField("Severity", "{{ .CommonLabels.severity }}").WithShort(true)).
+		WithActions(NewRocketChatAction("Runbook", "https://runbooks.example.com"))
+
+	if config.Channel != "#alerts" {
+		t.Errorf("Channel = %v", config.Channel)
+	}
+	if string(config.TokenID) != "user-id" || string(config.Token) != "token" {
+		t.Errorf("TokenID = %v, Token = %v", config.TokenID, config.Token)
+	}
+	if len(config.Fields) != 1 || config.Fields[0].Short == nil || !*config.Fields[0].Short {
+		t.Errorf("Fields = %+v", config.Fields)
+	}
+	if len(config.Actions) != 1 || config.Actions[0].Type != "button" {
+		t.Errorf("Actions = %+v", config.Actions)
+	}
+}
+
+func TestRocketChatConfig_Serialize(t *testing.T) {
+	config := NewRocketChatConfig().
+		WithAPIURL("https://chat.example.com").
+		WithChannel("#alerts").
+		WithTokenFiles("/etc/alertmanager/secrets/rc-id", "/etc/alertmanager/secrets/rc-token")
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"api_url: https://chat.example.com",
+		"channel: '#alerts'",
+		"token_id_file: /etc/alertmanager/secrets/rc-id",
+		"token_file: /etc/alertmanager/secrets/rc-token",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
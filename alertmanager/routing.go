@@ -0,0 +1,188 @@
+package alertmanager
+
+import (
+	"fmt"
+	"time"
+)
+
+// Match returns the routes an alert with the given labels is routed to,
+// following Alertmanager's routing semantics.
+//
+// A route matches when all of its matchers match. Child routes are evaluated
+// in order; evaluation stops at the first matching child unless that child
+// has Continue set. If no child matches, the route itself is returned.
+//
+// The returned routes are copies of the matching nodes with Receiver,
+// GroupBy, GroupWait, GroupInterval and RepeatInterval inherited from their
+// ancestors where unset. Time intervals are not inherited.
+func (r *Route) Match(labels map[string]string) []*Route {
+	return r.match(labels, nil)
+}
+
+// match implements Match, resolving inherited settings from parent.
+func (r *Route) match(labels map[string]string, parent *Route) []*Route {
+	if !matchAll(r.Matchers, labels) {
+		return nil
+	}
+
+	effective := r.inherit(parent)
+
+	var matches []*Route
+	for _, child := range r.Routes {
+		childMatches := child.match(labels, effective)
+		matches = append(matches, childMatches...)
+		if len(childMatches) > 0 && !child.Continue {
+			break
+		}
+	}
+
+	if len(matches) == 0 {
+		matches = append(matches, effective)
+	}
+	return matches
+}
+
+// inherit returns a copy of the route with unset options taken from parent.
+func (r *Route) inherit(parent *Route) *Route {
+	effective := *r
+	if parent == nil {
+		return &effective
+	}
+	if effective.Receiver == "" {
+		effective.Receiver = parent.Receiver
+	}
+	if effective.GroupBy == nil {
+		effective.GroupBy = parent.GroupBy
+	}
+	if effective.GroupWait == 0 {
+		effective.GroupWait = parent.GroupWait
+	}
+	if effective.GroupInterval == 0 {
+		effective.GroupInterval = parent.GroupInterval
+	}
+	if effective.RepeatInterval == 0 {
+		effective.RepeatInterval = parent.RepeatInterval
+	}
+	return &effective
+}
+
+// Receivers returns the receiver names an alert with the given labels is routed to.
+func (r *Route) Receivers(labels map[string]string) []string {
+	matches := r.Match(labels)
+	receivers := make([]string, len(matches))
+	for i, m := range matches {
+		receivers[i] = m.Receiver
+	}
+	return receivers
+}
+
+// RouteResult is the outcome of routing an alert to a single route at a given time.
+type RouteResult struct {
+	// Route is the matching route with inherited settings resolved.
+	Route *Route `json:"route"`
+
+	// Receiver is the name of the receiver notified by the route.
+	Receiver string `json:"receiver"`
+
+	// Muted reports whether notifications are suppressed at the evaluated time.
+	Muted bool `json:"muted"`
+
+	// MuteReason explains why the route is muted.
+	MuteReason string `json:"mute_reason,omitempty"`
+}
+
+// RouteAt routes an alert with the given labels through the configuration's
+// routing tree and evaluates each matching route's mute and active time
+// intervals at time t.
+func (c *AlertmanagerConfig) RouteAt(labels map[string]string, t time.Time) ([]*RouteResult, error) {
+	if c.Route == nil {
+		return nil, fmt.Errorf("config has no root route")
+	}
+
+	var results []*RouteResult
+	for _, route := range c.Route.Match(labels) {
+		result := &RouteResult{Route: route, Receiver: route.Receiver}
+
+		for _, name := range route.MuteTimeIntervals {
+			active, err := c.intervalContains(name, t)
+			if err != nil {
+				return nil, err
+			}
+			if active {
+				result.Muted = true
+				result.MuteReason = fmt.Sprintf("within mute time interval %q", name)
+				break
+			}
+		}
+
+		if !result.Muted && len(route.ActiveTimeIntervals) > 0 {
+			active := false
+			for _, name := range route.ActiveTimeIntervals {
+				ok, err := c.intervalContains(name, t)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					active = true
+					break
+				}
+			}
+			if !active {
+				result.Muted = true
+				result.MuteReason = fmt.Sprintf("outside active time intervals %v", route.ActiveTimeIntervals)
+			}
+		}
+
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// intervalContains reports whether the named time interval contains t.
+func (c *AlertmanagerConfig) intervalContains(name string, t time.Time) (bool, error) {
+	interval := c.LookupTimeInterval(name)
+	if interval == nil {
+		return false, fmt.Errorf("time interval %q is not defined", name)
+	}
+	return interval.ContainsTime(t)
+}
+
+// LookupTimeInterval returns the named interval from TimeIntervals or
+// MuteTimeIntervals, or nil if it is not defined.
+func (c *AlertmanagerConfig) LookupTimeInterval(name string) *MuteTimeInterval {
+	for _, ti := range c.TimeIntervals {
+		if ti.Name == name {
+			return ti
+		}
+	}
+	for _, mti := range c.MuteTimeIntervals {
+		if mti.Name == name {
+			return mti
+		}
+	}
+	return nil
+}
+
+// UndefinedTimeIntervals returns the time interval names referenced by
+// routes that are not defined in TimeIntervals or MuteTimeIntervals.
+func (c *AlertmanagerConfig) UndefinedTimeIntervals() []string {
+	var undefined []string
+	seen := make(map[string]bool)
+	var walk func(r *Route)
+	walk = func(r *Route) {
+		names := append(append([]string{}, r.MuteTimeIntervals...), r.ActiveTimeIntervals...)
+		for _, name := range names {
+			if !seen[name] && c.LookupTimeInterval(name) == nil {
+				undefined = append(undefined, name)
+			}
+			seen[name] = true
+		}
+		for _, child := range r.Routes {
+			walk(child)
+		}
+	}
+	if c.Route != nil {
+		walk(c.Route)
+	}
+	return undefined
+}
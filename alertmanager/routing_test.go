@@ -0,0 +1,224 @@
+package alertmanager
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func testRoutingTree() *Route {
+	return NewRoute("default").
+		WithGroupBy("alertname", "cluster").
+		WithGroupWait(30*Second).
+		WithRepeatInterval(4*Hour).
+		WithRoutes(
+			NewRoute("pagerduty").
+				Severity("critical").
+				WithGroupWait(10*Second).
+				WithContinue(true),
+			NewRoute("platform-slack").
+				Team("platform").
+				WithRoutes(
+					NewRoute("platform-db").
+						WithMatchers(Regex("service", "postgres|mysql")).
+						WithGroupBy("alertname", "instance"),
+				),
+			NewRoute("database-slack").
+				Team("database"),
+		)
+}
+
+func TestRoute_Match(t *testing.T) {
+	root := testRoutingTree()
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   []string
+	}{
+		{
+			name:   "no child matches falls back to root",
+			labels: map[string]string{"team": "frontend"},
+			want:   []string{"default"},
+		},
+		{
+			name:   "first matching child wins",
+			labels: map[string]string{"team": "platform"},
+			want:   []string{"platform-slack"},
+		},
+		{
+			name:   "continue evaluates later siblings",
+			labels: map[string]string{"severity": "critical", "team": "database"},
+			want:   []string{"pagerduty", "database-slack"},
+		},
+		{
+			name:   "continue only route matched",
+			labels: map[string]string{"severity": "critical"},
+			want:   []string{"pagerduty"},
+		},
+		{
+			name:   "nested child match",
+			labels: map[string]string{"team": "platform", "service": "postgres"},
+			want:   []string{"platform-db"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := root.Receivers(tt.labels)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Receivers(%v) = %v, want %v", tt.labels, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoute_Match_Inheritance(t *testing.T) {
+	root := testRoutingTree()
+
+	matches := root.Match(map[string]string{"team": "platform", "service": "mysql"})
+	if len(matches) != 1 {
+		t.Fatalf("len(Match()) = %d, want 1", len(matches))
+	}
+	m := matches[0]
+	if !slices.Equal(m.GroupBy, []string{"alertname", "instance"}) {
+		t.Errorf("GroupBy = %v, want own [alertname instance]", m.GroupBy)
+	}
+	if m.GroupWait != 30*Second {
+		t.Errorf("GroupWait = %v, want inherited 30s", m.GroupWait)
+	}
+	if m.RepeatInterval != 4*Hour {
+		t.Errorf("RepeatInterval = %v, want inherited 4h", m.RepeatInterval)
+	}
+
+	matches = root.Match(map[string]string{"team": "database"})
+	if !slices.Equal(matches[0].GroupBy, []string{"alertname", "cluster"}) {
+		t.Errorf("GroupBy = %v, want inherited [alertname cluster]", matches[0].GroupBy)
+	}
+
+	matches = root.Match(map[string]string{"severity": "critical"})
+	if matches[0].GroupWait != 10*Second {
+		t.Errorf("GroupWait = %v, want own 10s", matches[0].GroupWait)
+	}
+}
+
+func TestRoute_Match_ReceiverInherited(t *testing.T) {
+	root := NewRoute("default").WithRoutes(
+		&Route{Matchers: []*Matcher{Eq("team", "platform")}},
+	)
+	got := root.Receivers(map[string]string{"team": "platform"})
+	if !slices.Equal(got, []string{"default"}) {
+		t.Errorf("Receivers() = %v, want [default]", got)
+	}
+}
+
+func TestRoute_Match_DoesNotModifyTree(t *testing.T) {
+	root := testRoutingTree()
+	root.Match(map[string]string{"team": "database"})
+	if root.Routes[2].GroupBy != nil {
+		t.Errorf("Match() modified route GroupBy = %v", root.Routes[2].GroupBy)
+	}
+}
+
+func TestAlertmanagerConfig_RouteAt(t *testing.T) {
+	config := NewAlertmanagerConfig().
+		WithRoute(NewRoute("default").WithRoutes(
+			NewRoute("oncall").
+				Severity("warning").
+				WithMuteTimeIntervals("weekends"),
+			NewRoute("office").
+				Team("platform").
+				WithActiveTimeIntervals("business-hours"),
+		)).
+		WithMuteTimeIntervals(WeekendsMuteInterval(), BusinessHoursMuteInterval())
+
+	// 2026-01-05 is a Monday, 2026-01-10 a Saturday.
+	monday10 := time.Date(2026, time.January, 5, 10, 0, 0, 0, time.UTC)
+	monday20 := time.Date(2026, time.January, 5, 20, 0, 0, 0, time.UTC)
+	saturday := time.Date(2026, time.January, 10, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		labels    map[string]string
+		at        time.Time
+		wantMuted bool
+	}{
+		{"mute interval inactive", map[string]string{"severity": "warning"}, monday10, false},
+		{"mute interval active", map[string]string{"severity": "warning"}, saturday, true},
+		{"inside active interval", map[string]string{"team": "platform"}, monday10, false},
+		{"outside active interval", map[string]string{"team": "platform"}, monday20, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := config.RouteAt(tt.labels, tt.at)
+			if err != nil {
+				t.Fatalf("RouteAt() error = %v", err)
+			}
+			if len(results) != 1 {
+				t.Fatalf("len(results) = %d, want 1", len(results))
+			}
+			if results[0].Muted != tt.wantMuted {
+				t.Errorf("Muted = %v, want %v (%s)", results[0].Muted, tt.wantMuted, results[0].MuteReason)
+			}
+			if tt.wantMuted && results[0].MuteReason == "" {
+				t.Error("expected MuteReason for muted route")
+			}
+		})
+	}
+}
+
+func TestAlertmanagerConfig_RouteAt_UndefinedInterval(t *testing.T) {
+	config := NewAlertmanagerConfig().
+		WithRoute(NewRoute("default").WithMuteTimeIntervals("holidays"))
+
+	_, err := config.RouteAt(map[string]string{}, time.Now())
+	if err == nil {
+		t.Error("expected error for undefined time interval")
+	}
+}
+
+func TestAlertmanagerConfig_RouteAt_NoRoute(t *testing.T) {
+	_, err := NewAlertmanagerConfig().RouteAt(map[string]string{}, time.Now())
+	if err == nil {
+		t.Error("expected error for config without route")
+	}
+}
+
+func TestAlertmanagerConfig_RouteAt_TimeIntervals(t *testing.T) {
+	config := NewAlertmanagerConfig().
+		WithRoute(NewRoute("default").WithActiveTimeIntervals("office")).
+		WithTimeIntervals(NewMuteTimeInterval("office").WithTimeIntervals(
+			NewTimeInterval().
+				WithWeekdayRange(Monday, Friday).
+				WithTimes(NewTimeRange("09:00", "17:00")).
+				WithLocation("America/New_York"),
+		))
+
+	// 15:00 UTC on a Monday is 10:00 in New York.
+	at := time.Date(2026, time.January, 5, 15, 0, 0, 0, time.UTC)
+	results, err := config.RouteAt(map[string]string{}, at)
+	if err != nil {
+		t.Fatalf("RouteAt() error = %v", err)
+	}
+	if results[0].Muted {
+		t.Errorf("Muted = true, want false (%s)", results[0].MuteReason)
+	}
+}
+
+func TestAlertmanagerConfig_UndefinedTimeIntervals(t *testing.T) {
+	config := NewAlertmanagerConfig().
+		WithRoute(NewRoute("default").
+			WithMuteTimeIntervals("weekends").
+			WithRoutes(
+				NewRoute("oncall").WithActiveTimeIntervals("office", "holidays"),
+				NewRoute("pager").WithMuteTimeIntervals("holidays"),
+			)).
+		WithMuteTimeIntervals(WeekendsMuteInterval()).
+		WithTimeIntervals(NewMuteTimeInterval("office"))
+
+	got := config.UndefinedTimeIntervals()
+	if !slices.Equal(got, []string{"holidays"}) {
+		t.Errorf("UndefinedTimeIntervals() = %v, want [holidays]", got)
+	}
+}
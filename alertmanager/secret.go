@@ -24,7 +24,8 @@ func NewSecret(value string) Secret {
 }
 
 // SecretFromEnv creates a Secret reference from an environment variable.
-// The actual value should be loaded at runtime.
+// The reference is resolved at build time according to the --secrets mode;
+// see the secrets package.
 func SecretFromEnv(envVar string) Secret {
 	return Secret("${" + envVar + "}")
 }
@@ -0,0 +1,142 @@
+package alertmanager
+
+// SNSConfig configures notifications to AWS Simple Notification Service.
+// Exactly one of TopicARN, PhoneNumber or TargetARN should be set.
+type SNSConfig struct {
+	// SendResolved determines if resolved alerts should be sent.
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// APIURL is the SNS API URL. Defaults to the regional endpoint.
+	APIURL string `yaml:"api_url,omitempty"`
+
+	// SigV4 configures AWS request signing.
+	SigV4 *SigV4Config `yaml:"sigv4,omitempty"`
+
+	// TopicARN is the SNS topic to publish to.
+	TopicARN string `yaml:"topic_arn,omitempty"`
+
+	// PhoneNumber is the phone number to send an SMS to.
+	PhoneNumber string `yaml:"phone_number,omitempty"`
+
+	// TargetARN is the mobile platform endpoint to publish to.
+	TargetARN string `yaml:"target_arn,omitempty"`
+
+	// Subject is the message subject for email subscriptions.
+	Subject string `yaml:"subject,omitempty"`
+
+	// Message is the message body.
+	Message string `yaml:"message,omitempty"`
+
+	// Attributes are SNS message attributes.
+	Attributes map[string]string `yaml:"attributes,omitempty"`
+
+	// HTTPConfig configures HTTP client settings.
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// SigV4Config configures AWS Signature Version 4 request signing.
+type SigV4Config struct {
+	// Region is the AWS region.
+	Region string `yaml:"region,omitempty"`
+
+	// AccessKey is the AWS access key ID.
+	AccessKey string `yaml:"access_key,omitempty"`
+
+	// SecretKey is the AWS secret access key.
+	SecretKey Secret `yaml:"secret_key,omitempty"`
+
+	// Profile is the named AWS profile to use.
+	Profile string `yaml:"profile,omitempty"`
+
+	// RoleARN is an IAM role to assume.
+	RoleARN string `yaml:"role_arn,omitempty"`
+}
+
+// NewSNSConfig creates a new SNSConfig.
+func NewSNSConfig() *SNSConfig {
+	return &SNSConfig{}
+}
+
+// WithSendResolved sets whether to send resolved alerts.
+func (s *SNSConfig) WithSendResolved(send bool) *SNSConfig {
+	s.SendResolved = &send
+	return s
+}
+
+// WithAPIURL sets the SNS API URL.
+func (s *SNSConfig) WithAPIURL(url string) *SNSConfig {
+	s.APIURL = url
+	return s
+}
+
+// WithSigV4 sets AWS request signing configuration.
+func (s *SNSConfig) WithSigV4(sigv4 *SigV4Config) *SNSConfig {
+	s.SigV4 = sigv4
+	return s
+}
+
+// WithTopicARN sets the SNS topic ARN.
+func (s *SNSConfig) WithTopicARN(arn string) *SNSConfig {
+	s.TopicARN = arn
+	return s
+}
+
+// WithPhoneNumber sets the SMS phone number.
+func (s *SNSConfig) WithPhoneNumber(number string) *SNSConfig {
+	s.PhoneNumber = number
+	return s
+}
+
+// WithTargetARN sets the mobile platform endpoint ARN.
+func (s *SNSConfig) WithTargetARN(arn string) *SNSConfig {
+	s.TargetARN = arn
+	return s
+}
+
+// WithSubject sets the message subject.
+func (s *SNSConfig) WithSubject(subject string) *SNSConfig {
+	s.Subject = subject
+	return s
+}
+
+// WithMessage sets the message body.
+func (s *SNSConfig) WithMessage(message string) *SNSConfig {
+	s.Message = message
+	return s
+}
+
+// WithAttributes sets SNS message attributes.
+func (s *SNSConfig) WithAttributes(attrs map[string]string) *SNSConfig {
+	s.Attributes = attrs
+	return s
+}
+
+// WithHTTPConfig sets HTTP client configuration.
+func (s *SNSConfig) WithHTTPConfig(config *HTTPConfig) *SNSConfig {
+	s.HTTPConfig = config
+	return s
+}
+
+// NewSigV4Config creates a new SigV4Config for the given region.
+func NewSigV4Config(region string) *SigV4Config {
+	return &SigV4Config{Region: region}
+}
+
+// WithAccessKey sets static AWS credentials.
+func (s *SigV4Config) WithAccessKey(accessKey string, secretKey Secret) *SigV4Config {
+	s.AccessKey = accessKey
+	s.SecretKey = secretKey
+	return s
+}
+
+// WithProfile sets the named AWS profile.
+func (s *SigV4Config) WithProfile(profile string) *SigV4Config {
+	s.Profile = profile
+	return s
+}
+
+// WithRoleARN sets the IAM role to assume.
+func (s *SigV4Config) WithRoleARN(arn string) *SigV4Config {
+	s.RoleARN = arn
+	return s
+}
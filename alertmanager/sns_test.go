@@ -0,0 +1,73 @@
+package alertmanager
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewSNSConfig(t *testing.T) {
+	config := NewSNSConfig()
+	if config == nil {
+		t.Error("NewSNSConfig() returned nil")
+	}
+}
+
+func TestSNSConfig_FluentAPI(t *testing.T) {
+	config := NewSNSConfig().
+		WithTopicARN("arn:aws:sns:us-east-1:123456789012:alerts").
+		WithSubject("{{ .CommonLabels.alertname }}").
+		WithAttributes(map[string]string{"severity": "{{ .CommonLabels.severity }}"}).
+		WithSigV4(NewSigV4Config("us-east-1").WithRoleARN("arn:aws:iam::123456789012:role/alertmanager"))
+
+	if config.TopicARN != "arn:aws:sns:us-east-1:123456789012:alerts" {
+		t.Errorf("TopicARN = %v", config.TopicARN)
+	}
+	if len(config.Attributes) != 1 {
+		t.Errorf("len(Attributes) = %d, want 1", len(config.Attributes))
+	}
+	if config.SigV4 == nil || config.SigV4.Region != "us-east-1" {
+		t.Errorf("SigV4 = %+v", config.SigV4)
+	}
+}
+
+func TestSigV4Config_WithAccessKey(t *testing.T) {
+	sigv4 := NewSigV4Config("eu-west-1").
+		WithAccessKey("AKIAEXAMPLE", NewSecret("secret")).
+		WithProfile("default")
+
+	if sigv4.AccessKey != "AKIAEXAMPLE" {
+		t.Errorf("AccessKey = %v", sigv4.AccessKey)
+	}
+	if string(sigv4.SecretKey) != "secret" {
+		t.Errorf("SecretKey = %v", sigv4.SecretKey)
+	}
+	if sigv4.Profile != "default" {
+		t.Errorf("Profile = %v", sigv4.Profile)
+	}
+}
+
+func TestSNSConfig_Serialize(t *testing.T) {
+	config := NewSNSConfig().
+		WithTopicARN("arn:aws:sns:us-east-1:123456789012:alerts").
+		WithSigV4(NewSigV4Config("us-east-1"))
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"topic_arn: arn:aws:sns:us-east-1:123456789012:alerts",
+		"sigv4:",
+		"region: us-east-1",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
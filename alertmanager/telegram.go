@@ -0,0 +1,106 @@
+package alertmanager
+
+// TelegramConfig configures notifications to Telegram.
+type TelegramConfig struct {
+	// SendResolved determines if resolved alerts should be sent.
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// APIURL is the Telegram API URL.
+	APIURL string `yaml:"api_url,omitempty"`
+
+	// BotToken is the Telegram bot token.
+	BotToken Secret `yaml:"bot_token,omitempty"`
+
+	// BotTokenFile is a file containing the bot token.
+	BotTokenFile string `yaml:"bot_token_file,omitempty"`
+
+	// ChatID is the ID of the chat to send messages to.
+	ChatID int64 `yaml:"chat_id,omitempty"`
+
+	// MessageThreadID is the ID of the forum topic to send messages to.
+	MessageThreadID int `yaml:"message_thread_id,omitempty"`
+
+	// Message is the message text.
+	Message string `yaml:"message,omitempty"`
+
+	// DisableNotifications sends the message silently.
+	DisableNotifications bool `yaml:"disable_notifications,omitempty"`
+
+	// ParseMode is the message parse mode (MarkdownV2, Markdown, HTML).
+	ParseMode string `yaml:"parse_mode,omitempty"`
+
+	// HTTPConfig configures HTTP client settings.
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// Telegram parse modes.
+const (
+	TelegramParseModeMarkdownV2 = "MarkdownV2"
+	TelegramParseModeMarkdown   = "Markdown"
+	TelegramParseModeHTML       = "HTML"
+)
+
+// NewTelegramConfig creates a new TelegramConfig.
+func NewTelegramConfig() *TelegramConfig {
+	return &TelegramConfig{}
+}
+
+// WithSendResolved sets whether to send resolved alerts.
+func (t *TelegramConfig) WithSendResolved(send bool) *TelegramConfig {
+	t.SendResolved = &send
+	return t
+}
+
+// WithAPIURL sets the Telegram API URL.
+func (t *TelegramConfig) WithAPIURL(url string) *TelegramConfig {
+	t.APIURL = url
+	return t
+}
+
+// WithBotToken sets the bot token.
+func (t *TelegramConfig) WithBotToken(token Secret) *TelegramConfig {
+	t.BotToken = token
+	return t
+}
+
+// WithBotTokenFile sets the file containing the bot token.
+func (t *TelegramConfig) WithBotTokenFile(path string) *TelegramConfig {
+	t.BotTokenFile = path
+	return t
+}
+
+// WithChatID sets the chat ID.
+func (t *TelegramConfig) WithChatID(id int64) *TelegramConfig {
+	t.ChatID = id
+	return t
+}
+
+// WithMessageThreadID sets the forum topic ID.
+func (t *TelegramConfig) WithMessageThreadID(id int) *TelegramConfig {
+	t.MessageThreadID = id
+	return t
+}
+
+// WithMessage sets the message text.
+func (t *TelegramConfig) WithMessage(message string) *TelegramConfig {
+	t.Message = message
+	return t
+}
+
+// WithDisableNotifications sets whether messages are sent silently.
+func (t *TelegramConfig) WithDisableNotifications(disable bool) *TelegramConfig {
+	t.DisableNotifications = disable
+	return t
+}
+
+// WithParseMode sets the message parse mode.
+func (t *TelegramConfig) WithParseMode(mode string) *TelegramConfig {
+	t.ParseMode = mode
+	return t
+}
+
+// WithHTTPConfig sets HTTP client configuration.
+func (t *TelegramConfig) WithHTTPConfig(config *HTTPConfig) *TelegramConfig {
+	t.HTTPConfig = config
+	return t
+}
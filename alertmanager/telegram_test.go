@@ -0,0 +1,87 @@
+package alertmanager
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewTelegramConfig(t *testing.T) {
+	config := NewTelegramConfig()
+	if config == nil {
+		t.Error("NewTelegramConfig() returned nil")
+	}
+}
+
+func TestTelegramConfig_FluentAPI(t *testing.T) {
+	config := NewTelegramConfig().
+		WithBotToken(NewSecret("123:abc")).
+		WithChatID(-1001234567890).
+		WithMessageThreadID(42).
+		WithParseMode(TelegramParseModeHTML).
+		WithDisableNotifications(true)
+
+	if string(config.BotToken) != "123:abc" {
+		t.Errorf("BotToken = %v", config.BotToken)
+	}
+	if config.ChatID != -1001234567890 {
+		t.Errorf("ChatID = %v", config.ChatID)
+	}
+	if config.MessageThreadID != 42 {
+		t.Errorf("MessageThreadID = %v", config.MessageThreadID)
+	}
+	if config.ParseMode != "HTML" {
+		t.Errorf("ParseMode = %v", config.ParseMode)
+	}
+	if !config.DisableNotifications {
+		t.Error("DisableNotifications should be true")
+	}
+}
+
+func TestTelegramConfig_Serialize(t *testing.T) {
+	config := NewTelegramConfig().
+		WithBotTokenFile("/etc/alertmanager/secrets/telegram-token").
+		WithChatID(12345).
+		WithMessage("{{ .CommonAnnotations.summary }}")
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"bot_token_file: /etc/alertmanager/secrets/telegram-token",
+		"chat_id: 12345",
+		"message: '{{ .CommonAnnotations.summary }}'",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestTelegramConfig_Unmarshal(t *testing.T) {
+	input := `
+bot_token: "123:abc"
+chat_id: -100987
+parse_mode: MarkdownV2
+`
+	var config TelegramConfig
+	if err := yaml.Unmarshal([]byte(input), &config); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+
+	if string(config.BotToken) != "123:abc" {
+		t.Errorf("BotToken = %v", config.BotToken)
+	}
+	if config.ChatID != -100987 {
+		t.Errorf("ChatID = %v", config.ChatID)
+	}
+	if config.ParseMode != TelegramParseModeMarkdownV2 {
+		t.Errorf("ParseMode = %v", config.ParseMode)
+	}
+}
@@ -0,0 +1,202 @@
+package templates
+
+import (
+	"slices"
+	"sort"
+	"time"
+)
+
+// Alert status values.
+const (
+	StatusFiring   = "firing"
+	StatusResolved = "resolved"
+)
+
+// Data is the notification data passed to templates. It mirrors
+// Alertmanager's template data.
+type Data struct {
+	Receiver          string `json:"receiver" yaml:"receiver"`
+	Status            string `json:"status" yaml:"status"`
+	Alerts            Alerts `json:"alerts" yaml:"alerts"`
+	GroupLabels       KV     `json:"groupLabels" yaml:"groupLabels"`
+	CommonLabels      KV     `json:"commonLabels" yaml:"commonLabels"`
+	CommonAnnotations KV     `json:"commonAnnotations" yaml:"commonAnnotations"`
+	ExternalURL       string `json:"externalURL" yaml:"externalURL"`
+}
+
+// Alert is a single alert in the notification data.
+type Alert struct {
+	Status       string    `json:"status" yaml:"status"`
+	Labels       KV        `json:"labels" yaml:"labels"`
+	Annotations  KV        `json:"annotations" yaml:"annotations"`
+	StartsAt     time.Time `json:"startsAt" yaml:"startsAt"`
+	EndsAt       time.Time `json:"endsAt" yaml:"endsAt"`
+	GeneratorURL string    `json:"generatorURL" yaml:"generatorURL"`
+	Fingerprint  string    `json:"fingerprint" yaml:"fingerprint"`
+}
+
+// NewAlert creates a firing alert with the given labels.
+func NewAlert(labels map[string]string) Alert {
+	return Alert{Status: StatusFiring, Labels: labels, Annotations: KV{}}
+}
+
+// WithAnnotations sets the alert annotations.
+func (a Alert) WithAnnotations(annotations map[string]string) Alert {
+	a.Annotations = annotations
+	return a
+}
+
+// WithResolved marks the alert as resolved at the given time.
+func (a Alert) WithResolved(endsAt time.Time) Alert {
+	a.Status = StatusResolved
+	a.EndsAt = endsAt
+	return a
+}
+
+// Alerts is a list of alerts.
+type Alerts []Alert
+
+// Firing returns the firing alerts.
+func (as Alerts) Firing() []Alert {
+	return as.withStatus(StatusFiring)
+}
+
+// Resolved returns the resolved alerts.
+func (as Alerts) Resolved() []Alert {
+	return as.withStatus(StatusResolved)
+}
+
+func (as Alerts) withStatus(status string) []Alert {
+	result := []Alert{}
+	for _, a := range as {
+		if a.Status == status {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// KV is a set of label or annotation pairs.
+type KV map[string]string
+
+// Pair is a single name/value pair.
+type Pair struct {
+	Name  string
+	Value string
+}
+
+// Pairs is a list of pairs.
+type Pairs []Pair
+
+// Names returns the pair names.
+func (ps Pairs) Names() []string {
+	names := make([]string, len(ps))
+	for i, p := range ps {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// Values returns the pair values.
+func (ps Pairs) Values() []string {
+	values := make([]string, len(ps))
+	for i, p := range ps {
+		values[i] = p.Value
+	}
+	return values
+}
+
+// SortedPairs returns the pairs sorted by name, with alertname first.
+func (kv KV) SortedPairs() Pairs {
+	names := make([]string, 0, len(kv))
+	for name := range kv {
+		if name != "alertname" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if _, ok := kv["alertname"]; ok {
+		names = append([]string{"alertname"}, names...)
+	}
+	pairs := make(Pairs, len(names))
+	for i, name := range names {
+		pairs[i] = Pair{Name: name, Value: kv[name]}
+	}
+	return pairs
+}
+
+// Remove returns a copy of kv without the given keys.
+func (kv KV) Remove(keys []string) KV {
+	result := KV{}
+	for name, value := range kv {
+		if !slices.Contains(keys, name) {
+			result[name] = value
+		}
+	}
+	return result
+}
+
+// Names returns the sorted names.
+func (kv KV) Names() []string {
+	return kv.SortedPairs().Names()
+}
+
+// Values returns the values sorted by name.
+func (kv KV) Values() []string {
+	return kv.SortedPairs().Values()
+}
+
+// NewData builds notification data for a group of alerts, computing the
+// status, group labels and common labels and annotations as Alertmanager does.
+func NewData(receiver string, groupBy []string, alerts ...Alert) *Data {
+	data := &Data{
+		Receiver:          receiver,
+		Status:            StatusResolved,
+		Alerts:            alerts,
+		GroupLabels:       KV{},
+		CommonLabels:      KV{},
+		CommonAnnotations: KV{},
+	}
+
+	for _, a := range alerts {
+		if a.Status != StatusResolved {
+			data.Status = StatusFiring
+			break
+		}
+	}
+
+	if len(alerts) > 0 {
+		for _, name := range groupBy {
+			if v, ok := alerts[0].Labels[name]; ok {
+				data.GroupLabels[name] = v
+			}
+		}
+		data.CommonLabels = commonPairs(alerts, func(a Alert) KV { return a.Labels })
+		data.CommonAnnotations = commonPairs(alerts, func(a Alert) KV { return a.Annotations })
+	}
+
+	return data
+}
+
+// WithExternalURL sets the Alertmanager external URL.
+func (d *Data) WithExternalURL(url string) *Data {
+	d.ExternalURL = url
+	return d
+}
+
+// commonPairs returns the pairs shared by all alerts.
+func commonPairs(alerts []Alert, get func(Alert) KV) KV {
+	common := KV{}
+	for name, value := range get(alerts[0]) {
+		common[name] = value
+	}
+	for _, a := range alerts[1:] {
+		kv := get(a)
+		for name, value := range common {
+			if kv[name] != value {
+				delete(common, name)
+			}
+		}
+	}
+	return common
+}
@@ -0,0 +1,55 @@
+package templates
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewData(t *testing.T) {
+	a1 := NewAlert(map[string]string{"alertname": "HighLatency", "service": "api", "instance": "a"}).
+		WithAnnotations(map[string]string{"summary": "slow"})
+	a2 := NewAlert(map[string]string{"alertname": "HighLatency", "service": "api", "instance": "b"}).
+		WithAnnotations(map[string]string{"summary": "slow"}).
+		WithResolved(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	data := NewData("team-api", []string{"alertname"}, a1, a2)
+
+	if data.Status != StatusFiring {
+		t.Errorf("Status = %q, want %q", data.Status, StatusFiring)
+	}
+	if want := (KV{"alertname": "HighLatency"}); !reflect.DeepEqual(data.GroupLabels, want) {
+		t.Errorf("GroupLabels = %v, want %v", data.GroupLabels, want)
+	}
+	if want := (KV{"alertname": "HighLatency", "service": "api"}); !reflect.DeepEqual(data.CommonLabels, want) {
+		t.Errorf("CommonLabels = %v, want %v", data.CommonLabels, want)
+	}
+	if want := (KV{"summary": "slow"}); !reflect.DeepEqual(data.CommonAnnotations, want) {
+		t.Errorf("CommonAnnotations = %v, want %v", data.CommonAnnotations, want)
+	}
+	if len(data.Alerts.Firing()) != 1 || len(data.Alerts.Resolved()) != 1 {
+		t.Errorf("Firing/Resolved = %d/%d, want 1/1", len(data.Alerts.Firing()), len(data.Alerts.Resolved()))
+	}
+}
+
+func TestNewData_AllResolved(t *testing.T) {
+	a := NewAlert(map[string]string{"alertname": "Down"}).WithResolved(time.Now())
+	data := NewData("default", nil, a)
+	if data.Status != StatusResolved {
+		t.Errorf("Status = %q, want %q", data.Status, StatusResolved)
+	}
+}
+
+func TestKV_SortedPairs(t *testing.T) {
+	kv := KV{"severity": "critical", "alertname": "Down", "env": "prod"}
+
+	if got, want := kv.Names(), []string{"alertname", "env", "severity"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+	if got, want := kv.Values(), []string{"Down", "prod", "critical"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if got, want := kv.Remove([]string{"env"}), (KV{"severity": "critical", "alertname": "Down"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Remove() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,155 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"math"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DefaultFuncs returns the template functions Alertmanager provides to
+// notification templates.
+func DefaultFuncs() map[string]any {
+	return map[string]any{
+		"toUpper":   strings.ToUpper,
+		"toLower":   strings.ToLower,
+		"title":     title,
+		"trimSpace": strings.TrimSpace,
+		// join is equal to strings.Join but inverts the argument order
+		// for easier pipelining in templates.
+		"join": func(sep string, s []string) string {
+			return strings.Join(s, sep)
+		},
+		"match": regexp.MatchString,
+		"safeHtml": func(text string) template.HTML {
+			return template.HTML(text)
+		},
+		"safeUrl": func(text string) template.URL {
+			return template.URL(text)
+		},
+		"urlUnescape": url.QueryUnescape,
+		"reReplaceAll": func(pattern, repl, text string) string {
+			re := regexp.MustCompile(pattern)
+			return re.ReplaceAllString(text, repl)
+		},
+		"stringSlice": func(s ...string) []string {
+			return s
+		},
+		"date": func(layout string, t time.Time) string {
+			return t.Format(layout)
+		},
+		"tz": func(name string, t time.Time) (time.Time, error) {
+			loc, err := time.LoadLocation(name)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return t.In(loc), nil
+		},
+		"since":            time.Since,
+		"humanizeDuration": humanizeDuration,
+		"toJson": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return "", err
+			}
+			return string(b), nil
+		},
+	}
+}
+
+// FuncNames returns the names of the default template functions.
+func FuncNames() []string {
+	funcs := DefaultFuncs()
+	names := make([]string, 0, len(funcs))
+	for name := range funcs {
+		names = append(names, name)
+	}
+	return names
+}
+
+// title capitalizes the first letter of each word.
+func title(s string) string {
+	prev := ' '
+	return strings.Map(func(r rune) rune {
+		isStart := unicode.IsSpace(prev) || unicode.IsPunct(prev)
+		prev = r
+		if isStart {
+			return unicode.ToTitle(r)
+		}
+		return r
+	}, s)
+}
+
+// humanizeDuration formats seconds as a human readable duration,
+// e.g. "1h 2m 3s".
+func humanizeDuration(i any) (string, error) {
+	v, err := toFloat64(i)
+	if err != nil {
+		return "", err
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Sprintf("%.4g", v), nil
+	}
+	if v == 0 {
+		return "0s", nil
+	}
+	if math.Abs(v) >= 1 {
+		sign := ""
+		if v < 0 {
+			sign = "-"
+			v = -v
+		}
+		duration := int64(v)
+		seconds := duration % 60
+		minutes := (duration / 60) % 60
+		hours := (duration / 60 / 60) % 24
+		days := duration / 60 / 60 / 24
+		if days != 0 {
+			return fmt.Sprintf("%s%dd %dh %dm %ds", sign, days, hours, minutes, seconds), nil
+		}
+		if hours != 0 {
+			return fmt.Sprintf("%s%dh %dm %ds", sign, hours, minutes, seconds), nil
+		}
+		if minutes != 0 {
+			return fmt.Sprintf("%s%dm %ds", sign, minutes, seconds), nil
+		}
+		return fmt.Sprintf("%s%.4gs", sign, v), nil
+	}
+	prefix := ""
+	for _, p := range []string{"m", "u", "n", "p", "f", "a", "z", "y"} {
+		if math.Abs(v) >= 1 {
+			break
+		}
+		prefix = p
+		v *= 1000
+	}
+	return fmt.Sprintf("%.4g%ss", v, prefix), nil
+}
+
+func toFloat64(i any) (float64, error) {
+	switch v := i.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case time.Duration:
+		return v.Seconds(), nil
+	case string:
+		var f float64
+		if _, err := fmt.Sscan(v, &f); err != nil {
+			return 0, fmt.Errorf("humanizeDuration: invalid number %q", v)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("humanizeDuration: unsupported type %T", i)
+	}
+}
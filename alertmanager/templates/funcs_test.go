@@ -0,0 +1,71 @@
+package templates
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanizeDuration(t *testing.T) {
+	tests := []struct {
+		in   any
+		want string
+	}{
+		{0, "0s"},
+		{1.5, "1.5s"},
+		{90, "1m 30s"},
+		{3723, "1h 2m 3s"},
+		{90061, "1d 1h 1m 1s"},
+		{0.25, "250ms"},
+		{"60", "1m 0s"},
+		{2 * time.Minute, "2m 0s"},
+	}
+	for _, tt := range tests {
+		got, err := humanizeDuration(tt.in)
+		if err != nil {
+			t.Errorf("humanizeDuration(%v) error = %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("humanizeDuration(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+
+	if _, err := humanizeDuration("abc"); err == nil {
+		t.Error("humanizeDuration(\"abc\") should return an error")
+	}
+}
+
+func TestDefaultFuncs(t *testing.T) {
+	r, err := NewRenderer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := NewData("default", nil)
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{`{{ "abc" | toUpper }}`, "ABC"},
+		{`{{ "ABC" | toLower }}`, "abc"},
+		{`{{ "hello world" | title }}`, "Hello World"},
+		{`{{ "  x  " | trimSpace }}`, "x"},
+		{`{{ stringSlice "a" "b" | join ", " }}`, "a, b"},
+		{`{{ match "^api" "api-server" }}`, "true"},
+		{`{{ reReplaceAll "-.*" "" "api-server" }}`, "api"},
+		{`{{ "a%20b" | urlUnescape }}`, "a b"},
+		{`{{ "<b>" | safeHtml }}`, "<b>"},
+		{`{{ stringSlice "a" | toJson }}`, `["a"]`},
+		{`{{ 3600 | humanizeDuration }}`, "1h 0m 0s"},
+	}
+	for _, tt := range tests {
+		got, err := r.Render(tt.text, data)
+		if err != nil {
+			t.Errorf("Render(%q) error = %v", tt.text, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Render(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package templates
+
+import (
+	_ "embed"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/lex00/wetwire-observability-go/alertmanager"
+)
+
+//go:embed default.tmpl
+var defaultTemplates string
+
+// DefaultTemplates returns Alertmanager's built-in templates, such as
+// "slack.default.title" and "__subject".
+func DefaultTemplates() string {
+	return defaultTemplates
+}
+
+// Renderer executes notification templates against sample data, the way
+// Alertmanager does when sending a notification.
+type Renderer struct {
+	tmpl *template.Template
+}
+
+// NewRenderer creates a Renderer with the default templates and functions
+// and the given libraries.
+func NewRenderer(libs ...*Library) (*Renderer, error) {
+	tmpl, err := template.New("").Option("missingkey=zero").Funcs(DefaultFuncs()).Parse(defaultTemplates)
+	if err != nil {
+		return nil, fmt.Errorf("parsing default templates: %w", err)
+	}
+	r := &Renderer{tmpl: tmpl}
+	for _, lib := range libs {
+		if err := r.parse(lib.FileName(), lib.Content()); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// ParseGlob parses template files matching pattern, as Alertmanager does
+// for each entry in AlertmanagerConfig.Templates.
+func (r *Renderer) ParseGlob(pattern string) error {
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid template pattern %q: %w", pattern, err)
+	}
+	for _, path := range paths {
+		if _, err := r.tmpl.ParseFiles(path); err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// ParseConfigTemplates parses the template files listed in config.Templates.
+// Relative patterns are resolved against baseDir.
+func (r *Renderer) ParseConfigTemplates(config *alertmanager.AlertmanagerConfig, baseDir string) error {
+	for _, pattern := range config.Templates {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(baseDir, pattern)
+		}
+		if err := r.ParseGlob(pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Renderer) parse(name, text string) error {
+	if _, err := r.tmpl.New(name).Parse(text); err != nil {
+		return fmt.Errorf("parsing %s: %w", name, err)
+	}
+	return nil
+}
+
+// Defined reports whether a template with the given name is defined.
+func (r *Renderer) Defined(name string) bool {
+	return r.tmpl.Lookup(name) != nil
+}
+
+// Render executes text against data.
+func (r *Renderer) Render(text string, data *Data) (string, error) {
+	tmpl, err := r.parseText(text)
+	if err != nil {
+		return "", err
+	}
+	if undefined := r.undefined(tmpl.Tree); len(undefined) > 0 {
+		return "", fmt.Errorf("undefined template %q", undefined[0])
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// UndefinedTemplates returns the names of templates invoked by text, directly
+// or through other templates, that are not defined.
+func (r *Renderer) UndefinedTemplates(text string) ([]string, error) {
+	tmpl, err := r.parseText(text)
+	if err != nil {
+		return nil, err
+	}
+	return r.undefined(tmpl.Tree), nil
+}
+
+// parseText parses text as an anonymous template alongside the defined ones.
+func (r *Renderer) parseText(text string) (*template.Template, error) {
+	tmpl, err := r.tmpl.Clone()
+	if err != nil {
+		return nil, err
+	}
+	return tmpl.New("").Parse(text)
+}
+
+func (r *Renderer) undefined(root *parse.Tree) []string {
+	seen := map[string]bool{}
+	missing := map[string]bool{}
+	var visit func(node parse.Node)
+	visit = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				visit(child)
+			}
+		case *parse.IfNode:
+			visit(n.List)
+			visit(n.ElseList)
+		case *parse.RangeNode:
+			visit(n.List)
+			visit(n.ElseList)
+		case *parse.WithNode:
+			visit(n.List)
+			visit(n.ElseList)
+		case *parse.TemplateNode:
+			if seen[n.Name] {
+				return
+			}
+			seen[n.Name] = true
+			t := r.tmpl.Lookup(n.Name)
+			if t == nil || t.Tree == nil {
+				missing[n.Name] = true
+				return
+			}
+			visit(t.Tree.Root)
+		}
+	}
+	if root != nil {
+		visit(root.Root)
+	}
+
+	names := make([]string, 0, len(missing))
+	for name := range missing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RenderedField is the result of rendering one templated receiver field.
+type RenderedField struct {
+	// Integration identifies the notification config, e.g. "slack_configs[0]".
+	Integration string `json:"integration"`
+
+	// Field is the YAML path of the field within the config, e.g. "title".
+	Field string `json:"field"`
+
+	// Template is the raw field value.
+	Template string `json:"template"`
+
+	// Output is the rendered value.
+	Output string `json:"output,omitempty"`
+
+	// Err is set when the field fails to render.
+	Err error `json:"-"`
+}
+
+// RenderReceiver renders every templated string field of a receiver's
+// notification configs. Secret fields are never rendered.
+func (r *Renderer) RenderReceiver(rcv *alertmanager.Receiver, data *Data) []RenderedField {
+	var results []RenderedField
+	v := reflect.ValueOf(rcv).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.Slice {
+			continue
+		}
+		name := yamlName(t.Field(i))
+		for j := 0; j < field.Len(); j++ {
+			integration := fmt.Sprintf("%s[%d]", name, j)
+			for _, f := range templatedFields(field.Index(j), "") {
+				out, err := r.Render(f.value, data)
+				results = append(results, RenderedField{
+					Integration: integration,
+					Field:       f.path,
+					Template:    f.value,
+					Output:      out,
+					Err:         err,
+				})
+			}
+		}
+	}
+	return results
+}
+
+type templatedField struct {
+	path  string
+	value string
+}
+
+var secretType = reflect.TypeOf(alertmanager.Secret(""))
+
+// templatedFields returns the string fields of v that contain template
+// actions, in declaration order.
+func templatedFields(v reflect.Value, prefix string) []templatedField {
+	var fields []templatedField
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			fields = append(fields, templatedFields(v.Elem(), prefix)...)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if !sf.IsExported() || sf.Type == secretType {
+				continue
+			}
+			fields = append(fields, templatedFields(v.Field(i), joinPath(prefix, yamlName(sf)))...)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			fields = append(fields, templatedFields(v.Index(i), fmt.Sprintf("%s[%d]", prefix, i))...)
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			break
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, k := range keys {
+			fields = append(fields, templatedFields(v.MapIndex(k), joinPath(prefix, k.String()))...)
+		}
+	case reflect.String:
+		if v.Type() != secretType && strings.Contains(v.String(), "{{") {
+			fields = append(fields, templatedField{path: prefix, value: v.String()})
+		}
+	}
+	return fields
+}
+
+func yamlName(sf reflect.StructField) string {
+	name, _, _ := strings.Cut(sf.Tag.Get("yaml"), ",")
+	if name == "" {
+		return strings.ToLower(sf.Name)
+	}
+	return name
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
@@ -0,0 +1,132 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/alertmanager"
+)
+
+func sampleData() *Data {
+	return NewData("slack-api", []string{"alertname"},
+		NewAlert(map[string]string{"alertname": "HighLatency", "service": "api", "severity": "critical"}).
+			WithAnnotations(map[string]string{"summary": "p99 above 1s"}),
+	).WithExternalURL("http://alertmanager:9093")
+}
+
+func TestRenderer_DefaultTemplates(t *testing.T) {
+	r, err := NewRenderer()
+	if err != nil {
+		t.Fatalf("NewRenderer() error = %v", err)
+	}
+
+	got, err := r.Render(`{{ template "slack.default.title" . }}`, sampleData())
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := "[FIRING:1] HighLatency (api critical)"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+
+	for _, name := range []string{"slack.default.text", "pagerduty.default.description", "email.default.html", "msteamsv2.default.text"} {
+		if !r.Defined(name) {
+			t.Errorf("default template %q is not defined", name)
+		}
+	}
+}
+
+func TestRenderer_Library(t *testing.T) {
+	title := Define("myorg.title", `{{ .CommonLabels.service | toUpper }}: {{ .CommonAnnotations.summary }}`)
+	r, err := NewRenderer(NewLibrary("myorg", title))
+	if err != nil {
+		t.Fatalf("NewRenderer() error = %v", err)
+	}
+
+	got, err := r.Render(title.Ref(), sampleData())
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := "API: p99 above 1s"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestRenderer_UndefinedTemplates(t *testing.T) {
+	lib := NewLibrary("myorg",
+		Define("myorg.title", `{{ template "myorg.missing" . }}`),
+	)
+	r, err := NewRenderer(lib)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := r.UndefinedTemplates(`{{ if .Alerts }}{{ template "myorg.title" . }}{{ end }}{{ template "slack.default.title" . }}{{ template "nope" . }}`)
+	if err != nil {
+		t.Fatalf("UndefinedTemplates() error = %v", err)
+	}
+	if want := []string{"myorg.missing", "nope"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("UndefinedTemplates() = %v, want %v", got, want)
+	}
+
+	if _, err := r.Render(`{{ template "nope" . }}`, sampleData()); err == nil || !strings.Contains(err.Error(), `undefined template "nope"`) {
+		t.Errorf("Render() error = %v, want undefined template error", err)
+	}
+}
+
+func TestRenderer_ParseConfigTemplates(t *testing.T) {
+	dir := t.TempDir()
+	lib := NewLibrary("custom", Define("custom.text", "custom text"))
+	if _, err := lib.WriteToDir(filepath.Join(dir, "templates")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "templates", "other.txt"), []byte("ignored"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := alertmanager.NewAlertmanagerConfig().WithTemplates("templates/*.tmpl")
+	r, err := NewRenderer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.ParseConfigTemplates(config, dir); err != nil {
+		t.Fatalf("ParseConfigTemplates() error = %v", err)
+	}
+	if !r.Defined("custom.text") {
+		t.Error("custom.text should be defined after ParseConfigTemplates()")
+	}
+}
+
+func TestRenderer_RenderReceiver(t *testing.T) {
+	slack := alertmanager.NewSlackConfig().
+		WithChannel("#alerts").
+		WithTitle(`{{ template "slack.default.title" . }}`).
+		WithText(`{{ range .Alerts }}{{ .Annotations.summary }}{{ end }}`)
+	pd := alertmanager.NewPagerDutyConfig().
+		WithRoutingKey(alertmanager.NewSecret("{{ not rendered }}")).
+		WithDescription(`{{ template "myorg.missing" . }}`)
+	rcv := alertmanager.NewReceiver("team-api").
+		WithSlackConfigs(slack).
+		WithPagerDutyConfigs(pd)
+
+	r, err := NewRenderer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := r.RenderReceiver(rcv, sampleData())
+
+	if len(results) != 3 {
+		t.Fatalf("len(results) = %d, want 3: %+v", len(results), results)
+	}
+	if results[0].Integration != "slack_configs[0]" || results[0].Field != "title" {
+		t.Errorf("results[0] = %s.%s, want slack_configs[0].title", results[0].Integration, results[0].Field)
+	}
+	if results[1].Field != "text" || results[1].Output != "p99 above 1s" {
+		t.Errorf("results[1] = %+v", results[1])
+	}
+	if results[2].Integration != "pagerduty_configs[0]" || results[2].Err == nil {
+		t.Errorf("results[2] = %+v, want pagerduty error", results[2])
+	}
+}
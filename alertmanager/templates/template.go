@@ -0,0 +1,107 @@
+// Package templates defines Alertmanager notification templates in Go and
+// renders receiver fields offline against sample alerts.
+//
+// Templates are grouped into a Library, which is written as a single .tmpl
+// file referenced from AlertmanagerConfig.Templates:
+//
+//	var SlackTitle = templates.Define("myorg.slack.title",
+//		`[{{ .Status | toUpper }}] {{ .CommonLabels.alertname }}`)
+//
+//	var Notifications = templates.NewLibrary("notifications", SlackTitle)
+//
+//	var Slack = alertmanager.NewSlackConfig().WithTitle(SlackTitle.Ref())
+package templates
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Template is a named notification template.
+type Template struct {
+	// Name is the template name used in {{ template "name" . }}.
+	Name string `json:"name"`
+
+	// Text is the template body.
+	Text string `json:"text"`
+}
+
+// Define creates a named template.
+func Define(name, text string) *Template {
+	return &Template{Name: name, Text: text}
+}
+
+// Ref returns a template action that invokes this template with the
+// current notification data, for use in receiver fields.
+func (t *Template) Ref() string {
+	return fmt.Sprintf(`{{ template %q . }}`, t.Name)
+}
+
+// Definition returns the template wrapped in a define block.
+func (t *Template) Definition() string {
+	return fmt.Sprintf("{{ define %q }}%s{{ end }}", t.Name, t.Text)
+}
+
+// Library is a set of templates written to one .tmpl file.
+type Library struct {
+	// Name is the library name, used as the file name.
+	Name string `json:"name"`
+
+	// Templates are the templates defined in the library.
+	Templates []*Template `json:"templates"`
+}
+
+// NewLibrary creates a Library with the given templates.
+func NewLibrary(name string, templates ...*Template) *Library {
+	return &Library{Name: name, Templates: templates}
+}
+
+// WithTemplates adds templates to the library.
+func (l *Library) WithTemplates(templates ...*Template) *Library {
+	l.Templates = append(l.Templates, templates...)
+	return l
+}
+
+// Define adds a named template to the library and returns it.
+func (l *Library) Define(name, text string) *Template {
+	t := Define(name, text)
+	l.Templates = append(l.Templates, t)
+	return t
+}
+
+// FileName returns the library's file name.
+func (l *Library) FileName() string {
+	return l.Name + ".tmpl"
+}
+
+// Content returns the .tmpl file content.
+func (l *Library) Content() string {
+	var b strings.Builder
+	for i, t := range l.Templates {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(t.Definition())
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// WriteToDir writes the library to dir and returns the file path.
+func (l *Library) WriteToDir(dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating template directory: %w", err)
+	}
+	path := filepath.Join(dir, l.FileName())
+	if err := os.WriteFile(path, []byte(l.Content()), 0644); err != nil {
+		return "", fmt.Errorf("writing %s: %w", path, err)
+	}
+	return path, nil
+}
+
+// Path returns the library's path under dir, for AlertmanagerConfig.Templates.
+func (l *Library) Path(dir string) string {
+	return filepath.ToSlash(filepath.Join(dir, l.FileName()))
+}
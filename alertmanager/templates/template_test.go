@@ -0,0 +1,71 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDefine(t *testing.T) {
+	tmpl := Define("myorg.slack.title", "{{ .Status }}")
+	if tmpl.Name != "myorg.slack.title" {
+		t.Errorf("Name = %q, want %q", tmpl.Name, "myorg.slack.title")
+	}
+	if got := tmpl.Ref(); got != `{{ template "myorg.slack.title" . }}` {
+		t.Errorf("Ref() = %q", got)
+	}
+	if got := tmpl.Definition(); got != `{{ define "myorg.slack.title" }}{{ .Status }}{{ end }}` {
+		t.Errorf("Definition() = %q", got)
+	}
+}
+
+func TestNewLibrary(t *testing.T) {
+	title := Define("myorg.title", "title")
+	lib := NewLibrary("notifications", title)
+	text := lib.Define("myorg.text", "text")
+
+	if len(lib.Templates) != 2 {
+		t.Fatalf("len(Templates) = %d, want 2", len(lib.Templates))
+	}
+	if lib.Templates[1] != text {
+		t.Error("Define() should append the template to the library")
+	}
+	if lib.FileName() != "notifications.tmpl" {
+		t.Errorf("FileName() = %q, want %q", lib.FileName(), "notifications.tmpl")
+	}
+	if got := lib.Path("/etc/alertmanager/templates"); got != "/etc/alertmanager/templates/notifications.tmpl" {
+		t.Errorf("Path() = %q", got)
+	}
+
+	content := lib.Content()
+	expectations := []string{
+		`{{ define "myorg.title" }}title{{ end }}`,
+		`{{ define "myorg.text" }}text{{ end }}`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(content, exp) {
+			t.Errorf("Content() missing %q\nGot:\n%s", exp, content)
+		}
+	}
+}
+
+func TestLibrary_WriteToDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "templates")
+	lib := NewLibrary("notifications", Define("myorg.title", "title"))
+
+	path, err := lib.WriteToDir(dir)
+	if err != nil {
+		t.Fatalf("WriteToDir() error = %v", err)
+	}
+	if path != filepath.Join(dir, "notifications.tmpl") {
+		t.Errorf("WriteToDir() path = %q", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != lib.Content() {
+		t.Errorf("file content = %q, want %q", data, lib.Content())
+	}
+}
@@ -0,0 +1,616 @@
+package alertmanager
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ValidationError is a problem found while validating an Alertmanager
+// configuration. Path locates the offending field using YAML keys, for
+// example "receivers[0].slack_configs[1].api_url".
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+// Error implements the error interface.
+func (e ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// Validate checks the configuration against the rules Alertmanager enforces
+// when loading alertmanager.yml: the routing tree only references defined
+// receivers and time intervals, receiver names are unique, matchers and
+// group_by are well formed, time intervals parse, and every integration has
+// its required fields (taking global defaults into account) and valid URLs.
+//
+// Values that reference secrets (e.g. "${SLACK_URL}") are treated as set
+// and are not parsed. Validate returns nil if the configuration is valid.
+func (c *AlertmanagerConfig) Validate() []ValidationError {
+	v := &validator{config: c, global: c.Global}
+	if v.global == nil {
+		v.global = &GlobalConfig{}
+	}
+	v.validate()
+	return v.errs
+}
+
+// Validate checks the matcher's label name, operator and, for regular
+// expression matchers, that the value compiles.
+func (m *Matcher) Validate() error {
+	if err := validateLabelName(m.Label); err != nil {
+		return err
+	}
+	switch m.Op {
+	case MatchEqual, MatchNotEqual:
+	case MatchRegex, MatchNotRegex:
+		if _, err := regexp.Compile("^(?:" + m.Value + ")$"); err != nil {
+			return fmt.Errorf("invalid regular expression %q: %w", m.Value, err)
+		}
+	default:
+		return fmt.Errorf("invalid matcher operator %q", m.Op)
+	}
+	return nil
+}
+
+// ValidateGroupBy checks a route's group_by labels. The special value "..."
+// disables grouping and must be the only entry.
+func ValidateGroupBy(labels []string) error {
+	seen := make(map[string]bool)
+	for _, l := range labels {
+		if l == "..." {
+			if len(labels) > 1 {
+				return fmt.Errorf("cannot have wildcard group_by (`...`) and other labels at the same time")
+			}
+			continue
+		}
+		if err := validateLabelName(l); err != nil {
+			return err
+		}
+		if seen[l] {
+			return fmt.Errorf("duplicated label %q in group_by", l)
+		}
+		seen[l] = true
+	}
+	return nil
+}
+
+// validateLabelName checks that a label name is non-empty valid UTF-8.
+func validateLabelName(name string) error {
+	if name == "" {
+		return fmt.Errorf("label name must not be empty")
+	}
+	if !utf8.ValidString(name) {
+		return fmt.Errorf("invalid label name %q", name)
+	}
+	return nil
+}
+
+// validator accumulates validation errors for a configuration.
+type validator struct {
+	config *AlertmanagerConfig
+	global *GlobalConfig
+	errs   []ValidationError
+}
+
+func (v *validator) add(path, format string, args ...any) {
+	v.errs = append(v.errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) validate() {
+	v.validateGlobal()
+	receivers := v.validateReceivers()
+	intervals := v.validateTimeIntervals()
+
+	if v.config.Route == nil {
+		v.add("route", "no route provided")
+	} else {
+		v.validateRoot(v.config.Route)
+		v.validateRoute("route", v.config.Route, receivers, intervals)
+	}
+
+	for i, rule := range v.config.InhibitRules {
+		v.validateInhibitRule(fmt.Sprintf("inhibit_rules[%d]", i), rule)
+	}
+}
+
+func (v *validator) validateGlobal() {
+	g := v.global
+	for _, f := range []struct{ key, value string }{
+		{"slack_api_url", g.SlackAPIURL},
+		{"pagerduty_url", g.PagerDutyURL},
+		{"opsgenie_api_url", g.OpsGenieAPIURL},
+		{"telegram_api_url", g.TelegramAPIURL},
+		{"victorops_api_url", g.VictorOpsAPIURL},
+		{"wechat_api_url", g.WeChatAPIURL},
+		{"webex_api_url", g.WebexAPIURL},
+		{"jira_api_url", g.JiraAPIURL},
+		{"rocketchat_api_url", g.RocketChatAPIURL},
+	} {
+		v.checkURL("global."+f.key, f.value)
+	}
+	v.exclusive("global", "smtp_auth_password", g.SMTPAuthPassword, "smtp_auth_password_file", g.SMTPAuthPasswordFile)
+	v.exclusive("global", "slack_api_url", g.SlackAPIURL, "slack_api_url_file", g.SlackAPIURLFile)
+	v.exclusive("global", "opsgenie_api_key", g.OpsGenieAPIKey, "opsgenie_api_key_file", g.OpsGenieAPIKeyFile)
+	v.exclusive("global", "victorops_api_key", string(g.VictorOpsAPIKey), "victorops_api_key_file", g.VictorOpsAPIKeyFile)
+	v.validateHTTPConfig("global.http_config", g.HTTPConfig)
+}
+
+// validateRoot checks the restrictions that only apply to the root route.
+func (v *validator) validateRoot(r *Route) {
+	if r.Receiver == "" {
+		v.add("route", "root route must specify a default receiver")
+	}
+	if len(r.Matchers) > 0 {
+		v.add("route", "root route must not have any matchers")
+	}
+	if len(r.MuteTimeIntervals) > 0 {
+		v.add("route", "root route must not have any mute time intervals")
+	}
+	if len(r.ActiveTimeIntervals) > 0 {
+		v.add("route", "root route must not have any active time intervals")
+	}
+}
+
+func (v *validator) validateRoute(path string, r *Route, receivers, intervals map[string]bool) {
+	if r.Receiver != "" && !receivers[r.Receiver] {
+		v.add(path+".receiver", "undefined receiver %q used in route", r.Receiver)
+	}
+	if err := ValidateGroupBy(r.GroupBy); err != nil {
+		v.add(path+".group_by", "%v", err)
+	}
+	for i, m := range r.Matchers {
+		if err := m.Validate(); err != nil {
+			v.add(fmt.Sprintf("%s.matchers[%d]", path, i), "%v", err)
+		}
+	}
+	for i, name := range r.MuteTimeIntervals {
+		if !intervals[name] {
+			v.add(fmt.Sprintf("%s.mute_time_intervals[%d]", path, i), "undefined time interval %q used in route", name)
+		}
+	}
+	for i, name := range r.ActiveTimeIntervals {
+		if !intervals[name] {
+			v.add(fmt.Sprintf("%s.active_time_intervals[%d]", path, i), "undefined time interval %q used in route", name)
+		}
+	}
+	for i, child := range r.Routes {
+		if child == nil {
+			continue
+		}
+		v.validateRoute(fmt.Sprintf("%s.routes[%d]", path, i), child, receivers, intervals)
+	}
+}
+
+func (v *validator) validateInhibitRule(path string, rule *InhibitRule) {
+	for _, set := range []struct {
+		key      string
+		match    map[string]string
+		matchers []*Matcher
+	}{
+		{"source", rule.SourceMatch, rule.SourceMatchers},
+		{"target", rule.TargetMatch, rule.TargetMatchers},
+	} {
+		for name := range set.match {
+			if err := validateLabelName(name); err != nil {
+				v.add(path+"."+set.key+"_match", "%v", err)
+			}
+		}
+		for i, m := range set.matchers {
+			if err := m.Validate(); err != nil {
+				v.add(fmt.Sprintf("%s.%s_matchers[%d]", path, set.key, i), "%v", err)
+			}
+		}
+	}
+	for i, name := range rule.Equal {
+		if err := validateLabelName(name); err != nil {
+			v.add(fmt.Sprintf("%s.equal[%d]", path, i), "%v", err)
+		}
+	}
+}
+
+// validateReceivers checks receiver names and integrations, and returns the
+// set of defined receiver names.
+func (v *validator) validateReceivers() map[string]bool {
+	names := make(map[string]bool)
+	for i, r := range v.config.Receivers {
+		path := fmt.Sprintf("receivers[%d]", i)
+		if r == nil {
+			continue
+		}
+		if r.Name == "" {
+			v.add(path+".name", "missing name in receiver")
+		} else if names[r.Name] {
+			v.add(path+".name", "notification config name %q is not unique", r.Name)
+		}
+		names[r.Name] = true
+		v.validateReceiver(path, r)
+	}
+	return names
+}
+
+func (v *validator) validateReceiver(path string, r *Receiver) {
+	g := v.global
+	for i, c := range r.EmailConfigs {
+		p := fmt.Sprintf("%s.email_configs[%d]", path, i)
+		if c.To == "" {
+			v.add(p+".to", "missing to address in email config")
+		}
+		if c.Smarthost == "" && g.SMTPSmarthost == "" {
+			v.add(p+".smarthost", "no global SMTP smarthost set")
+		}
+		if c.From == "" && g.SMTPFrom == "" {
+			v.add(p+".from", "no global SMTP from set")
+		}
+		v.exclusive(p, "auth_password", string(c.AuthPassword), "auth_password_file", c.AuthPasswordFile)
+	}
+	for i, c := range r.SlackConfigs {
+		p := fmt.Sprintf("%s.slack_configs[%d]", path, i)
+		v.exclusive(p, "api_url", string(c.APIURL), "api_url_file", c.APIURLFile)
+		if c.APIURL == "" && c.APIURLFile == "" && g.SlackAPIURL == "" && g.SlackAPIURLFile == "" {
+			v.add(p+".api_url", "no global Slack API URL set")
+		}
+		v.checkURL(p+".api_url", string(c.APIURL))
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.PagerDutyConfigs {
+		p := fmt.Sprintf("%s.pagerduty_configs[%d]", path, i)
+		if c.RoutingKey == "" && c.RoutingKeyFile == "" && c.ServiceKey == "" && c.ServiceKeyFile == "" {
+			v.add(p, "missing service or routing key in PagerDuty config")
+		}
+		v.exclusive(p, "routing_key", string(c.RoutingKey), "routing_key_file", c.RoutingKeyFile)
+		v.exclusive(p, "service_key", string(c.ServiceKey), "service_key_file", c.ServiceKeyFile)
+		v.checkURL(p+".url", c.URL)
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.WebhookConfigs {
+		p := fmt.Sprintf("%s.webhook_configs[%d]", path, i)
+		v.exactlyOne(p, "url", c.URL, "url_file", c.URLFile)
+		v.checkURL(p+".url", c.URL)
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.OpsGenieConfigs {
+		p := fmt.Sprintf("%s.opsgenie_configs[%d]", path, i)
+		v.exclusive(p, "api_key", string(c.APIKey), "api_key_file", c.APIKeyFile)
+		if c.APIKey == "" && c.APIKeyFile == "" && g.OpsGenieAPIKey == "" && g.OpsGenieAPIKeyFile == "" {
+			v.add(p+".api_key", "no global OpsGenie API Key set")
+		}
+		v.checkURL(p+".api_url", c.APIURL)
+		for j, resp := range c.Responders {
+			rp := fmt.Sprintf("%s.responders[%d]", p, j)
+			if resp.ID == "" && resp.Name == "" && resp.Username == "" {
+				v.add(rp, "responder must have at least one of id, username or name specified")
+			}
+			if !isTemplated(resp.Type) && !opsGenieResponderTypes[strings.ToLower(resp.Type)] {
+				v.add(rp+".type", "responder type %q is not one of team, teams, user, escalation or schedule", resp.Type)
+			}
+		}
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.MSTeamsConfigs {
+		p := fmt.Sprintf("%s.msteams_configs[%d]", path, i)
+		v.exactlyOne(p, "webhook_url", string(c.WebhookURL), "webhook_url_file", c.WebhookURLFile)
+		v.checkURL(p+".webhook_url", string(c.WebhookURL))
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.MSTeamsV2Configs {
+		p := fmt.Sprintf("%s.msteamsv2_configs[%d]", path, i)
+		v.exactlyOne(p, "webhook_url", string(c.WebhookURL), "webhook_url_file", c.WebhookURLFile)
+		v.checkURL(p+".webhook_url", string(c.WebhookURL))
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.TelegramConfigs {
+		p := fmt.Sprintf("%s.telegram_configs[%d]", path, i)
+		v.exactlyOne(p, "bot_token", string(c.BotToken), "bot_token_file", c.BotTokenFile)
+		if c.ChatID == 0 {
+			v.add(p+".chat_id", "missing chat_id on telegram_config")
+		}
+		switch c.ParseMode {
+		case "", TelegramParseModeMarkdown, TelegramParseModeMarkdownV2, TelegramParseModeHTML:
+		default:
+			v.add(p+".parse_mode", "unknown parse_mode %q, must be Markdown, MarkdownV2 or HTML", c.ParseMode)
+		}
+		v.checkURL(p+".api_url", c.APIURL)
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.DiscordConfigs {
+		p := fmt.Sprintf("%s.discord_configs[%d]", path, i)
+		v.exactlyOne(p, "webhook_url", string(c.WebhookURL), "webhook_url_file", c.WebhookURLFile)
+		v.checkURL(p+".webhook_url", string(c.WebhookURL))
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.SNSConfigs {
+		p := fmt.Sprintf("%s.sns_configs[%d]", path, i)
+		set := 0
+		for _, target := range []string{c.TopicARN, c.TargetARN, c.PhoneNumber} {
+			if target != "" {
+				set++
+			}
+		}
+		if set != 1 {
+			v.add(p, "must provide exactly one of target_arn, topic_arn or phone_number for SNS config")
+		}
+		v.checkURL(p+".api_url", c.APIURL)
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.WebexConfigs {
+		p := fmt.Sprintf("%s.webex_configs[%d]", path, i)
+		if c.RoomID == "" {
+			v.add(p+".room_id", "missing room_id on webex_config")
+		}
+		if c.HTTPConfig == nil || c.HTTPConfig.Authorization == nil {
+			v.add(p+".http_config.authorization", "missing webex_configs.http_config.authorization")
+		}
+		v.checkURL(p+".api_url", c.APIURL)
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.VictorOpsConfigs {
+		p := fmt.Sprintf("%s.victorops_configs[%d]", path, i)
+		if c.RoutingKey == "" {
+			v.add(p+".routing_key", "missing routing key in VictorOps config")
+		}
+		v.exclusive(p, "api_key", string(c.APIKey), "api_key_file", c.APIKeyFile)
+		if c.APIKey == "" && c.APIKeyFile == "" && g.VictorOpsAPIKey == "" && g.VictorOpsAPIKeyFile == "" {
+			v.add(p+".api_key", "no global VictorOps API Key set")
+		}
+		v.checkURL(p+".api_url", c.APIURL)
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.PushoverConfigs {
+		p := fmt.Sprintf("%s.pushover_configs[%d]", path, i)
+		v.exactlyOne(p, "user_key", string(c.UserKey), "user_key_file", c.UserKeyFile)
+		v.exactlyOne(p, "token", string(c.Token), "token_file", c.TokenFile)
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.WeChatConfigs {
+		p := fmt.Sprintf("%s.wechat_configs[%d]", path, i)
+		if c.APISecret == "" && g.WeChatAPISecret == "" {
+			v.add(p+".api_secret", "no global WeChat API secret set")
+		}
+		if c.CorpID == "" && g.WeChatAPICorpID == "" {
+			v.add(p+".corp_id", "no global WeChat corp ID set")
+		}
+		switch c.MessageType {
+		case "", "text", "markdown":
+		default:
+			v.add(p+".message_type", "unknown message_type %q, must be text or markdown", c.MessageType)
+		}
+		v.checkURL(p+".api_url", c.APIURL)
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.JiraConfigs {
+		p := fmt.Sprintf("%s.jira_configs[%d]", path, i)
+		if c.Project == "" {
+			v.add(p+".project", "missing project in jira_config")
+		}
+		if c.IssueType == "" {
+			v.add(p+".issue_type", "missing issue_type in jira_config")
+		}
+		if c.APIURL == "" && g.JiraAPIURL == "" {
+			v.add(p+".api_url", "no global Jira API URL set")
+		}
+		v.checkURL(p+".api_url", c.APIURL)
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+	for i, c := range r.RocketChatConfigs {
+		p := fmt.Sprintf("%s.rocketchat_configs[%d]", path, i)
+		v.exclusive(p, "token", string(c.Token), "token_file", c.TokenFile)
+		v.exclusive(p, "token_id", string(c.TokenID), "token_id_file", c.TokenIDFile)
+		if c.Token == "" && c.TokenFile == "" && g.RocketChatToken == "" {
+			v.add(p+".token", "no global Rocket.Chat token set")
+		}
+		if c.TokenID == "" && c.TokenIDFile == "" && g.RocketChatTokenID == "" {
+			v.add(p+".token_id", "no global Rocket.Chat token ID set")
+		}
+		v.checkURL(p+".api_url", c.APIURL)
+		v.validateHTTPConfig(p+".http_config", c.HTTPConfig)
+	}
+}
+
+// opsGenieResponderTypes are the responder types OpsGenie accepts.
+var opsGenieResponderTypes = map[string]bool{
+	"team":       true,
+	"teams":      true,
+	"user":       true,
+	"escalation": true,
+	"schedule":   true,
+}
+
+func (v *validator) validateHTTPConfig(path string, h *HTTPConfig) {
+	if h == nil {
+		return
+	}
+	auth := 0
+	if h.BasicAuth != nil {
+		auth++
+	}
+	if h.Authorization != nil {
+		auth++
+	}
+	if h.OAuth2 != nil {
+		auth++
+	}
+	if h.BearerToken != "" || h.BearerTokenFile != "" {
+		auth++
+	}
+	if auth > 1 {
+		v.add(path, "at most one of basic_auth, oauth2, bearer_token & bearer_token_file, authorization must be configured")
+	}
+	v.exclusive(path, "bearer_token", h.BearerToken, "bearer_token_file", h.BearerTokenFile)
+	if h.BasicAuth != nil {
+		v.exclusive(path+".basic_auth", "password", h.BasicAuth.Password, "password_file", h.BasicAuth.PasswordFile)
+	}
+	if a := h.Authorization; a != nil {
+		if strings.EqualFold(a.Type, "basic") {
+			v.add(path+".authorization.type", `authorization type cannot be set to "basic", use "basic_auth" instead`)
+		}
+		v.exclusive(path+".authorization", "credentials", string(a.Credentials), "credentials_file", a.CredentialsFile)
+	}
+	if o := h.OAuth2; o != nil {
+		if o.ClientID == "" {
+			v.add(path+".oauth2.client_id", "missing client_id in oauth2 config")
+		}
+		if o.TokenURL == "" {
+			v.add(path+".oauth2.token_url", "missing token_url in oauth2 config")
+		}
+		v.exclusive(path+".oauth2", "client_secret", string(o.ClientSecret), "client_secret_file", o.ClientSecretFile)
+		v.checkURL(path+".oauth2.token_url", o.TokenURL)
+		v.checkURL(path+".oauth2.proxy_url", o.ProxyURL)
+	}
+	v.checkURL(path+".proxy_url", h.ProxyURL)
+	if h.ProxyURL != "" && h.ProxyFromEnvironment {
+		v.add(path, "if proxy_from_environment is configured, proxy_url must not be configured")
+	}
+}
+
+// validateTimeIntervals checks the time_intervals and mute_time_intervals
+// sections and returns the set of defined interval names.
+func (v *validator) validateTimeIntervals() map[string]bool {
+	names := make(map[string]bool)
+	for _, section := range []struct {
+		key       string
+		intervals []*MuteTimeInterval
+	}{
+		{"mute_time_intervals", v.config.MuteTimeIntervals},
+		{"time_intervals", v.config.TimeIntervals},
+	} {
+		for i, mti := range section.intervals {
+			if mti == nil {
+				continue
+			}
+			path := fmt.Sprintf("%s[%d]", section.key, i)
+			if mti.Name == "" {
+				v.add(path+".name", "missing name in time interval")
+			} else if names[mti.Name] {
+				v.add(path+".name", "time interval %q is not unique", mti.Name)
+			}
+			names[mti.Name] = true
+			for j, ti := range mti.TimeIntervals {
+				v.validateTimeInterval(fmt.Sprintf("%s.time_intervals[%d]", path, j), &ti)
+			}
+		}
+	}
+	return names
+}
+
+func (v *validator) validateTimeInterval(path string, t *TimeInterval) {
+	for i, tr := range t.Times {
+		p := fmt.Sprintf("%s.times[%d]", path, i)
+		start, err := parseClock(tr.StartTime)
+		if err != nil {
+			v.add(p+".start_time", "%v", err)
+			continue
+		}
+		end, err := parseClock(tr.EndTime)
+		if err != nil {
+			v.add(p+".end_time", "%v", err)
+			continue
+		}
+		if start >= end {
+			v.add(p, "start_time %q must be before end_time %q", tr.StartTime, tr.EndTime)
+		}
+	}
+	validateRanges(v, path+".weekdays", t.Weekdays, parseWeekday)
+	validateRanges(v, path+".days_of_month", t.DaysOfMonth, parseDayOfMonth)
+	validateRanges(v, path+".months", t.Months, parseMonth)
+	validateRanges(v, path+".years", t.Years, parseYear)
+	if t.Location != "" {
+		if _, err := time.LoadLocation(t.Location); err != nil {
+			v.add(path+".location", "invalid location %q: %v", t.Location, err)
+		}
+	}
+}
+
+// validateRanges checks that each "a" or "a:b" range parses and that a
+// range does not end before it starts.
+func validateRanges[T ~string](v *validator, path string, ranges []T, parse func(string) (int, error)) {
+	for i, r := range ranges {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		startStr, endStr, isRange := strings.Cut(string(r), ":")
+		start, err := parse(startStr)
+		if err != nil {
+			v.add(p, "%v", err)
+			continue
+		}
+		if !isRange {
+			continue
+		}
+		end, err := parse(endStr)
+		if err != nil {
+			v.add(p, "%v", err)
+			continue
+		}
+		// Negative days of month count from the end of the month, so a
+		// range may mix signs; only compare like with like.
+		if (start < 0) == (end < 0) && start > end {
+			v.add(p, "range %q ends before it starts", r)
+		}
+	}
+}
+
+// parseDayOfMonth parses a day of month between -31 and 31, excluding 0.
+func parseDayOfMonth(s string) (int, error) {
+	day, err := strconv.Atoi(s)
+	if err != nil || day == 0 || day < -31 || day > 31 {
+		return 0, fmt.Errorf("invalid day of month: %q", s)
+	}
+	return day, nil
+}
+
+// parseYear parses a non-negative year.
+func parseYear(s string) (int, error) {
+	year, err := strconv.Atoi(s)
+	if err != nil || year < 0 {
+		return 0, fmt.Errorf("invalid year: %q", s)
+	}
+	return year, nil
+}
+
+// exclusive reports an error if both of two mutually exclusive fields are set.
+func (v *validator) exclusive(path, key, value, fileKey, fileValue string) {
+	if value != "" && fileValue != "" {
+		v.add(path, "at most one of %s & %s must be configured", key, fileKey)
+	}
+}
+
+// exactlyOne reports an error unless exactly one of two fields is set.
+func (v *validator) exactlyOne(path, key, value, fileKey, fileValue string) {
+	if value == "" && fileValue == "" {
+		v.add(path, "one of %s or %s must be configured", key, fileKey)
+		return
+	}
+	v.exclusive(path, key, value, fileKey, fileValue)
+}
+
+// checkURL reports an error if s is set but is not an absolute http or
+// https URL. Secret references and templates are skipped.
+func (v *validator) checkURL(path, s string) {
+	if s == "" || isTemplated(s) || strings.Contains(s, "${") {
+		return
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		v.add(path, "invalid URL %q: %v", s, err)
+		return
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		v.add(path, "unsupported scheme %q for URL %q", u.Scheme, s)
+		return
+	}
+	if u.Host == "" {
+		v.add(path, "missing host for URL %q", s)
+	}
+}
+
+// isTemplated reports whether s contains a Go template action.
+func isTemplated(s string) bool {
+	return strings.Contains(s, "{{")
+}
@@ -0,0 +1,287 @@
+package alertmanager
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestAlertmanagerConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want []string
+	}{
+		{
+			name: "valid config",
+			yaml: `
+global:
+  slack_api_url: https://hooks.slack.com/services/T/B/X
+  smtp_smarthost: smtp.example.com:587
+  smtp_from: alertmanager@example.com
+route:
+  receiver: default
+  group_by: [alertname, team]
+  routes:
+    - receiver: pager
+      matchers: ['severity="critical"', 'service=~"api|web"']
+      active_time_intervals: [office]
+receivers:
+  - name: default
+    slack_configs:
+      - channel: '#alerts'
+    email_configs:
+      - to: oncall@example.com
+  - name: pager
+    pagerduty_configs:
+      - routing_key: ${PD_KEY}
+time_intervals:
+  - name: office
+    time_intervals:
+      - times: [{start_time: "09:00", end_time: "17:00"}]
+        weekdays: ['monday:friday']
+        location: Europe/Berlin
+`,
+		},
+		{
+			name: "missing route",
+			yaml: `receivers: [{name: default}]`,
+			want: []string{"route: no route provided"},
+		},
+		{
+			name: "undefined and duplicate receivers",
+			yaml: `
+route:
+  receiver: default
+  routes:
+    - receiver: missing
+receivers:
+  - name: default
+  - name: default
+`,
+			want: []string{
+				`route.routes[0].receiver: undefined receiver "missing" used in route`,
+				`receivers[1].name: notification config name "default" is not unique`,
+			},
+		},
+		{
+			name: "root route restrictions",
+			yaml: `
+route:
+  matchers: ['team="a"']
+  mute_time_intervals: [nights]
+receivers: [{name: default}]
+time_intervals: [{name: nights}]
+`,
+			want: []string{
+				"root route must specify a default receiver",
+				"root route must not have any matchers",
+				"root route must not have any mute time intervals",
+			},
+		},
+		{
+			name: "invalid matchers and group_by",
+			yaml: `
+route:
+  receiver: default
+  group_by: ['...', alertname]
+  routes:
+    - receiver: default
+      matchers: ['service=~"(api"']
+      group_by: [alertname, alertname]
+receivers: [{name: default}]
+inhibit_rules:
+  - source_matchers: ['severity=~"[crit"']
+    target_matchers: ['severity="warning"']
+`,
+			want: []string{
+				"route.group_by: cannot have wildcard group_by",
+				`route.routes[0].matchers[0]: invalid regular expression "(api"`,
+				`route.routes[0].group_by: duplicated label "alertname" in group_by`,
+				"inhibit_rules[0].source_matchers[0]: invalid regular expression",
+			},
+		},
+		{
+			name: "invalid time intervals",
+			yaml: `
+route:
+  receiver: default
+  routes:
+    - receiver: default
+      mute_time_intervals: [weekends, holidays]
+receivers: [{name: default}]
+time_intervals:
+  - name: weekends
+    time_intervals:
+      - times: [{start_time: "17:00", end_time: "09:00"}]
+        weekdays: ['saturday:sunday', 'funday']
+        days_of_month: ['0']
+        months: ['december:january']
+        location: Mars/Olympus
+  - name: weekends
+`,
+			want: []string{
+				`route.routes[0].mute_time_intervals[1]: undefined time interval "holidays" used in route`,
+				`time_intervals[1].name: time interval "weekends" is not unique`,
+				`time_intervals[0].time_intervals[0].times[0]: start_time "17:00" must be before end_time "09:00"`,
+				`weekdays[0]: range "saturday:sunday" ends before it starts`,
+				`weekdays[1]: invalid weekday: "funday"`,
+				`days_of_month[0]: invalid day of month: "0"`,
+				`months[0]: range "december:january" ends before it starts`,
+				`location: invalid location "Mars/Olympus"`,
+			},
+		},
+		{
+			name: "missing integration fields",
+			yaml: `
+route:
+  receiver: default
+receivers:
+  - name: default
+    slack_configs: [{channel: '#alerts'}]
+    email_configs: [{}]
+    pagerduty_configs: [{}]
+    webhook_configs: [{url: 'http://a', url_file: /etc/url}]
+    opsgenie_configs: [{responders: [{type: squad}]}]
+    telegram_configs: [{bot_token: x, parse_mode: Plain}]
+    sns_configs: [{topic_arn: a, phone_number: '+1'}]
+    webex_configs: [{}]
+    victorops_configs: [{}]
+    pushover_configs: [{}]
+    wechat_configs: [{}]
+    jira_configs: [{}]
+    rocketchat_configs: [{}]
+    msteams_configs: [{}]
+    discord_configs: [{}]
+`,
+			want: []string{
+				"slack_configs[0].api_url: no global Slack API URL set",
+				"email_configs[0].to: missing to address in email config",
+				"email_configs[0].smarthost: no global SMTP smarthost set",
+				"email_configs[0].from: no global SMTP from set",
+				"pagerduty_configs[0]: missing service or routing key in PagerDuty config",
+				"webhook_configs[0]: at most one of url & url_file must be configured",
+				"opsgenie_configs[0].api_key: no global OpsGenie API Key set",
+				"opsgenie_configs[0].responders[0]: responder must have at least one of id, username or name specified",
+				`opsgenie_configs[0].responders[0].type: responder type "squad"`,
+				"telegram_configs[0].chat_id: missing chat_id on telegram_config",
+				`telegram_configs[0].parse_mode: unknown parse_mode "Plain"`,
+				"sns_configs[0]: must provide exactly one of target_arn, topic_arn or phone_number",
+				"webex_configs[0].room_id: missing room_id on webex_config",
+				"webex_configs[0].http_config.authorization: missing webex_configs.http_config.authorization",
+				"victorops_configs[0].routing_key: missing routing key in VictorOps config",
+				"victorops_configs[0].api_key: no global VictorOps API Key set",
+				"pushover_configs[0]: one of user_key or user_key_file must be configured",
+				"pushover_configs[0]: one of token or token_file must be configured",
+				"wechat_configs[0].api_secret: no global WeChat API secret set",
+				"wechat_configs[0].corp_id: no global WeChat corp ID set",
+				"jira_configs[0].project: missing project in jira_config",
+				"jira_configs[0].issue_type: missing issue_type in jira_config",
+				"jira_configs[0].api_url: no global Jira API URL set",
+				"rocketchat_configs[0].token: no global Rocket.Chat token set",
+				"rocketchat_configs[0].token_id: no global Rocket.Chat token ID set",
+				"msteams_configs[0]: one of webhook_url or webhook_url_file must be configured",
+				"discord_configs[0]: one of webhook_url or webhook_url_file must be configured",
+			},
+		},
+		{
+			name: "invalid URLs and http config",
+			yaml: `
+global:
+  slack_api_url: hooks.slack.com/services/T/B/X
+route:
+  receiver: default
+receivers:
+  - name: default
+    webhook_configs:
+      - url: ftp://example.com/hook
+        http_config:
+          bearer_token: abc
+          basic_auth: {username: u, password: p}
+          proxy_url: http://
+      - url: '{{ template "url" . }}'
+`,
+			want: []string{
+				`global.slack_api_url: unsupported scheme "" for URL`,
+				`webhook_configs[0].url: unsupported scheme "ftp"`,
+				"webhook_configs[0].http_config: at most one of basic_auth, oauth2, bearer_token & bearer_token_file, authorization must be configured",
+				`webhook_configs[0].http_config.proxy_url: missing host for URL "http://"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var config AlertmanagerConfig
+			if err := yaml.Unmarshal([]byte(tt.yaml), &config); err != nil {
+				t.Fatalf("yaml.Unmarshal() error = %v", err)
+			}
+
+			errs := config.Validate()
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			joined := strings.Join(got, "\n")
+
+			if len(tt.want) == 0 && len(errs) > 0 {
+				t.Fatalf("Validate() = %v, want no errors", joined)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(joined, want) {
+					t.Errorf("Validate() missing %q\nGot:\n%s", want, joined)
+				}
+			}
+			if len(errs) != len(tt.want) {
+				t.Errorf("Validate() returned %d errors, want %d\nGot:\n%s", len(errs), len(tt.want), joined)
+			}
+		})
+	}
+}
+
+func TestRoutingPolicy_ConfigValidates(t *testing.T) {
+	config := testRoutingPolicy().Config().
+		WithGlobal(NewGlobalConfig().WithSlackAPIURL("https://hooks.slack.com/services/T/B/X"))
+
+	if errs := config.Validate(); len(errs) > 0 {
+		t.Errorf("Validate() = %v, want no errors", errs)
+	}
+}
+
+func TestMatcher_Validate(t *testing.T) {
+	tests := []struct {
+		matcher *Matcher
+		wantErr bool
+	}{
+		{Eq("team", "platform"), false},
+		{Regex("service", "api|web"), false},
+		{NotRegex("service", "(api"), true},
+		{Eq("", "x"), true},
+		{&Matcher{Label: "team", Op: "==", Value: "x"}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.matcher.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s.Validate() error = %v, wantErr %v", tt.matcher, err, tt.wantErr)
+		}
+	}
+}
+
+func TestValidateGroupBy(t *testing.T) {
+	tests := []struct {
+		labels  []string
+		wantErr bool
+	}{
+		{nil, false},
+		{[]string{"..."}, false},
+		{[]string{"alertname", "team"}, false},
+		{[]string{"...", "team"}, true},
+		{[]string{"team", "team"}, true},
+		{[]string{""}, true},
+	}
+	for _, tt := range tests {
+		if err := ValidateGroupBy(tt.labels); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateGroupBy(%v) error = %v, wantErr %v", tt.labels, err, tt.wantErr)
+		}
+	}
+}
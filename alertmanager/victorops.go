@@ -0,0 +1,115 @@
+package alertmanager
+
+// VictorOpsConfig configures notifications to Splunk On-Call (VictorOps).
+type VictorOpsConfig struct {
+	// SendResolved determines if resolved alerts should be sent.
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// APIKey is the VictorOps API key.
+	APIKey Secret `yaml:"api_key,omitempty"`
+
+	// APIKeyFile is a file containing the API key.
+	APIKeyFile string `yaml:"api_key_file,omitempty"`
+
+	// APIURL is the VictorOps API URL.
+	APIURL string `yaml:"api_url,omitempty"`
+
+	// RoutingKey routes alerts to a team.
+	RoutingKey string `yaml:"routing_key"`
+
+	// MessageType describes the behavior of the alert (CRITICAL, WARNING, INFO).
+	MessageType string `yaml:"message_type,omitempty"`
+
+	// EntityDisplayName is the summary of the alerted problem.
+	EntityDisplayName string `yaml:"entity_display_name,omitempty"`
+
+	// StateMessage is the long explanation of the alerted problem.
+	StateMessage string `yaml:"state_message,omitempty"`
+
+	// MonitoringTool is the monitoring tool the state message is from.
+	MonitoringTool string `yaml:"monitoring_tool,omitempty"`
+
+	// CustomFields contains additional fields for the incident.
+	CustomFields map[string]string `yaml:"custom_fields,omitempty"`
+
+	// HTTPConfig configures HTTP client settings.
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// VictorOps message types.
+const (
+	VictorOpsMessageCritical = "CRITICAL"
+	VictorOpsMessageWarning  = "WARNING"
+	VictorOpsMessageInfo     = "INFO"
+)
+
+// NewVictorOpsConfig creates a new VictorOpsConfig.
+func NewVictorOpsConfig() *VictorOpsConfig {
+	return &VictorOpsConfig{}
+}
+
+// WithSendResolved sets whether to send resolved alerts.
+func (v *VictorOpsConfig) WithSendResolved(send bool) *VictorOpsConfig {
+	v.SendResolved = &send
+	return v
+}
+
+// WithAPIKey sets the VictorOps API key.
+func (v *VictorOpsConfig) WithAPIKey(key Secret) *VictorOpsConfig {
+	v.APIKey = key
+	return v
+}
+
+// WithAPIKeyFile sets the file containing the API key.
+func (v *VictorOpsConfig) WithAPIKeyFile(path string) *VictorOpsConfig {
+	v.APIKeyFile = path
+	return v
+}
+
+// WithAPIURL sets the VictorOps API URL.
+func (v *VictorOpsConfig) WithAPIURL(url string) *VictorOpsConfig {
+	v.APIURL = url
+	return v
+}
+
+// WithRoutingKey sets the routing key of the team receiving alerts.
+func (v *VictorOpsConfig) WithRoutingKey(key string) *VictorOpsConfig {
+	v.RoutingKey = key
+	return v
+}
+
+// WithMessageType sets the message type.
+func (v *VictorOpsConfig) WithMessageType(messageType string) *VictorOpsConfig {
+	v.MessageType = messageType
+	return v
+}
+
+// WithEntityDisplayName sets the incident summary.
+func (v *VictorOpsConfig) WithEntityDisplayName(name string) *VictorOpsConfig {
+	v.EntityDisplayName = name
+	return v
+}
+
+// WithStateMessage sets the incident description.
+func (v *VictorOpsConfig) WithStateMessage(message string) *VictorOpsConfig {
+	v.StateMessage = message
+	return v
+}
+
+// WithMonitoringTool sets the monitoring tool name.
+func (v *VictorOpsConfig) WithMonitoringTool(tool string) *VictorOpsConfig {
+	v.MonitoringTool = tool
+	return v
+}
+
+// WithCustomFields sets additional incident fields.
+func (v *VictorOpsConfig) WithCustomFields(fields map[string]string) *VictorOpsConfig {
+	v.CustomFields = fields
+	return v
+}
+
+// WithHTTPConfig sets HTTP client configuration.
+func (v *VictorOpsConfig) WithHTTPConfig(config *HTTPConfig) *VictorOpsConfig {
+	v.HTTPConfig = config
+	return v
+}
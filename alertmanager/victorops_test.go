@@ -0,0 +1,57 @@
+package alertmanager
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewVictorOpsConfig(t *testing.T) {
+	config := NewVictorOpsConfig().WithRoutingKey("platform")
+	if config.RoutingKey != "platform" {
+		t.Errorf("RoutingKey = %v", config.RoutingKey)
+	}
+}
+
+func TestVictorOpsConfig_FluentAPI(t *testing.T) {
+	config := NewVictorOpsConfig().WithRoutingKey("platform").
+		WithAPIKey(NewSecret("api-key")).
+		WithMessageType(VictorOpsMessageCritical).
+		WithEntityDisplayName("{{ .CommonLabels.alertname }}").
+		WithCustomFields(map[string]string{"team": "platform"})
+
+	if string(config.APIKey) != "api-key" {
+		t.Errorf("APIKey = %v", config.APIKey)
+	}
+	if config.MessageType != "CRITICAL" {
+		t.Errorf("MessageType = %v", config.MessageType)
+	}
+	if config.CustomFields["team"] != "platform" {
+		t.Errorf("CustomFields = %v", config.CustomFields)
+	}
+}
+
+func TestVictorOpsConfig_Serialize(t *testing.T) {
+	config := NewVictorOpsConfig().WithRoutingKey("platform").
+		WithAPIKeyFile("/etc/alertmanager/secrets/victorops-key").
+		WithMonitoringTool("prometheus")
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"routing_key: platform",
+		"api_key_file: /etc/alertmanager/secrets/victorops-key",
+		"monitoring_tool: prometheus",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package alertmanager
+
+// WebexConfig configures notifications to Cisco Webex.
+// The bot token is supplied through HTTPConfig authorization.
+type WebexConfig struct {
+	// SendResolved determines if resolved alerts should be sent.
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// APIURL is the Webex Teams API URL.
+	APIURL string `yaml:"api_url,omitempty"`
+
+	// RoomID is the ID of the Webex room to send messages to.
+	RoomID string `yaml:"room_id"`
+
+	// Message is the message text.
+	Message string `yaml:"message,omitempty"`
+
+	// HTTPConfig configures HTTP client settings.
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// NewWebexConfig creates a new WebexConfig.
+func NewWebexConfig() *WebexConfig {
+	return &WebexConfig{}
+}
+
+// WithSendResolved sets whether to send resolved alerts.
+func (w *WebexConfig) WithSendResolved(send bool) *WebexConfig {
+	w.SendResolved = &send
+	return w
+}
+
+// WithRoomID sets the room to send messages to.
+func (w *WebexConfig) WithRoomID(id string) *WebexConfig {
+	w.RoomID = id
+	return w
+}
+
+// WithAPIURL sets the Webex API URL.
+func (w *WebexConfig) WithAPIURL(url string) *WebexConfig {
+	w.APIURL = url
+	return w
+}
+
+// WithMessage sets the message text.
+func (w *WebexConfig) WithMessage(message string) *WebexConfig {
+	w.Message = message
+	return w
+}
+
+// WithBotToken sets the bot access token sent in the Authorization header.
+func (w *WebexConfig) WithBotToken(token Secret) *WebexConfig {
+	if w.HTTPConfig == nil {
+		w.HTTPConfig = NewHTTPConfig()
+	}
+	w.HTTPConfig.WithAuthorization(token)
+	return w
+}
+
+// WithHTTPConfig sets HTTP client configuration.
+func (w *WebexConfig) WithHTTPConfig(config *HTTPConfig) *WebexConfig {
+	w.HTTPConfig = config
+	return w
+}
@@ -0,0 +1,52 @@
+package alertmanager
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewWebexConfig(t *testing.T) {
+	config := NewWebexConfig().WithRoomID("room-123")
+	if config.RoomID != "room-123" {
+		t.Errorf("RoomID = %v", config.RoomID)
+	}
+}
+
+func TestWebexConfig_Serialize(t *testing.T) {
+	config := NewWebexConfig().WithRoomID("room-123").
+		WithAPIURL("https://webexapis.com/v1/messages").
+		WithMessage("{{ .CommonAnnotations.summary }}").
+		WithSendResolved(true)
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"send_resolved: true",
+		"room_id: room-123",
+		"api_url: https://webexapis.com/v1/messages",
+		"message: '{{ .CommonAnnotations.summary }}'",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestWebexConfig_WithBotToken(t *testing.T) {
+	config := NewWebexConfig().WithRoomID("room-123").WithBotToken(NewSecret("bot-token"))
+
+	if config.HTTPConfig == nil || config.HTTPConfig.Authorization == nil {
+		t.Fatal("expected HTTPConfig authorization")
+	}
+	if string(config.HTTPConfig.Authorization.Credentials) != "bot-token" {
+		t.Errorf("Credentials = %v", config.HTTPConfig.Authorization.Credentials)
+	}
+}
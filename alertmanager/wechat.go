@@ -0,0 +1,108 @@
+package alertmanager
+
+// WeChatConfig configures notifications to WeChat Work.
+type WeChatConfig struct {
+	// SendResolved determines if resolved alerts should be sent.
+	SendResolved *bool `yaml:"send_resolved,omitempty"`
+
+	// APISecret is the application secret.
+	APISecret Secret `yaml:"api_secret,omitempty"`
+
+	// APIURL is the WeChat API URL.
+	APIURL string `yaml:"api_url,omitempty"`
+
+	// CorpID is the corporate ID for authentication.
+	CorpID string `yaml:"corp_id,omitempty"`
+
+	// AgentID is the application agent ID.
+	AgentID string `yaml:"agent_id,omitempty"`
+
+	// ToUser is the user IDs to notify, separated by "|".
+	ToUser string `yaml:"to_user,omitempty"`
+
+	// ToParty is the department IDs to notify, separated by "|".
+	ToParty string `yaml:"to_party,omitempty"`
+
+	// ToTag is the tag IDs to notify, separated by "|".
+	ToTag string `yaml:"to_tag,omitempty"`
+
+	// Message is the message text.
+	Message string `yaml:"message,omitempty"`
+
+	// MessageType is the message type (text, markdown).
+	MessageType string `yaml:"message_type,omitempty"`
+
+	// HTTPConfig configures HTTP client settings.
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+// NewWeChatConfig creates a new WeChatConfig.
+func NewWeChatConfig() *WeChatConfig {
+	return &WeChatConfig{}
+}
+
+// WithSendResolved sets whether to send resolved alerts.
+func (w *WeChatConfig) WithSendResolved(send bool) *WeChatConfig {
+	w.SendResolved = &send
+	return w
+}
+
+// WithAPISecret sets the application secret.
+func (w *WeChatConfig) WithAPISecret(secret Secret) *WeChatConfig {
+	w.APISecret = secret
+	return w
+}
+
+// WithAPIURL sets the WeChat API URL.
+func (w *WeChatConfig) WithAPIURL(url string) *WeChatConfig {
+	w.APIURL = url
+	return w
+}
+
+// WithCorpID sets the corporate ID.
+func (w *WeChatConfig) WithCorpID(corpID string) *WeChatConfig {
+	w.CorpID = corpID
+	return w
+}
+
+// WithAgentID sets the application agent ID.
+func (w *WeChatConfig) WithAgentID(agentID string) *WeChatConfig {
+	w.AgentID = agentID
+	return w
+}
+
+// WithToUser sets the user IDs to notify.
+func (w *WeChatConfig) WithToUser(users string) *WeChatConfig {
+	w.ToUser = users
+	return w
+}
+
+// WithToParty sets the department IDs to notify.
+func (w *WeChatConfig) WithToParty(parties string) *WeChatConfig {
+	w.ToParty = parties
+	return w
+}
+
+// WithToTag sets the tag IDs to notify.
+func (w *WeChatConfig) WithToTag(tags string) *WeChatConfig {
+	w.ToTag = tags
+	return w
+}
+
+// WithMessage sets the message text.
+func (w *WeChatConfig) WithMessage(message string) *WeChatConfig {
+	w.Message = message
+	return w
+}
+
+// WithMessageType sets the message type.
+func (w *WeChatConfig) WithMessageType(messageType string) *WeChatConfig {
+	w.MessageType = messageType
+	return w
+}
+
+// WithHTTPConfig sets HTTP client configuration.
+func (w *WeChatConfig) WithHTTPConfig(config *HTTPConfig) *WeChatConfig {
+	w.HTTPConfig = config
+	return w
+}
@@ -0,0 +1,44 @@
+package alertmanager
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewWeChatConfig(t *testing.T) {
+	config := NewWeChatConfig()
+	if config == nil {
+		t.Error("NewWeChatConfig() returned nil")
+	}
+}
+
+func TestWeChatConfig_Serialize(t *testing.T) {
+	config := NewWeChatConfig().
+		WithAPISecret(NewSecret("wechat-secret")).
+		WithCorpID("corp-1").
+		WithAgentID("1000002").
+		WithToParty("2|3").
+		WithMessageType("markdown")
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"api_secret: wechat-secret",
+		"corp_id: corp-1",
+		`agent_id: "1000002"`,
+		"to_party: 2|3",
+		"message_type: markdown",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package blackbox
+
+import "github.com/lex00/wetwire-observability-go/rules"
+
+// ProbeFailed fires when a probe has been failing for 5 minutes.
+var ProbeFailed = rules.AlertingRule{
+	Alert: "BlackboxProbeFailed",
+	Expr:  "probe_success == 0",
+	For:   5 * rules.Minute,
+	Labels: map[string]string{
+		"severity": "critical",
+	},
+	Annotations: map[string]string{
+		"summary":     "Blackbox probe failed",
+		"description": "Probe of {{ $labels.instance }} ({{ $labels.job }}) has been failing for 5 minutes",
+	},
+}
+
+// CertificateExpiringSoon fires when a probed TLS certificate expires within
+// 14 days.
+var CertificateExpiringSoon = rules.AlertingRule{
+	Alert: "BlackboxCertificateExpiringSoon",
+	Expr:  "probe_ssl_earliest_cert_expiry - time() < 86400 * 14",
+	For:   1 * rules.Hour,
+	Labels: map[string]string{
+		"severity": "warning",
+	},
+	Annotations: map[string]string{
+		"summary":     "TLS certificate expires soon",
+		"description": "TLS certificate of {{ $labels.instance }} expires in {{ $value | humanizeDuration }}",
+	},
+}
+
+// CertificateExpiryCritical fires when a probed TLS certificate expires
+// within 3 days.
+var CertificateExpiryCritical = rules.AlertingRule{
+	Alert: "BlackboxCertificateExpiryCritical",
+	Expr:  "probe_ssl_earliest_cert_expiry - time() < 86400 * 3",
+	For:   10 * rules.Minute,
+	Labels: map[string]string{
+		"severity": "critical",
+	},
+	Annotations: map[string]string{
+		"summary":     "TLS certificate about to expire",
+		"description": "TLS certificate of {{ $labels.instance }} expires in {{ $value | humanizeDuration }}",
+	},
+}
+
+// Alerts returns the default probe failure and certificate expiry alerts
+// as a rule group.
+func Alerts() *rules.RuleGroup {
+	return rules.NewRuleGroup("blackbox").WithRules(
+		&ProbeFailed,
+		&CertificateExpiringSoon,
+		&CertificateExpiryCritical,
+	)
+}
@@ -0,0 +1,22 @@
+package blackbox
+
+import (
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/rules"
+)
+
+func TestAlerts(t *testing.T) {
+	group := Alerts()
+	if group.Name != "blackbox" {
+		t.Errorf("Name = %q, want blackbox", group.Name)
+	}
+	if len(group.Rules) != 3 {
+		t.Fatalf("len(Rules) = %d, want 3", len(group.Rules))
+	}
+
+	f := rules.NewRulesFile().WithGroups(group)
+	if errs := f.Validate(); len(errs) > 0 {
+		t.Errorf("Validate() = %v, want no errors", errs)
+	}
+}
@@ -0,0 +1,406 @@
+// Package blackbox provides types for blackbox_exporter configuration and
+// the Prometheus jobs that probe targets through it.
+package blackbox
+
+import "github.com/lex00/wetwire-observability-go/prometheus"
+
+// Duration is an alias for prometheus.Duration for consistency.
+type Duration = prometheus.Duration
+
+// Convenience duration constants.
+const (
+	Second = prometheus.Second
+	Minute = prometheus.Minute
+)
+
+// Prober is the protocol a module probes with.
+type Prober string
+
+// Supported probers.
+const (
+	ProberHTTP Prober = "http"
+	ProberTCP  Prober = "tcp"
+	ProberICMP Prober = "icmp"
+	ProberDNS  Prober = "dns"
+	ProberGRPC Prober = "grpc"
+)
+
+// IPProtocol is the IP protocol used by a probe.
+type IPProtocol string
+
+// IP protocols.
+const (
+	IPv4 IPProtocol = "ip4"
+	IPv6 IPProtocol = "ip6"
+)
+
+// Config is a blackbox_exporter configuration (blackbox.yml). The build
+// command writes each Config variable to blackbox-<name>.yml.
+//
+// Example usage:
+//
+//	var Blackbox *blackbox.Config = blackbox.NewConfig().
+//	    AddModule("http_2xx", blackbox.HTTPModule().WithValidStatusCodes(200, 204)).
+//	    AddModule("tcp_tls", blackbox.TCPModule().WithTLS())
+type Config struct {
+	// Modules maps module names to probe settings. Probes select a module
+	// with the module URL parameter.
+	Modules map[string]*Module `yaml:"modules"`
+}
+
+// Module configures how a target is probed.
+type Module struct {
+	// Prober is the protocol used by the module.
+	Prober Prober `yaml:"prober"`
+
+	// Timeout is the probe timeout. Defaults to the scrape timeout minus
+	// a small offset.
+	Timeout Duration `yaml:"timeout,omitempty"`
+
+	// HTTP configures the http prober.
+	HTTP *HTTPProbe `yaml:"http,omitempty"`
+
+	// TCP configures the tcp prober.
+	TCP *TCPProbe `yaml:"tcp,omitempty"`
+
+	// ICMP configures the icmp prober.
+	ICMP *ICMPProbe `yaml:"icmp,omitempty"`
+
+	// DNS configures the dns prober.
+	DNS *DNSProbe `yaml:"dns,omitempty"`
+
+	// GRPC configures the grpc prober.
+	GRPC *GRPCProbe `yaml:"grpc,omitempty"`
+}
+
+// HTTPProbe configures the http prober.
+type HTTPProbe struct {
+	// HTTPClientConfig configures authentication, TLS and proxying.
+	prometheus.HTTPClientConfig `yaml:",inline"`
+
+	// ValidStatusCodes are the accepted status codes. Defaults to 2xx.
+	ValidStatusCodes []int `yaml:"valid_status_codes,omitempty"`
+
+	// ValidHTTPVersions are the accepted HTTP versions, e.g. "HTTP/1.1".
+	ValidHTTPVersions []string `yaml:"valid_http_versions,omitempty"`
+
+	// Method is the HTTP method. Defaults to GET.
+	Method string `yaml:"method,omitempty"`
+
+	// Headers are sent with the probe request.
+	Headers map[string]string `yaml:"headers,omitempty"`
+
+	// Body is the request body.
+	Body string `yaml:"body,omitempty"`
+
+	// BodySizeLimit is the maximum uncompressed body size in bytes.
+	BodySizeLimit int64 `yaml:"body_size_limit,omitempty"`
+
+	// Compression is the expected response compression.
+	Compression string `yaml:"compression,omitempty"`
+
+	// FailIfSSL fails the probe if TLS is used.
+	FailIfSSL bool `yaml:"fail_if_ssl,omitempty"`
+
+	// FailIfNotSSL fails the probe if TLS is not used.
+	FailIfNotSSL bool `yaml:"fail_if_not_ssl,omitempty"`
+
+	// FailIfBodyMatchesRegexp fails the probe if the body matches any regex.
+	FailIfBodyMatchesRegexp []string `yaml:"fail_if_body_matches_regexp,omitempty"`
+
+	// FailIfBodyNotMatchesRegexp fails the probe unless the body matches
+	// every regex.
+	FailIfBodyNotMatchesRegexp []string `yaml:"fail_if_body_not_matches_regexp,omitempty"`
+
+	// PreferredIPProtocol is the IP protocol tried first. Defaults to ip6.
+	PreferredIPProtocol IPProtocol `yaml:"preferred_ip_protocol,omitempty"`
+
+	// IPProtocolFallback falls back to the other IP protocol. Defaults to true.
+	IPProtocolFallback *bool `yaml:"ip_protocol_fallback,omitempty"`
+}
+
+// TCPProbe configures the tcp prober.
+type TCPProbe struct {
+	// PreferredIPProtocol is the IP protocol tried first. Defaults to ip6.
+	PreferredIPProtocol IPProtocol `yaml:"preferred_ip_protocol,omitempty"`
+
+	// IPProtocolFallback falls back to the other IP protocol. Defaults to true.
+	IPProtocolFallback *bool `yaml:"ip_protocol_fallback,omitempty"`
+
+	// SourceIPAddress is the source address of the connection.
+	SourceIPAddress string `yaml:"source_ip_address,omitempty"`
+
+	// QueryResponse is a sequence of expect/send steps run on the connection.
+	QueryResponse []*QueryResponse `yaml:"query_response,omitempty"`
+
+	// TLS upgrades the connection to TLS immediately.
+	TLS bool `yaml:"tls,omitempty"`
+
+	// TLSConfig configures TLS.
+	TLSConfig *prometheus.TLSConfig `yaml:"tls_config,omitempty"`
+}
+
+// QueryResponse is one step of a tcp probe conversation.
+type QueryResponse struct {
+	// Expect is a regex the next line read must match.
+	Expect string `yaml:"expect,omitempty"`
+
+	// Send is written to the connection.
+	Send string `yaml:"send,omitempty"`
+
+	// StartTLS upgrades the connection to TLS.
+	StartTLS bool `yaml:"starttls,omitempty"`
+}
+
+// ICMPProbe configures the icmp prober.
+type ICMPProbe struct {
+	// PreferredIPProtocol is the IP protocol tried first. Defaults to ip6.
+	PreferredIPProtocol IPProtocol `yaml:"preferred_ip_protocol,omitempty"`
+
+	// IPProtocolFallback falls back to the other IP protocol. Defaults to true.
+	IPProtocolFallback *bool `yaml:"ip_protocol_fallback,omitempty"`
+
+	// SourceIPAddress is the source address of the packets.
+	SourceIPAddress string `yaml:"source_ip_address,omitempty"`
+
+	// DontFragment sets the DF bit. Only supported with ip4.
+	DontFragment bool `yaml:"dont_fragment,omitempty"`
+
+	// PayloadSize is the size of the ICMP payload in bytes.
+	PayloadSize int `yaml:"payload_size,omitempty"`
+
+	// TTL is the time to live of the packets.
+	TTL int `yaml:"ttl,omitempty"`
+}
+
+// DNSProbe configures the dns prober.
+type DNSProbe struct {
+	// PreferredIPProtocol is the IP protocol tried first. Defaults to ip6.
+	PreferredIPProtocol IPProtocol `yaml:"preferred_ip_protocol,omitempty"`
+
+	// IPProtocolFallback falls back to the other IP protocol. Defaults to true.
+	IPProtocolFallback *bool `yaml:"ip_protocol_fallback,omitempty"`
+
+	// SourceIPAddress is the source address of the query.
+	SourceIPAddress string `yaml:"source_ip_address,omitempty"`
+
+	// TransportProtocol is udp or tcp. Defaults to udp.
+	TransportProtocol string `yaml:"transport_protocol,omitempty"`
+
+	// DNSOverTLS queries over TLS. Requires the tcp transport.
+	DNSOverTLS bool `yaml:"dns_over_tls,omitempty"`
+
+	// TLSConfig configures TLS for DNS over TLS.
+	TLSConfig *prometheus.TLSConfig `yaml:"tls_config,omitempty"`
+
+	// QueryName is the name to resolve.
+	QueryName string `yaml:"query_name"`
+
+	// QueryType is the record type. Defaults to ANY.
+	QueryType string `yaml:"query_type,omitempty"`
+
+	// QueryClass is the record class. Defaults to IN.
+	QueryClass string `yaml:"query_class,omitempty"`
+
+	// RecursionDesired sets the RD flag. Defaults to true.
+	RecursionDesired *bool `yaml:"recursion_desired,omitempty"`
+
+	// ValidRcodes are the accepted response codes. Defaults to NOERROR.
+	ValidRcodes []string `yaml:"valid_rcodes,omitempty"`
+
+	// ValidateAnswer validates the answer section.
+	ValidateAnswer *DNSRRValidator `yaml:"validate_answer_rrs,omitempty"`
+
+	// ValidateAuthority validates the authority section.
+	ValidateAuthority *DNSRRValidator `yaml:"validate_authority_rrs,omitempty"`
+
+	// ValidateAdditional validates the additional section.
+	ValidateAdditional *DNSRRValidator `yaml:"validate_additional_rrs,omitempty"`
+}
+
+// DNSRRValidator validates the resource records of a DNS response section.
+type DNSRRValidator struct {
+	// FailIfMatchesRegexp fails if any record matches any regex.
+	FailIfMatchesRegexp []string `yaml:"fail_if_matches_regexp,omitempty"`
+
+	// FailIfAllMatchRegexp fails if all records match any regex.
+	FailIfAllMatchRegexp []string `yaml:"fail_if_all_match_regexp,omitempty"`
+
+	// FailIfNotMatchesRegexp fails if any record does not match a regex.
+	FailIfNotMatchesRegexp []string `yaml:"fail_if_not_matches_regexp,omitempty"`
+
+	// FailIfNoneMatchesRegexp fails if no record matches a regex.
+	FailIfNoneMatchesRegexp []string `yaml:"fail_if_none_matches_regexp,omitempty"`
+}
+
+// GRPCProbe configures the grpc prober, which calls the standard health
+// checking service.
+type GRPCProbe struct {
+	// Service is the service name sent in the health check request.
+	Service string `yaml:"service,omitempty"`
+
+	// PreferredIPProtocol is the IP protocol tried first. Defaults to ip6.
+	PreferredIPProtocol IPProtocol `yaml:"preferred_ip_protocol,omitempty"`
+
+	// IPProtocolFallback falls back to the other IP protocol. Defaults to true.
+	IPProtocolFallback *bool `yaml:"ip_protocol_fallback,omitempty"`
+
+	// TLS uses TLS for the connection.
+	TLS bool `yaml:"tls,omitempty"`
+
+	// TLSConfig configures TLS.
+	TLSConfig *prometheus.TLSConfig `yaml:"tls_config,omitempty"`
+}
+
+// NewConfig creates a new empty Config.
+func NewConfig() *Config {
+	return &Config{}
+}
+
+// AddModule adds a module under the given name.
+func (c *Config) AddModule(name string, module *Module) *Config {
+	if c.Modules == nil {
+		c.Modules = make(map[string]*Module)
+	}
+	c.Modules[name] = module
+	return c
+}
+
+// HTTPModule creates an http module.
+func HTTPModule() *Module {
+	return &Module{Prober: ProberHTTP, HTTP: &HTTPProbe{}}
+}
+
+// TCPModule creates a tcp module.
+func TCPModule() *Module {
+	return &Module{Prober: ProberTCP, TCP: &TCPProbe{}}
+}
+
+// ICMPModule creates an icmp module.
+func ICMPModule() *Module {
+	return &Module{Prober: ProberICMP, ICMP: &ICMPProbe{}}
+}
+
+// DNSModule creates a dns module resolving queryName.
+func DNSModule(queryName string) *Module {
+	return &Module{Prober: ProberDNS, DNS: &DNSProbe{QueryName: queryName}}
+}
+
+// GRPCModule creates a grpc module.
+func GRPCModule() *Module {
+	return &Module{Prober: ProberGRPC, GRPC: &GRPCProbe{}}
+}
+
+// WithTimeout sets the probe timeout.
+func (m *Module) WithTimeout(d Duration) *Module {
+	m.Timeout = d
+	return m
+}
+
+// WithPreferredIPProtocol sets the IP protocol tried first.
+func (m *Module) WithPreferredIPProtocol(protocol IPProtocol) *Module {
+	switch {
+	case m.HTTP != nil:
+		m.HTTP.PreferredIPProtocol = protocol
+	case m.TCP != nil:
+		m.TCP.PreferredIPProtocol = protocol
+	case m.ICMP != nil:
+		m.ICMP.PreferredIPProtocol = protocol
+	case m.DNS != nil:
+		m.DNS.PreferredIPProtocol = protocol
+	case m.GRPC != nil:
+		m.GRPC.PreferredIPProtocol = protocol
+	}
+	return m
+}
+
+// WithValidStatusCodes sets the accepted HTTP status codes.
+func (m *Module) WithValidStatusCodes(codes ...int) *Module {
+	m.httpProbe().ValidStatusCodes = codes
+	return m
+}
+
+// WithMethod sets the HTTP method.
+func (m *Module) WithMethod(method string) *Module {
+	m.httpProbe().Method = method
+	return m
+}
+
+// WithHeaders sets the HTTP request headers.
+func (m *Module) WithHeaders(headers map[string]string) *Module {
+	m.httpProbe().Headers = headers
+	return m
+}
+
+// WithFailIfNotSSL fails HTTP probes that do not use TLS.
+func (m *Module) WithFailIfNotSSL() *Module {
+	m.httpProbe().FailIfNotSSL = true
+	return m
+}
+
+// WithBodyMatching fails HTTP probes whose body does not match regex.
+func (m *Module) WithBodyMatching(regex string) *Module {
+	h := m.httpProbe()
+	h.FailIfBodyNotMatchesRegexp = append(h.FailIfBodyNotMatchesRegexp, regex)
+	return m
+}
+
+// WithTLS enables TLS on tcp and grpc probes.
+func (m *Module) WithTLS() *Module {
+	switch {
+	case m.TCP != nil:
+		m.TCP.TLS = true
+	case m.GRPC != nil:
+		m.GRPC.TLS = true
+	}
+	return m
+}
+
+// WithTLSConfig sets the TLS configuration of the probe.
+func (m *Module) WithTLSConfig(tls *prometheus.TLSConfig) *Module {
+	switch {
+	case m.HTTP != nil:
+		m.HTTP.TLSConfig = tls
+	case m.TCP != nil:
+		m.TCP.TLSConfig = tls
+	case m.DNS != nil:
+		m.DNS.TLSConfig = tls
+	case m.GRPC != nil:
+		m.GRPC.TLSConfig = tls
+	}
+	return m
+}
+
+// WithQueryResponse appends expect/send steps to a tcp probe.
+func (m *Module) WithQueryResponse(steps ...*QueryResponse) *Module {
+	if m.TCP == nil {
+		m.TCP = &TCPProbe{}
+	}
+	m.TCP.QueryResponse = append(m.TCP.QueryResponse, steps...)
+	return m
+}
+
+// WithQueryType sets the DNS record type.
+func (m *Module) WithQueryType(queryType string) *Module {
+	if m.DNS == nil {
+		m.DNS = &DNSProbe{}
+	}
+	m.DNS.QueryType = queryType
+	return m
+}
+
+// WithService sets the service name of a grpc health check.
+func (m *Module) WithService(service string) *Module {
+	if m.GRPC == nil {
+		m.GRPC = &GRPCProbe{}
+	}
+	m.GRPC.Service = service
+	return m
+}
+
+func (m *Module) httpProbe() *HTTPProbe {
+	if m.HTTP == nil {
+		m.HTTP = &HTTPProbe{}
+	}
+	return m.HTTP
+}
@@ -0,0 +1,104 @@
+package blackbox
+
+import (
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+func TestModuleConstructors(t *testing.T) {
+	tests := []struct {
+		name   string
+		module *Module
+		want   Prober
+	}{
+		{"http", HTTPModule(), ProberHTTP},
+		{"tcp", TCPModule(), ProberTCP},
+		{"icmp", ICMPModule(), ProberICMP},
+		{"dns", DNSModule("example.com"), ProberDNS},
+		{"grpc", GRPCModule(), ProberGRPC},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.module.Prober != tt.want {
+				t.Errorf("Prober = %q, want %q", tt.module.Prober, tt.want)
+			}
+		})
+	}
+}
+
+func TestModule_HTTPBuilders(t *testing.T) {
+	m := HTTPModule().
+		WithTimeout(5*Second).
+		WithValidStatusCodes(200, 204).
+		WithMethod("POST").
+		WithFailIfNotSSL().
+		WithBodyMatching("ok").
+		WithPreferredIPProtocol(IPv4).
+		WithTLSConfig(&prometheus.TLSConfig{InsecureSkipVerify: true})
+
+	if m.Timeout != 5*Second {
+		t.Errorf("Timeout = %v, want 5s", m.Timeout)
+	}
+	if len(m.HTTP.ValidStatusCodes) != 2 {
+		t.Errorf("len(ValidStatusCodes) = %d, want 2", len(m.HTTP.ValidStatusCodes))
+	}
+	if m.HTTP.Method != "POST" {
+		t.Errorf("Method = %q, want POST", m.HTTP.Method)
+	}
+	if !m.HTTP.FailIfNotSSL {
+		t.Error("FailIfNotSSL should be true")
+	}
+	if len(m.HTTP.FailIfBodyNotMatchesRegexp) != 1 {
+		t.Errorf("len(FailIfBodyNotMatchesRegexp) = %d, want 1", len(m.HTTP.FailIfBodyNotMatchesRegexp))
+	}
+	if m.HTTP.PreferredIPProtocol != IPv4 {
+		t.Errorf("PreferredIPProtocol = %q, want ip4", m.HTTP.PreferredIPProtocol)
+	}
+	if m.HTTP.TLSConfig == nil || !m.HTTP.TLSConfig.InsecureSkipVerify {
+		t.Error("TLSConfig should skip verification")
+	}
+}
+
+func TestModule_TCPBuilders(t *testing.T) {
+	m := TCPModule().
+		WithTLS().
+		WithQueryResponse(&QueryResponse{Expect: "^220"}, &QueryResponse{Send: "QUIT"})
+
+	if !m.TCP.TLS {
+		t.Error("TLS should be true")
+	}
+	if len(m.TCP.QueryResponse) != 2 {
+		t.Errorf("len(QueryResponse) = %d, want 2", len(m.TCP.QueryResponse))
+	}
+	if m.HTTP != nil {
+		t.Error("HTTP should be nil for a tcp module")
+	}
+}
+
+func TestModule_DNSAndGRPCBuilders(t *testing.T) {
+	dns := DNSModule("example.com").WithQueryType("A")
+	if dns.DNS.QueryName != "example.com" || dns.DNS.QueryType != "A" {
+		t.Errorf("DNS = %+v, want example.com A", dns.DNS)
+	}
+
+	grpc := GRPCModule().WithService("api.Health").WithTLS()
+	if grpc.GRPC.Service != "api.Health" {
+		t.Errorf("Service = %q, want api.Health", grpc.GRPC.Service)
+	}
+	if !grpc.GRPC.TLS {
+		t.Error("TLS should be true")
+	}
+}
+
+func TestConfig_AddModule(t *testing.T) {
+	c := NewConfig().
+		AddModule("http_2xx", HTTPModule()).
+		AddModule("icmp", ICMPModule())
+	if len(c.Modules) != 2 {
+		t.Fatalf("len(Modules) = %d, want 2", len(c.Modules))
+	}
+	if c.Modules["icmp"].Prober != ProberICMP {
+		t.Errorf("Modules[icmp].Prober = %q, want icmp", c.Modules["icmp"].Prober)
+	}
+}
@@ -0,0 +1,163 @@
+package blackbox
+
+import (
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/operator"
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+// DefaultExporter is the address of the blackbox_exporter service.
+const DefaultExporter = "blackbox-exporter:9115"
+
+// Probe is a job probing targets through blackbox_exporter with one module.
+// It generates the Prometheus scrape config in standalone mode and a
+// Prometheus Operator Probe in operator mode.
+//
+// Example usage:
+//
+//	var APIProbe *blackbox.Probe = blackbox.NewProbe("api-http", "http_2xx").
+//	    WithTargets("https://api.example.com/healthz", "https://www.example.com")
+//
+//	var Config = prometheus.PrometheusConfig{
+//	    ScrapeConfigs: []*prometheus.ScrapeConfig{APIProbe.ScrapeConfig()},
+//	}
+type Probe struct {
+	// JobName is the job label of the probe metrics.
+	JobName string
+
+	// Module is the blackbox module used for every target.
+	Module string
+
+	// Exporter is the host:port of blackbox_exporter.
+	// Defaults to DefaultExporter.
+	Exporter string
+
+	// Targets are the URLs, hosts or host:port pairs to probe.
+	Targets []string
+
+	// Labels are added to all metrics of the targets.
+	Labels map[string]string
+
+	// Interval is the probe interval. Defaults to the global scrape interval.
+	Interval Duration
+
+	// Timeout is the probe timeout. Defaults to the global scrape timeout.
+	Timeout Duration
+
+	// Namespace is the namespace of the generated Probe resource.
+	// Defaults to monitoring.
+	Namespace string
+}
+
+// NewProbe creates a probe job using module.
+func NewProbe(jobName, module string) *Probe {
+	return &Probe{
+		JobName: jobName,
+		Module:  module,
+	}
+}
+
+// WithExporter sets the host:port of blackbox_exporter.
+func (p *Probe) WithExporter(address string) *Probe {
+	p.Exporter = address
+	return p
+}
+
+// WithTargets sets the targets to probe.
+func (p *Probe) WithTargets(targets ...string) *Probe {
+	p.Targets = targets
+	return p
+}
+
+// AddTarget adds a target to probe.
+func (p *Probe) AddTarget(target string) *Probe {
+	p.Targets = append(p.Targets, target)
+	return p
+}
+
+// WithLabels sets labels added to all metrics of the targets.
+func (p *Probe) WithLabels(labels map[string]string) *Probe {
+	p.Labels = labels
+	return p
+}
+
+// WithInterval sets the probe interval.
+func (p *Probe) WithInterval(d Duration) *Probe {
+	p.Interval = d
+	return p
+}
+
+// WithTimeout sets the probe timeout.
+func (p *Probe) WithTimeout(d Duration) *Probe {
+	p.Timeout = d
+	return p
+}
+
+// InNamespace sets the namespace of the generated Probe resource.
+func (p *Probe) InNamespace(namespace string) *Probe {
+	p.Namespace = namespace
+	return p
+}
+
+// ScrapeConfig returns the scrape config probing the targets.
+//
+// Each target is passed to the exporter as the target URL parameter and
+// kept as the instance label, while the scrape itself goes to the exporter:
+//
+//	__address__    -> __param_target
+//	__param_target -> instance
+//	exporter       -> __address__
+func (p *Probe) ScrapeConfig() *prometheus.ScrapeConfig {
+	sc := prometheus.NewScrapeConfig(p.JobName).
+		WithInterval(p.Interval).
+		WithTimeout(p.Timeout)
+	sc.MetricsPath = "/probe"
+	sc.Params = map[string][]string{"module": {p.Module}}
+	sc.StaticConfigs = []*prometheus.StaticConfig{{
+		Targets: p.Targets,
+		Labels:  p.Labels,
+	}}
+	sc.RelabelConfigs = []*prometheus.RelabelConfig{
+		{SourceLabels: []string{"__address__"}, TargetLabel: "__param_target"},
+		{SourceLabels: []string{"__param_target"}, TargetLabel: "instance"},
+		{TargetLabel: "__address__", Replacement: p.exporter()},
+	}
+	return sc
+}
+
+// Operator returns the Prometheus Operator Probe resource for the job. The
+// operator generates the same relabeling as ScrapeConfig.
+func (p *Probe) Operator() *operator.Probe {
+	namespace := p.Namespace
+	if namespace == "" {
+		namespace = "monitoring"
+	}
+	probe := operator.NewProbe(resourceName(p.JobName), namespace).
+		WithJobName(p.JobName).
+		WithProber(p.exporter()).
+		WithModule(p.Module).
+		WithStaticTargets(p.Targets...)
+	if len(p.Labels) > 0 {
+		probe.WithTargetLabels(p.Labels)
+	}
+	if p.Interval > 0 {
+		probe.WithInterval(p.Interval.String())
+	}
+	if p.Timeout > 0 {
+		probe.WithScrapeTimeout(p.Timeout.String())
+	}
+	return probe
+}
+
+func (p *Probe) exporter() string {
+	if p.Exporter == "" {
+		return DefaultExporter
+	}
+	return p.Exporter
+}
+
+// resourceName converts a job name to a Kubernetes resource name.
+func resourceName(jobName string) string {
+	return strings.ToLower(strings.ReplaceAll(jobName, "_", "-"))
+}
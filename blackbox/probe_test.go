@@ -0,0 +1,134 @@
+package blackbox
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+func TestProbe_ScrapeConfig(t *testing.T) {
+	p := NewProbe("api_http", "http_2xx").
+		WithExporter("blackbox:9115").
+		WithTargets("https://api.example.com/healthz").
+		WithLabels(map[string]string{"team": "api"}).
+		WithInterval(30 * Second)
+
+	sc := p.ScrapeConfig()
+	if sc.JobName != "api_http" {
+		t.Errorf("JobName = %q, want api_http", sc.JobName)
+	}
+	if sc.MetricsPath != "/probe" {
+		t.Errorf("MetricsPath = %q, want /probe", sc.MetricsPath)
+	}
+	if got := sc.Params["module"]; len(got) != 1 || got[0] != "http_2xx" {
+		t.Errorf("Params[module] = %v, want [http_2xx]", got)
+	}
+	if sc.ScrapeInterval != 30*Second {
+		t.Errorf("ScrapeInterval = %v, want 30s", sc.ScrapeInterval)
+	}
+	if len(sc.StaticConfigs) != 1 || sc.StaticConfigs[0].Labels["team"] != "api" {
+		t.Errorf("StaticConfigs = %+v", sc.StaticConfigs)
+	}
+
+	data, err := sc.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	yamlStr := string(data)
+	for _, want := range []string{
+		"target_label: __param_target",
+		"target_label: instance",
+		"replacement: blackbox:9115",
+		"- https://api.example.com/healthz",
+	} {
+		if !strings.Contains(yamlStr, want) {
+			t.Errorf("Serialize() missing %q\nGot:\n%s", want, yamlStr)
+		}
+	}
+}
+
+func TestProbe_ScrapeConfigRelabelsTarget(t *testing.T) {
+	sc := NewProbe("tls", "tcp_tls").WithTargets("db.example.com:5432").ScrapeConfig()
+	target := map[string]string{"__address__": "db.example.com:5432"}
+
+	relabeled, keep := prometheus.ApplyRelabel(sc.RelabelConfigs, target)
+	if !keep {
+		t.Fatal("target dropped")
+	}
+	if relabeled["__address__"] != DefaultExporter {
+		t.Errorf("__address__ = %q, want %s", relabeled["__address__"], DefaultExporter)
+	}
+	if relabeled["__param_target"] != "db.example.com:5432" {
+		t.Errorf("__param_target = %q, want db.example.com:5432", relabeled["__param_target"])
+	}
+
+	labels, _ := sc.TargetLabels(target, nil)
+	if labels["instance"] != "db.example.com:5432" {
+		t.Errorf("instance = %q, want db.example.com:5432", labels["instance"])
+	}
+}
+
+func TestProbe_Shard(t *testing.T) {
+	var targets []string
+	for n := 0; n < 300; n++ {
+		targets = append(targets, fmt.Sprintf("https://site-%d.example.com", n))
+	}
+	shards := prometheus.Shard([]*prometheus.ScrapeConfig{NewProbe("sites", "http_2xx").WithTargets(targets...).ScrapeConfig()}, 3)
+
+	counts := make([]int, len(shards))
+	for _, target := range targets {
+		kept := 0
+		for i, shard := range shards {
+			if _, keep := prometheus.ApplyRelabel(shard.ScrapeConfigs[0].RelabelConfigs, map[string]string{"__address__": target}); keep {
+				counts[i]++
+				kept++
+			}
+		}
+		if kept != 1 {
+			t.Errorf("target %s kept by %d shards, want 1", target, kept)
+		}
+	}
+	for i, count := range counts {
+		if count == 0 {
+			t.Errorf("shards[%d] scrapes no targets: %v", i, counts)
+		}
+	}
+}
+
+func TestProbe_Operator(t *testing.T) {
+	p := NewProbe("api_http", "http_2xx").
+		WithTargets("https://api.example.com").
+		WithTimeout(10 * Second).
+		InNamespace("observability")
+
+	probe := p.Operator()
+	if probe.Kind != "Probe" {
+		t.Errorf("Kind = %q, want Probe", probe.Kind)
+	}
+	if probe.Name != "api-http" {
+		t.Errorf("Name = %q, want api-http", probe.Name)
+	}
+	if probe.Namespace != "observability" {
+		t.Errorf("Namespace = %q, want observability", probe.Namespace)
+	}
+
+	data, err := probe.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	yamlStr := string(data)
+	for _, want := range []string{
+		"jobName: api_http",
+		"url: " + DefaultExporter,
+		"module: http_2xx",
+		"static:",
+		"- https://api.example.com",
+		"scrapeTimeout: 10s",
+	} {
+		if !strings.Contains(yamlStr, want) {
+			t.Errorf("Serialize() missing %q\nGot:\n%s", want, yamlStr)
+		}
+	}
+}
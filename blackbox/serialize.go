@@ -0,0 +1,33 @@
+package blackbox
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Serialize converts a Config to YAML bytes.
+// The output is a valid blackbox.yml configuration.
+func (c *Config) Serialize() ([]byte, error) {
+	return yaml.Marshal(c)
+}
+
+// SerializeToFile writes a Config to a file in YAML format.
+// The file is created with 0644 permissions.
+func (c *Config) SerializeToFile(path string) error {
+	data, err := c.Serialize()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// MustSerialize converts a Config to YAML bytes.
+// It panics if serialization fails.
+func (c *Config) MustSerialize() []byte {
+	data, err := c.Serialize()
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
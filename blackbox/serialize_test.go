@@ -0,0 +1,66 @@
+package blackbox
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestConfig_Serialize(t *testing.T) {
+	c := NewConfig().
+		AddModule("http_2xx", HTTPModule().WithTimeout(5*Second).WithValidStatusCodes(200)).
+		AddModule("tcp_tls", TCPModule().WithTLS()).
+		AddModule("dns_a", DNSModule("example.com").WithQueryType("A")).
+		AddModule("grpc", GRPCModule().WithService("health"))
+	c.Modules["http_2xx"].HTTP.BearerTokenFile = "/etc/token"
+
+	data, err := c.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	yamlStr := string(data)
+
+	for _, want := range []string{
+		"modules:",
+		"http_2xx:",
+		"prober: http",
+		"timeout: 5s",
+		"valid_status_codes:",
+		"bearer_token_file: /etc/token",
+		"prober: tcp",
+		"tls: true",
+		"query_name: example.com",
+		"query_type: A",
+		"service: health",
+	} {
+		if !strings.Contains(yamlStr, want) {
+			t.Errorf("Serialize() missing %q\nGot:\n%s", want, yamlStr)
+		}
+	}
+
+	var parsed Config
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+	if parsed.Modules["http_2xx"].HTTP.BearerTokenFile != "/etc/token" {
+		t.Errorf("round-trip bearer_token_file = %q", parsed.Modules["http_2xx"].HTTP.BearerTokenFile)
+	}
+}
+
+func TestConfig_SerializeToFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blackbox.yml")
+	c := NewConfig().AddModule("icmp", ICMPModule())
+	if err := c.SerializeToFile(path); err != nil {
+		t.Fatalf("SerializeToFile() error = %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "prober: icmp") {
+		t.Errorf("file missing prober\nGot:\n%s", data)
+	}
+}
@@ -0,0 +1,116 @@
+package blackbox
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+// ValidationError is a problem found while validating a blackbox config.
+type ValidationError = prometheus.ValidationError
+
+// Validate checks the configuration against the rules blackbox_exporter
+// enforces when loading it: every module has a known prober with settings
+// only for that prober, dns modules have a query name, status codes are
+// valid, and regular expressions compile.
+//
+// Validate returns nil if the configuration is valid.
+func (c *Config) Validate() []ValidationError {
+	var errs []ValidationError
+	add := func(path, format string, args ...any) {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	names := make([]string, 0, len(c.Modules))
+	for name := range c.Modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		m := c.Modules[name]
+		path := "modules." + name
+		if m == nil {
+			add(path, "module must not be empty")
+			continue
+		}
+
+		configured := map[Prober]bool{
+			ProberHTTP: m.HTTP != nil,
+			ProberTCP:  m.TCP != nil,
+			ProberICMP: m.ICMP != nil,
+			ProberDNS:  m.DNS != nil,
+			ProberGRPC: m.GRPC != nil,
+		}
+		if _, ok := configured[m.Prober]; !ok {
+			add(path+".prober", "unknown prober %q", m.Prober)
+		}
+		for _, p := range []Prober{ProberHTTP, ProberTCP, ProberICMP, ProberDNS, ProberGRPC} {
+			if configured[p] && p != m.Prober {
+				add(path+"."+string(p), "%s settings are ignored by the %s prober", p, m.Prober)
+			}
+		}
+		if m.Timeout < 0 {
+			add(path+".timeout", "timeout must not be negative")
+		}
+
+		if h := m.HTTP; h != nil {
+			for _, code := range h.ValidStatusCodes {
+				if code < 100 || code > 599 {
+					add(path+".http.valid_status_codes", "invalid status code %d", code)
+				}
+			}
+			for _, re := range append(append([]string{}, h.FailIfBodyMatchesRegexp...), h.FailIfBodyNotMatchesRegexp...) {
+				if _, err := regexp.Compile(re); err != nil {
+					add(path+".http", "invalid regex %q: %v", re, err)
+				}
+			}
+			checkIPProtocol(add, path+".http", h.PreferredIPProtocol)
+		}
+		if t := m.TCP; t != nil {
+			for i, step := range t.QueryResponse {
+				if step == nil || step.Expect == "" {
+					continue
+				}
+				if _, err := regexp.Compile(step.Expect); err != nil {
+					add(fmt.Sprintf("%s.tcp.query_response[%d].expect", path, i), "invalid regex %q: %v", step.Expect, err)
+				}
+			}
+			checkIPProtocol(add, path+".tcp", t.PreferredIPProtocol)
+		}
+		if i := m.ICMP; i != nil {
+			if i.DontFragment && i.PreferredIPProtocol == IPv6 {
+				add(path+".icmp.dont_fragment", "dont_fragment is only supported with ip4")
+			}
+			checkIPProtocol(add, path+".icmp", i.PreferredIPProtocol)
+		}
+		if d := m.DNS; d != nil {
+			if d.QueryName == "" {
+				add(path+".dns.query_name", "query name must be set for DNS module")
+			}
+			switch d.TransportProtocol {
+			case "", "udp", "tcp":
+			default:
+				add(path+".dns.transport_protocol", "unknown transport protocol %q", d.TransportProtocol)
+			}
+			if d.DNSOverTLS && d.TransportProtocol == "udp" {
+				add(path+".dns.dns_over_tls", "dns_over_tls requires the tcp transport protocol")
+			}
+			checkIPProtocol(add, path+".dns", d.PreferredIPProtocol)
+		}
+		if g := m.GRPC; g != nil {
+			checkIPProtocol(add, path+".grpc", g.PreferredIPProtocol)
+		}
+	}
+	return errs
+}
+
+func checkIPProtocol(add func(path, format string, args ...any), path string, p IPProtocol) {
+	switch p {
+	case "", IPv4, IPv6:
+	default:
+		add(path+".preferred_ip_protocol", "unknown IP protocol %q", p)
+	}
+}
@@ -0,0 +1,68 @@
+package blackbox
+
+import "testing"
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+		want   []string
+	}{
+		{
+			name: "valid config",
+			config: NewConfig().
+				AddModule("http_2xx", HTTPModule().WithValidStatusCodes(200, 301)).
+				AddModule("smtp", TCPModule().WithQueryResponse(&QueryResponse{Expect: "^220"})).
+				AddModule("icmp", ICMPModule()).
+				AddModule("dns", DNSModule("example.com")).
+				AddModule("grpc", GRPCModule()),
+		},
+		{
+			name: "unknown prober and mismatched settings",
+			config: &Config{Modules: map[string]*Module{
+				"ftp":  {Prober: "ftp"},
+				"http": {Prober: ProberHTTP, TCP: &TCPProbe{}},
+			}},
+			want: []string{
+				`modules.ftp.prober: unknown prober "ftp"`,
+				"modules.http.tcp: tcp settings are ignored by the http prober",
+			},
+		},
+		{
+			name: "invalid http settings",
+			config: NewConfig().AddModule("http", HTTPModule().
+				WithValidStatusCodes(99).
+				WithBodyMatching("(").
+				WithPreferredIPProtocol("ip5")),
+			want: []string{
+				"modules.http.http.valid_status_codes: invalid status code 99",
+				"modules.http.http: invalid regex \"(\": error parsing regexp: missing closing ): `(`",
+				`modules.http.http.preferred_ip_protocol: unknown IP protocol "ip5"`,
+			},
+		},
+		{
+			name: "invalid dns settings",
+			config: &Config{Modules: map[string]*Module{
+				"dns": {Prober: ProberDNS, DNS: &DNSProbe{TransportProtocol: "udp", DNSOverTLS: true}},
+			}},
+			want: []string{
+				"modules.dns.dns.query_name: query name must be set for DNS module",
+				"modules.dns.dns.dns_over_tls: dns_over_tls requires the tcp transport protocol",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.config.Validate()
+			if len(errs) != len(tt.want) {
+				t.Fatalf("Validate() returned %d errors, want %d: %v", len(errs), len(tt.want), errs)
+			}
+			for i, w := range tt.want {
+				if errs[i].Error() != w {
+					t.Errorf("errs[%d] = %q, want %q", i, errs[i].Error(), w)
+				}
+			}
+		})
+	}
+}
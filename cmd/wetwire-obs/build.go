@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"go/build"
@@ -9,12 +10,16 @@ import (
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/lex00/wetwire-observability-go/alertmanager"
+	"github.com/lex00/wetwire-observability-go/alertmanager/templates"
+	"github.com/lex00/wetwire-observability-go/blackbox"
 	"github.com/lex00/wetwire-observability-go/internal/discover"
 	"github.com/lex00/wetwire-observability-go/prometheus"
 	"github.com/lex00/wetwire-observability-go/rules"
+	"github.com/lex00/wetwire-observability-go/secrets"
 )
 
 // buildCmd handles the build command
@@ -22,6 +27,9 @@ func buildCmd(args []string) int {
 	fs := flag.NewFlagSet("build", flag.ExitOnError)
 	outputDir := fs.String("output", ".", "Output directory for generated files")
 	mode := fs.String("mode", "standalone", "Output mode: standalone, operator, or both")
+	secretsMode := fs.String("secrets", "placeholder", "Secret handling: placeholder, resolve, or file-ref")
+	secretsDir := fs.String("secrets-dir", secrets.DefaultMountDir, "Directory Kubernetes Secrets are mounted at (file-ref)")
+	ageKeyFile := fs.String("sops-age-key", os.Getenv("SOPS_AGE_KEY_FILE"), "age key file for SOPS-encrypted secrets (resolve)")
 	fs.Usage = func() {
 		fmt.Println("Usage: wetwire-obs build [options] [directory]")
 		fmt.Println()
@@ -34,6 +42,7 @@ func buildCmd(args []string) int {
 		fmt.Println("  wetwire-obs build                    # Build from current directory")
 		fmt.Println("  wetwire-obs build ./monitoring       # Build from specific directory")
 		fmt.Println("  wetwire-obs build --output ./out     # Write output to ./out")
+		fmt.Println("  wetwire-obs build --secrets resolve  # Resolve secret references")
 	}
 
 	if err := fs.Parse(args); err != nil {
@@ -66,6 +75,17 @@ func buildCmd(args []string) int {
 		return 2
 	}
 
+	parsedSecretsMode, err := secrets.ParseMode(*secretsMode)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+	secretOpts := secrets.Options{
+		Mode:     parsedSecretsMode,
+		Resolver: secrets.NewResolver(srcDir).WithSOPS(srcDir, *ageKeyFile),
+		MountDir: *secretsDir,
+	}
+
 	// Discover resources
 	result, err := discover.Discover(srcDir)
 	if err != nil {
@@ -80,19 +100,49 @@ func buildCmd(args []string) int {
 
 	// Load and serialize configs
 	if len(result.PrometheusConfigs) > 0 {
-		if err := buildPrometheusConfigs(srcDir, result.PrometheusConfigs, *outputDir, *mode); err != nil {
+		if err := buildPrometheusConfigs(srcDir, result.PrometheusConfigs, *outputDir, *mode, secretOpts); err != nil {
 			fmt.Fprintf(os.Stderr, "Error building prometheus configs: %v\n", err)
 			return 1
 		}
 	}
 
+	if len(result.WebConfigs) > 0 {
+		if err := buildWebConfigs(srcDir, result.WebConfigs, *outputDir, secretOpts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error building web configs: %v\n", err)
+			return 1
+		}
+	}
+
+	if len(result.BlackboxConfigs) > 0 {
+		if err := buildBlackboxConfigs(srcDir, result.BlackboxConfigs, *outputDir); err != nil {
+			fmt.Fprintf(os.Stderr, "Error building blackbox configs: %v\n", err)
+			return 1
+		}
+	}
+
+	// Probe jobs are part of prometheus.yml in standalone mode; the operator
+	// needs a Probe resource per job
+	if len(result.BlackboxProbes) > 0 && *mode != "standalone" {
+		if err := buildBlackboxProbes(srcDir, result.BlackboxProbes, *outputDir); err != nil {
+			fmt.Fprintf(os.Stderr, "Error building blackbox probes: %v\n", err)
+			return 1
+		}
+	}
+
 	if len(result.AlertmanagerConfigs) > 0 {
-		if err := buildAlertmanagerConfigs(srcDir, result.AlertmanagerConfigs, *outputDir, *mode); err != nil {
+		if err := buildAlertmanagerConfigs(srcDir, result.AlertmanagerConfigs, *outputDir, *mode, secretOpts); err != nil {
 			fmt.Fprintf(os.Stderr, "Error building alertmanager configs: %v\n", err)
 			return 1
 		}
 	}
 
+	if len(result.TemplateLibraries) > 0 {
+		if err := buildTemplateLibraries(srcDir, result.TemplateLibraries, *outputDir); err != nil {
+			fmt.Fprintf(os.Stderr, "Error building template libraries: %v\n", err)
+			return 1
+		}
+	}
+
 	// Build rules files from RulesFile or RuleGroup resources
 	if len(result.RulesFiles) > 0 {
 		if err := buildRulesFiles(srcDir, result.RulesFiles, *outputDir, *mode); err != nil {
@@ -113,7 +163,7 @@ func buildCmd(args []string) int {
 }
 
 // buildPrometheusConfigs loads and serializes PrometheusConfig resources
-func buildPrometheusConfigs(srcDir string, refs []*discover.ResourceRef, outputDir, mode string) error {
+func buildPrometheusConfigs(srcDir string, refs []*discover.ResourceRef, outputDir, mode string, secretOpts secrets.Options) error {
 	// Create output directory
 	if err := os.MkdirAll(outputDir, 0755); err != nil {
 		return fmt.Errorf("creating output directory: %w", err)
@@ -129,6 +179,18 @@ func buildPrometheusConfigs(srcDir string, refs []*discover.ResourceRef, outputD
 			continue
 		}
 
+		// Agent mode has no rule evaluation or alerting; reject those
+		// sections instead of emitting a config Prometheus refuses to load
+		if config.Agent {
+			if errs := config.ValidateAgent(); len(errs) > 0 {
+				return fmt.Errorf("%s: %w", ref.Name, errors.Join(validationErrors(errs)...))
+			}
+		}
+
+		if err := applySecrets(config, secretOpts); err != nil {
+			return fmt.Errorf("%s: %w", ref.Name, err)
+		}
+
 		// Generate output filename
 		outputFile := filepath.Join(outputDir, fmt.Sprintf("prometheus-%s.yml", strings.ToLower(ref.Name)))
 
@@ -238,6 +300,145 @@ func createMinimalConfig(ref *discover.ResourceRef) *prometheus.PrometheusConfig
 	}
 }
 
+// buildBlackboxConfigs loads and serializes blackbox_exporter configurations
+func buildBlackboxConfigs(srcDir string, refs []*discover.ResourceRef, outputDir string) error {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+
+	for _, ref := range refs {
+		fmt.Printf("Processing %s.%s from %s:%d\n", ref.Package, ref.Name, filepath.Base(ref.FilePath), ref.Line)
+
+		var config blackbox.Config
+		if err := loadResource(ref, &config); err != nil {
+			return fmt.Errorf("%s: %w", ref.Name, err)
+		}
+
+		if errs := config.Validate(); len(errs) > 0 {
+			return fmt.Errorf("%s: %w", ref.Name, errors.Join(validationErrors(errs)...))
+		}
+
+		outputFile := filepath.Join(outputDir, fmt.Sprintf("blackbox-%s.yml", strings.ToLower(ref.Name)))
+		if err := config.SerializeToFile(outputFile); err != nil {
+			return fmt.Errorf("serializing %s: %w", ref.Name, err)
+		}
+
+		fmt.Printf("  Generated %s\n", outputFile)
+	}
+
+	return nil
+}
+
+// buildBlackboxProbes writes a Prometheus Operator Probe for each probe job
+func buildBlackboxProbes(srcDir string, refs []*discover.ResourceRef, outputDir string) error {
+	manifestsDir := filepath.Join(outputDir, "manifests")
+	if err := os.MkdirAll(manifestsDir, 0755); err != nil {
+		return fmt.Errorf("creating manifests directory: %w", err)
+	}
+
+	for _, ref := range refs {
+		fmt.Printf("Processing %s.%s from %s:%d\n", ref.Package, ref.Name, filepath.Base(ref.FilePath), ref.Line)
+
+		var probe blackbox.Probe
+		if err := loadResource(ref, &probe); err != nil {
+			return fmt.Errorf("%s: %w", ref.Name, err)
+		}
+
+		data, err := probe.Operator().Serialize()
+		if err != nil {
+			return fmt.Errorf("serializing %s: %w", ref.Name, err)
+		}
+		outputFile := filepath.Join(manifestsDir, fmt.Sprintf("probe-%s.yaml", strings.ToLower(ref.Name)))
+		if err := os.WriteFile(outputFile, data, 0644); err != nil {
+			return fmt.Errorf("writing %s: %w", ref.Name, err)
+		}
+
+		fmt.Printf("  Generated %s\n", outputFile)
+	}
+
+	return nil
+}
+
+// validationErrors converts validation errors for use with errors.Join
+func validationErrors(errs []prometheus.ValidationError) []error {
+	out := make([]error, len(errs))
+	for i, e := range errs {
+		out[i] = e
+	}
+	return out
+}
+
+// buildWebConfigs loads WebConfig resources, hashes their basic auth passwords
+// and writes them next to the Prometheus configs
+func buildWebConfigs(srcDir string, refs []*discover.ResourceRef, outputDir string, secretOpts secrets.Options) error {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+
+	for _, ref := range refs {
+		fmt.Printf("Processing %s.%s from %s:%d\n", ref.Package, ref.Name, filepath.Base(ref.FilePath), ref.Line)
+
+		// Load the config by executing the package
+		web, err := loadWebConfig(srcDir, ref)
+		if err != nil {
+			return fmt.Errorf("%s: %w", ref.Name, err)
+		}
+
+		if err := applySecrets(web, secretOpts); err != nil {
+			return fmt.Errorf("%s: %w", ref.Name, err)
+		}
+
+		// Passwords must be known to be hashed, so references only work
+		// with --secrets resolve
+		if err := web.HashPasswords(); err != nil {
+			return fmt.Errorf("%s: %w (build with --secrets resolve)", ref.Name, err)
+		}
+
+		if errs := web.Validate(); len(errs) > 0 {
+			return fmt.Errorf("%s: %w", ref.Name, errors.Join(validationErrors(errs)...))
+		}
+
+		outputFile := filepath.Join(outputDir, fmt.Sprintf("web-config-%s.yml", strings.ToLower(ref.Name)))
+		if err := web.SerializeToFile(outputFile); err != nil {
+			return fmt.Errorf("serializing %s: %w", ref.Name, err)
+		}
+
+		fmt.Printf("  Generated %s\n", outputFile)
+	}
+
+	return nil
+}
+
+// loadWebConfig loads a WebConfig by building and running the package.
+// A placeholder would silently drop authentication, so failures are returned.
+func loadWebConfig(srcDir string, ref *discover.ResourceRef) (*prometheus.WebConfig, error) {
+	var web prometheus.WebConfig
+	if err := loadResource(ref, &web); err != nil {
+		return nil, err
+	}
+	return &web, nil
+}
+
+// applySecrets rewrites secret references in a loaded config and, in file-ref
+// mode, lists the keys the mounted Kubernetes Secret must provide
+func applySecrets(config any, opts secrets.Options) error {
+	if opts.Mode == secrets.ModeFileRef {
+		keys, err := secrets.MountedKeys(config)
+		if err != nil {
+			return err
+		}
+		names := make([]string, 0, len(keys))
+		for name := range keys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Printf("  Secret key %s/%s <- %s\n", opts.MountDir, name, keys[name])
+		}
+	}
+	return secrets.Apply(config, opts)
+}
+
 // isZeroValue checks if an interface value is the zero value for its type
 func isZeroValue(v interface{}) bool {
 	if v == nil {
@@ -248,7 +449,7 @@ func isZeroValue(v interface{}) bool {
 }
 
 // buildAlertmanagerConfigs loads and serializes AlertmanagerConfig resources
-func buildAlertmanagerConfigs(srcDir string, refs []*discover.ResourceRef, outputDir, mode string) error {
+func buildAlertmanagerConfigs(srcDir string, refs []*discover.ResourceRef, outputDir, mode string, secretOpts secrets.Options) error {
 	// Create output directory
 	if err := os.MkdirAll(outputDir, 0755); err != nil {
 		return fmt.Errorf("creating output directory: %w", err)
@@ -264,6 +465,10 @@ func buildAlertmanagerConfigs(srcDir string, refs []*discover.ResourceRef, outpu
 			continue
 		}
 
+		if err := applySecrets(config, secretOpts); err != nil {
+			return fmt.Errorf("%s: %w", ref.Name, err)
+		}
+
 		// Generate output filename
 		outputFile := filepath.Join(outputDir, fmt.Sprintf("alertmanager-%s.yml", strings.ToLower(ref.Name)))
 
@@ -371,6 +576,118 @@ func createMinimalAlertmanagerConfig(ref *discover.ResourceRef) *alertmanager.Al
 	}
 }
 
+// buildTemplateLibraries loads template libraries and writes them as .tmpl files
+func buildTemplateLibraries(srcDir string, refs []*discover.ResourceRef, outputDir string) error {
+	templatesDir := filepath.Join(outputDir, "templates")
+
+	for _, ref := range refs {
+		fmt.Printf("Processing %s.%s from %s:%d\n", ref.Package, ref.Name, filepath.Base(ref.FilePath), ref.Line)
+
+		// Load the library by executing the package
+		lib, err := loadTemplateLibrary(srcDir, ref)
+		if err != nil {
+			return fmt.Errorf("%s: %w", ref.Name, err)
+		}
+
+		outputFile, err := lib.WriteToDir(templatesDir)
+		if err != nil {
+			return fmt.Errorf("writing %s: %w", ref.Name, err)
+		}
+
+		fmt.Printf("  Generated %s\n", outputFile)
+	}
+
+	return nil
+}
+
+// loadTemplateLibrary loads a template Library by building and running the package.
+// Unlike configs there is no sensible placeholder, so failures are returned.
+func loadTemplateLibrary(srcDir string, ref *discover.ResourceRef) (*templates.Library, error) {
+	var lib templates.Library
+	if err := loadResource(ref, &lib); err != nil {
+		return nil, err
+	}
+	return &lib, nil
+}
+
+// loadResource builds and runs a helper program that prints the resource as
+// JSON and decodes it into out
+func loadResource(ref *discover.ResourceRef, out any) error {
+	// Get the package path
+	pkgDir := filepath.Dir(ref.FilePath)
+	pkg, err := build.ImportDir(pkgDir, build.FindOnly)
+	if err != nil {
+		return fmt.Errorf("resolving package: %w", err)
+	}
+
+	// Create a temporary program to load and output the resource
+	tmpDir, err := os.MkdirTemp("", "wetwire-build-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Write the helper program
+	helperCode := fmt.Sprintf(`package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	target %q
+)
+
+func main() {
+	data, err := json.Marshal(target.%s)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %%v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(string(data))
+}
+`, pkg.ImportPath, ref.Name)
+
+	if err := os.WriteFile(filepath.Join(tmpDir, "main.go"), []byte(helperCode), 0644); err != nil {
+		return err
+	}
+
+	// Initialize go.mod for the helper
+	modContent := fmt.Sprintf(`module helper
+
+go 1.23
+
+require %s v0.0.0
+
+replace %s => %s
+`, pkg.ImportPath, pkg.ImportPath, pkgDir)
+	if err := os.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte(modContent), 0644); err != nil {
+		return err
+	}
+
+	// Run go mod tidy
+	tidyCmd := exec.Command("go", "mod", "tidy")
+	tidyCmd.Dir = tmpDir
+	if err := tidyCmd.Run(); err != nil {
+		return fmt.Errorf("go mod tidy: %w", err)
+	}
+
+	// Build and run the helper
+	runCmd := exec.Command("go", "run", "main.go")
+	runCmd.Dir = tmpDir
+	output, err := runCmd.Output()
+	if err != nil {
+		return fmt.Errorf("go run: %w", err)
+	}
+
+	// Parse the output
+	if err := json.Unmarshal(output, out); err != nil {
+		return fmt.Errorf("decoding %s: %w", ref.Name, err)
+	}
+
+	return nil
+}
+
 // buildRulesFiles loads and serializes RulesFile resources
 func buildRulesFiles(srcDir string, refs []*discover.ResourceRef, outputDir, mode string) error {
 	// Create rules output directory
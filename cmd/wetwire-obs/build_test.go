@@ -0,0 +1,54 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/internal/discover"
+	"github.com/lex00/wetwire-observability-go/secrets"
+)
+
+// missingRef returns a reference to a resource in a package that does not
+// exist, so loading it fails.
+func missingRef(t *testing.T, name string) *discover.ResourceRef {
+	t.Helper()
+	return &discover.ResourceRef{
+		Package:  "missing",
+		Name:     name,
+		FilePath: filepath.Join(t.TempDir(), "missing", "resources.go"),
+		Line:     1,
+	}
+}
+
+func TestBuildWebConfigs_LoadError(t *testing.T) {
+	ref := missingRef(t, "Web")
+	err := buildWebConfigs(t.TempDir(), []*discover.ResourceRef{ref}, t.TempDir(), secrets.Options{})
+	if err == nil || !strings.HasPrefix(err.Error(), "Web: ") {
+		t.Errorf("buildWebConfigs() error = %v, want load error for Web", err)
+	}
+}
+
+func TestBuildTemplateLibraries_LoadError(t *testing.T) {
+	ref := missingRef(t, "Templates")
+	err := buildTemplateLibraries(t.TempDir(), []*discover.ResourceRef{ref}, t.TempDir())
+	if err == nil || !strings.HasPrefix(err.Error(), "Templates: ") {
+		t.Errorf("buildTemplateLibraries() error = %v, want load error for Templates", err)
+	}
+}
+
+func TestBuildBlackboxConfigs_LoadError(t *testing.T) {
+	ref := missingRef(t, "Blackbox")
+	err := buildBlackboxConfigs(t.TempDir(), []*discover.ResourceRef{ref}, t.TempDir())
+	if err == nil || !strings.HasPrefix(err.Error(), "Blackbox: ") {
+		t.Errorf("buildBlackboxConfigs() error = %v, want load error for Blackbox", err)
+	}
+}
+
+func TestBuildBlackboxProbes_LoadError(t *testing.T) {
+	ref := missingRef(t, "APIProbe")
+	err := buildBlackboxProbes(t.TempDir(), []*discover.ResourceRef{ref}, t.TempDir())
+	if err == nil || !strings.HasPrefix(err.Error(), "APIProbe: ") {
+		t.Errorf("buildBlackboxProbes() error = %v, want load error for APIProbe", err)
+	}
+}
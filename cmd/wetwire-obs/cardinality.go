@@ -0,0 +1,265 @@
+// Command cardinality estimates the series scrape jobs and recording rules
+// produce.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/lex00/wetwire-observability-go/internal/importer"
+	"github.com/lex00/wetwire-observability-go/prometheus/cardinality"
+	"github.com/lex00/wetwire-observability-go/rules"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func newCardinalityCmd() *cobra.Command {
+	var (
+		configFile string
+		samples    []string
+		stats      []string
+		rulesFiles []string
+		targets    []string
+		top        int
+		format     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "cardinality",
+		Short: "Estimate series per scrape job and recording rule",
+		Long: `Cardinality estimates the number of series each scrape job of a
+prometheus.yml ingests and each recording rule records.
+
+A job's series are taken from a sample scrape in the Prometheus or
+OpenMetrics text format (--sample JOB=FILE, e.g. captured with
+curl http://exporter:9100/metrics), or from a TSDB stats snapshot of a
+server already scraping it (--stats JOB=FILE, the JSON response of
+/api/v1/status/tsdb). The job's metric_relabel_configs are applied to every
+series, and sample scrapes are multiplied by the job's target count: the
+number of static targets, or --targets JOB=N for service discovery.
+
+Recording rules from --rules files are estimated from their by (...) or
+without (...) labels over the series they select.
+
+Jobs exceeding sample_limit, label_limit, target_limit or the label length
+limits are reported and make the command fail.
+
+Examples:
+  wetwire-obs cardinality -c out/prometheus.yml --sample node=node.txt
+  wetwire-obs cardinality --sample api=api.txt --targets api=40 --rules out/rules/api.yml
+  wetwire-obs cardinality --stats api=tsdb.json -f json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := cardinalityOptions{
+				configFile: configFile,
+				samples:    samples,
+				stats:      stats,
+				rulesFiles: rulesFiles,
+				targets:    targets,
+				top:        top,
+				format:     format,
+			}
+			return runCardinality(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&configFile, "config", "c", "prometheus.yml", "Path to prometheus.yml")
+	cmd.Flags().StringArrayVar(&samples, "sample", nil, "JOB=FILE exposition sample of one target of a job (repeatable)")
+	cmd.Flags().StringArrayVar(&stats, "stats", nil, "JOB=FILE TSDB stats snapshot of a job's series (repeatable)")
+	cmd.Flags().StringArrayVar(&rulesFiles, "rules", nil, "Rules file with recording rules to estimate (repeatable)")
+	cmd.Flags().StringArrayVar(&targets, "targets", nil, "JOB=N number of targets of a job (repeatable)")
+	cmd.Flags().IntVar(&top, "top", 5, "Number of largest metrics to show per job")
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text or json")
+
+	return cmd
+}
+
+// cardinalityOptions are the flags of the cardinality command.
+type cardinalityOptions struct {
+	configFile string
+	samples    []string
+	stats      []string
+	rulesFiles []string
+	targets    []string
+	top        int
+	format     string
+}
+
+// cardinalityReport is the JSON output of the cardinality command.
+type cardinalityReport struct {
+	Jobs   []*cardinality.JobEstimate  `json:"jobs"`
+	Rules  []*cardinality.RuleEstimate `json:"rules,omitempty"`
+	Series int                         `json:"series"`
+}
+
+func runCardinality(opts cardinalityOptions) error {
+	config, err := importer.ParsePrometheusConfig(opts.configFile)
+	if err != nil {
+		return err
+	}
+	samples, err := parseJobArgs("--sample", opts.samples)
+	if err != nil {
+		return err
+	}
+	stats, err := parseJobArgs("--stats", opts.stats)
+	if err != nil {
+		return err
+	}
+	targetArgs, err := parseJobArgs("--targets", opts.targets)
+	if err != nil {
+		return err
+	}
+	if len(samples) == 0 && len(stats) == 0 {
+		return fmt.Errorf("no --sample or --stats given")
+	}
+
+	report := &cardinalityReport{}
+	for _, sc := range config.ScrapeConfigs {
+		if sc == nil {
+			continue
+		}
+		n := 0
+		if arg, ok := targetArgs[sc.JobName]; ok {
+			if n, err = strconv.Atoi(arg); err != nil || n < 1 {
+				return fmt.Errorf("invalid --targets %s=%s, expected a positive number", sc.JobName, arg)
+			}
+		}
+		switch {
+		case samples[sc.JobName] != "":
+			f, err := os.Open(samples[sc.JobName])
+			if err != nil {
+				return fmt.Errorf("failed to read sample: %w", err)
+			}
+			series, err := cardinality.ParseExposition(f)
+			f.Close()
+			if err != nil {
+				return fmt.Errorf("failed to parse sample %s: %w", samples[sc.JobName], err)
+			}
+			report.Jobs = append(report.Jobs, cardinality.EstimateJob(sc, series, n))
+		case stats[sc.JobName] != "":
+			data, err := os.ReadFile(stats[sc.JobName])
+			if err != nil {
+				return fmt.Errorf("failed to read stats: %w", err)
+			}
+			snapshot, err := cardinality.ParseTSDBStats(data)
+			if err != nil {
+				return fmt.Errorf("failed to parse stats %s: %w", stats[sc.JobName], err)
+			}
+			report.Jobs = append(report.Jobs, cardinality.EstimateJobFromStats(sc, snapshot, n))
+		}
+		delete(samples, sc.JobName)
+		delete(stats, sc.JobName)
+	}
+	for _, unknown := range []map[string]string{samples, stats} {
+		for job := range unknown {
+			return fmt.Errorf("job %q not found in %s", job, opts.configFile)
+		}
+	}
+	report.Series = cardinality.Total(report.Jobs)
+
+	for _, path := range opts.rulesFiles {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read rules: %w", err)
+		}
+		var file rules.RulesFile
+		if err := yaml.Unmarshal(data, &file); err != nil {
+			return fmt.Errorf("failed to parse rules %s: %w", path, err)
+		}
+		estimates, err := cardinality.EstimateRules(report.Jobs, file.Groups...)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		report.Rules = append(report.Rules, estimates...)
+	}
+
+	switch opts.format {
+	case "json":
+		data, _ := json.MarshalIndent(report, "", "  ")
+		fmt.Println(string(data))
+	default:
+		printCardinalityReport(report, opts.top)
+	}
+
+	exceeding := 0
+	for _, j := range report.Jobs {
+		if len(j.Problems) > 0 {
+			exceeding++
+		}
+	}
+	if exceeding > 0 {
+		return fmt.Errorf("%d job(s) exceed scrape limits", exceeding)
+	}
+	return nil
+}
+
+// parseJobArgs parses JOB=VALUE flag values.
+func parseJobArgs(flag string, args []string) (map[string]string, error) {
+	values := make(map[string]string, len(args))
+	for _, arg := range args {
+		job, value, ok := strings.Cut(arg, "=")
+		if !ok || job == "" || value == "" {
+			return nil, fmt.Errorf("invalid %s %q, expected JOB=VALUE", flag, arg)
+		}
+		values[job] = value
+	}
+	return values, nil
+}
+
+func printCardinalityReport(report *cardinalityReport, top int) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "JOB\tTARGETS\tSERIES/TARGET\tDROPPED/TARGET\tSERIES")
+	for _, j := range report.Jobs {
+		series := strconv.Itoa(j.Series)
+		if j.Approximate {
+			series = "~" + series
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%s\n", j.Job, j.Targets, j.SeriesPerTarget, j.DroppedPerTarget, series)
+	}
+	fmt.Fprintf(w, "total\t\t\t\t%d\n", report.Series)
+	w.Flush()
+
+	if top > 0 {
+		for _, j := range report.Jobs {
+			fmt.Printf("\n%s: largest metrics per target\n", j.Job)
+			for _, s := range j.TopMetrics(top) {
+				fmt.Printf("  %-50s %d\n", s.Name, s.Value)
+			}
+		}
+	}
+
+	if len(report.Rules) > 0 {
+		fmt.Println()
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "RECORDING RULE\tGROUPING\tINPUT\tSERIES")
+		for _, r := range report.Rules {
+			grouping := "-"
+			switch {
+			case r.Without:
+				grouping = fmt.Sprintf("without (%s)", strings.Join(r.Grouping, ", "))
+			case r.Grouping != nil:
+				grouping = fmt.Sprintf("by (%s)", strings.Join(r.Grouping, ", "))
+			}
+			series := strconv.Itoa(r.Series)
+			if r.Approximate {
+				series = "<=" + series
+			}
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", r.Record, grouping, r.Input, series)
+		}
+		w.Flush()
+	}
+
+	first := true
+	for _, j := range report.Jobs {
+		for _, p := range j.Problems {
+			if first {
+				fmt.Println()
+				first = false
+			}
+			fmt.Printf("ERROR %s: %s\n", j.Job, p)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const cardinalityTestConfig = `scrape_configs:
+  - job_name: api
+    sample_limit: 100
+    static_configs:
+      - targets: [api-1:8080, api-2:8080]
+    metric_relabel_configs:
+      - source_labels: [__name__]
+        regex: go_.*
+        action: drop
+  - job_name: node
+    sample_limit: 2
+    static_configs:
+      - targets: [localhost:9100]
+`
+
+const cardinalityTestSample = `# TYPE http_requests_total counter
+http_requests_total{method="GET",code="200"} 10
+http_requests_total{method="POST",code="500"} 1
+go_goroutines 12
+`
+
+const cardinalityTestRules = `groups:
+  - name: api
+    rules:
+      - record: job_method:http_requests:rate5m
+        expr: sum by (job, method) (rate(http_requests_total[5m]))
+`
+
+func writeCardinalityFiles(t *testing.T) (dir string) {
+	t.Helper()
+	dir = t.TempDir()
+	for name, content := range map[string]string{
+		"prometheus.yml": cardinalityTestConfig,
+		"api.txt":        cardinalityTestSample,
+		"rules.yml":      cardinalityTestRules,
+		"tsdb.json":      `{"status":"success","data":{"seriesCountByMetricName":[{"name":"node_cpu_seconds_total","value":64}]}}`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestCardinalityCmd(t *testing.T) {
+	dir := writeCardinalityFiles(t)
+	config := filepath.Join(dir, "prometheus.yml")
+	sample := "api=" + filepath.Join(dir, "api.txt")
+
+	for _, args := range [][]string{
+		{"-c", config, "--sample", sample},
+		{"-c", config, "--sample", sample, "--targets", "api=10", "--rules", filepath.Join(dir, "rules.yml")},
+		{"-c", config, "--sample", sample, "-f", "json"},
+	} {
+		cmd := newCardinalityCmd()
+		cmd.SetArgs(args)
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		if err := cmd.Execute(); err != nil {
+			t.Errorf("cardinality %v error = %v", args, err)
+		}
+	}
+}
+
+func TestCardinalityCmd_Errors(t *testing.T) {
+	dir := writeCardinalityFiles(t)
+	config := filepath.Join(dir, "prometheus.yml")
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "no input", args: []string{"-c", config}, want: "no --sample or --stats given"},
+		{name: "unknown job", args: []string{"-c", config, "--sample", "web=" + filepath.Join(dir, "api.txt")}, want: `job "web" not found`},
+		{name: "invalid flag", args: []string{"-c", config, "--sample", "api"}, want: "expected JOB=VALUE"},
+		{name: "invalid targets", args: []string{"-c", config, "--sample", "api=" + filepath.Join(dir, "api.txt"), "--targets", "api=x"}, want: "expected a positive number"},
+		{name: "sample limit", args: []string{"-c", config, "--stats", "node=" + filepath.Join(dir, "tsdb.json")}, want: "1 job(s) exceed scrape limits"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newCardinalityCmd()
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			err := cmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("cardinality error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseJobArgs(t *testing.T) {
+	got, err := parseJobArgs("--sample", []string{"api=api.txt", "node=a=b.txt"})
+	if err != nil {
+		t.Fatalf("parseJobArgs() error = %v", err)
+	}
+	if got["api"] != "api.txt" || got["node"] != "a=b.txt" {
+		t.Errorf("parseJobArgs() = %v, want api=api.txt node=a=b.txt", got)
+	}
+}
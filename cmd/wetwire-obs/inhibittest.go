@@ -0,0 +1,115 @@
+// Command inhibit-test shows which firing alerts are suppressed by inhibit rules.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/alertmanager"
+	"github.com/lex00/wetwire-observability-go/internal/importer"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func newInhibitTestCmd() *cobra.Command {
+	var (
+		configFile string
+		alertsFile string
+		format     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "inhibit-test [label=value,...]...",
+		Short: "Test which firing alerts are inhibited",
+		Long: `Inhibit-test evaluates an alertmanager.yml's inhibit rules over a set of
+firing alerts and reports which alerts are inhibited, by which source alert
+and which rule.
+
+Each positional argument is one alert, given as comma-separated label=value
+pairs. Alerts can also be read from a YAML or JSON file containing a list of
+label maps.
+
+Examples:
+  wetwire-obs inhibit-test alertname=HighCPU,severity=critical alertname=HighCPU,severity=warning
+  wetwire-obs inhibit-test -c out/alertmanager.yml --alerts firing.yml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInhibitTest(configFile, alertsFile, args, format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&configFile, "config", "c", "alertmanager.yml", "Path to alertmanager.yml")
+	cmd.Flags().StringVar(&alertsFile, "alerts", "", "YAML or JSON file with a list of firing alert label sets")
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text or json")
+
+	return cmd
+}
+
+func runInhibitTest(configFile, alertsFile string, args []string, format string) error {
+	config, err := importer.ParseAlertmanagerConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	var alerts []map[string]string
+	if alertsFile != "" {
+		data, err := os.ReadFile(alertsFile)
+		if err != nil {
+			return fmt.Errorf("failed to read alerts: %w", err)
+		}
+		if err := yaml.Unmarshal(data, &alerts); err != nil {
+			return fmt.Errorf("failed to parse alerts: %w", err)
+		}
+	}
+	for _, arg := range args {
+		labels, err := parseLabelArgs(strings.Split(arg, ","))
+		if err != nil {
+			return err
+		}
+		alerts = append(alerts, labels)
+	}
+	if len(alerts) == 0 {
+		return fmt.Errorf("no alerts given")
+	}
+
+	inhibitions := config.Inhibited(alerts)
+
+	switch format {
+	case "json":
+		data, _ := json.MarshalIndent(inhibitions, "", "  ")
+		fmt.Println(string(data))
+	default:
+		printInhibitions(alerts, inhibitions)
+	}
+
+	return nil
+}
+
+func printInhibitions(alerts []map[string]string, inhibitions []*alertmanager.Inhibition) {
+	if len(inhibitions) == 0 {
+		fmt.Printf("No alerts inhibited (%d firing)\n", len(alerts))
+		return
+	}
+	fmt.Printf("%d of %d alerts inhibited:\n", len(inhibitions), len(alerts))
+	for _, inh := range inhibitions {
+		fmt.Printf("  %s\n", formatLabels(inh.Target))
+		fmt.Printf("    by %s\n", formatLabels(inh.Source))
+		fmt.Printf("    rule %d: equal=[%s]\n", inh.RuleIndex, strings.Join(inh.Rule.Equal, ","))
+	}
+}
+
+// formatLabels renders a label set as {a="b", c="d"} with sorted names.
+func formatLabels(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s=%q", name, labels[name])
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
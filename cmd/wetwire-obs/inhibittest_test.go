@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const inhibitTestConfig = `route:
+  receiver: default
+receivers:
+  - name: default
+inhibit_rules:
+  - source_matchers:
+      - severity="critical"
+    target_matchers:
+      - severity="warning"
+    equal: [alertname]
+`
+
+func TestInhibitTestCmd(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "alertmanager.yml")
+	if err := os.WriteFile(configPath, []byte(inhibitTestConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	alertsPath := filepath.Join(dir, "alerts.yml")
+	alerts := "- {alertname: HighCPU, severity: critical}\n- {alertname: HighCPU, severity: warning}\n"
+	if err := os.WriteFile(alertsPath, []byte(alerts), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{
+		{"-c", configPath, "--alerts", alertsPath},
+		{"-c", configPath, "-f", "json", "alertname=HighCPU,severity=critical", "alertname=HighCPU,severity=warning"},
+	} {
+		cmd := newInhibitTestCmd()
+		cmd.SetArgs(args)
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		if err := cmd.Execute(); err != nil {
+			t.Errorf("inhibit-test %v error = %v", args, err)
+		}
+	}
+}
+
+func TestInhibitTestCmd_NoAlerts(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "alertmanager.yml")
+	if err := os.WriteFile(configPath, []byte(inhibitTestConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := newInhibitTestCmd()
+	cmd.SetArgs([]string{"-c", configPath})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error when no alerts given")
+	}
+}
@@ -25,6 +25,12 @@ func main() {
 	cmd.AddCommand(newDiffCmd())
 	cmd.AddCommand(newWatchCmd())
 	cmd.AddCommand(newMCPCmd())
+	cmd.AddCommand(newRouteTestCmd())
+	cmd.AddCommand(newInhibitTestCmd())
+	cmd.AddCommand(newTemplateTestCmd())
+	cmd.AddCommand(newRelabelTestCmd())
+	cmd.AddCommand(newCardinalityCmd())
+	cmd.AddCommand(newMetricsCmd())
 
 	// Execute
 	if err := cmd.Execute(); err != nil {
@@ -0,0 +1,159 @@
+// Command metrics generates typed metric descriptors.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/internal/importer"
+	"github.com/lex00/wetwire-observability-go/promql/metrics"
+	"github.com/spf13/cobra"
+)
+
+func newMetricsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metrics",
+		Short: "Work with typed metric descriptors",
+	}
+	cmd.AddCommand(newMetricsGenCmd())
+	return cmd
+}
+
+func newMetricsGenCmd() *cobra.Command {
+	var (
+		output string
+		pkg    string
+		match  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "gen FILE...",
+		Short: "Generate a Go package of metric descriptors",
+		Long: `Gen reads metrics exposition files (the Prometheus or OpenMetrics text
+format, e.g. captured with curl http://exporter:9100/metrics) and
+/api/v1/metadata JSON dumps (*.json), and generates a Go package with a
+typed metrics.Descriptor per metric and a Catalog of all of them.
+
+Descriptors carry the metric's name, type, help text, unit and the label
+names seen in exposition samples. Metadata dumps have no labels; when a
+metric appears in both, the descriptors are merged.
+
+Build selectors from the descriptors instead of raw metric names, and check
+expressions with the catalog for unknown labels and rate() on gauges:
+
+  expr := promql.Rate(nodemetrics.NodeCPUSecondsTotal.Range("5m"))
+  problems, err := nodemetrics.Catalog.CheckExpr(expr)
+
+Examples:
+  wetwire-obs metrics gen node.txt -o monitoring/nodemetrics/metrics.go
+  wetwire-obs metrics gen api.txt metadata.json --package apimetrics --match '^http_'`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := metricsGenOptions{
+				inputs:      args,
+				output:      output,
+				packageName: pkg,
+				match:       match,
+			}
+			return runMetricsGen(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file path (default: stdout)")
+	cmd.Flags().StringVarP(&pkg, "package", "p", "", "Go package name (default: output directory name, or metrics)")
+	cmd.Flags().StringVar(&match, "match", "", "Only generate metrics whose name matches the regex")
+
+	return cmd
+}
+
+// metricsGenOptions are the flags of the metrics gen command.
+type metricsGenOptions struct {
+	inputs      []string
+	output      string
+	packageName string
+	match       string
+}
+
+func runMetricsGen(opts metricsGenOptions) error {
+	var filter *regexp.Regexp
+	if opts.match != "" {
+		re, err := regexp.Compile(opts.match)
+		if err != nil {
+			return fmt.Errorf("invalid --match: %w", err)
+		}
+		filter = re
+	}
+
+	var sets [][]*metrics.Descriptor
+	for _, path := range opts.inputs {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		var descs []*metrics.Descriptor
+		if strings.EqualFold(filepath.Ext(path), ".json") {
+			descs, err = metrics.ParseMetadata(data)
+		} else {
+			descs, err = metrics.ParseExposition(bytes.NewReader(data))
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		sets = append(sets, descs)
+	}
+
+	var descs []*metrics.Descriptor
+	for _, d := range metrics.Merge(sets...) {
+		if filter == nil || filter.MatchString(d.Name) {
+			descs = append(descs, d)
+		}
+	}
+	if len(descs) == 0 {
+		return fmt.Errorf("no metrics found")
+	}
+
+	pkg := opts.packageName
+	if pkg == "" {
+		pkg = metricsPackageName(opts.output)
+	}
+	code, err := importer.GenerateMetricsGoCode(descs, pkg)
+	if err != nil {
+		return err
+	}
+
+	if opts.output == "" {
+		fmt.Print(string(code))
+		return nil
+	}
+	if dir := filepath.Dir(opts.output); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(opts.output, code, 0644); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Generated %d metric descriptors in %s\n", len(descs), opts.output)
+	return nil
+}
+
+// metricsPackageName returns the package name for an output file: its
+// directory name if that is a valid identifier, or metrics.
+func metricsPackageName(output string) string {
+	if output == "" {
+		return "metrics"
+	}
+	dir, err := filepath.Abs(filepath.Dir(output))
+	if err != nil {
+		return "metrics"
+	}
+	name := strings.ToLower(filepath.Base(dir))
+	if !regexp.MustCompile(`^[a-z_][a-z0-9_]*$`).MatchString(name) {
+		return "metrics"
+	}
+	return name
+}
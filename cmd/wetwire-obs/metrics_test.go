@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const metricsTestExposition = `# HELP node_cpu_seconds_total Seconds the CPUs spent in each mode.
+# TYPE node_cpu_seconds_total counter
+node_cpu_seconds_total{cpu="0",mode="idle"} 100
+# TYPE node_load1 gauge
+node_load1 0.5
+`
+
+const metricsTestMetadata = `{"status":"success","data":{"node_load1":[{"type":"gauge","help":"1m load average.","unit":""}]}}`
+
+func TestMetricsGenCmd(t *testing.T) {
+	dir := t.TempDir()
+	expositionPath := filepath.Join(dir, "node.txt")
+	metadataPath := filepath.Join(dir, "metadata.json")
+	if err := os.WriteFile(expositionPath, []byte(metricsTestExposition), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(metadataPath, []byte(metricsTestMetadata), 0644); err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(dir, "nodemetrics", "metrics.go")
+
+	cmd := newMetricsCmd()
+	cmd.SetArgs([]string{"gen", expositionPath, metadataPath, "-o", output})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("metrics gen error = %v", err)
+	}
+
+	data, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := string(data)
+	for _, want := range []string{
+		"package nodemetrics",
+		"var NodeCPUSecondsTotal = &metrics.Descriptor{",
+		`Labels: []string{"cpu", "mode"},`,
+		"var NodeLoad1 = &metrics.Descriptor{",
+		`Help:   "1m load average.",`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("metrics gen output missing %q\nGot:\n%s", want, code)
+		}
+	}
+}
+
+func TestMetricsGenCmd_Errors(t *testing.T) {
+	dir := t.TempDir()
+	expositionPath := filepath.Join(dir, "node.txt")
+	if err := os.WriteFile(expositionPath, []byte(metricsTestExposition), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "missing file", args: []string{"gen", filepath.Join(dir, "missing.txt")}, want: "failed to read"},
+		{name: "no match", args: []string{"gen", expositionPath, "--match", "^http_"}, want: "no metrics found"},
+		{name: "invalid match", args: []string{"gen", expositionPath, "--match", "("}, want: "invalid --match"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newMetricsCmd()
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			err := cmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("metrics gen error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetricsPackageName(t *testing.T) {
+	tests := []struct {
+		output string
+		want   string
+	}{
+		{output: "", want: "metrics"},
+		{output: "monitoring/nodemetrics/metrics.go", want: "nodemetrics"},
+		{output: "monitoring/node-metrics/metrics.go", want: "metrics"},
+	}
+	for _, tt := range tests {
+		if got := metricsPackageName(tt.output); got != tt.want {
+			t.Errorf("metricsPackageName(%q) = %q, want %q", tt.output, got, tt.want)
+		}
+	}
+}
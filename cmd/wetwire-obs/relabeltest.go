@@ -0,0 +1,361 @@
+// Command relabel-test shows how a scrape job's relabel configs transform
+// discovered targets.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/internal/importer"
+	"github.com/lex00/wetwire-observability-go/prometheus"
+	"github.com/lex00/wetwire-observability-go/prometheus/kubesd"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func newRelabelTestCmd() *cobra.Command {
+	var (
+		configFile  string
+		job         string
+		targetsFile string
+		manifests   []string
+		ownNS       string
+		metric      bool
+		format      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "relabel-test [label=value,...]...",
+		Short: "Show how relabel configs transform targets",
+		Long: `Relabel-test applies a scrape job's relabel_configs from a prometheus.yml
+to sample targets and shows the labels after each step, the step that
+dropped a target, and the labels attached to the scraped series.
+
+Targets are given as Kubernetes SD (or any other SD) labels: each positional
+argument is one target of comma-separated label=value pairs, and targets can
+also be read from a YAML or JSON file containing a list of label maps. The
+job's scheme, metrics path, interval, timeout and params are added before
+relabeling, as Prometheus does.
+
+With --manifests, targets are discovered from Kubernetes manifests (Pods,
+Services, Endpoints, EndpointSlices, Nodes and Ingresses) using the job's
+kubernetes_sd_configs, as Prometheus would discover them in a live cluster.
+
+With --metric, the job's metric_relabel_configs are applied to the given
+label sets instead, which are treated as scraped series.
+
+Examples:
+  wetwire-obs relabel-test --job kubernetes-pods --targets pods.yml
+  wetwire-obs relabel-test --job kubernetes-pods --manifests k8s/
+  wetwire-obs relabel-test -c out/prometheus.yml __address__=10.0.0.5:8080,__meta_kubernetes_namespace=prod
+  wetwire-obs relabel-test --job api --metric __name__=http_requests_total,path=/health`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := relabelTestOptions{
+				configFile:   configFile,
+				job:          job,
+				targetsFile:  targetsFile,
+				manifests:    manifests,
+				ownNamespace: ownNS,
+				metric:       metric,
+				format:       format,
+			}
+			return runRelabelTest(opts, args)
+		},
+	}
+
+	cmd.Flags().StringVarP(&configFile, "config", "c", "prometheus.yml", "Path to prometheus.yml")
+	cmd.Flags().StringVar(&job, "job", "", "Scrape job to test (required if the config has several)")
+	cmd.Flags().StringVar(&targetsFile, "targets", "", "YAML or JSON file with a list of target label sets")
+	cmd.Flags().StringArrayVar(&manifests, "manifests", nil, "Kubernetes manifest file or directory to discover targets from (repeatable)")
+	cmd.Flags().StringVar(&ownNS, "own-namespace", "", "Namespace Prometheus runs in, for namespaces.own_namespace")
+	cmd.Flags().BoolVar(&metric, "metric", false, "Apply metric_relabel_configs to series labels")
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text or json")
+
+	return cmd
+}
+
+// relabelResult is the outcome of relabeling one target.
+type relabelResult struct {
+	Input      map[string]string `json:"input"`
+	Discovered map[string]string `json:"discovered,omitempty"`
+	Steps      []relabelStep     `json:"steps"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	Keep       bool              `json:"keep"`
+	DropReason string            `json:"drop_reason,omitempty"`
+}
+
+// relabelStep is the JSON form of prometheus.RelabelStep.
+type relabelStep struct {
+	Index  int               `json:"index"`
+	Action string            `json:"action"`
+	Config string            `json:"config"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Keep   bool              `json:"keep"`
+	Error  string            `json:"error,omitempty"`
+}
+
+// relabelTestOptions are the flags of the relabel-test command.
+type relabelTestOptions struct {
+	configFile   string
+	job          string
+	targetsFile  string
+	manifests    []string
+	ownNamespace string
+	metric       bool
+	format       string
+}
+
+func runRelabelTest(opts relabelTestOptions, args []string) error {
+	config, err := importer.ParsePrometheusConfig(opts.configFile)
+	if err != nil {
+		return err
+	}
+	sc, err := findScrapeConfig(config, opts.job)
+	if err != nil {
+		return err
+	}
+
+	var targets []map[string]string
+	if len(opts.manifests) > 0 {
+		cluster := kubesd.NewCluster()
+		cluster.OwnNamespace = opts.ownNamespace
+		for _, path := range opts.manifests {
+			if err := cluster.LoadFile(path); err != nil {
+				return fmt.Errorf("failed to load manifests: %w", err)
+			}
+		}
+		discovered, err := cluster.ScrapeTargets(sc)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, discovered...)
+	}
+	if opts.targetsFile != "" {
+		data, err := os.ReadFile(opts.targetsFile)
+		if err != nil {
+			return fmt.Errorf("failed to read targets: %w", err)
+		}
+		var fileTargets []map[string]string
+		if err := yaml.Unmarshal(data, &fileTargets); err != nil {
+			return fmt.Errorf("failed to parse targets: %w", err)
+		}
+		targets = append(targets, fileTargets...)
+	}
+	for _, arg := range args {
+		labels, err := parseLabelArgs(strings.Split(arg, ","))
+		if err != nil {
+			return err
+		}
+		targets = append(targets, labels)
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("no targets given")
+	}
+
+	results := make([]*relabelResult, len(targets))
+	for i, target := range targets {
+		results[i] = relabelTarget(sc, config.Global, target, opts.metric)
+	}
+
+	switch opts.format {
+	case "json":
+		data, _ := json.MarshalIndent(results, "", "  ")
+		fmt.Println(string(data))
+	default:
+		printRelabelResults(sc, results, opts.metric)
+	}
+
+	return nil
+}
+
+// findScrapeConfig returns the scrape config for job, or the only scrape
+// config if job is empty.
+func findScrapeConfig(config *prometheus.PrometheusConfig, job string) (*prometheus.ScrapeConfig, error) {
+	var names []string
+	for _, sc := range config.ScrapeConfigs {
+		if sc == nil {
+			continue
+		}
+		if sc.JobName == job {
+			return sc, nil
+		}
+		names = append(names, sc.JobName)
+	}
+	switch {
+	case job != "":
+		return nil, fmt.Errorf("job %q not found (jobs: %s)", job, strings.Join(names, ", "))
+	case len(names) == 0:
+		return nil, fmt.Errorf("no scrape configs found")
+	case len(names) > 1:
+		return nil, fmt.Errorf("--job is required (jobs: %s)", strings.Join(names, ", "))
+	}
+	for _, sc := range config.ScrapeConfigs {
+		if sc != nil {
+			return sc, nil
+		}
+	}
+	return nil, fmt.Errorf("no scrape configs found")
+}
+
+// relabelTarget runs the job's relabel chain over one target, or its metric
+// relabel chain over one series if metric is set.
+func relabelTarget(sc *prometheus.ScrapeConfig, global *prometheus.GlobalConfig, target map[string]string, metric bool) *relabelResult {
+	result := &relabelResult{Input: target}
+	configs := sc.RelabelConfigs
+	labels := target
+	if metric {
+		configs = sc.MetricRelabelConfigs
+	} else {
+		labels = sc.DiscoveredLabels(target, global)
+		result.Discovered = labels
+	}
+
+	result.Keep = true
+	for _, step := range prometheus.TraceRelabel(configs, labels) {
+		s := relabelStep{
+			Index:  step.Index,
+			Action: relabelAction(step.Config),
+			Config: describeRelabel(step.Config),
+			Labels: step.Labels,
+			Keep:   step.Keep,
+		}
+		if step.Err != nil {
+			s.Error = step.Err.Error()
+		}
+		result.Steps = append(result.Steps, s)
+		if step.Keep {
+			labels = step.Labels
+			continue
+		}
+		result.Keep = false
+		result.DropReason = fmt.Sprintf("dropped by step %d (%s)", step.Index, s.Action)
+		return result
+	}
+
+	if metric {
+		result.Labels = labels
+		return result
+	}
+	result.Labels, result.Keep = prometheus.FinalizeTargetLabels(labels)
+	if !result.Keep {
+		result.DropReason = "dropped: no __address__ label after relabeling"
+	}
+	return result
+}
+
+func printRelabelResults(sc *prometheus.ScrapeConfig, results []*relabelResult, metric bool) {
+	chain := "relabel_configs"
+	if metric {
+		chain = "metric_relabel_configs"
+	}
+	kept := 0
+	for _, r := range results {
+		if r.Keep {
+			kept++
+		}
+	}
+	fmt.Printf("job %q %s: %d of %d kept\n", sc.JobName, chain, kept, len(results))
+
+	for i, r := range results {
+		fmt.Println()
+		fmt.Printf("[%d] %s\n", i+1, formatLabels(r.Input))
+		prev := r.Input
+		if r.Discovered != nil {
+			printLabelChanges("defaults", prev, r.Discovered)
+			prev = r.Discovered
+		}
+		for _, s := range r.Steps {
+			fmt.Printf("  step %d: %s\n", s.Index, s.Config)
+			switch {
+			case s.Error != "":
+				fmt.Printf("    skipped: %s\n", s.Error)
+			case !s.Keep:
+				fmt.Println("    dropped")
+			default:
+				printLabelChanges("", prev, s.Labels)
+				prev = s.Labels
+			}
+		}
+		if r.Keep {
+			fmt.Printf("  => %s\n", formatLabels(r.Labels))
+		} else {
+			fmt.Printf("  => %s\n", r.DropReason)
+		}
+	}
+}
+
+// printLabelChanges prints the labels added, changed and removed between two
+// label sets.
+func printLabelChanges(title string, before, after map[string]string) {
+	indent := "    "
+	if title != "" {
+		fmt.Printf("  %s:\n", title)
+	}
+	names := make(map[string]bool)
+	for name := range before {
+		names[name] = true
+	}
+	for name := range after {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	changed := false
+	for _, name := range sorted {
+		old, hadOld := before[name]
+		value, hasNew := after[name]
+		switch {
+		case !hadOld && hasNew:
+			fmt.Printf("%s+ %s=%q\n", indent, name, value)
+		case hadOld && !hasNew:
+			fmt.Printf("%s- %s=%q\n", indent, name, old)
+		case old != value:
+			fmt.Printf("%s~ %s=%q -> %q\n", indent, name, old, value)
+		default:
+			continue
+		}
+		changed = true
+	}
+	if !changed {
+		fmt.Printf("%s(no change)\n", indent)
+	}
+}
+
+// relabelAction returns the config's action, defaulting to replace.
+func relabelAction(rc *prometheus.RelabelConfig) string {
+	if rc.Action == "" {
+		return string(prometheus.RelabelReplace)
+	}
+	return rc.Action
+}
+
+// describeRelabel renders a relabel config on one line.
+func describeRelabel(rc *prometheus.RelabelConfig) string {
+	parts := []string{relabelAction(rc)}
+	if len(rc.SourceLabels) > 0 {
+		parts = append(parts, fmt.Sprintf("source_labels=[%s]", strings.Join(rc.SourceLabels, ",")))
+	}
+	if rc.Separator != "" {
+		parts = append(parts, fmt.Sprintf("separator=%q", rc.Separator))
+	}
+	if rc.Regex != "" {
+		parts = append(parts, fmt.Sprintf("regex=%q", rc.Regex))
+	}
+	if rc.Modulus != 0 {
+		parts = append(parts, fmt.Sprintf("modulus=%d", rc.Modulus))
+	}
+	if rc.TargetLabel != "" {
+		parts = append(parts, fmt.Sprintf("target_label=%q", rc.TargetLabel))
+	}
+	if rc.Replacement != "" {
+		parts = append(parts, fmt.Sprintf("replacement=%q", rc.Replacement))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+const relabelTestConfig = `scrape_configs:
+  - job_name: kubernetes-pods
+    kubernetes_sd_configs:
+      - role: pod
+    relabel_configs:
+      - source_labels: [__meta_kubernetes_pod_annotation_prometheus_io_scrape]
+        regex: "true"
+        action: keep
+      - regex: __meta_kubernetes_pod_label_(.+)
+        action: labelmap
+      - source_labels: [__meta_kubernetes_namespace]
+        target_label: namespace
+    metric_relabel_configs:
+      - source_labels: [__name__]
+        regex: go_.*
+        action: drop
+  - job_name: node
+    static_configs:
+      - targets: [localhost:9100]
+`
+
+func TestRelabelTestCmd(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "prometheus.yml")
+	if err := os.WriteFile(configPath, []byte(relabelTestConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	targetsPath := filepath.Join(dir, "pods.yml")
+	targets := `- __address__: 10.0.0.5:8080
+  __meta_kubernetes_namespace: prod
+  __meta_kubernetes_pod_label_app: api
+  __meta_kubernetes_pod_annotation_prometheus_io_scrape: "true"
+- __address__: 10.0.0.6:8080
+`
+	if err := os.WriteFile(targetsPath, []byte(targets), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{
+		{"-c", configPath, "--job", "kubernetes-pods", "--targets", targetsPath},
+		{"-c", configPath, "--job", "kubernetes-pods", "-f", "json", "__address__=a:80,__meta_kubernetes_pod_annotation_prometheus_io_scrape=true"},
+		{"-c", configPath, "--job", "kubernetes-pods", "--metric", "__name__=go_goroutines"},
+	} {
+		cmd := newRelabelTestCmd()
+		cmd.SetArgs(args)
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		if err := cmd.Execute(); err != nil {
+			t.Errorf("relabel-test %v error = %v", args, err)
+		}
+	}
+}
+
+func TestRelabelTestCmd_Manifests(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "prometheus.yml")
+	if err := os.WriteFile(configPath, []byte(relabelTestConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	manifestsPath := filepath.Join(dir, "pods.yaml")
+	manifests := `apiVersion: v1
+kind: Pod
+metadata:
+  name: api
+  namespace: prod
+  annotations:
+    prometheus.io/scrape: "true"
+spec:
+  containers:
+    - name: api
+      ports:
+        - containerPort: 8080
+status:
+  podIP: 10.0.0.5
+`
+	if err := os.WriteFile(manifestsPath, []byte(manifests), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := newRelabelTestCmd()
+	cmd.SetArgs([]string{"-c", configPath, "--job", "kubernetes-pods", "--manifests", dir})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("relabel-test --manifests error = %v", err)
+	}
+
+	cmd = newRelabelTestCmd()
+	cmd.SetArgs([]string{"-c", configPath, "--job", "node", "--manifests", manifestsPath})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	if err := cmd.Execute(); err == nil {
+		t.Error("relabel-test --manifests for a job without kubernetes_sd_configs: expected error")
+	}
+}
+
+func TestRelabelTestCmd_Errors(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "prometheus.yml")
+	if err := os.WriteFile(configPath, []byte(relabelTestConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{
+		{"-c", configPath, "__address__=a:80"},
+		{"-c", configPath, "--job", "missing", "__address__=a:80"},
+		{"-c", configPath, "--job", "node"},
+	} {
+		cmd := newRelabelTestCmd()
+		cmd.SetArgs(args)
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		if err := cmd.Execute(); err == nil {
+			t.Errorf("relabel-test %v: expected error", args)
+		}
+	}
+}
+
+func TestRelabelTarget(t *testing.T) {
+	sc := &prometheus.ScrapeConfig{
+		JobName: "pods",
+		RelabelConfigs: []*prometheus.RelabelConfig{
+			prometheus.KeepByAnnotation("prometheus.io/scrape", "true"),
+			prometheus.RenameLabel("__meta_kubernetes_namespace", "namespace"),
+		},
+	}
+
+	r := relabelTarget(sc, nil, map[string]string{
+		"__address__":                 "10.0.0.5:8080",
+		"__meta_kubernetes_namespace": "prod",
+		"__meta_kubernetes_pod_annotation_prometheus_io_scrape": "true",
+	}, false)
+	if !r.Keep || len(r.Steps) != 2 {
+		t.Fatalf("result = %+v, want kept after 2 steps", r)
+	}
+	if r.Labels["namespace"] != "prod" || r.Labels["instance"] != "10.0.0.5:8080" || r.Labels["job"] != "pods" {
+		t.Errorf("Labels = %v", r.Labels)
+	}
+
+	r = relabelTarget(sc, nil, map[string]string{"__address__": "10.0.0.6:8080"}, false)
+	if r.Keep || r.DropReason != "dropped by step 0 (keep)" {
+		t.Errorf("result = %+v, want dropped by step 0", r)
+	}
+}
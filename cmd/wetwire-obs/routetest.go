@@ -0,0 +1,119 @@
+// Command route-test shows which receivers an alert is routed to.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lex00/wetwire-observability-go/alertmanager"
+	"github.com/lex00/wetwire-observability-go/internal/importer"
+	"github.com/spf13/cobra"
+)
+
+func newRouteTestCmd() *cobra.Command {
+	var (
+		configFile string
+		at         string
+		expect     []string
+		format     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "route-test <label=value>...",
+		Short: "Test which receivers an alert is routed to",
+		Long: `Route-test evaluates an alertmanager.yml routing tree against a label set.
+
+Routing follows Alertmanager semantics, including continue, inherited
+group_by and timing settings, and mute/active time intervals evaluated
+at the given time.
+
+Examples:
+  wetwire-obs route-test severity=critical team=platform
+  wetwire-obs route-test -c out/alertmanager.yml --time 2026-01-03T10:00:00Z team=platform
+  wetwire-obs route-test --expect platform-pagerduty,platform-slack severity=critical team=platform`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRouteTest(configFile, args, at, expect, format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&configFile, "config", "c", "alertmanager.yml", "Path to alertmanager.yml")
+	cmd.Flags().StringVar(&at, "time", "", "Evaluation time in RFC3339 format (default: now)")
+	cmd.Flags().StringSliceVar(&expect, "expect", nil, "Expected receivers, in order; fails on mismatch")
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text or json")
+
+	return cmd
+}
+
+func runRouteTest(configFile string, args []string, at string, expect []string, format string) error {
+	config, err := importer.ParseAlertmanagerConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	labels, err := parseLabelArgs(args)
+	if err != nil {
+		return err
+	}
+
+	evalTime := time.Now()
+	if at != "" {
+		evalTime, err = time.Parse(time.RFC3339, at)
+		if err != nil {
+			return fmt.Errorf("invalid --time: %w", err)
+		}
+	}
+
+	results, err := config.RouteAt(labels, evalTime)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "json":
+		data, _ := json.MarshalIndent(results, "", "  ")
+		fmt.Println(string(data))
+	default:
+		printRouteResults(results)
+	}
+
+	if expect != nil {
+		var got []string
+		for _, r := range results {
+			if !r.Muted {
+				got = append(got, r.Receiver)
+			}
+		}
+		if !slices.Equal(got, expect) {
+			return fmt.Errorf("receivers %v do not match expected %v", got, expect)
+		}
+	}
+
+	return nil
+}
+
+// parseLabelArgs parses label=value arguments into a label set.
+func parseLabelArgs(args []string) (map[string]string, error) {
+	labels := make(map[string]string, len(args))
+	for _, arg := range args {
+		name, value, ok := strings.Cut(arg, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid label %q, expected label=value", arg)
+		}
+		labels[name] = strings.Trim(value, `"`)
+	}
+	return labels, nil
+}
+
+func printRouteResults(results []*alertmanager.RouteResult) {
+	for _, r := range results {
+		line := fmt.Sprintf("%s  group_by=[%s]", r.Receiver, strings.Join(r.Route.GroupBy, ","))
+		if r.Muted {
+			line += fmt.Sprintf("  (muted: %s)", r.MuteReason)
+		}
+		fmt.Println(line)
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const routeTestConfig = `route:
+  receiver: default
+  group_by: [alertname]
+  routes:
+    - receiver: pagerduty
+      matchers:
+        - severity="critical"
+      continue: true
+    - receiver: platform-slack
+      matchers:
+        - team="platform"
+receivers:
+  - name: default
+  - name: pagerduty
+  - name: platform-slack
+`
+
+func writeRouteTestConfig(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "alertmanager.yml")
+	if err := os.WriteFile(path, []byte(routeTestConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRouteTestCmd_Help(t *testing.T) {
+	cmd := newRouteTestCmd()
+	cmd.SetArgs([]string{"--help"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	_ = cmd.Execute()
+}
+
+func TestRouteTestCmd_Expect(t *testing.T) {
+	path := writeRouteTestConfig(t)
+
+	cmd := newRouteTestCmd()
+	cmd.SetArgs([]string{"-c", path, "--expect", "pagerduty,platform-slack", "severity=critical", "team=platform"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("route-test error = %v", err)
+	}
+}
+
+func TestRouteTestCmd_ExpectMismatch(t *testing.T) {
+	path := writeRouteTestConfig(t)
+
+	cmd := newRouteTestCmd()
+	cmd.SetArgs([]string{"-c", path, "--expect", "default", "team=platform"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error for mismatched receivers")
+	}
+}
+
+func TestRouteTestCmd_InvalidLabel(t *testing.T) {
+	path := writeRouteTestConfig(t)
+
+	cmd := newRouteTestCmd()
+	cmd.SetArgs([]string{"-c", path, "severity"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error for invalid label argument")
+	}
+}
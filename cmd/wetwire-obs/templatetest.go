@@ -0,0 +1,203 @@
+// Command template-test renders receiver notification templates offline.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lex00/wetwire-observability-go/alertmanager"
+	"github.com/lex00/wetwire-observability-go/alertmanager/templates"
+	"github.com/lex00/wetwire-observability-go/internal/importer"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func newTemplateTestCmd() *cobra.Command {
+	var (
+		configFile  string
+		alertsFile  string
+		receiver    string
+		externalURL string
+		format      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "template-test [label=value]...",
+		Short: "Render receiver notification templates against sample alerts",
+		Long: `Template-test renders the templated fields of receivers, such as a Slack
+title or text, against sample alerts without sending a notification.
+
+Alerts are read from a JSON or YAML file in the Alertmanager webhook format
+(a list of alerts, or an object with an "alerts" list), or built from
+label=value arguments as a single firing alert. The alerts are routed through
+the config to find the receivers and group_by labels; --receiver renders a
+single receiver instead.
+
+Templates listed under "templates:" in the config are loaded relative to the
+config file, along with Alertmanager's default templates and functions.
+References to undefined templates are reported as errors.
+
+Examples:
+  wetwire-obs template-test alertname=HighLatency severity=critical
+  wetwire-obs template-test -c out/alertmanager.yml --alerts alerts.json
+  wetwire-obs template-test --receiver slack-api -f json alertname=Down`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTemplateTest(configFile, alertsFile, receiver, externalURL, args, format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&configFile, "config", "c", "alertmanager.yml", "Path to alertmanager.yml")
+	cmd.Flags().StringVar(&alertsFile, "alerts", "", "Path to sample alerts (JSON or YAML)")
+	cmd.Flags().StringVarP(&receiver, "receiver", "r", "", "Receiver to render (default: receivers the alerts route to)")
+	cmd.Flags().StringVar(&externalURL, "external-url", "http://localhost:9093", "Alertmanager external URL")
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "Output format: text or json")
+
+	return cmd
+}
+
+// templateTestResult is the rendered output for one receiver.
+type templateTestResult struct {
+	Receiver string                    `json:"receiver"`
+	Fields   []templates.RenderedField `json:"fields"`
+	Errors   []string                  `json:"errors,omitempty"`
+}
+
+func runTemplateTest(configFile, alertsFile, receiver, externalURL string, args []string, format string) error {
+	config, err := importer.ParseAlertmanagerConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	alerts, err := loadSampleAlerts(alertsFile, args)
+	if err != nil {
+		return err
+	}
+
+	renderer, err := templates.NewRenderer()
+	if err != nil {
+		return err
+	}
+	if err := renderer.ParseConfigTemplates(config, filepath.Dir(configFile)); err != nil {
+		return err
+	}
+
+	// Route the first alert to find receivers and their group_by labels
+	routes, err := config.RouteAt(alerts[0].Labels, time.Now())
+	if err != nil {
+		return err
+	}
+	groupBy := make(map[string][]string)
+	var names []string
+	for _, r := range routes {
+		if _, ok := groupBy[r.Receiver]; !ok {
+			names = append(names, r.Receiver)
+		}
+		groupBy[r.Receiver] = r.Route.GroupBy
+	}
+	if receiver != "" {
+		names = []string{receiver}
+	}
+
+	var results []templateTestResult
+	failed := false
+	for _, name := range names {
+		rcv := findReceiver(config, name)
+		if rcv == nil {
+			return fmt.Errorf("receiver %q is not defined", name)
+		}
+		data := templates.NewData(name, groupBy[name], alerts...).WithExternalURL(externalURL)
+		result := templateTestResult{Receiver: name, Fields: renderer.RenderReceiver(rcv, data)}
+		for _, f := range result.Fields {
+			if f.Err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("%s.%s: %v", f.Integration, f.Field, f.Err))
+				failed = true
+			}
+		}
+		results = append(results, result)
+	}
+
+	switch format {
+	case "json":
+		data, _ := json.MarshalIndent(results, "", "  ")
+		fmt.Println(string(data))
+	default:
+		printTemplateResults(results)
+	}
+
+	if failed {
+		return fmt.Errorf("one or more templates failed to render")
+	}
+	return nil
+}
+
+// loadSampleAlerts reads alerts from a file, or builds one from label arguments.
+func loadSampleAlerts(path string, args []string) ([]templates.Alert, error) {
+	if path == "" {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("provide sample alerts with --alerts or label=value arguments")
+		}
+		labels, err := parseLabelArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		alert := templates.NewAlert(labels)
+		alert.StartsAt = time.Now()
+		return []templates.Alert{alert}, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading alerts: %w", err)
+	}
+
+	// Accept both a bare list and a webhook payload
+	var alerts []templates.Alert
+	if err := yaml.Unmarshal(content, &alerts); err != nil {
+		var payload templates.Data
+		if err := yaml.Unmarshal(content, &payload); err != nil {
+			return nil, fmt.Errorf("parsing alerts: %w", err)
+		}
+		alerts = payload.Alerts
+	}
+	if len(alerts) == 0 {
+		return nil, fmt.Errorf("no alerts in %s", path)
+	}
+	for i := range alerts {
+		if alerts[i].Status == "" {
+			alerts[i].Status = templates.StatusFiring
+		}
+	}
+	return alerts, nil
+}
+
+func findReceiver(config *alertmanager.AlertmanagerConfig, name string) *alertmanager.Receiver {
+	for _, r := range config.Receivers {
+		if r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+func printTemplateResults(results []templateTestResult) {
+	for _, r := range results {
+		fmt.Printf("%s:\n", r.Receiver)
+		if len(r.Fields) == 0 {
+			fmt.Println("  (no templated fields)")
+		}
+		for _, f := range r.Fields {
+			if f.Err != nil {
+				fmt.Printf("  %s.%s: ERROR %v\n", f.Integration, f.Field, f.Err)
+				continue
+			}
+			fmt.Printf("  %s.%s:\n", f.Integration, f.Field)
+			for _, line := range strings.Split(strings.TrimRight(f.Output, "\n"), "\n") {
+				fmt.Printf("    %s\n", line)
+			}
+		}
+	}
+}
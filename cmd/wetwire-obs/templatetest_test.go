@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const templateTestConfig = `route:
+  receiver: slack
+  group_by: [alertname]
+receivers:
+  - name: slack
+    slack_configs:
+      - channel: '#alerts'
+        title: '{{ template "myorg.title" . }}'
+        text: '{{ range .Alerts }}{{ .Annotations.summary }}{{ end }}'
+  - name: broken
+    slack_configs:
+      - channel: '#alerts'
+        title: '{{ template "myorg.missing" . }}'
+templates:
+  - templates/*.tmpl
+`
+
+const templateTestTemplates = `{{ define "myorg.title" }}[{{ .Status | toUpper }}] {{ .GroupLabels.alertname }}{{ end }}
+`
+
+func writeTemplateTestConfig(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "templates"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "templates", "myorg.tmpl"), []byte(templateTestTemplates), 0644); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "alertmanager.yml")
+	if err := os.WriteFile(path, []byte(templateTestConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestTemplateTestCmd_Help(t *testing.T) {
+	cmd := newTemplateTestCmd()
+	cmd.SetArgs([]string{"--help"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	_ = cmd.Execute()
+}
+
+func TestTemplateTestCmd_Labels(t *testing.T) {
+	path := writeTemplateTestConfig(t)
+
+	cmd := newTemplateTestCmd()
+	cmd.SetArgs([]string{"-c", path, "alertname=HighLatency"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("template-test error = %v", err)
+	}
+}
+
+func TestTemplateTestCmd_AlertsFile(t *testing.T) {
+	path := writeTemplateTestConfig(t)
+	alerts := filepath.Join(filepath.Dir(path), "alerts.json")
+	payload := `{"alerts": [{"status": "firing", "labels": {"alertname": "Down"}, "annotations": {"summary": "api is down"}}]}`
+	if err := os.WriteFile(alerts, []byte(payload), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := newTemplateTestCmd()
+	cmd.SetArgs([]string{"-c", path, "--alerts", alerts, "-f", "json"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	if err := cmd.Execute(); err != nil {
+		t.Errorf("template-test error = %v", err)
+	}
+}
+
+func TestTemplateTestCmd_UndefinedTemplate(t *testing.T) {
+	path := writeTemplateTestConfig(t)
+
+	cmd := newTemplateTestCmd()
+	cmd.SetArgs([]string{"-c", path, "--receiver", "broken", "alertname=Down"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error for undefined template")
+	}
+}
+
+func TestTemplateTestCmd_NoAlerts(t *testing.T) {
+	path := writeTemplateTestConfig(t)
+
+	cmd := newTemplateTestCmd()
+	cmd.SetArgs([]string{"-c", path})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error when no alerts are given")
+	}
+}
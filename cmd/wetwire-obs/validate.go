@@ -7,6 +7,10 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+
+	"github.com/lex00/wetwire-observability-go/internal/importer"
+	"github.com/lex00/wetwire-observability-go/prometheus"
+	"github.com/lex00/wetwire-observability-go/rules"
 )
 
 func validateCmd(args []string) int {
@@ -30,7 +34,9 @@ func validateCmd(args []string) int {
 		fmt.Println("  promtool     Validates prometheus.yml and rule files")
 		fmt.Println("  amtool       Validates alertmanager.yml")
 		fmt.Println()
-		fmt.Println("Validators are skipped gracefully if not installed.")
+		fmt.Println("Validators are skipped gracefully if not installed, except promtool and")
+		fmt.Println("amtool: prometheus.yml, rule files and alertmanager.yml are then checked")
+		fmt.Println("by the built-in validators.")
 	}
 
 	if err := fs.Parse(args); err != nil {
@@ -148,11 +154,9 @@ func validateKubeconform(outputDir string, verbose bool) *validationResult {
 func validatePromtool(outputDir string, verbose bool) *validationResult {
 	result := &validationResult{tool: "promtool"}
 
-	// Check if promtool is installed
+	// Fall back to the built-in validator if promtool is not installed
 	if _, err := exec.LookPath("promtool"); err != nil {
-		result.skipped = true
-		result.skipMsg = "promtool not found in PATH (install Prometheus)"
-		return result
+		return validatePrometheusBuiltin(outputDir, verbose)
 	}
 
 	var validated int
@@ -215,16 +219,64 @@ func validatePromtool(outputDir string, verbose bool) *validationResult {
 	return result
 }
 
-func validateAmtool(outputDir string, verbose bool) *validationResult {
-	result := &validationResult{tool: "amtool"}
+// validatePrometheusBuiltin checks prometheus.yml and the rule files in the
+// rules directory with the in-process validators, which enforce the rules
+// promtool check config and check rules apply to their contents.
+func validatePrometheusBuiltin(outputDir string, verbose bool) *validationResult {
+	result := &validationResult{tool: "prometheus", success: true}
 
-	// Check if amtool is installed
-	if _, err := exec.LookPath("amtool"); err != nil {
+	type check struct {
+		path     string
+		validate func([]byte) ([]prometheus.ValidationError, error)
+	}
+	var checks []check
+	prometheusYml := filepath.Join(outputDir, "prometheus.yml")
+	if _, err := os.Stat(prometheusYml); err == nil {
+		checks = append(checks, check{prometheusYml, prometheus.ValidateYAML})
+	}
+	files, _ := filepath.Glob(filepath.Join(outputDir, "rules", "*.yml"))
+	for _, file := range files {
+		checks = append(checks, check{file, rules.ValidateYAML})
+	}
+
+	if len(checks) == 0 {
 		result.skipped = true
-		result.skipMsg = "amtool not found in PATH (install Alertmanager)"
+		result.skipMsg = "no Prometheus config or rules files found"
 		return result
 	}
 
+	for _, c := range checks {
+		name := filepath.Base(c.path)
+		data, err := os.ReadFile(c.path)
+		if err != nil {
+			result.success = false
+			result.messages = append(result.messages, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		errs, err := c.validate(data)
+		if err != nil {
+			result.success = false
+			result.messages = append(result.messages, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		for _, e := range errs {
+			result.success = false
+			result.messages = append(result.messages, fmt.Sprintf("%s:%v", name, e))
+		}
+		if len(errs) == 0 && verbose {
+			result.messages = append(result.messages, name+": valid")
+		}
+	}
+
+	if result.success {
+		result.messages = append(result.messages, fmt.Sprintf("validated %d files (built-in validator)", len(checks)))
+	}
+	return result
+}
+
+func validateAmtool(outputDir string, verbose bool) *validationResult {
+	result := &validationResult{tool: "amtool"}
+
 	// Validate alertmanager.yml
 	alertmanagerYml := filepath.Join(outputDir, "alertmanager.yml")
 	if _, err := os.Stat(alertmanagerYml); err != nil {
@@ -233,6 +285,11 @@ func validateAmtool(outputDir string, verbose bool) *validationResult {
 		return result
 	}
 
+	// Fall back to the built-in validator if amtool is not installed
+	if _, err := exec.LookPath("amtool"); err != nil {
+		return validateAlertmanagerBuiltin(alertmanagerYml)
+	}
+
 	cmd := exec.Command("amtool", "check-config", alertmanagerYml)
 	output, err := cmd.CombinedOutput()
 
@@ -254,6 +311,29 @@ func validateAmtool(outputDir string, verbose bool) *validationResult {
 	return result
 }
 
+// validateAlertmanagerBuiltin checks an alertmanager.yml with the in-process
+// validator, which enforces the same rules as amtool check-config.
+func validateAlertmanagerBuiltin(path string) *validationResult {
+	result := &validationResult{tool: "alertmanager"}
+	name := filepath.Base(path)
+
+	config, err := importer.ParseAlertmanagerConfig(path)
+	if err != nil {
+		result.messages = append(result.messages, fmt.Sprintf("%s: %v", name, err))
+		return result
+	}
+
+	errs := config.Validate()
+	for _, err := range errs {
+		result.messages = append(result.messages, fmt.Sprintf("%s: %v", name, err))
+	}
+	if len(errs) == 0 {
+		result.success = true
+		result.messages = append(result.messages, name+": valid (built-in validator)")
+	}
+	return result
+}
+
 func printValidationSummary(results *validationResults) {
 	fmt.Println()
 	fmt.Println("Validation Summary")
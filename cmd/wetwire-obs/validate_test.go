@@ -3,6 +3,7 @@ package main
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -107,15 +108,108 @@ func TestValidatePromtool_SkippedWhenNotInstalled(t *testing.T) {
 	}
 }
 
-func TestValidateAmtool_SkippedWhenNotInstalled(t *testing.T) {
+func TestValidatePromtool_BuiltinWhenNotInstalled(t *testing.T) {
 	oldPath := os.Getenv("PATH")
 	defer os.Setenv("PATH", oldPath)
 
 	os.Setenv("PATH", "")
 
-	result := validateAmtool("nonexistent", false)
-	if !result.skipped {
-		t.Error("expected amtool to be skipped when not installed")
+	tests := []struct {
+		name        string
+		prometheus  string
+		rules       string
+		wantSuccess bool
+		wantMessage string
+	}{
+		{
+			name:        "valid",
+			prometheus:  "scrape_configs:\n  - job_name: api\n",
+			rules:       "groups:\n  - name: api\n    rules:\n      - alert: Down\n        expr: up == 0\n",
+			wantSuccess: true,
+		},
+		{
+			name:        "duplicate job",
+			prometheus:  "scrape_configs:\n  - job_name: api\n  - job_name: api\n",
+			wantSuccess: false,
+			wantMessage: `prometheus.yml:3:15: scrape_configs[1].job_name: found multiple scrape configs with job name "api"`,
+		},
+		{
+			name:        "invalid expression",
+			rules:       "groups:\n  - name: api\n    rules:\n      - alert: Down\n        expr: rate(up)\n",
+			wantSuccess: false,
+			wantMessage: "api.yml:5:15: groups[0].rules[0].expr: could not parse expression",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if tt.prometheus != "" {
+				if err := os.WriteFile(filepath.Join(tmpDir, "prometheus.yml"), []byte(tt.prometheus), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.rules != "" {
+				if err := os.MkdirAll(filepath.Join(tmpDir, "rules"), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(tmpDir, "rules", "api.yml"), []byte(tt.rules), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			result := validatePromtool(tmpDir, false)
+			if result.skipped {
+				t.Fatal("expected built-in validation instead of skipping when promtool is not installed")
+			}
+			if result.success != tt.wantSuccess {
+				t.Errorf("success = %v, want %v (messages: %v)", result.success, tt.wantSuccess, result.messages)
+			}
+			if tt.wantMessage != "" && !strings.Contains(strings.Join(result.messages, "\n"), tt.wantMessage) {
+				t.Errorf("messages missing %q\nGot:\n%s", tt.wantMessage, strings.Join(result.messages, "\n"))
+			}
+		})
+	}
+}
+
+func TestValidateAmtool_BuiltinWhenNotInstalled(t *testing.T) {
+	oldPath := os.Getenv("PATH")
+	defer os.Setenv("PATH", oldPath)
+
+	os.Setenv("PATH", "")
+
+	tests := []struct {
+		name        string
+		yaml        string
+		wantSuccess bool
+	}{
+		{
+			name:        "valid",
+			yaml:        "route:\n  receiver: default\nreceivers:\n  - name: default\n",
+			wantSuccess: true,
+		},
+		{
+			name:        "undefined receiver",
+			yaml:        "route:\n  receiver: missing\nreceivers:\n  - name: default\n",
+			wantSuccess: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tmpDir, "alertmanager.yml"), []byte(tt.yaml), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			result := validateAmtool(tmpDir, false)
+			if result.skipped {
+				t.Fatal("expected built-in validation instead of skipping when amtool is not installed")
+			}
+			if result.success != tt.wantSuccess {
+				t.Errorf("success = %v, want %v (messages: %v)", result.success, tt.wantSuccess, result.messages)
+			}
+		})
 	}
 }
 
@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	coredomain "github.com/lex00/wetwire-core-go/domain"
@@ -63,6 +66,70 @@ func TestObservabilityDomainValidator(t *testing.T) {
 	}
 }
 
+func TestValidatorChecksAlertmanagerConfigs(t *testing.T) {
+	dir := t.TempDir()
+	config := "route:\n  receiver: missing\nreceivers:\n  - name: default\n"
+	if err := os.WriteFile(filepath.Join(dir, "alertmanager.yml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	v := &observabilityValidator{}
+	result, err := v.Validate(&Context{}, dir, ValidateOpts{})
+	if err != nil {
+		t.Fatalf("validate failed: %v", err)
+	}
+	if result.Success {
+		t.Fatal("expected validation to fail for undefined receiver")
+	}
+	if len(result.Errors) != 1 || !strings.Contains(result.Errors[0].Message, `undefined receiver "missing"`) {
+		t.Errorf("Errors = %+v, want undefined receiver error", result.Errors)
+	}
+}
+
+func TestValidatorChecksPrometheusAndRulesFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"prometheus.yml":  "scrape_configs:\n  - job_name: api\n  - job_name: api\n",
+		"rules/api.yml":   "groups:\n  - name: api\n    rules:\n      - alert: Down\n        expr: up ==\n",
+		"dashboards.yaml": "apiVersion: 1\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	v := &observabilityValidator{}
+	result, err := v.Validate(&Context{}, dir, ValidateOpts{})
+	if err != nil {
+		t.Fatalf("validate failed: %v", err)
+	}
+	if result.Success {
+		t.Fatal("expected validation to fail")
+	}
+	if len(result.Errors) != 2 {
+		t.Fatalf("Errors = %+v, want 2 errors", result.Errors)
+	}
+	for _, e := range result.Errors {
+		switch filepath.Base(e.Path) {
+		case "prometheus.yml":
+			if e.Line != 3 || !strings.Contains(e.Message, `found multiple scrape configs with job name "api"`) {
+				t.Errorf("prometheus.yml error = %+v", e)
+			}
+		case "api.yml":
+			if e.Line != 5 || !strings.Contains(e.Message, "groups[0].rules[0].expr: could not parse expression") {
+				t.Errorf("api.yml error = %+v", e)
+			}
+		default:
+			t.Errorf("unexpected error for %s: %+v", e.Path, e)
+		}
+	}
+}
+
 func TestObservabilityDomainLister(t *testing.T) {
 	d := &ObservabilityDomain{}
 	l := d.Lister()
@@ -6,12 +6,17 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	coredomain "github.com/lex00/wetwire-core-go/domain"
 	"github.com/lex00/wetwire-observability-go/internal/differ"
 	"github.com/lex00/wetwire-observability-go/internal/discover"
+	"github.com/lex00/wetwire-observability-go/internal/importer"
 	"github.com/lex00/wetwire-observability-go/internal/lint"
+	"github.com/lex00/wetwire-observability-go/prometheus"
+	"github.com/lex00/wetwire-observability-go/rules"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 // Version is set at build time
@@ -145,6 +150,18 @@ func (b *observabilityBuilder) Build(ctx *Context, path string, opts BuildOpts)
 	if len(resources.RecordingRules) > 0 {
 		outputData["recording_rules"] = resourceRefsToMap(resources.RecordingRules)
 	}
+	if len(resources.TemplateLibraries) > 0 {
+		outputData["template_libraries"] = resourceRefsToMap(resources.TemplateLibraries)
+	}
+	if len(resources.WebConfigs) > 0 {
+		outputData["web_configs"] = resourceRefsToMap(resources.WebConfigs)
+	}
+	if len(resources.BlackboxConfigs) > 0 {
+		outputData["blackbox_configs"] = resourceRefsToMap(resources.BlackboxConfigs)
+	}
+	if len(resources.BlackboxProbes) > 0 {
+		outputData["blackbox_probes"] = resourceRefsToMap(resources.BlackboxProbes)
+	}
 
 	// Format output
 	var jsonData []byte
@@ -358,9 +375,141 @@ var Production = prometheus.PrometheusConfig{
 type observabilityValidator struct{}
 
 func (v *observabilityValidator) Validate(ctx *Context, path string, opts ValidateOpts) (*Result, error) {
-	// For now, validation is the same as lint
 	linter := &observabilityLinter{}
-	return linter.Lint(ctx, path, LintOpts{})
+	result, err := linter.Lint(ctx, path, LintOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Generated Prometheus, rules and Alertmanager files are checked
+	// in-process, so validation does not depend on promtool or amtool being
+	// installed.
+	fileErrs, counts, err := validateConfigFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(fileErrs) > 0 {
+		return NewErrorResultMultiple("validation failed", append(result.Errors, fileErrs...)), nil
+	}
+	if !result.Success || counts.total() == 0 {
+		return result, nil
+	}
+	return NewResult(fmt.Sprintf("%s; %s valid", result.Message, counts)), nil
+}
+
+// configFileCounts counts the generated configuration files validated.
+type configFileCounts struct {
+	prometheus, rules, alertmanager int
+}
+
+func (c configFileCounts) total() int {
+	return c.prometheus + c.rules + c.alertmanager
+}
+
+// String lists the non-zero counts, e.g. "1 Prometheus config(s), 2 rules
+// file(s)".
+func (c configFileCounts) String() string {
+	var parts []string
+	if c.prometheus > 0 {
+		parts = append(parts, fmt.Sprintf("%d Prometheus config(s)", c.prometheus))
+	}
+	if c.rules > 0 {
+		parts = append(parts, fmt.Sprintf("%d rules file(s)", c.rules))
+	}
+	if c.alertmanager > 0 {
+		parts = append(parts, fmt.Sprintf("%d Alertmanager config(s)", c.alertmanager))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// validateConfigFiles validates the YAML configuration files found at path,
+// which may be a file or a directory searched recursively:
+// prometheus*.yml, alertmanager*.yml, and rules files (documents with a
+// top-level "groups" list). It returns the validation errors and the number
+// of files checked.
+func validateConfigFiles(path string) ([]Error, configFileCounts, error) {
+	var errs []Error
+	var counts configFileCounts
+	addErr := func(p string, err error) {
+		e := Error{Path: p, Severity: "error", Message: err.Error()}
+		if verr, ok := err.(prometheus.ValidationError); ok {
+			e.Line, e.Column = verr.Line, verr.Column
+			e.Message = verr.Message
+			if verr.Path != "" {
+				e.Message = verr.Path + ": " + verr.Message
+			}
+		}
+		errs = append(errs, e)
+	}
+
+	err := filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != path && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		name := d.Name()
+		ext := filepath.Ext(p)
+		if ext != ".yml" && ext != ".yaml" {
+			return nil
+		}
+
+		switch {
+		case strings.HasPrefix(name, "alertmanager"):
+			counts.alertmanager++
+			config, err := importer.ParseAlertmanagerConfig(p)
+			if err != nil {
+				addErr(p, err)
+				return nil
+			}
+			for _, verr := range config.Validate() {
+				addErr(p, verr)
+			}
+		default:
+			data, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			validate := prometheus.ValidateYAML
+			switch {
+			case isRulesFile(data):
+				counts.rules++
+				validate = rules.ValidateYAML
+			case strings.HasPrefix(name, "prometheus"):
+				counts.prometheus++
+			default:
+				return nil
+			}
+			verrs, err := validate(data)
+			if err != nil {
+				addErr(p, err)
+				return nil
+			}
+			for _, verr := range verrs {
+				addErr(p, verr)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, configFileCounts{}, fmt.Errorf("validate config files: %w", err)
+	}
+	return errs, counts, nil
+}
+
+// isRulesFile reports whether data is a YAML document whose only top-level
+// key is a "groups" list, as in Prometheus rules files.
+func isRulesFile(data []byte) bool {
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil || len(doc) != 1 {
+		return false
+	}
+	_, ok := doc["groups"].([]any)
+	return ok
 }
 
 // observabilityLister implements domain.Lister
@@ -0,0 +1,39 @@
+package monitoring
+
+import "github.com/lex00/wetwire-observability-go/alertmanager"
+
+// TeamPolicy generates the same kind of team and severity routing as
+// RootRoute from team definitions and an escalation matrix, instead of
+// hand-built nested routes.
+var TeamPolicy = alertmanager.NewRoutingPolicy().
+	WithDefaultReceiver(DefaultReceiver).
+	WithGroupBy("alertname", "cluster", "service").
+	WithBusinessHours(BusinessHours).
+	WithTeams(
+		alertmanager.NewTeamDefinition("platform").
+			WithSlackChannel("#alerts-platform").
+			WithPagerDutyKey(alertmanager.SecretFromEnv("PLATFORM_PAGERDUTY_KEY")),
+		alertmanager.NewTeamDefinition("database").
+			WithSlackChannel("#alerts-database").
+			WithPagerDutyKey(alertmanager.SecretFromEnv("DATABASE_PAGERDUTY_KEY")),
+		alertmanager.NewTeamDefinition("security").
+			WithSlackChannel("#alerts-security"),
+	).
+	WithEscalations(
+		// Production critical alerts page and post to Slack
+		alertmanager.NewEscalation("critical").InEnvironment("production").
+			ToPagerDuty().ToSlack().
+			WithGroupWait(10*alertmanager.Second).
+			WithRepeatInterval(1*alertmanager.Hour),
+		// Critical alerts elsewhere only post to Slack
+		alertmanager.NewEscalation("critical").ToSlack(),
+		// Warnings wait for business hours
+		alertmanager.NewEscalation("warning").ToSlack().DuringBusinessHours(),
+		// Test environment info alerts are dropped
+		alertmanager.NewEscalation("info").InEnvironment("test"),
+	)
+
+// PolicyConfig is an Alertmanager configuration generated from TeamPolicy.
+var PolicyConfig = TeamPolicy.Apply(&alertmanager.AlertmanagerConfig{
+	Global: &GlobalSettings,
+})
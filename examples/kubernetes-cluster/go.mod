@@ -4,6 +4,9 @@ go 1.23.0
 
 require github.com/lex00/wetwire-observability-go v0.0.0
 
-require gopkg.in/yaml.v3 v3.0.1 // indirect
+require (
+	golang.org/x/crypto v0.39.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
 
 replace github.com/lex00/wetwire-observability-go => ../..
@@ -116,9 +116,11 @@ var CAdvisorScrape = prometheus.ScrapeConfig{
 		prometheus.NewKubernetesSD(prometheus.KubernetesRoleNode).
 			WithBearerTokenFile("/var/run/secrets/kubernetes.io/serviceaccount/token"),
 	},
-	TLSConfig: &prometheus.TLSConfig{
-		CAFile:             "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt",
-		InsecureSkipVerify: true,
+	HTTPClientConfig: prometheus.HTTPClientConfig{
+		TLSConfig: &prometheus.TLSConfig{
+			CAFile:             "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt",
+			InsecureSkipVerify: true,
+		},
 	},
 	RelabelConfigs: []*prometheus.RelabelConfig{
 		{
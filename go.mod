@@ -8,10 +8,11 @@ require (
 	github.com/fsnotify/fsnotify v1.9.0
 	github.com/lex00/wetwire-core-go v1.20.0
 	github.com/spf13/cobra v1.10.2
+	golang.org/x/crypto v0.39.0
 )
 
 require (
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/spf13/pflag v1.0.9 // indirect
-	golang.org/x/sys v0.13.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
 )
@@ -0,0 +1,233 @@
+package grafana
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/promql"
+)
+
+// Built-in annotation defaults.
+const (
+	builtInAnnotationName  = "Annotations & Alerts"
+	builtInAnnotationColor = "rgba(0, 211, 255, 1)"
+	grafanaDataSourceUID   = "-- Grafana --"
+)
+
+// Annotation represents a dashboard annotation query, shown as markers on
+// time series panels.
+//
+// Example usage:
+//
+//	var Deploys = grafana.DeploymentAnnotation("Deploys", `kube_deployment_status_observed_generation{deployment="api"}`).
+//	    WithDatasource("prometheus").
+//	    WithTextFormat("{{deployment}}")
+type Annotation struct {
+	// Name is the annotation name shown in the dashboard controls.
+	Name string `json:"name"`
+
+	// Datasource is the data source queried for annotations.
+	Datasource *DataSourceRef `json:"datasource,omitempty"`
+
+	// IsEnabled indicates whether the annotation is shown by default.
+	IsEnabled bool `json:"enable"`
+
+	// Hidden hides the annotation toggle from the dashboard controls.
+	Hidden bool `json:"hide,omitempty"`
+
+	// IconColor is the marker color (e.g., "red", "rgba(0, 211, 255, 1)").
+	IconColor string `json:"iconColor,omitempty"`
+
+	// BuiltIn is 1 for the built-in Grafana annotations and alerts query.
+	BuiltIn int `json:"builtIn,omitempty"`
+
+	// Type is the annotation type of Grafana annotations ("dashboard" or "tags").
+	Type string `json:"type,omitempty"`
+
+	// Target filters Grafana annotations.
+	Target *AnnotationTarget `json:"target,omitempty"`
+
+	// Expr is the PromQL or LogQL query. Every returned series value
+	// becomes an annotation.
+	Expr string `json:"expr,omitempty"`
+
+	// Step is the query resolution of Prometheus annotations (e.g., "60s").
+	Step string `json:"step,omitempty"`
+
+	// TitleFormat is the annotation title template (e.g., "{{job}}").
+	TitleFormat string `json:"titleFormat,omitempty"`
+
+	// TextFormat is the annotation text template.
+	TextFormat string `json:"textFormat,omitempty"`
+
+	// TagKeys is a comma-separated list of labels used as annotation tags.
+	TagKeys string `json:"tagKeys,omitempty"`
+
+	// ValueForTime uses the series value, in milliseconds, as the
+	// annotation time instead of the sample timestamp.
+	ValueForTime bool `json:"useValueForTime,omitempty"`
+}
+
+// AnnotationTarget filters the annotations stored in Grafana.
+type AnnotationTarget struct {
+	// Limit is the maximum number of annotations returned.
+	Limit int `json:"limit,omitempty"`
+
+	// MatchAny matches annotations with any of the tags instead of all.
+	MatchAny bool `json:"matchAny"`
+
+	// Tags are the tags annotations must have.
+	Tags []string `json:"tags"`
+
+	// Type is "dashboard" for annotations of this dashboard or "tags".
+	Type string `json:"type"`
+}
+
+// BuiltInAnnotation creates the built-in annotation showing annotations and
+// alerts stored in Grafana for the dashboard. Grafana adds it to dashboards
+// that do not define it.
+func BuiltInAnnotation() *Annotation {
+	return &Annotation{
+		Name:       builtInAnnotationName,
+		Datasource: &DataSourceRef{Type: "grafana", UID: grafanaDataSourceUID},
+		IsEnabled:  true,
+		Hidden:     true,
+		IconColor:  builtInAnnotationColor,
+		BuiltIn:    1,
+		Type:       "dashboard",
+		Target: &AnnotationTarget{
+			Limit: 100,
+			Tags:  []string{},
+			Type:  "dashboard",
+		},
+	}
+}
+
+// TagAnnotation creates an annotation showing annotations stored in Grafana
+// with the given tags, from any dashboard.
+func TagAnnotation(name string, tags ...string) *Annotation {
+	return &Annotation{
+		Name:       name,
+		Datasource: &DataSourceRef{Type: "grafana", UID: grafanaDataSourceUID},
+		IsEnabled:  true,
+		IconColor:  "red",
+		Type:       "tags",
+		Target: &AnnotationTarget{
+			Limit: 100,
+			Tags:  tags,
+			Type:  "tags",
+		},
+	}
+}
+
+// PrometheusAnnotation creates an annotation from a PromQL query.
+func PrometheusAnnotation(name, expr string) *Annotation {
+	return &Annotation{
+		Name:       name,
+		Datasource: &DataSourceRef{Type: DataSourceTypePrometheus},
+		IsEnabled:  true,
+		IconColor:  "red",
+		Expr:       expr,
+	}
+}
+
+// PrometheusAnnotationExpr creates an annotation from a typed PromQL
+// expression.
+func PrometheusAnnotationExpr(name string, expr promql.Expr) *Annotation {
+	return PrometheusAnnotation(name, expr.String())
+}
+
+// LokiAnnotation creates an annotation from a LogQL query. Every matching
+// log line becomes an annotation.
+func LokiAnnotation(name, expr string) *Annotation {
+	return &Annotation{
+		Name:       name,
+		Datasource: &DataSourceRef{Type: DataSourceTypeLoki},
+		IsEnabled:  true,
+		IconColor:  "orange",
+		Expr:       expr,
+	}
+}
+
+// DeploymentAnnotation creates a Prometheus annotation marking the times
+// the series selected by selector change, such as the observed generation
+// of a Deployment:
+//
+//	changes(selector[1m]) > 0
+func DeploymentAnnotation(name, selector string) *Annotation {
+	a := PrometheusAnnotation(name, fmt.Sprintf("changes(%s[1m]) > 0", selector))
+	a.IconColor = "blue"
+	a.Step = "1m"
+	a.TitleFormat = name
+	return a
+}
+
+// WithDatasource sets the data source UID. The data source type is kept.
+func (a *Annotation) WithDatasource(uid string) *Annotation {
+	ref := &DataSourceRef{UID: uid}
+	if a.Datasource != nil {
+		ref.Type = a.Datasource.Type
+	}
+	a.Datasource = ref
+	return a
+}
+
+// WithIconColor sets the marker color.
+func (a *Annotation) WithIconColor(color string) *Annotation {
+	a.IconColor = color
+	return a
+}
+
+// WithStep sets the query resolution.
+func (a *Annotation) WithStep(step string) *Annotation {
+	a.Step = step
+	return a
+}
+
+// WithTitleFormat sets the title template.
+func (a *Annotation) WithTitleFormat(format string) *Annotation {
+	a.TitleFormat = format
+	return a
+}
+
+// WithTextFormat sets the text template.
+func (a *Annotation) WithTextFormat(format string) *Annotation {
+	a.TextFormat = format
+	return a
+}
+
+// WithTagKeys sets the labels used as annotation tags.
+func (a *Annotation) WithTagKeys(keys ...string) *Annotation {
+	a.TagKeys = strings.Join(keys, ",")
+	return a
+}
+
+// UseValueForTime uses the series value as the annotation time.
+func (a *Annotation) UseValueForTime() *Annotation {
+	a.ValueForTime = true
+	return a
+}
+
+// Enable shows the annotation markers by default.
+func (a *Annotation) Enable() *Annotation {
+	a.IsEnabled = true
+	return a
+}
+
+// Disable hides the annotation markers until enabled in the dashboard.
+func (a *Annotation) Disable() *Annotation {
+	a.IsEnabled = false
+	return a
+}
+
+// Hide hides the annotation toggle from the dashboard controls.
+func (a *Annotation) Hide() *Annotation {
+	a.Hidden = true
+	return a
+}
+
+// Show shows the annotation toggle in the dashboard controls.
+func (a *Annotation) Show() *Annotation {
+	a.Hidden = false
+	return a
+}
@@ -0,0 +1,106 @@
+package grafana
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/promql"
+)
+
+func TestBuiltInAnnotation(t *testing.T) {
+	a := BuiltInAnnotation()
+	if a.BuiltIn != 1 || a.Type != "dashboard" {
+		t.Errorf("BuiltIn = %d, Type = %q, want 1, dashboard", a.BuiltIn, a.Type)
+	}
+	if a.Datasource == nil || a.Datasource.UID != "-- Grafana --" {
+		t.Errorf("Datasource = %+v, want -- Grafana --", a.Datasource)
+	}
+	if !a.IsEnabled || !a.Hidden {
+		t.Errorf("IsEnabled = %v, Hidden = %v, want true, true", a.IsEnabled, a.Hidden)
+	}
+	if a.Target == nil || a.Target.Limit != 100 || a.Target.Type != "dashboard" {
+		t.Errorf("Target = %+v", a.Target)
+	}
+}
+
+func TestTagAnnotation(t *testing.T) {
+	a := TagAnnotation("Releases", "release", "prod")
+	if a.Type != "tags" || a.Target == nil || a.Target.Type != "tags" {
+		t.Fatalf("Type = %q, Target = %+v, want tags", a.Type, a.Target)
+	}
+	if len(a.Target.Tags) != 2 || a.Target.Tags[0] != "release" {
+		t.Errorf("Tags = %v, want [release prod]", a.Target.Tags)
+	}
+}
+
+func TestPrometheusAnnotation(t *testing.T) {
+	a := PrometheusAnnotation("Restarts", "changes(process_start_time_seconds[1m]) > 0").
+		WithDatasource("prom").
+		WithStep("30s").
+		WithTitleFormat("Restart").
+		WithTextFormat("{{instance}}").
+		WithTagKeys("job", "instance").
+		WithIconColor("purple")
+
+	if a.Datasource == nil || a.Datasource.Type != "prometheus" || a.Datasource.UID != "prom" {
+		t.Errorf("Datasource = %+v, want prometheus/prom", a.Datasource)
+	}
+	if a.Expr != "changes(process_start_time_seconds[1m]) > 0" {
+		t.Errorf("Expr = %q", a.Expr)
+	}
+	if a.Step != "30s" || a.TitleFormat != "Restart" || a.TextFormat != "{{instance}}" {
+		t.Errorf("Step = %q, TitleFormat = %q, TextFormat = %q", a.Step, a.TitleFormat, a.TextFormat)
+	}
+	if a.TagKeys != "job,instance" {
+		t.Errorf("TagKeys = %q, want job,instance", a.TagKeys)
+	}
+	if a.IconColor != "purple" || !a.IsEnabled {
+		t.Errorf("IconColor = %q, IsEnabled = %v", a.IconColor, a.IsEnabled)
+	}
+}
+
+func TestPrometheusAnnotationExpr(t *testing.T) {
+	a := PrometheusAnnotationExpr("Down", promql.Vector("up"))
+	if a.Expr != "up" {
+		t.Errorf("Expr = %q, want up", a.Expr)
+	}
+}
+
+func TestLokiAnnotation(t *testing.T) {
+	a := LokiAnnotation("Errors", `{app="api"} |= "panic"`).WithDatasource("loki")
+	if a.Datasource.Type != "loki" || a.Datasource.UID != "loki" {
+		t.Errorf("Datasource = %+v, want loki/loki", a.Datasource)
+	}
+	if a.Expr != `{app="api"} |= "panic"` {
+		t.Errorf("Expr = %q", a.Expr)
+	}
+}
+
+func TestDeploymentAnnotation(t *testing.T) {
+	a := DeploymentAnnotation("Deploys", `kube_deployment_status_observed_generation{deployment="api"}`)
+	want := `changes(kube_deployment_status_observed_generation{deployment="api"}[1m]) > 0`
+	if a.Expr != want {
+		t.Errorf("Expr = %q, want %q", a.Expr, want)
+	}
+	if a.Step != "1m" || a.TitleFormat != "Deploys" {
+		t.Errorf("Step = %q, TitleFormat = %q, want 1m, Deploys", a.Step, a.TitleFormat)
+	}
+}
+
+func TestAnnotation_DisableHide(t *testing.T) {
+	a := PrometheusAnnotation("x", "up").Disable().Hide().UseValueForTime()
+	if a.IsEnabled || !a.Hidden || !a.ValueForTime {
+		t.Errorf("IsEnabled = %v, Hidden = %v, ValueForTime = %v", a.IsEnabled, a.Hidden, a.ValueForTime)
+	}
+}
+
+func TestAnnotation_JSON(t *testing.T) {
+	data, err := json.Marshal(PrometheusAnnotation("Deploys", "up").WithDatasource("prom").Disable())
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"name":"Deploys","datasource":{"type":"prometheus","uid":"prom"},"enable":false,"iconColor":"red","expr":"up"}`
+	if string(data) != want {
+		t.Errorf("JSON = %s, want %s", data, want)
+	}
+}
@@ -32,11 +32,11 @@ type Dashboard struct {
 	// Variables contains template variables for the dashboard.
 	Variables []any `json:"templating,omitempty"`
 
-	// Annotations contains annotation settings.
-	Annotations []any `json:"annotations,omitempty"`
+	// Annotations contains the annotation queries.
+	Annotations []*Annotation `json:"annotations,omitempty"`
 
-	// Links contains dashboard links.
-	Links []any `json:"links,omitempty"`
+	// Links contains the links shown in the dashboard header.
+	Links []*DashboardLink `json:"links,omitempty"`
 
 	// Version is the dashboard version (incremented on save).
 	Version int `json:"version,omitempty"`
@@ -125,3 +125,27 @@ func (d *Dashboard) AddVariable(variable any) *Dashboard {
 	d.Variables = append(d.Variables, variable)
 	return d
 }
+
+// WithAnnotations sets the annotation queries.
+func (d *Dashboard) WithAnnotations(annotations ...*Annotation) *Dashboard {
+	d.Annotations = annotations
+	return d
+}
+
+// AddAnnotation adds an annotation query.
+func (d *Dashboard) AddAnnotation(annotation *Annotation) *Dashboard {
+	d.Annotations = append(d.Annotations, annotation)
+	return d
+}
+
+// WithLinks sets the dashboard links.
+func (d *Dashboard) WithLinks(links ...*DashboardLink) *Dashboard {
+	d.Links = links
+	return d
+}
+
+// AddLink adds a dashboard link.
+func (d *Dashboard) AddLink(link *DashboardLink) *Dashboard {
+	d.Links = append(d.Links, link)
+	return d
+}
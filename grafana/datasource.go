@@ -59,7 +59,7 @@ type DataSource struct {
 // DataSourceRef is a reference to a data source.
 type DataSourceRef struct {
 	Type string `json:"type"`
-	UID  string `json:"uid"`
+	UID  string `json:"uid,omitempty"`
 }
 
 // NewDataSource creates a new data source.
@@ -64,6 +64,12 @@ func (fc *FieldConfig) WithFixedColor(color string) *FieldConfig {
 	return fc
 }
 
+// WithLinks sets the data links of the fields.
+func (fc *FieldConfig) WithLinks(links ...*DataLink) *FieldConfig {
+	fc.Defaults.Links = links
+	return fc
+}
+
 // AddOverride adds a field override.
 func (fc *FieldConfig) AddOverride(override *FieldOverrideBuilder) *FieldConfig {
 	fc.Overrides = append(fc.Overrides, override.Build())
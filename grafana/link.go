@@ -0,0 +1,195 @@
+package grafana
+
+import "strings"
+
+// Dashboard link types.
+const (
+	LinkTypeDashboards = "dashboards"
+	LinkTypeLink       = "link"
+)
+
+// Link interpolation variables for data link and panel link URLs.
+const (
+	// URLTimeRange expands to the from and to URL parameters of the current
+	// time range.
+	URLTimeRange = "${__url_time_range}"
+
+	// AllVariables expands to the var- URL parameters of all dashboard
+	// variables.
+	AllVariables = "${__all_variables}"
+
+	// SeriesName expands to the name of the series.
+	SeriesName = "${__series.name}"
+
+	// FieldName expands to the name of the field.
+	FieldName = "${__field.name}"
+
+	// ValueRaw expands to the raw value of the data point.
+	ValueRaw = "${__value.raw}"
+
+	// ValueTime expands to the timestamp of the data point, in milliseconds.
+	ValueTime = "${__value.time}"
+)
+
+// DashboardLink represents a link in the dashboard header, either to the
+// dashboards with given tags or to a URL.
+//
+// Example usage:
+//
+//	var ServiceLinks = grafana.DashboardsByTag("Services", "service").
+//	    AsDropdown().
+//	    KeepTime().
+//	    IncludeVars()
+type DashboardLink struct {
+	// Title is the link text, or the dropdown title.
+	Title string `json:"title"`
+
+	// Type is the link type: dashboards or link.
+	Type string `json:"type"`
+
+	// Tags select the linked dashboards, for dashboards links.
+	Tags []string `json:"tags"`
+
+	// URL is the link target, for link links.
+	URL string `json:"url,omitempty"`
+
+	// Tooltip is the link tooltip.
+	Tooltip string `json:"tooltip,omitempty"`
+
+	// Icon is the link icon (e.g., "external link", "dashboard", "doc").
+	Icon string `json:"icon,omitempty"`
+
+	// Dropdown shows the linked dashboards in a dropdown instead of inline.
+	Dropdown bool `json:"asDropdown"`
+
+	// IncludeVariables passes the current variable values to the link.
+	IncludeVariables bool `json:"includeVars"`
+
+	// KeepTimeRange passes the current time range to the link.
+	KeepTimeRange bool `json:"keepTime"`
+
+	// TargetBlank opens the link in a new tab.
+	TargetBlank bool `json:"targetBlank"`
+}
+
+// DashboardsByTag creates a link to the dashboards with all of the given
+// tags.
+func DashboardsByTag(title string, tags ...string) *DashboardLink {
+	if tags == nil {
+		tags = []string{}
+	}
+	return &DashboardLink{
+		Title: title,
+		Type:  LinkTypeDashboards,
+		Tags:  tags,
+		Icon:  "external link",
+	}
+}
+
+// URLLink creates a link to a URL.
+func URLLink(title, url string) *DashboardLink {
+	return &DashboardLink{
+		Title: title,
+		Type:  LinkTypeLink,
+		Tags:  []string{},
+		URL:   url,
+		Icon:  "external link",
+	}
+}
+
+// DashboardUIDLink creates a link to the dashboard with the given UID.
+func DashboardUIDLink(title, uid string) *DashboardLink {
+	link := URLLink(title, DashboardURL(uid))
+	link.Icon = "dashboard"
+	return link
+}
+
+// WithTooltip sets the link tooltip.
+func (l *DashboardLink) WithTooltip(tooltip string) *DashboardLink {
+	l.Tooltip = tooltip
+	return l
+}
+
+// WithIcon sets the link icon.
+func (l *DashboardLink) WithIcon(icon string) *DashboardLink {
+	l.Icon = icon
+	return l
+}
+
+// AsDropdown shows the linked dashboards in a dropdown.
+func (l *DashboardLink) AsDropdown() *DashboardLink {
+	l.Dropdown = true
+	return l
+}
+
+// IncludeVars passes the current variable values to the link.
+func (l *DashboardLink) IncludeVars() *DashboardLink {
+	l.IncludeVariables = true
+	return l
+}
+
+// KeepTime passes the current time range to the link.
+func (l *DashboardLink) KeepTime() *DashboardLink {
+	l.KeepTimeRange = true
+	return l
+}
+
+// OpenInNewTab opens the link in a new tab.
+func (l *DashboardLink) OpenInNewTab() *DashboardLink {
+	l.TargetBlank = true
+	return l
+}
+
+// DataLink represents a panel link, shown in the panel header, or a data
+// link, shown when clicking a data point. URLs can use interpolation
+// variables such as ${__field.labels.pod}, see FieldLabel.
+//
+// Example usage:
+//
+//	var PodLink = grafana.NewDataLink("Pod details",
+//	    grafana.DashboardURL("pod-details", grafana.VarParam("pod", grafana.FieldLabel("pod")), grafana.URLTimeRange))
+type DataLink struct {
+	// Title is the link text.
+	Title string `json:"title"`
+
+	// URL is the link target.
+	URL string `json:"url"`
+
+	// TargetBlank opens the link in a new tab.
+	TargetBlank bool `json:"targetBlank,omitempty"`
+}
+
+// NewDataLink creates a panel or data link.
+func NewDataLink(title, url string) *DataLink {
+	return &DataLink{
+		Title: title,
+		URL:   url,
+	}
+}
+
+// OpenInNewTab opens the link in a new tab.
+func (l *DataLink) OpenInNewTab() *DataLink {
+	l.TargetBlank = true
+	return l
+}
+
+// DashboardURL returns the URL of the dashboard with the given UID, with
+// optional query parameters such as VarParam or URLTimeRange.
+func DashboardURL(uid string, params ...string) string {
+	url := "/d/" + uid
+	if len(params) > 0 {
+		url += "?" + strings.Join(params, "&")
+	}
+	return url
+}
+
+// FieldLabel returns the interpolation variable of a label of the clicked
+// series.
+func FieldLabel(name string) string {
+	return "${__field.labels." + name + "}"
+}
+
+// VarParam returns the URL parameter setting a dashboard variable.
+func VarParam(name, value string) string {
+	return "var-" + name + "=" + value
+}
@@ -0,0 +1,84 @@
+package grafana
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDashboardsByTag(t *testing.T) {
+	l := DashboardsByTag("Services", "service", "prod").AsDropdown().KeepTime().IncludeVars()
+	if l.Type != LinkTypeDashboards {
+		t.Errorf("Type = %q, want dashboards", l.Type)
+	}
+	if len(l.Tags) != 2 || l.Tags[1] != "prod" {
+		t.Errorf("Tags = %v, want [service prod]", l.Tags)
+	}
+	if !l.Dropdown || !l.KeepTimeRange || !l.IncludeVariables {
+		t.Errorf("Dropdown = %v, KeepTimeRange = %v, IncludeVariables = %v", l.Dropdown, l.KeepTimeRange, l.IncludeVariables)
+	}
+}
+
+func TestURLLink(t *testing.T) {
+	l := URLLink("Runbook", "https://runbooks.example.com").WithTooltip("Open runbook").WithIcon("doc").OpenInNewTab()
+	if l.Type != LinkTypeLink || l.URL != "https://runbooks.example.com" {
+		t.Errorf("Type = %q, URL = %q", l.Type, l.URL)
+	}
+	if l.Tooltip != "Open runbook" || l.Icon != "doc" || !l.TargetBlank {
+		t.Errorf("Tooltip = %q, Icon = %q, TargetBlank = %v", l.Tooltip, l.Icon, l.TargetBlank)
+	}
+}
+
+func TestDashboardUIDLink(t *testing.T) {
+	l := DashboardUIDLink("Pods", "pods")
+	if l.URL != "/d/pods" || l.Icon != "dashboard" {
+		t.Errorf("URL = %q, Icon = %q, want /d/pods, dashboard", l.URL, l.Icon)
+	}
+}
+
+func TestDashboardLink_JSON(t *testing.T) {
+	data, err := json.Marshal(DashboardsByTag("Services"))
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"title":"Services","type":"dashboards","tags":[],"icon":"external link","asDropdown":false,"includeVars":false,"keepTime":false,"targetBlank":false}`
+	if string(data) != want {
+		t.Errorf("JSON = %s, want %s", data, want)
+	}
+}
+
+func TestDashboardURL(t *testing.T) {
+	got := DashboardURL("pod-details", VarParam("pod", FieldLabel("pod")), URLTimeRange)
+	want := "/d/pod-details?var-pod=${__field.labels.pod}&${__url_time_range}"
+	if got != want {
+		t.Errorf("DashboardURL() = %q, want %q", got, want)
+	}
+	if got := DashboardURL("home"); got != "/d/home" {
+		t.Errorf("DashboardURL() = %q, want /d/home", got)
+	}
+}
+
+func TestNewDataLink(t *testing.T) {
+	l := NewDataLink("Logs", "/explore").OpenInNewTab()
+	if l.Title != "Logs" || l.URL != "/explore" || !l.TargetBlank {
+		t.Errorf("DataLink = %+v", l)
+	}
+}
+
+func TestPanel_WithLinks(t *testing.T) {
+	p := Table("Pods").
+		WithLinks(NewDataLink("Docs", "https://docs.example.com")).
+		WithDataLinks(NewDataLink("Pod", DashboardURL("pod", VarParam("pod", FieldLabel("pod")))))
+	if len(p.Links) != 1 || p.Links[0].Title != "Docs" {
+		t.Errorf("Links = %+v", p.Links)
+	}
+	if len(p.FieldConfig.Defaults.Links) != 1 || p.FieldConfig.Defaults.Links[0].URL != "/d/pod?var-pod=${__field.labels.pod}" {
+		t.Errorf("FieldConfig.Defaults.Links = %+v", p.FieldConfig.Defaults.Links)
+	}
+}
+
+func TestFieldConfig_WithLinks(t *testing.T) {
+	fc := NewFieldConfig().WithLinks(NewDataLink("Pod", "/d/pod"))
+	if len(fc.Defaults.Links) != 1 {
+		t.Errorf("Defaults.Links = %+v", fc.Defaults.Links)
+	}
+}
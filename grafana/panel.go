@@ -57,6 +57,12 @@ const (
 	OrientationVertical   = "vertical"
 )
 
+// Repeat directions.
+const (
+	RepeatHorizontal = "h"
+	RepeatVertical   = "v"
+)
+
 // Reduce calculation options.
 const (
 	ReduceLast      = "last"
@@ -114,11 +120,26 @@ type BasePanel struct {
 	// FieldConfig contains field configuration.
 	FieldConfig FieldConfig `json:"fieldConfig,omitempty"`
 
-	// Links are panel links.
-	Links []any `json:"links,omitempty"`
+	// Transformations are applied in order to the query results.
+	Transformations []*Transformation `json:"transformations,omitempty"`
+
+	// Links are the links shown in the panel header.
+	Links []*DataLink `json:"links,omitempty"`
 
 	// Transparent makes the panel background transparent.
 	Transparent bool `json:"transparent,omitempty"`
+
+	// Repeat is the name of the variable the panel is repeated for, once
+	// per selected value.
+	Repeat string `json:"repeat,omitempty"`
+
+	// RepeatDirection is the direction of repeated panels: RepeatHorizontal
+	// or RepeatVertical.
+	RepeatDirection string `json:"repeatDirection,omitempty"`
+
+	// MaxPerRow is the maximum number of horizontally repeated panels per
+	// line.
+	MaxPerRow int `json:"maxPerRow,omitempty"`
 }
 
 // GetTitle returns the panel title.
@@ -136,6 +157,12 @@ func (b *BasePanel) GetGridPos() GridPos {
 	return b.GridPos
 }
 
+// RepeatsHorizontally reports whether the panel is repeated side by side.
+// Grafana repeats horizontally unless the direction is RepeatVertical.
+func (b *BasePanel) RepeatsHorizontally() bool {
+	return b.Repeat != "" && b.RepeatDirection != RepeatVertical
+}
+
 // FieldConfig contains field configuration.
 type FieldConfig struct {
 	Defaults  FieldDefaults   `json:"defaults,omitempty"`
@@ -149,6 +176,7 @@ type FieldDefaults struct {
 	Max        *float64        `json:"max,omitempty"`
 	Decimals   *int            `json:"decimals,omitempty"`
 	NoValue    string          `json:"noValue,omitempty"`
+	Links      []*DataLink     `json:"links,omitempty"`
 	Color      *ColorConfig    `json:"color,omitempty"`
 	Thresholds *ThresholdStyle `json:"thresholds,omitempty"`
 	Custom     CustomFieldConfig `json:"custom,omitempty"`
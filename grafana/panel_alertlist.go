@@ -0,0 +1,185 @@
+package grafana
+
+// Alert states for alert list panels.
+const (
+	AlertStateFiring  = "firing"
+	AlertStatePending = "pending"
+	AlertStateNoData  = "noData"
+	AlertStateNormal  = "normal"
+	AlertStateError   = "error"
+)
+
+// Sort orders for alert list panels.
+const (
+	AlertSortAlphabeticalAsc  = 1
+	AlertSortAlphabeticalDesc = 2
+	AlertSortImportance       = 3
+	AlertSortTimeAsc          = 4
+	AlertSortTimeDesc         = 5
+)
+
+// AlertListPanel represents a Grafana alert list panel, showing Grafana
+// alert rules and their states.
+type AlertListPanel struct {
+	BasePanel
+	Options AlertListOptions `json:"options,omitempty"`
+}
+
+// AlertListOptions contains alert list panel options.
+type AlertListOptions struct {
+	ViewMode                 string               `json:"viewMode,omitempty"`
+	GroupMode                string               `json:"groupMode,omitempty"`
+	GroupBy                  []string             `json:"groupBy"`
+	MaxItems                 int                  `json:"maxItems,omitempty"`
+	SortOrder                int                  `json:"sortOrder,omitempty"`
+	DashboardAlerts          bool                 `json:"dashboardAlerts"`
+	AlertName                string               `json:"alertName"`
+	AlertInstanceLabelFilter string               `json:"alertInstanceLabelFilter"`
+	StateFilter              AlertListStateFilter `json:"stateFilter"`
+}
+
+// AlertListStateFilter selects the alert states shown.
+type AlertListStateFilter struct {
+	Firing  bool `json:"firing"`
+	Pending bool `json:"pending"`
+	NoData  bool `json:"noData"`
+	Normal  bool `json:"normal"`
+	Error   bool `json:"error"`
+}
+
+// AlertList creates a new AlertListPanel showing firing and pending alerts.
+func AlertList(title string) *AlertListPanel {
+	return &AlertListPanel{
+		BasePanel: BasePanel{
+			Type:  "alertlist",
+			Title: title,
+			GridPos: GridPos{
+				W: 12,
+				H: 8,
+			},
+		},
+		Options: AlertListOptions{
+			ViewMode:  "list",
+			GroupMode: "default",
+			GroupBy:   []string{},
+			MaxItems:  20,
+			SortOrder: AlertSortAlphabeticalAsc,
+			StateFilter: AlertListStateFilter{
+				Firing:  true,
+				Pending: true,
+			},
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *AlertListPanel) WithDescription(desc string) *AlertListPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *AlertListPanel) WithLinks(links ...*DataLink) *AlertListPanel {
+	p.Links = links
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *AlertListPanel) WithSize(w, h int) *AlertListPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *AlertListPanel) WithPosition(x, y int) *AlertListPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *AlertListPanel) RepeatBy(variable string) *AlertListPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *AlertListPanel) RepeatVertically() *AlertListPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *AlertListPanel) WithMaxPerRow(n int) *AlertListPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// WithStates sets the alert states shown (e.g., AlertStateFiring).
+func (p *AlertListPanel) WithStates(states ...string) *AlertListPanel {
+	p.Options.StateFilter = AlertListStateFilter{}
+	for _, s := range states {
+		switch s {
+		case AlertStateFiring:
+			p.Options.StateFilter.Firing = true
+		case AlertStatePending:
+			p.Options.StateFilter.Pending = true
+		case AlertStateNoData:
+			p.Options.StateFilter.NoData = true
+		case AlertStateNormal:
+			p.Options.StateFilter.Normal = true
+		case AlertStateError:
+			p.Options.StateFilter.Error = true
+		}
+	}
+	return p
+}
+
+// WithMaxItems sets the maximum number of alerts shown.
+func (p *AlertListPanel) WithMaxItems(n int) *AlertListPanel {
+	p.Options.MaxItems = n
+	return p
+}
+
+// WithSortOrder sets the order alerts are listed in (e.g.,
+// AlertSortImportance).
+func (p *AlertListPanel) WithSortOrder(order int) *AlertListPanel {
+	p.Options.SortOrder = order
+	return p
+}
+
+// WithAlertName only shows alert rules whose name contains name.
+func (p *AlertListPanel) WithAlertName(name string) *AlertListPanel {
+	p.Options.AlertName = name
+	return p
+}
+
+// WithLabelFilter only shows alert instances matching a label filter, e.g.
+// `severity="critical"`.
+func (p *AlertListPanel) WithLabelFilter(filter string) *AlertListPanel {
+	p.Options.AlertInstanceLabelFilter = filter
+	return p
+}
+
+// GroupBy groups alert instances by labels.
+func (p *AlertListPanel) GroupBy(labels ...string) *AlertListPanel {
+	p.Options.GroupMode = "custom"
+	p.Options.GroupBy = labels
+	return p
+}
+
+// CurrentDashboardOnly only shows alert rules of the current dashboard.
+func (p *AlertListPanel) CurrentDashboardOnly() *AlertListPanel {
+	p.Options.DashboardAlerts = true
+	return p
+}
+
+// StatView shows the number of alerts instead of a list.
+func (p *AlertListPanel) StatView() *AlertListPanel {
+	p.Options.ViewMode = "stat"
+	return p
+}
@@ -0,0 +1,38 @@
+package grafana
+
+import "testing"
+
+func TestAlertList(t *testing.T) {
+	p := AlertList("Firing Alerts")
+	if p.Type != "alertlist" {
+		t.Errorf("Type = %q, want alertlist", p.Type)
+	}
+	if p.Options.StateFilter != (AlertListStateFilter{Firing: true, Pending: true}) {
+		t.Errorf("StateFilter = %+v", p.Options.StateFilter)
+	}
+	if p.Options.MaxItems != 20 || p.Options.GroupBy == nil {
+		t.Errorf("Options = %+v", p.Options)
+	}
+}
+
+func TestAlertList_Options(t *testing.T) {
+	p := AlertList("Test").
+		WithStates(AlertStateFiring, AlertStateError).
+		WithMaxItems(50).
+		WithSortOrder(AlertSortImportance).
+		WithAlertName("API").
+		WithLabelFilter(`severity="critical"`).
+		GroupBy("namespace").
+		CurrentDashboardOnly().
+		StatView()
+	o := p.Options
+	if o.StateFilter != (AlertListStateFilter{Firing: true, Error: true}) {
+		t.Errorf("StateFilter = %+v", o.StateFilter)
+	}
+	if o.MaxItems != 50 || o.SortOrder != AlertSortImportance || o.AlertName != "API" || o.AlertInstanceLabelFilter != `severity="critical"` {
+		t.Errorf("Options = %+v", o)
+	}
+	if o.GroupMode != "custom" || len(o.GroupBy) != 1 || !o.DashboardAlerts || o.ViewMode != "stat" {
+		t.Errorf("Options = %+v", o)
+	}
+}
@@ -0,0 +1,246 @@
+package grafana
+
+// Stacking modes for bar chart panels.
+const (
+	StackingNone    = "none"
+	StackingNormal  = "normal"
+	StackingPercent = "percent"
+)
+
+// BarChartPanel represents a Grafana bar chart panel, showing categorical
+// data as bars.
+type BarChartPanel struct {
+	BasePanel
+	Options BarChartOptions `json:"options,omitempty"`
+}
+
+// BarChartOptions contains bar chart panel options.
+type BarChartOptions struct {
+	Orientation        string         `json:"orientation,omitempty"`
+	XField             string         `json:"xField,omitempty"`
+	Stacking           string         `json:"stacking,omitempty"`
+	ShowValue          string         `json:"showValue,omitempty"`
+	GroupWidth         float64        `json:"groupWidth,omitempty"`
+	BarWidth           float64        `json:"barWidth,omitempty"`
+	XTickLabelRotation int            `json:"xTickLabelRotation,omitempty"`
+	Legend             LegendOptions  `json:"legend,omitempty"`
+	Tooltip            TooltipOptions `json:"tooltip,omitempty"`
+}
+
+// BarChart creates a new BarChartPanel.
+func BarChart(title string) *BarChartPanel {
+	return &BarChartPanel{
+		BasePanel: BasePanel{
+			Type:  "barchart",
+			Title: title,
+			GridPos: GridPos{
+				W: 12,
+				H: 8,
+			},
+		},
+		Options: BarChartOptions{
+			Orientation: OrientationAuto,
+			Stacking:    StackingNone,
+			ShowValue:   ShowValueAuto,
+			GroupWidth:  0.7,
+			BarWidth:    0.97,
+			Legend: LegendOptions{
+				DisplayMode: "list",
+				Placement:   LegendBottom,
+				ShowLegend:  true,
+			},
+			Tooltip: TooltipOptions{
+				Mode: TooltipSingle,
+			},
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *BarChartPanel) WithDescription(desc string) *BarChartPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *BarChartPanel) WithLinks(links ...*DataLink) *BarChartPanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *BarChartPanel) WithDataLinks(links ...*DataLink) *BarChartPanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
+// WithDatasource sets the data source.
+func (p *BarChartPanel) WithDatasource(ds string) *BarChartPanel {
+	p.Datasource = ds
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *BarChartPanel) WithSize(w, h int) *BarChartPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *BarChartPanel) WithPosition(x, y int) *BarChartPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *BarChartPanel) RepeatBy(variable string) *BarChartPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *BarChartPanel) RepeatVertically() *BarChartPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *BarChartPanel) WithMaxPerRow(n int) *BarChartPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// WithTargets sets the query targets.
+func (p *BarChartPanel) WithTargets(targets ...any) *BarChartPanel {
+	p.Targets = targets
+	return p
+}
+
+// AddTarget adds a query target.
+func (p *BarChartPanel) AddTarget(target any) *BarChartPanel {
+	p.Targets = append(p.Targets, target)
+	return p
+}
+
+// WithTransformations sets the transformations applied to the query results.
+func (p *BarChartPanel) WithTransformations(transformations ...*Transformation) *BarChartPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *BarChartPanel) AddTransformation(transformation *Transformation) *BarChartPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
+// WithUnit sets the display unit.
+func (p *BarChartPanel) WithUnit(unit string) *BarChartPanel {
+	p.FieldConfig.Defaults.Unit = unit
+	return p
+}
+
+// WithMin sets the minimum value.
+func (p *BarChartPanel) WithMin(min float64) *BarChartPanel {
+	p.FieldConfig.Defaults.Min = &min
+	return p
+}
+
+// WithMax sets the maximum value.
+func (p *BarChartPanel) WithMax(max float64) *BarChartPanel {
+	p.FieldConfig.Defaults.Max = &max
+	return p
+}
+
+// WithDecimals sets the number of decimal places.
+func (p *BarChartPanel) WithDecimals(decimals int) *BarChartPanel {
+	p.FieldConfig.Defaults.Decimals = &decimals
+	return p
+}
+
+// LegendBottom places the legend at the bottom.
+func (p *BarChartPanel) LegendBottom() *BarChartPanel {
+	p.Options.Legend.Placement = LegendBottom
+	p.Options.Legend.ShowLegend = true
+	return p
+}
+
+// LegendRight places the legend on the right.
+func (p *BarChartPanel) LegendRight() *BarChartPanel {
+	p.Options.Legend.Placement = LegendRight
+	p.Options.Legend.ShowLegend = true
+	return p
+}
+
+// HideLegend hides the legend.
+func (p *BarChartPanel) HideLegend() *BarChartPanel {
+	p.Options.Legend.DisplayMode = "hidden"
+	p.Options.Legend.ShowLegend = false
+	return p
+}
+
+// WithTooltip sets the tooltip mode.
+func (p *BarChartPanel) WithTooltip(mode string) *BarChartPanel {
+	p.Options.Tooltip.Mode = mode
+	return p
+}
+
+// Horizontal draws horizontal bars.
+func (p *BarChartPanel) Horizontal() *BarChartPanel {
+	p.Options.Orientation = OrientationHorizontal
+	return p
+}
+
+// Vertical draws vertical bars.
+func (p *BarChartPanel) Vertical() *BarChartPanel {
+	p.Options.Orientation = OrientationVertical
+	return p
+}
+
+// WithXField sets the field used for the categories.
+func (p *BarChartPanel) WithXField(field string) *BarChartPanel {
+	p.Options.XField = field
+	return p
+}
+
+// Stacked stacks the bars of each category.
+func (p *BarChartPanel) Stacked() *BarChartPanel {
+	p.Options.Stacking = StackingNormal
+	return p
+}
+
+// StackedPercent stacks the bars of each category as percentages.
+func (p *BarChartPanel) StackedPercent() *BarChartPanel {
+	p.Options.Stacking = StackingPercent
+	return p
+}
+
+// ShowValues sets when values are shown on the bars: ShowValueAuto,
+// ShowValueAlways or ShowValueNever.
+func (p *BarChartPanel) ShowValues(mode string) *BarChartPanel {
+	p.Options.ShowValue = mode
+	return p
+}
+
+// WithGroupWidth sets the width of each group of bars, between 0 and 1.
+func (p *BarChartPanel) WithGroupWidth(width float64) *BarChartPanel {
+	p.Options.GroupWidth = width
+	return p
+}
+
+// WithBarWidth sets the width of each bar within its group, between 0 and 1.
+func (p *BarChartPanel) WithBarWidth(width float64) *BarChartPanel {
+	p.Options.BarWidth = width
+	return p
+}
+
+// WithLabelRotation sets the rotation of the category labels, in degrees.
+func (p *BarChartPanel) WithLabelRotation(degrees int) *BarChartPanel {
+	p.Options.XTickLabelRotation = degrees
+	return p
+}
@@ -0,0 +1,35 @@
+package grafana
+
+import "testing"
+
+func TestBarChart(t *testing.T) {
+	p := BarChart("Requests by Route")
+	if p.Type != "barchart" {
+		t.Errorf("Type = %q, want barchart", p.Type)
+	}
+	if p.Options.Orientation != OrientationAuto || p.Options.Stacking != StackingNone {
+		t.Errorf("Options = %+v", p.Options)
+	}
+}
+
+func TestBarChart_Options(t *testing.T) {
+	p := BarChart("Test").
+		Horizontal().
+		WithXField("route").
+		StackedPercent().
+		ShowValues(ShowValueNever).
+		WithGroupWidth(0.5).
+		WithBarWidth(0.8).
+		WithLabelRotation(-45)
+	o := p.Options
+	if o.Orientation != OrientationHorizontal || o.XField != "route" || o.Stacking != StackingPercent {
+		t.Errorf("Orientation = %q, XField = %q, Stacking = %q", o.Orientation, o.XField, o.Stacking)
+	}
+	if o.ShowValue != ShowValueNever || o.GroupWidth != 0.5 || o.BarWidth != 0.8 || o.XTickLabelRotation != -45 {
+		t.Errorf("Options = %+v", o)
+	}
+
+	if p := BarChart("Test").Vertical().Stacked(); p.Options.Orientation != OrientationVertical || p.Options.Stacking != StackingNormal {
+		t.Errorf("Orientation = %q, Stacking = %q", p.Options.Orientation, p.Options.Stacking)
+	}
+}
@@ -51,6 +51,18 @@ func (p *BarGaugePanel) WithDescription(desc string) *BarGaugePanel {
 	return p
 }
 
+// WithLinks sets the links shown in the panel header.
+func (p *BarGaugePanel) WithLinks(links ...*DataLink) *BarGaugePanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *BarGaugePanel) WithDataLinks(links ...*DataLink) *BarGaugePanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
 // WithDatasource sets the data source.
 func (p *BarGaugePanel) WithDatasource(ds string) *BarGaugePanel {
 	p.Datasource = ds
@@ -71,6 +83,27 @@ func (p *BarGaugePanel) WithPosition(x, y int) *BarGaugePanel {
 	return p
 }
 
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *BarGaugePanel) RepeatBy(variable string) *BarGaugePanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *BarGaugePanel) RepeatVertically() *BarGaugePanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *BarGaugePanel) WithMaxPerRow(n int) *BarGaugePanel {
+	p.MaxPerRow = n
+	return p
+}
+
 // WithTargets sets the query targets.
 func (p *BarGaugePanel) WithTargets(targets ...any) *BarGaugePanel {
 	p.Targets = targets
@@ -83,6 +116,18 @@ func (p *BarGaugePanel) AddTarget(target any) *BarGaugePanel {
 	return p
 }
 
+// WithTransformations sets the transformations applied to the query results.
+func (p *BarGaugePanel) WithTransformations(transformations ...*Transformation) *BarGaugePanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *BarGaugePanel) AddTransformation(transformation *Transformation) *BarGaugePanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
 // WithMin sets the minimum value.
 func (p *BarGaugePanel) WithMin(min float64) *BarGaugePanel {
 	p.FieldConfig.Defaults.Min = &min
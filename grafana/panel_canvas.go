@@ -0,0 +1,210 @@
+package grafana
+
+// CanvasPanel represents a Grafana canvas panel, a free-form layout of
+// text, metric values, shapes and icons.
+type CanvasPanel struct {
+	BasePanel
+	Options CanvasOptions `json:"options,omitempty"`
+}
+
+// CanvasOptions contains canvas panel options.
+type CanvasOptions struct {
+	InlineEditing     bool           `json:"inlineEditing"`
+	ShowAdvancedTypes bool           `json:"showAdvancedTypes"`
+	Root              *CanvasElement `json:"root,omitempty"`
+}
+
+// CanvasElement is an element of a canvas.
+//
+// Example usage:
+//
+//	var Overview = grafana.Canvas("Overview").WithElements(
+//	    grafana.CanvasText("Title", "API").At(10, 10, 200, 40),
+//	    grafana.CanvasMetricValue("Requests", "Value").At(10, 60, 200, 40),
+//	)
+type CanvasElement struct {
+	// Type is the element type (e.g., "text", "metric-value", "rectangle",
+	// "icon", "frame").
+	Type string `json:"type"`
+
+	// Name is the element name.
+	Name string `json:"name"`
+
+	// Config contains the element type specific options.
+	Config map[string]any `json:"config,omitempty"`
+
+	// Placement is the element position and size, in pixels.
+	Placement *CanvasPlacement `json:"placement,omitempty"`
+
+	// Elements are the children of frame elements.
+	Elements []*CanvasElement `json:"elements,omitempty"`
+}
+
+// CanvasPlacement is the position and size of a canvas element.
+type CanvasPlacement struct {
+	Top    float64 `json:"top"`
+	Left   float64 `json:"left"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// NewCanvasElement creates a canvas element of any type.
+func NewCanvasElement(elementType, name string, config map[string]any) *CanvasElement {
+	return &CanvasElement{Type: elementType, Name: name, Config: config}
+}
+
+// CanvasText creates a text element with fixed text.
+func CanvasText(name, text string) *CanvasElement {
+	return NewCanvasElement("text", name, map[string]any{
+		"text": map[string]any{"mode": "fixed", "fixed": text},
+	})
+}
+
+// CanvasMetricValue creates a text element showing the value of a field.
+func CanvasMetricValue(name, field string) *CanvasElement {
+	return NewCanvasElement("metric-value", name, map[string]any{
+		"text": map[string]any{"mode": "field", "field": field},
+	})
+}
+
+// At sets the element position and size, in pixels.
+func (e *CanvasElement) At(left, top, width, height float64) *CanvasElement {
+	e.Placement = &CanvasPlacement{Top: top, Left: left, Width: width, Height: height}
+	return e
+}
+
+// Canvas creates a new CanvasPanel.
+func Canvas(title string) *CanvasPanel {
+	return &CanvasPanel{
+		BasePanel: BasePanel{
+			Type:  "canvas",
+			Title: title,
+			GridPos: GridPos{
+				W: 12,
+				H: 12,
+			},
+		},
+		Options: CanvasOptions{
+			InlineEditing: true,
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *CanvasPanel) WithDescription(desc string) *CanvasPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *CanvasPanel) WithLinks(links ...*DataLink) *CanvasPanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *CanvasPanel) WithDataLinks(links ...*DataLink) *CanvasPanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
+// WithDatasource sets the data source.
+func (p *CanvasPanel) WithDatasource(ds string) *CanvasPanel {
+	p.Datasource = ds
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *CanvasPanel) WithSize(w, h int) *CanvasPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *CanvasPanel) WithPosition(x, y int) *CanvasPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *CanvasPanel) RepeatBy(variable string) *CanvasPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *CanvasPanel) RepeatVertically() *CanvasPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *CanvasPanel) WithMaxPerRow(n int) *CanvasPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// WithTargets sets the query targets.
+func (p *CanvasPanel) WithTargets(targets ...any) *CanvasPanel {
+	p.Targets = targets
+	return p
+}
+
+// AddTarget adds a query target.
+func (p *CanvasPanel) AddTarget(target any) *CanvasPanel {
+	p.Targets = append(p.Targets, target)
+	return p
+}
+
+// WithTransformations sets the transformations applied to the query results.
+func (p *CanvasPanel) WithTransformations(transformations ...*Transformation) *CanvasPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *CanvasPanel) AddTransformation(transformation *Transformation) *CanvasPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
+// WithUnit sets the display unit.
+func (p *CanvasPanel) WithUnit(unit string) *CanvasPanel {
+	p.FieldConfig.Defaults.Unit = unit
+	return p
+}
+
+// WithMin sets the minimum value.
+func (p *CanvasPanel) WithMin(min float64) *CanvasPanel {
+	p.FieldConfig.Defaults.Min = &min
+	return p
+}
+
+// WithMax sets the maximum value.
+func (p *CanvasPanel) WithMax(max float64) *CanvasPanel {
+	p.FieldConfig.Defaults.Max = &max
+	return p
+}
+
+// WithDecimals sets the number of decimal places.
+func (p *CanvasPanel) WithDecimals(decimals int) *CanvasPanel {
+	p.FieldConfig.Defaults.Decimals = &decimals
+	return p
+}
+
+// WithElements sets the elements of the canvas.
+func (p *CanvasPanel) WithElements(elements ...*CanvasElement) *CanvasPanel {
+	p.Options.Root = &CanvasElement{Type: "frame", Name: "Root", Elements: elements}
+	return p
+}
+
+// DisableInlineEditing prevents editing the canvas from the dashboard.
+func (p *CanvasPanel) DisableInlineEditing() *CanvasPanel {
+	p.Options.InlineEditing = false
+	return p
+}
@@ -0,0 +1,39 @@
+package grafana
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCanvas(t *testing.T) {
+	p := Canvas("Overview").
+		WithElements(
+			CanvasText("Title", "API").At(10, 20, 200, 40),
+			CanvasMetricValue("Requests", "Value"),
+		).
+		DisableInlineEditing()
+	if p.Type != "canvas" {
+		t.Errorf("Type = %q, want canvas", p.Type)
+	}
+	if p.Options.InlineEditing {
+		t.Error("InlineEditing = true, want false")
+	}
+	root := p.Options.Root
+	if root == nil || root.Type != "frame" || len(root.Elements) != 2 {
+		t.Fatalf("Root = %+v", root)
+	}
+	if pl := root.Elements[0].Placement; pl == nil || *pl != (CanvasPlacement{Top: 20, Left: 10, Width: 200, Height: 40}) {
+		t.Errorf("Placement = %+v", pl)
+	}
+}
+
+func TestCanvasElement_JSON(t *testing.T) {
+	data, err := json.Marshal(CanvasMetricValue("Requests", "Value"))
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"type":"metric-value","name":"Requests","config":{"text":{"field":"Value","mode":"field"}}}`
+	if string(data) != want {
+		t.Errorf("JSON = %s, want %s", data, want)
+	}
+}
@@ -0,0 +1,142 @@
+package grafana
+
+// DashboardListPanel represents a Grafana dashboard list panel, showing
+// starred, recently viewed or searched dashboards.
+type DashboardListPanel struct {
+	BasePanel
+	Options DashboardListOptions `json:"options,omitempty"`
+}
+
+// DashboardListOptions contains dashboard list panel options.
+type DashboardListOptions struct {
+	ShowStarred        bool     `json:"showStarred"`
+	ShowRecentlyViewed bool     `json:"showRecentlyViewed"`
+	ShowSearch         bool     `json:"showSearch"`
+	ShowHeadings       bool     `json:"showHeadings"`
+	MaxItems           int      `json:"maxItems,omitempty"`
+	Query              string   `json:"query"`
+	Tags               []string `json:"tags"`
+	IncludeVars        bool     `json:"includeVars"`
+	KeepTime           bool     `json:"keepTime"`
+}
+
+// DashboardList creates a new DashboardListPanel showing starred
+// dashboards.
+func DashboardList(title string) *DashboardListPanel {
+	return &DashboardListPanel{
+		BasePanel: BasePanel{
+			Type:  "dashlist",
+			Title: title,
+			GridPos: GridPos{
+				W: 8,
+				H: 8,
+			},
+		},
+		Options: DashboardListOptions{
+			ShowStarred:  true,
+			ShowHeadings: true,
+			MaxItems:     10,
+			Tags:         []string{},
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *DashboardListPanel) WithDescription(desc string) *DashboardListPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *DashboardListPanel) WithLinks(links ...*DataLink) *DashboardListPanel {
+	p.Links = links
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *DashboardListPanel) WithSize(w, h int) *DashboardListPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *DashboardListPanel) WithPosition(x, y int) *DashboardListPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *DashboardListPanel) RepeatBy(variable string) *DashboardListPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *DashboardListPanel) RepeatVertically() *DashboardListPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *DashboardListPanel) WithMaxPerRow(n int) *DashboardListPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// ShowStarred shows starred dashboards.
+func (p *DashboardListPanel) ShowStarred() *DashboardListPanel {
+	p.Options.ShowStarred = true
+	return p
+}
+
+// HideStarred hides starred dashboards.
+func (p *DashboardListPanel) HideStarred() *DashboardListPanel {
+	p.Options.ShowStarred = false
+	return p
+}
+
+// ShowRecentlyViewed shows recently viewed dashboards.
+func (p *DashboardListPanel) ShowRecentlyViewed() *DashboardListPanel {
+	p.Options.ShowRecentlyViewed = true
+	return p
+}
+
+// Search shows the dashboards matching a query and all of the given tags.
+func (p *DashboardListPanel) Search(query string, tags ...string) *DashboardListPanel {
+	p.Options.ShowSearch = true
+	p.Options.Query = query
+	if tags == nil {
+		tags = []string{}
+	}
+	p.Options.Tags = tags
+	return p
+}
+
+// HideHeadings hides the section headings.
+func (p *DashboardListPanel) HideHeadings() *DashboardListPanel {
+	p.Options.ShowHeadings = false
+	return p
+}
+
+// WithMaxItems sets the maximum number of dashboards per section.
+func (p *DashboardListPanel) WithMaxItems(n int) *DashboardListPanel {
+	p.Options.MaxItems = n
+	return p
+}
+
+// IncludeVars passes the current variable values to the linked dashboards.
+func (p *DashboardListPanel) IncludeVars() *DashboardListPanel {
+	p.Options.IncludeVars = true
+	return p
+}
+
+// KeepTime passes the current time range to the linked dashboards.
+func (p *DashboardListPanel) KeepTime() *DashboardListPanel {
+	p.Options.KeepTime = true
+	return p
+}
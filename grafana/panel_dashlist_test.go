@@ -0,0 +1,34 @@
+package grafana
+
+import "testing"
+
+func TestDashboardList(t *testing.T) {
+	p := DashboardList("Starred")
+	if p.Type != "dashlist" {
+		t.Errorf("Type = %q, want dashlist", p.Type)
+	}
+	if !p.Options.ShowStarred || !p.Options.ShowHeadings || p.Options.MaxItems != 10 {
+		t.Errorf("Options = %+v", p.Options)
+	}
+}
+
+func TestDashboardList_Options(t *testing.T) {
+	p := DashboardList("Services").
+		HideStarred().
+		ShowRecentlyViewed().
+		Search("api", "service").
+		HideHeadings().
+		WithMaxItems(20).
+		IncludeVars().
+		KeepTime()
+	o := p.Options
+	if o.ShowStarred || !o.ShowRecentlyViewed || !o.ShowSearch || o.ShowHeadings {
+		t.Errorf("Options = %+v", o)
+	}
+	if o.Query != "api" || len(o.Tags) != 1 || o.Tags[0] != "service" {
+		t.Errorf("Query = %q, Tags = %v", o.Query, o.Tags)
+	}
+	if o.MaxItems != 20 || !o.IncludeVars || !o.KeepTime {
+		t.Errorf("Options = %+v", o)
+	}
+}
@@ -0,0 +1,98 @@
+package grafana
+
+// FlameGraphPanel represents a Grafana flame graph panel, showing profiling
+// data from a data source such as Pyroscope.
+type FlameGraphPanel struct {
+	BasePanel
+}
+
+// FlameGraph creates a new FlameGraphPanel.
+func FlameGraph(title string) *FlameGraphPanel {
+	return &FlameGraphPanel{
+		BasePanel: BasePanel{
+			Type:  "flamegraph",
+			Title: title,
+			GridPos: GridPos{
+				W: 24,
+				H: 16,
+			},
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *FlameGraphPanel) WithDescription(desc string) *FlameGraphPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *FlameGraphPanel) WithLinks(links ...*DataLink) *FlameGraphPanel {
+	p.Links = links
+	return p
+}
+
+// WithDatasource sets the data source.
+func (p *FlameGraphPanel) WithDatasource(ds string) *FlameGraphPanel {
+	p.Datasource = ds
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *FlameGraphPanel) WithSize(w, h int) *FlameGraphPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *FlameGraphPanel) WithPosition(x, y int) *FlameGraphPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *FlameGraphPanel) RepeatBy(variable string) *FlameGraphPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *FlameGraphPanel) RepeatVertically() *FlameGraphPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *FlameGraphPanel) WithMaxPerRow(n int) *FlameGraphPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// WithTargets sets the query targets.
+func (p *FlameGraphPanel) WithTargets(targets ...any) *FlameGraphPanel {
+	p.Targets = targets
+	return p
+}
+
+// AddTarget adds a query target.
+func (p *FlameGraphPanel) AddTarget(target any) *FlameGraphPanel {
+	p.Targets = append(p.Targets, target)
+	return p
+}
+
+// WithTransformations sets the transformations applied to the query results.
+func (p *FlameGraphPanel) WithTransformations(transformations ...*Transformation) *FlameGraphPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *FlameGraphPanel) AddTransformation(transformation *Transformation) *FlameGraphPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
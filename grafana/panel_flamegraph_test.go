@@ -0,0 +1,13 @@
+package grafana
+
+import "testing"
+
+func TestFlameGraph(t *testing.T) {
+	p := FlameGraph("CPU Profile").WithDatasource("pyroscope").WithSize(24, 20)
+	if p.Type != "flamegraph" {
+		t.Errorf("Type = %q, want flamegraph", p.Type)
+	}
+	if p.Datasource != "pyroscope" || p.GridPos.H != 20 {
+		t.Errorf("Datasource = %q, GridPos = %+v", p.Datasource, p.GridPos)
+	}
+}
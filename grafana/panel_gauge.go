@@ -42,6 +42,18 @@ func (p *GaugePanel) WithDescription(desc string) *GaugePanel {
 	return p
 }
 
+// WithLinks sets the links shown in the panel header.
+func (p *GaugePanel) WithLinks(links ...*DataLink) *GaugePanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *GaugePanel) WithDataLinks(links ...*DataLink) *GaugePanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
 // WithDatasource sets the data source.
 func (p *GaugePanel) WithDatasource(ds string) *GaugePanel {
 	p.Datasource = ds
@@ -62,6 +74,27 @@ func (p *GaugePanel) WithPosition(x, y int) *GaugePanel {
 	return p
 }
 
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *GaugePanel) RepeatBy(variable string) *GaugePanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *GaugePanel) RepeatVertically() *GaugePanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *GaugePanel) WithMaxPerRow(n int) *GaugePanel {
+	p.MaxPerRow = n
+	return p
+}
+
 // WithTargets sets the query targets.
 func (p *GaugePanel) WithTargets(targets ...any) *GaugePanel {
 	p.Targets = targets
@@ -74,6 +107,18 @@ func (p *GaugePanel) AddTarget(target any) *GaugePanel {
 	return p
 }
 
+// WithTransformations sets the transformations applied to the query results.
+func (p *GaugePanel) WithTransformations(transformations ...*Transformation) *GaugePanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *GaugePanel) AddTransformation(transformation *Transformation) *GaugePanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
 // WithMin sets the minimum value.
 func (p *GaugePanel) WithMin(min float64) *GaugePanel {
 	p.FieldConfig.Defaults.Min = &min
@@ -0,0 +1,309 @@
+package grafana
+
+// Location modes for geomap layers.
+const (
+	LocationAuto    = "auto"
+	LocationCoords  = "coords"
+	LocationGeohash = "geohash"
+	LocationLookup  = "lookup"
+)
+
+// GeomapPanel represents a Grafana geomap panel, showing data on a world
+// map.
+type GeomapPanel struct {
+	BasePanel
+	Options GeomapOptions `json:"options,omitempty"`
+}
+
+// GeomapOptions contains geomap panel options.
+type GeomapOptions struct {
+	View     GeomapView     `json:"view"`
+	Controls GeomapControls `json:"controls"`
+	Basemap  *GeomapLayer   `json:"basemap,omitempty"`
+	Layers   []*GeomapLayer `json:"layers,omitempty"`
+	Tooltip  GeomapTooltip  `json:"tooltip"`
+}
+
+// GeomapView is the initial map view.
+type GeomapView struct {
+	ID   string  `json:"id"`
+	Lat  float64 `json:"lat"`
+	Lon  float64 `json:"lon"`
+	Zoom float64 `json:"zoom,omitempty"`
+}
+
+// GeomapControls contains the map controls.
+type GeomapControls struct {
+	ShowZoom        bool `json:"showZoom"`
+	MouseWheelZoom  bool `json:"mouseWheelZoom"`
+	ShowAttribution bool `json:"showAttribution"`
+	ShowScale       bool `json:"showScale,omitempty"`
+	ShowMeasure     bool `json:"showMeasure,omitempty"`
+}
+
+// GeomapTooltip contains the tooltip options of geomap panels.
+type GeomapTooltip struct {
+	Mode string `json:"mode"`
+}
+
+// GeomapLayer is a map layer.
+//
+// Example usage:
+//
+//	var Sites = grafana.Geomap("Sites").
+//	    WithView(48.85, 2.35, 5).
+//	    AddLayer(grafana.MarkersLayer("Sites").WithCoords("lat", "lon"))
+type GeomapLayer struct {
+	// Type is the layer type (e.g., "markers", "heatmap", "default").
+	Type string `json:"type"`
+
+	// Name is the layer name.
+	Name string `json:"name,omitempty"`
+
+	// Config contains the layer type specific options.
+	Config map[string]any `json:"config,omitempty"`
+
+	// Location selects the fields holding the location of each row.
+	Location *GeomapLocation `json:"location,omitempty"`
+
+	// Tooltip shows a tooltip when hovering the layer.
+	Tooltip bool `json:"tooltip,omitempty"`
+}
+
+// GeomapLocation selects the fields holding locations.
+type GeomapLocation struct {
+	// Mode is auto, coords, geohash or lookup.
+	Mode string `json:"mode"`
+
+	// Latitude is the latitude field, for coords mode.
+	Latitude string `json:"latitude,omitempty"`
+
+	// Longitude is the longitude field, for coords mode.
+	Longitude string `json:"longitude,omitempty"`
+
+	// Geohash is the geohash field, for geohash mode.
+	Geohash string `json:"geohash,omitempty"`
+
+	// Lookup is the field looked up in the gazetteer, for lookup mode.
+	Lookup string `json:"lookup,omitempty"`
+
+	// Gazetteer is the lookup table (e.g., "public/gazetteer/countries.json").
+	Gazetteer string `json:"gazetteer,omitempty"`
+}
+
+// MarkersLayer creates a layer showing a marker per row, located
+// automatically from the field names.
+func MarkersLayer(name string) *GeomapLayer {
+	return &GeomapLayer{
+		Type:     "markers",
+		Name:     name,
+		Location: &GeomapLocation{Mode: LocationAuto},
+		Tooltip:  true,
+	}
+}
+
+// HeatmapLayer creates a layer showing the density of rows, located
+// automatically from the field names.
+func HeatmapLayer(name string) *GeomapLayer {
+	return &GeomapLayer{
+		Type:     "heatmap",
+		Name:     name,
+		Location: &GeomapLocation{Mode: LocationAuto},
+		Tooltip:  true,
+	}
+}
+
+// WithCoords locates rows from latitude and longitude fields.
+func (l *GeomapLayer) WithCoords(latitude, longitude string) *GeomapLayer {
+	l.Location = &GeomapLocation{Mode: LocationCoords, Latitude: latitude, Longitude: longitude}
+	return l
+}
+
+// WithGeohash locates rows from a geohash field.
+func (l *GeomapLayer) WithGeohash(field string) *GeomapLayer {
+	l.Location = &GeomapLocation{Mode: LocationGeohash, Geohash: field}
+	return l
+}
+
+// WithLookup locates rows by looking up a field, such as a country code,
+// in a gazetteer.
+func (l *GeomapLayer) WithLookup(field, gazetteer string) *GeomapLayer {
+	l.Location = &GeomapLocation{Mode: LocationLookup, Lookup: field, Gazetteer: gazetteer}
+	return l
+}
+
+// WithConfig sets the layer type specific options.
+func (l *GeomapLayer) WithConfig(config map[string]any) *GeomapLayer {
+	l.Config = config
+	return l
+}
+
+// Geomap creates a new GeomapPanel.
+func Geomap(title string) *GeomapPanel {
+	return &GeomapPanel{
+		BasePanel: BasePanel{
+			Type:  "geomap",
+			Title: title,
+			GridPos: GridPos{
+				W: 12,
+				H: 12,
+			},
+		},
+		Options: GeomapOptions{
+			View: GeomapView{
+				ID: "zero",
+			},
+			Controls: GeomapControls{
+				ShowZoom:        true,
+				MouseWheelZoom:  true,
+				ShowAttribution: true,
+			},
+			Basemap: &GeomapLayer{
+				Type: "default",
+				Name: "Basemap",
+			},
+			Tooltip: GeomapTooltip{
+				Mode: "details",
+			},
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *GeomapPanel) WithDescription(desc string) *GeomapPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *GeomapPanel) WithLinks(links ...*DataLink) *GeomapPanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *GeomapPanel) WithDataLinks(links ...*DataLink) *GeomapPanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
+// WithDatasource sets the data source.
+func (p *GeomapPanel) WithDatasource(ds string) *GeomapPanel {
+	p.Datasource = ds
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *GeomapPanel) WithSize(w, h int) *GeomapPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *GeomapPanel) WithPosition(x, y int) *GeomapPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *GeomapPanel) RepeatBy(variable string) *GeomapPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *GeomapPanel) RepeatVertically() *GeomapPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *GeomapPanel) WithMaxPerRow(n int) *GeomapPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// WithTargets sets the query targets.
+func (p *GeomapPanel) WithTargets(targets ...any) *GeomapPanel {
+	p.Targets = targets
+	return p
+}
+
+// AddTarget adds a query target.
+func (p *GeomapPanel) AddTarget(target any) *GeomapPanel {
+	p.Targets = append(p.Targets, target)
+	return p
+}
+
+// WithTransformations sets the transformations applied to the query results.
+func (p *GeomapPanel) WithTransformations(transformations ...*Transformation) *GeomapPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *GeomapPanel) AddTransformation(transformation *Transformation) *GeomapPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
+// WithUnit sets the display unit.
+func (p *GeomapPanel) WithUnit(unit string) *GeomapPanel {
+	p.FieldConfig.Defaults.Unit = unit
+	return p
+}
+
+// WithMin sets the minimum value.
+func (p *GeomapPanel) WithMin(min float64) *GeomapPanel {
+	p.FieldConfig.Defaults.Min = &min
+	return p
+}
+
+// WithMax sets the maximum value.
+func (p *GeomapPanel) WithMax(max float64) *GeomapPanel {
+	p.FieldConfig.Defaults.Max = &max
+	return p
+}
+
+// WithDecimals sets the number of decimal places.
+func (p *GeomapPanel) WithDecimals(decimals int) *GeomapPanel {
+	p.FieldConfig.Defaults.Decimals = &decimals
+	return p
+}
+
+// WithView centers the initial view on a location.
+func (p *GeomapPanel) WithView(lat, lon, zoom float64) *GeomapPanel {
+	p.Options.View = GeomapView{ID: "coords", Lat: lat, Lon: lon, Zoom: zoom}
+	return p
+}
+
+// WithPresetView sets the initial view to a preset, such as "fit" to fit
+// the data or a region like "europe", at a zoom level (0 for the preset's
+// default).
+func (p *GeomapPanel) WithPresetView(id string, zoom float64) *GeomapPanel {
+	p.Options.View = GeomapView{ID: id, Zoom: zoom}
+	return p
+}
+
+// AddLayer adds a data layer.
+func (p *GeomapPanel) AddLayer(layer *GeomapLayer) *GeomapPanel {
+	p.Options.Layers = append(p.Options.Layers, layer)
+	return p
+}
+
+// ShowScale shows the map scale.
+func (p *GeomapPanel) ShowScale() *GeomapPanel {
+	p.Options.Controls.ShowScale = true
+	return p
+}
+
+// DisableMouseWheelZoom disables zooming with the mouse wheel.
+func (p *GeomapPanel) DisableMouseWheelZoom() *GeomapPanel {
+	p.Options.Controls.MouseWheelZoom = false
+	return p
+}
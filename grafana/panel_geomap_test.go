@@ -0,0 +1,62 @@
+package grafana
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGeomap(t *testing.T) {
+	p := Geomap("Sites")
+	if p.Type != "geomap" {
+		t.Errorf("Type = %q, want geomap", p.Type)
+	}
+	if p.Options.View.ID != "zero" || !p.Options.Controls.MouseWheelZoom {
+		t.Errorf("Options = %+v", p.Options)
+	}
+}
+
+func TestGeomap_Layers(t *testing.T) {
+	p := Geomap("Sites").
+		WithView(48.85, 2.35, 5).
+		AddLayer(MarkersLayer("Sites").WithCoords("lat", "lon")).
+		AddLayer(HeatmapLayer("Density").WithGeohash("geohash")).
+		AddLayer(MarkersLayer("Countries").WithLookup("country", "public/gazetteer/countries.json")).
+		ShowScale().
+		DisableMouseWheelZoom()
+	if p.Options.View != (GeomapView{ID: "coords", Lat: 48.85, Lon: 2.35, Zoom: 5}) {
+		t.Errorf("View = %+v", p.Options.View)
+	}
+	if len(p.Options.Layers) != 3 {
+		t.Fatalf("Layers = %d, want 3", len(p.Options.Layers))
+	}
+	if loc := p.Options.Layers[0].Location; loc.Mode != LocationCoords || loc.Latitude != "lat" || loc.Longitude != "lon" {
+		t.Errorf("Location = %+v", loc)
+	}
+	if loc := p.Options.Layers[1].Location; p.Options.Layers[1].Type != "heatmap" || loc.Mode != LocationGeohash || loc.Geohash != "geohash" {
+		t.Errorf("Location = %+v", loc)
+	}
+	if loc := p.Options.Layers[2].Location; loc.Mode != LocationLookup || loc.Lookup != "country" {
+		t.Errorf("Location = %+v", loc)
+	}
+	if !p.Options.Controls.ShowScale || p.Options.Controls.MouseWheelZoom {
+		t.Errorf("Controls = %+v", p.Options.Controls)
+	}
+}
+
+func TestGeomap_PresetView(t *testing.T) {
+	p := Geomap("Sites").WithView(48.85, 2.35, 5).WithPresetView("fit", 4)
+	if p.Options.View != (GeomapView{ID: "fit", Zoom: 4}) {
+		t.Errorf("View = %+v", p.Options.View)
+	}
+}
+
+func TestGeomapLayer_JSON(t *testing.T) {
+	data, err := json.Marshal(MarkersLayer("Sites"))
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"type":"markers","name":"Sites","location":{"mode":"auto"},"tooltip":true}`
+	if string(data) != want {
+		t.Errorf("JSON = %s, want %s", data, want)
+	}
+}
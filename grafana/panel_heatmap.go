@@ -109,6 +109,18 @@ func (p *HeatmapPanel) WithDescription(desc string) *HeatmapPanel {
 	return p
 }
 
+// WithLinks sets the links shown in the panel header.
+func (p *HeatmapPanel) WithLinks(links ...*DataLink) *HeatmapPanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *HeatmapPanel) WithDataLinks(links ...*DataLink) *HeatmapPanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
 // WithDatasource sets the data source.
 func (p *HeatmapPanel) WithDatasource(ds string) *HeatmapPanel {
 	p.Datasource = ds
@@ -129,6 +141,27 @@ func (p *HeatmapPanel) WithPosition(x, y int) *HeatmapPanel {
 	return p
 }
 
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *HeatmapPanel) RepeatBy(variable string) *HeatmapPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *HeatmapPanel) RepeatVertically() *HeatmapPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *HeatmapPanel) WithMaxPerRow(n int) *HeatmapPanel {
+	p.MaxPerRow = n
+	return p
+}
+
 // WithTargets sets the query targets.
 func (p *HeatmapPanel) WithTargets(targets ...any) *HeatmapPanel {
 	p.Targets = targets
@@ -141,6 +174,18 @@ func (p *HeatmapPanel) AddTarget(target any) *HeatmapPanel {
 	return p
 }
 
+// WithTransformations sets the transformations applied to the query results.
+func (p *HeatmapPanel) WithTransformations(transformations ...*Transformation) *HeatmapPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *HeatmapPanel) AddTransformation(transformation *Transformation) *HeatmapPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
 // WithColorScheme sets the color scheme.
 func (p *HeatmapPanel) WithColorScheme(scheme string) *HeatmapPanel {
 	p.Options.Color.Scheme = scheme
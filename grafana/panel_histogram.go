@@ -0,0 +1,200 @@
+package grafana
+
+// HistogramPanel represents a Grafana histogram panel, showing the
+// distribution of values as buckets.
+type HistogramPanel struct {
+	BasePanel
+	Options HistogramOptions `json:"options,omitempty"`
+}
+
+// HistogramOptions contains histogram panel options.
+type HistogramOptions struct {
+	BucketCount  int            `json:"bucketCount,omitempty"`
+	BucketSize   *float64       `json:"bucketSize,omitempty"`
+	BucketOffset float64        `json:"bucketOffset,omitempty"`
+	Combine      bool           `json:"combine,omitempty"`
+	Legend       LegendOptions  `json:"legend,omitempty"`
+	Tooltip      TooltipOptions `json:"tooltip,omitempty"`
+}
+
+// Histogram creates a new HistogramPanel.
+func Histogram(title string) *HistogramPanel {
+	return &HistogramPanel{
+		BasePanel: BasePanel{
+			Type:  "histogram",
+			Title: title,
+			GridPos: GridPos{
+				W: 12,
+				H: 8,
+			},
+		},
+		Options: HistogramOptions{
+			Legend: LegendOptions{
+				DisplayMode: "list",
+				Placement:   LegendBottom,
+				ShowLegend:  true,
+			},
+			Tooltip: TooltipOptions{
+				Mode: TooltipSingle,
+			},
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *HistogramPanel) WithDescription(desc string) *HistogramPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *HistogramPanel) WithLinks(links ...*DataLink) *HistogramPanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *HistogramPanel) WithDataLinks(links ...*DataLink) *HistogramPanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
+// WithDatasource sets the data source.
+func (p *HistogramPanel) WithDatasource(ds string) *HistogramPanel {
+	p.Datasource = ds
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *HistogramPanel) WithSize(w, h int) *HistogramPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *HistogramPanel) WithPosition(x, y int) *HistogramPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *HistogramPanel) RepeatBy(variable string) *HistogramPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *HistogramPanel) RepeatVertically() *HistogramPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *HistogramPanel) WithMaxPerRow(n int) *HistogramPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// WithTargets sets the query targets.
+func (p *HistogramPanel) WithTargets(targets ...any) *HistogramPanel {
+	p.Targets = targets
+	return p
+}
+
+// AddTarget adds a query target.
+func (p *HistogramPanel) AddTarget(target any) *HistogramPanel {
+	p.Targets = append(p.Targets, target)
+	return p
+}
+
+// WithTransformations sets the transformations applied to the query results.
+func (p *HistogramPanel) WithTransformations(transformations ...*Transformation) *HistogramPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *HistogramPanel) AddTransformation(transformation *Transformation) *HistogramPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
+// WithUnit sets the display unit.
+func (p *HistogramPanel) WithUnit(unit string) *HistogramPanel {
+	p.FieldConfig.Defaults.Unit = unit
+	return p
+}
+
+// WithMin sets the minimum value.
+func (p *HistogramPanel) WithMin(min float64) *HistogramPanel {
+	p.FieldConfig.Defaults.Min = &min
+	return p
+}
+
+// WithMax sets the maximum value.
+func (p *HistogramPanel) WithMax(max float64) *HistogramPanel {
+	p.FieldConfig.Defaults.Max = &max
+	return p
+}
+
+// WithDecimals sets the number of decimal places.
+func (p *HistogramPanel) WithDecimals(decimals int) *HistogramPanel {
+	p.FieldConfig.Defaults.Decimals = &decimals
+	return p
+}
+
+// LegendBottom places the legend at the bottom.
+func (p *HistogramPanel) LegendBottom() *HistogramPanel {
+	p.Options.Legend.Placement = LegendBottom
+	p.Options.Legend.ShowLegend = true
+	return p
+}
+
+// LegendRight places the legend on the right.
+func (p *HistogramPanel) LegendRight() *HistogramPanel {
+	p.Options.Legend.Placement = LegendRight
+	p.Options.Legend.ShowLegend = true
+	return p
+}
+
+// HideLegend hides the legend.
+func (p *HistogramPanel) HideLegend() *HistogramPanel {
+	p.Options.Legend.DisplayMode = "hidden"
+	p.Options.Legend.ShowLegend = false
+	return p
+}
+
+// WithTooltip sets the tooltip mode.
+func (p *HistogramPanel) WithTooltip(mode string) *HistogramPanel {
+	p.Options.Tooltip.Mode = mode
+	return p
+}
+
+// WithBucketCount sets the approximate number of buckets.
+func (p *HistogramPanel) WithBucketCount(count int) *HistogramPanel {
+	p.Options.BucketCount = count
+	return p
+}
+
+// WithBucketSize sets the width of each bucket.
+func (p *HistogramPanel) WithBucketSize(size float64) *HistogramPanel {
+	p.Options.BucketSize = &size
+	return p
+}
+
+// WithBucketOffset shifts the bucket boundaries.
+func (p *HistogramPanel) WithBucketOffset(offset float64) *HistogramPanel {
+	p.Options.BucketOffset = offset
+	return p
+}
+
+// Combine merges all series into a single histogram.
+func (p *HistogramPanel) Combine() *HistogramPanel {
+	p.Options.Combine = true
+	return p
+}
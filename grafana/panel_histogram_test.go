@@ -0,0 +1,21 @@
+package grafana
+
+import "testing"
+
+func TestHistogram(t *testing.T) {
+	p := Histogram("Latency Distribution")
+	if p.Type != "histogram" {
+		t.Errorf("Type = %q, want histogram", p.Type)
+	}
+	if p.Options.BucketSize != nil {
+		t.Errorf("BucketSize = %v, want nil", *p.Options.BucketSize)
+	}
+}
+
+func TestHistogram_Options(t *testing.T) {
+	p := Histogram("Test").WithBucketCount(20).WithBucketSize(0.05).WithBucketOffset(0.01).Combine()
+	o := p.Options
+	if o.BucketCount != 20 || o.BucketSize == nil || *o.BucketSize != 0.05 || o.BucketOffset != 0.01 || !o.Combine {
+		t.Errorf("Options = %+v", o)
+	}
+}
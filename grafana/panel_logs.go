@@ -44,6 +44,18 @@ func (p *LogsPanel) WithDescription(desc string) *LogsPanel {
 	return p
 }
 
+// WithLinks sets the links shown in the panel header.
+func (p *LogsPanel) WithLinks(links ...*DataLink) *LogsPanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *LogsPanel) WithDataLinks(links ...*DataLink) *LogsPanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
 // WithDatasource sets the data source.
 func (p *LogsPanel) WithDatasource(ds string) *LogsPanel {
 	p.Datasource = ds
@@ -64,6 +76,27 @@ func (p *LogsPanel) WithPosition(x, y int) *LogsPanel {
 	return p
 }
 
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *LogsPanel) RepeatBy(variable string) *LogsPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *LogsPanel) RepeatVertically() *LogsPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *LogsPanel) WithMaxPerRow(n int) *LogsPanel {
+	p.MaxPerRow = n
+	return p
+}
+
 // WithTargets sets the query targets.
 func (p *LogsPanel) WithTargets(targets ...any) *LogsPanel {
 	p.Targets = targets
@@ -76,6 +109,18 @@ func (p *LogsPanel) AddTarget(target any) *LogsPanel {
 	return p
 }
 
+// WithTransformations sets the transformations applied to the query results.
+func (p *LogsPanel) WithTransformations(transformations ...*Transformation) *LogsPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *LogsPanel) AddTransformation(transformation *Transformation) *LogsPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
 // ShowTime shows the timestamp.
 func (p *LogsPanel) ShowTime() *LogsPanel {
 	p.Options.ShowTime = true
@@ -0,0 +1,84 @@
+package grafana
+
+// NewsPanel represents a Grafana news panel, showing an RSS or Atom feed.
+type NewsPanel struct {
+	BasePanel
+	Options NewsOptions `json:"options,omitempty"`
+}
+
+// NewsOptions contains news panel options.
+type NewsOptions struct {
+	FeedURL   string `json:"feedUrl,omitempty"`
+	ShowImage bool   `json:"showImage"`
+}
+
+// News creates a new NewsPanel showing the feed at feedURL.
+func News(title, feedURL string) *NewsPanel {
+	return &NewsPanel{
+		BasePanel: BasePanel{
+			Type:  "news",
+			Title: title,
+			GridPos: GridPos{
+				W: 8,
+				H: 12,
+			},
+		},
+		Options: NewsOptions{
+			FeedURL:   feedURL,
+			ShowImage: true,
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *NewsPanel) WithDescription(desc string) *NewsPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *NewsPanel) WithLinks(links ...*DataLink) *NewsPanel {
+	p.Links = links
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *NewsPanel) WithSize(w, h int) *NewsPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *NewsPanel) WithPosition(x, y int) *NewsPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *NewsPanel) RepeatBy(variable string) *NewsPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *NewsPanel) RepeatVertically() *NewsPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *NewsPanel) WithMaxPerRow(n int) *NewsPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// HideImages hides the images of feed items.
+func (p *NewsPanel) HideImages() *NewsPanel {
+	p.Options.ShowImage = false
+	return p
+}
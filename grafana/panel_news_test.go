@@ -0,0 +1,13 @@
+package grafana
+
+import "testing"
+
+func TestNews(t *testing.T) {
+	p := News("Status", "https://status.example.com/feed.atom").HideImages()
+	if p.Type != "news" {
+		t.Errorf("Type = %q, want news", p.Type)
+	}
+	if p.Options.FeedURL != "https://status.example.com/feed.atom" || p.Options.ShowImage {
+		t.Errorf("Options = %+v", p.Options)
+	}
+}
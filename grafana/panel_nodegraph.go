@@ -0,0 +1,147 @@
+package grafana
+
+// NodeGraphPanel represents a Grafana node graph panel, showing a directed
+// graph of nodes and edges such as a service map.
+type NodeGraphPanel struct {
+	BasePanel
+	Options NodeGraphOptions `json:"options,omitempty"`
+}
+
+// NodeGraphOptions contains node graph panel options.
+type NodeGraphOptions struct {
+	Nodes NodeGraphNodeOptions `json:"nodes,omitempty"`
+	Edges NodeGraphEdgeOptions `json:"edges,omitempty"`
+}
+
+// NodeGraphNodeOptions contains node options for node graphs.
+type NodeGraphNodeOptions struct {
+	MainStatUnit      string         `json:"mainStatUnit,omitempty"`
+	SecondaryStatUnit string         `json:"secondaryStatUnit,omitempty"`
+	Arcs              []NodeGraphArc `json:"arcs,omitempty"`
+}
+
+// NodeGraphEdgeOptions contains edge options for node graphs.
+type NodeGraphEdgeOptions struct {
+	MainStatUnit      string `json:"mainStatUnit,omitempty"`
+	SecondaryStatUnit string `json:"secondaryStatUnit,omitempty"`
+}
+
+// NodeGraphArc is a section of the arc around nodes, sized by a field.
+type NodeGraphArc struct {
+	// Field is the node field, with values between 0 and 1.
+	Field string `json:"field"`
+
+	// Color is the section color.
+	Color string `json:"color"`
+}
+
+// NodeGraph creates a new NodeGraphPanel.
+func NodeGraph(title string) *NodeGraphPanel {
+	return &NodeGraphPanel{
+		BasePanel: BasePanel{
+			Type:  "nodeGraph",
+			Title: title,
+			GridPos: GridPos{
+				W: 24,
+				H: 12,
+			},
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *NodeGraphPanel) WithDescription(desc string) *NodeGraphPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *NodeGraphPanel) WithLinks(links ...*DataLink) *NodeGraphPanel {
+	p.Links = links
+	return p
+}
+
+// WithDatasource sets the data source.
+func (p *NodeGraphPanel) WithDatasource(ds string) *NodeGraphPanel {
+	p.Datasource = ds
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *NodeGraphPanel) WithSize(w, h int) *NodeGraphPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *NodeGraphPanel) WithPosition(x, y int) *NodeGraphPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *NodeGraphPanel) RepeatBy(variable string) *NodeGraphPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *NodeGraphPanel) RepeatVertically() *NodeGraphPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *NodeGraphPanel) WithMaxPerRow(n int) *NodeGraphPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// WithTargets sets the query targets.
+func (p *NodeGraphPanel) WithTargets(targets ...any) *NodeGraphPanel {
+	p.Targets = targets
+	return p
+}
+
+// AddTarget adds a query target.
+func (p *NodeGraphPanel) AddTarget(target any) *NodeGraphPanel {
+	p.Targets = append(p.Targets, target)
+	return p
+}
+
+// WithTransformations sets the transformations applied to the query results.
+func (p *NodeGraphPanel) WithTransformations(transformations ...*Transformation) *NodeGraphPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *NodeGraphPanel) AddTransformation(transformation *Transformation) *NodeGraphPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
+// WithNodeStatUnits sets the units of the main and secondary node stats.
+func (p *NodeGraphPanel) WithNodeStatUnits(main, secondary string) *NodeGraphPanel {
+	p.Options.Nodes.MainStatUnit = main
+	p.Options.Nodes.SecondaryStatUnit = secondary
+	return p
+}
+
+// WithEdgeStatUnits sets the units of the main and secondary edge stats.
+func (p *NodeGraphPanel) WithEdgeStatUnits(main, secondary string) *NodeGraphPanel {
+	p.Options.Edges.MainStatUnit = main
+	p.Options.Edges.SecondaryStatUnit = secondary
+	return p
+}
+
+// AddArc adds a section to the arc around nodes.
+func (p *NodeGraphPanel) AddArc(field, color string) *NodeGraphPanel {
+	p.Options.Nodes.Arcs = append(p.Options.Nodes.Arcs, NodeGraphArc{Field: field, Color: color})
+	return p
+}
@@ -0,0 +1,20 @@
+package grafana
+
+import "testing"
+
+func TestNodeGraph(t *testing.T) {
+	p := NodeGraph("Service Map").
+		WithNodeStatUnits("ms", "reqps").
+		WithEdgeStatUnits("reqps", "").
+		AddArc("arc__success", "green").
+		AddArc("arc__failed", "red")
+	if p.Type != "nodeGraph" {
+		t.Errorf("Type = %q, want nodeGraph", p.Type)
+	}
+	if p.Options.Nodes.MainStatUnit != "ms" || p.Options.Nodes.SecondaryStatUnit != "reqps" || p.Options.Edges.MainStatUnit != "reqps" {
+		t.Errorf("Options = %+v", p.Options)
+	}
+	if len(p.Options.Nodes.Arcs) != 2 || p.Options.Nodes.Arcs[1] != (NodeGraphArc{Field: "arc__failed", Color: "red"}) {
+		t.Errorf("Arcs = %+v", p.Options.Nodes.Arcs)
+	}
+}
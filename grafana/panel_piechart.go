@@ -70,6 +70,18 @@ func (p *PieChartPanel) WithDescription(desc string) *PieChartPanel {
 	return p
 }
 
+// WithLinks sets the links shown in the panel header.
+func (p *PieChartPanel) WithLinks(links ...*DataLink) *PieChartPanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *PieChartPanel) WithDataLinks(links ...*DataLink) *PieChartPanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
 // WithDatasource sets the data source.
 func (p *PieChartPanel) WithDatasource(ds string) *PieChartPanel {
 	p.Datasource = ds
@@ -90,6 +102,27 @@ func (p *PieChartPanel) WithPosition(x, y int) *PieChartPanel {
 	return p
 }
 
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *PieChartPanel) RepeatBy(variable string) *PieChartPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *PieChartPanel) RepeatVertically() *PieChartPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *PieChartPanel) WithMaxPerRow(n int) *PieChartPanel {
+	p.MaxPerRow = n
+	return p
+}
+
 // WithTargets sets the query targets.
 func (p *PieChartPanel) WithTargets(targets ...any) *PieChartPanel {
 	p.Targets = targets
@@ -102,6 +135,18 @@ func (p *PieChartPanel) AddTarget(target any) *PieChartPanel {
 	return p
 }
 
+// WithTransformations sets the transformations applied to the query results.
+func (p *PieChartPanel) WithTransformations(transformations ...*Transformation) *PieChartPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *PieChartPanel) AddTransformation(transformation *Transformation) *PieChartPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
 // Pie sets the chart type to pie.
 func (p *PieChartPanel) Pie() *PieChartPanel {
 	p.Options.PieType = PieTypePie
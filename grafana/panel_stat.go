@@ -52,6 +52,18 @@ func (p *StatPanel) WithDescription(desc string) *StatPanel {
 	return p
 }
 
+// WithLinks sets the links shown in the panel header.
+func (p *StatPanel) WithLinks(links ...*DataLink) *StatPanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *StatPanel) WithDataLinks(links ...*DataLink) *StatPanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
 // WithDatasource sets the data source.
 func (p *StatPanel) WithDatasource(ds string) *StatPanel {
 	p.Datasource = ds
@@ -72,6 +84,27 @@ func (p *StatPanel) WithPosition(x, y int) *StatPanel {
 	return p
 }
 
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *StatPanel) RepeatBy(variable string) *StatPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *StatPanel) RepeatVertically() *StatPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *StatPanel) WithMaxPerRow(n int) *StatPanel {
+	p.MaxPerRow = n
+	return p
+}
+
 // WithTargets sets the query targets.
 func (p *StatPanel) WithTargets(targets ...any) *StatPanel {
 	p.Targets = targets
@@ -84,6 +117,18 @@ func (p *StatPanel) AddTarget(target any) *StatPanel {
 	return p
 }
 
+// WithTransformations sets the transformations applied to the query results.
+func (p *StatPanel) WithTransformations(transformations ...*Transformation) *StatPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *StatPanel) AddTransformation(transformation *Transformation) *StatPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
 // ColorByValue colors the text by value (default).
 func (p *StatPanel) ColorByValue() *StatPanel {
 	p.Options.ColorMode = ColorModeValue
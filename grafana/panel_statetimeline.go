@@ -0,0 +1,220 @@
+package grafana
+
+// Show value options for state timeline, status history and bar chart
+// panels.
+const (
+	ShowValueAuto   = "auto"
+	ShowValueAlways = "always"
+	ShowValueNever  = "never"
+)
+
+// StateTimelinePanel represents a Grafana state timeline panel, showing
+// state changes of each series over time.
+type StateTimelinePanel struct {
+	BasePanel
+	Options StateTimelineOptions `json:"options,omitempty"`
+}
+
+// StateTimelineOptions contains state timeline panel options.
+type StateTimelineOptions struct {
+	MergeValues bool           `json:"mergeValues"`
+	ShowValue   string         `json:"showValue,omitempty"`
+	AlignValue  string         `json:"alignValue,omitempty"`
+	RowHeight   float64        `json:"rowHeight,omitempty"`
+	Legend      LegendOptions  `json:"legend,omitempty"`
+	Tooltip     TooltipOptions `json:"tooltip,omitempty"`
+}
+
+// StateTimeline creates a new StateTimelinePanel.
+func StateTimeline(title string) *StateTimelinePanel {
+	return &StateTimelinePanel{
+		BasePanel: BasePanel{
+			Type:  "state-timeline",
+			Title: title,
+			GridPos: GridPos{
+				W: 24,
+				H: 8,
+			},
+		},
+		Options: StateTimelineOptions{
+			MergeValues: true,
+			ShowValue:   ShowValueAuto,
+			AlignValue:  "left",
+			RowHeight:   0.9,
+			Legend: LegendOptions{
+				DisplayMode: "list",
+				Placement:   LegendBottom,
+				ShowLegend:  true,
+			},
+			Tooltip: TooltipOptions{
+				Mode: TooltipSingle,
+			},
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *StateTimelinePanel) WithDescription(desc string) *StateTimelinePanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *StateTimelinePanel) WithLinks(links ...*DataLink) *StateTimelinePanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *StateTimelinePanel) WithDataLinks(links ...*DataLink) *StateTimelinePanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
+// WithDatasource sets the data source.
+func (p *StateTimelinePanel) WithDatasource(ds string) *StateTimelinePanel {
+	p.Datasource = ds
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *StateTimelinePanel) WithSize(w, h int) *StateTimelinePanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *StateTimelinePanel) WithPosition(x, y int) *StateTimelinePanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *StateTimelinePanel) RepeatBy(variable string) *StateTimelinePanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *StateTimelinePanel) RepeatVertically() *StateTimelinePanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *StateTimelinePanel) WithMaxPerRow(n int) *StateTimelinePanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// WithTargets sets the query targets.
+func (p *StateTimelinePanel) WithTargets(targets ...any) *StateTimelinePanel {
+	p.Targets = targets
+	return p
+}
+
+// AddTarget adds a query target.
+func (p *StateTimelinePanel) AddTarget(target any) *StateTimelinePanel {
+	p.Targets = append(p.Targets, target)
+	return p
+}
+
+// WithTransformations sets the transformations applied to the query results.
+func (p *StateTimelinePanel) WithTransformations(transformations ...*Transformation) *StateTimelinePanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *StateTimelinePanel) AddTransformation(transformation *Transformation) *StateTimelinePanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
+// WithUnit sets the display unit.
+func (p *StateTimelinePanel) WithUnit(unit string) *StateTimelinePanel {
+	p.FieldConfig.Defaults.Unit = unit
+	return p
+}
+
+// WithMin sets the minimum value.
+func (p *StateTimelinePanel) WithMin(min float64) *StateTimelinePanel {
+	p.FieldConfig.Defaults.Min = &min
+	return p
+}
+
+// WithMax sets the maximum value.
+func (p *StateTimelinePanel) WithMax(max float64) *StateTimelinePanel {
+	p.FieldConfig.Defaults.Max = &max
+	return p
+}
+
+// WithDecimals sets the number of decimal places.
+func (p *StateTimelinePanel) WithDecimals(decimals int) *StateTimelinePanel {
+	p.FieldConfig.Defaults.Decimals = &decimals
+	return p
+}
+
+// LegendBottom places the legend at the bottom.
+func (p *StateTimelinePanel) LegendBottom() *StateTimelinePanel {
+	p.Options.Legend.Placement = LegendBottom
+	p.Options.Legend.ShowLegend = true
+	return p
+}
+
+// LegendRight places the legend on the right.
+func (p *StateTimelinePanel) LegendRight() *StateTimelinePanel {
+	p.Options.Legend.Placement = LegendRight
+	p.Options.Legend.ShowLegend = true
+	return p
+}
+
+// HideLegend hides the legend.
+func (p *StateTimelinePanel) HideLegend() *StateTimelinePanel {
+	p.Options.Legend.DisplayMode = "hidden"
+	p.Options.Legend.ShowLegend = false
+	return p
+}
+
+// WithTooltip sets the tooltip mode.
+func (p *StateTimelinePanel) WithTooltip(mode string) *StateTimelinePanel {
+	p.Options.Tooltip.Mode = mode
+	return p
+}
+
+// MergeValues merges consecutive equal values into one region.
+func (p *StateTimelinePanel) MergeValues() *StateTimelinePanel {
+	p.Options.MergeValues = true
+	return p
+}
+
+// SplitValues shows every value as its own region.
+func (p *StateTimelinePanel) SplitValues() *StateTimelinePanel {
+	p.Options.MergeValues = false
+	return p
+}
+
+// ShowValues sets when values are shown in the regions: ShowValueAuto,
+// ShowValueAlways or ShowValueNever.
+func (p *StateTimelinePanel) ShowValues(mode string) *StateTimelinePanel {
+	p.Options.ShowValue = mode
+	return p
+}
+
+// WithAlignValue sets the alignment of values in the regions: left, center
+// or right.
+func (p *StateTimelinePanel) WithAlignValue(align string) *StateTimelinePanel {
+	p.Options.AlignValue = align
+	return p
+}
+
+// WithRowHeight sets the height of each row, between 0 and 1.
+func (p *StateTimelinePanel) WithRowHeight(height float64) *StateTimelinePanel {
+	p.Options.RowHeight = height
+	return p
+}
@@ -0,0 +1,34 @@
+package grafana
+
+import "testing"
+
+func TestStateTimeline(t *testing.T) {
+	p := StateTimeline("Pod Phase")
+	if p.Type != "state-timeline" {
+		t.Errorf("Type = %q, want state-timeline", p.Type)
+	}
+	if !p.Options.MergeValues || p.Options.ShowValue != ShowValueAuto || p.Options.RowHeight != 0.9 {
+		t.Errorf("Options = %+v", p.Options)
+	}
+	if p.GridPos.W != 24 {
+		t.Errorf("GridPos.W = %d, want 24", p.GridPos.W)
+	}
+}
+
+func TestStateTimeline_Options(t *testing.T) {
+	p := StateTimeline("Test").
+		SplitValues().
+		ShowValues(ShowValueNever).
+		WithAlignValue("center").
+		WithRowHeight(0.5).
+		HideLegend()
+	if p.Options.MergeValues {
+		t.Error("MergeValues = true, want false")
+	}
+	if p.Options.ShowValue != ShowValueNever || p.Options.AlignValue != "center" || p.Options.RowHeight != 0.5 {
+		t.Errorf("Options = %+v", p.Options)
+	}
+	if p.Options.Legend.ShowLegend {
+		t.Error("Legend.ShowLegend = true, want false")
+	}
+}
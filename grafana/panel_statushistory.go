@@ -0,0 +1,197 @@
+package grafana
+
+// StatusHistoryPanel represents a Grafana status history panel, showing
+// periodic states of each series as a grid of cells.
+type StatusHistoryPanel struct {
+	BasePanel
+	Options StatusHistoryOptions `json:"options,omitempty"`
+}
+
+// StatusHistoryOptions contains status history panel options.
+type StatusHistoryOptions struct {
+	ShowValue   string         `json:"showValue,omitempty"`
+	RowHeight   float64        `json:"rowHeight,omitempty"`
+	ColumnWidth float64        `json:"colWidth,omitempty"`
+	Legend      LegendOptions  `json:"legend,omitempty"`
+	Tooltip     TooltipOptions `json:"tooltip,omitempty"`
+}
+
+// StatusHistory creates a new StatusHistoryPanel.
+func StatusHistory(title string) *StatusHistoryPanel {
+	return &StatusHistoryPanel{
+		BasePanel: BasePanel{
+			Type:  "status-history",
+			Title: title,
+			GridPos: GridPos{
+				W: 24,
+				H: 8,
+			},
+		},
+		Options: StatusHistoryOptions{
+			ShowValue:   ShowValueAuto,
+			RowHeight:   0.9,
+			ColumnWidth: 0.9,
+			Legend: LegendOptions{
+				DisplayMode: "list",
+				Placement:   LegendBottom,
+				ShowLegend:  true,
+			},
+			Tooltip: TooltipOptions{
+				Mode: TooltipSingle,
+			},
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *StatusHistoryPanel) WithDescription(desc string) *StatusHistoryPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *StatusHistoryPanel) WithLinks(links ...*DataLink) *StatusHistoryPanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *StatusHistoryPanel) WithDataLinks(links ...*DataLink) *StatusHistoryPanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
+// WithDatasource sets the data source.
+func (p *StatusHistoryPanel) WithDatasource(ds string) *StatusHistoryPanel {
+	p.Datasource = ds
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *StatusHistoryPanel) WithSize(w, h int) *StatusHistoryPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *StatusHistoryPanel) WithPosition(x, y int) *StatusHistoryPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *StatusHistoryPanel) RepeatBy(variable string) *StatusHistoryPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *StatusHistoryPanel) RepeatVertically() *StatusHistoryPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *StatusHistoryPanel) WithMaxPerRow(n int) *StatusHistoryPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// WithTargets sets the query targets.
+func (p *StatusHistoryPanel) WithTargets(targets ...any) *StatusHistoryPanel {
+	p.Targets = targets
+	return p
+}
+
+// AddTarget adds a query target.
+func (p *StatusHistoryPanel) AddTarget(target any) *StatusHistoryPanel {
+	p.Targets = append(p.Targets, target)
+	return p
+}
+
+// WithTransformations sets the transformations applied to the query results.
+func (p *StatusHistoryPanel) WithTransformations(transformations ...*Transformation) *StatusHistoryPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *StatusHistoryPanel) AddTransformation(transformation *Transformation) *StatusHistoryPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
+// WithUnit sets the display unit.
+func (p *StatusHistoryPanel) WithUnit(unit string) *StatusHistoryPanel {
+	p.FieldConfig.Defaults.Unit = unit
+	return p
+}
+
+// WithMin sets the minimum value.
+func (p *StatusHistoryPanel) WithMin(min float64) *StatusHistoryPanel {
+	p.FieldConfig.Defaults.Min = &min
+	return p
+}
+
+// WithMax sets the maximum value.
+func (p *StatusHistoryPanel) WithMax(max float64) *StatusHistoryPanel {
+	p.FieldConfig.Defaults.Max = &max
+	return p
+}
+
+// WithDecimals sets the number of decimal places.
+func (p *StatusHistoryPanel) WithDecimals(decimals int) *StatusHistoryPanel {
+	p.FieldConfig.Defaults.Decimals = &decimals
+	return p
+}
+
+// LegendBottom places the legend at the bottom.
+func (p *StatusHistoryPanel) LegendBottom() *StatusHistoryPanel {
+	p.Options.Legend.Placement = LegendBottom
+	p.Options.Legend.ShowLegend = true
+	return p
+}
+
+// LegendRight places the legend on the right.
+func (p *StatusHistoryPanel) LegendRight() *StatusHistoryPanel {
+	p.Options.Legend.Placement = LegendRight
+	p.Options.Legend.ShowLegend = true
+	return p
+}
+
+// HideLegend hides the legend.
+func (p *StatusHistoryPanel) HideLegend() *StatusHistoryPanel {
+	p.Options.Legend.DisplayMode = "hidden"
+	p.Options.Legend.ShowLegend = false
+	return p
+}
+
+// WithTooltip sets the tooltip mode.
+func (p *StatusHistoryPanel) WithTooltip(mode string) *StatusHistoryPanel {
+	p.Options.Tooltip.Mode = mode
+	return p
+}
+
+// ShowValues sets when values are shown in the cells: ShowValueAuto,
+// ShowValueAlways or ShowValueNever.
+func (p *StatusHistoryPanel) ShowValues(mode string) *StatusHistoryPanel {
+	p.Options.ShowValue = mode
+	return p
+}
+
+// WithRowHeight sets the height of each row, between 0 and 1.
+func (p *StatusHistoryPanel) WithRowHeight(height float64) *StatusHistoryPanel {
+	p.Options.RowHeight = height
+	return p
+}
+
+// WithColumnWidth sets the width of each cell, between 0 and 1.
+func (p *StatusHistoryPanel) WithColumnWidth(width float64) *StatusHistoryPanel {
+	p.Options.ColumnWidth = width
+	return p
+}
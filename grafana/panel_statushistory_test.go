@@ -0,0 +1,23 @@
+package grafana
+
+import "testing"
+
+func TestStatusHistory(t *testing.T) {
+	p := StatusHistory("Node Health")
+	if p.Type != "status-history" {
+		t.Errorf("Type = %q, want status-history", p.Type)
+	}
+	if p.Options.ColumnWidth != 0.9 || p.Options.RowHeight != 0.9 {
+		t.Errorf("Options = %+v", p.Options)
+	}
+}
+
+func TestStatusHistory_Options(t *testing.T) {
+	p := StatusHistory("Test").ShowValues(ShowValueAlways).WithRowHeight(0.8).WithColumnWidth(0.6).WithUnit(UnitPercent)
+	if p.Options.ShowValue != ShowValueAlways || p.Options.RowHeight != 0.8 || p.Options.ColumnWidth != 0.6 {
+		t.Errorf("Options = %+v", p.Options)
+	}
+	if p.FieldConfig.Defaults.Unit != UnitPercent {
+		t.Errorf("Unit = %q, want percent", p.FieldConfig.Defaults.Unit)
+	}
+}
@@ -53,6 +53,18 @@ func (p *TablePanel) WithDescription(desc string) *TablePanel {
 	return p
 }
 
+// WithLinks sets the links shown in the panel header.
+func (p *TablePanel) WithLinks(links ...*DataLink) *TablePanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *TablePanel) WithDataLinks(links ...*DataLink) *TablePanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
 // WithDatasource sets the data source.
 func (p *TablePanel) WithDatasource(ds string) *TablePanel {
 	p.Datasource = ds
@@ -73,6 +85,27 @@ func (p *TablePanel) WithPosition(x, y int) *TablePanel {
 	return p
 }
 
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *TablePanel) RepeatBy(variable string) *TablePanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *TablePanel) RepeatVertically() *TablePanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *TablePanel) WithMaxPerRow(n int) *TablePanel {
+	p.MaxPerRow = n
+	return p
+}
+
 // WithTargets sets the query targets.
 func (p *TablePanel) WithTargets(targets ...any) *TablePanel {
 	p.Targets = targets
@@ -85,6 +118,18 @@ func (p *TablePanel) AddTarget(target any) *TablePanel {
 	return p
 }
 
+// WithTransformations sets the transformations applied to the query results.
+func (p *TablePanel) WithTransformations(transformations ...*Transformation) *TablePanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *TablePanel) AddTransformation(transformation *Transformation) *TablePanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
 // ShowHeader shows the table header.
 func (p *TablePanel) ShowHeader() *TablePanel {
 	p.Options.ShowHeader = true
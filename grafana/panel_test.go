@@ -225,3 +225,21 @@ func TestStat_FluentAPI(t *testing.T) {
 		t.Errorf("ColorMode = %v", p.Options.ColorMode)
 	}
 }
+
+func TestPanel_RepeatBy(t *testing.T) {
+	p := TimeSeries("CPU").RepeatBy("pod").WithMaxPerRow(3)
+	if p.Repeat != "pod" || p.RepeatDirection != RepeatHorizontal || p.MaxPerRow != 3 {
+		t.Errorf("Repeat = %q, RepeatDirection = %q, MaxPerRow = %d", p.Repeat, p.RepeatDirection, p.MaxPerRow)
+	}
+	if !p.RepeatsHorizontally() {
+		t.Error("RepeatsHorizontally() = false, want true")
+	}
+
+	v := Text("Notes").RepeatBy("pod").RepeatVertically()
+	if v.RepeatDirection != RepeatVertical || v.RepeatsHorizontally() {
+		t.Errorf("RepeatDirection = %q, RepeatsHorizontally() = %v", v.RepeatDirection, v.RepeatsHorizontally())
+	}
+	if Stat("Total").RepeatsHorizontally() {
+		t.Error("RepeatsHorizontally() = true for a panel without repeat")
+	}
+}
@@ -86,6 +86,12 @@ func (p *TextPanel) WithDescription(desc string) *TextPanel {
 	return p
 }
 
+// WithLinks sets the links shown in the panel header.
+func (p *TextPanel) WithLinks(links ...*DataLink) *TextPanel {
+	p.Links = links
+	return p
+}
+
 // WithSize sets the panel size.
 func (p *TextPanel) WithSize(w, h int) *TextPanel {
 	p.GridPos.W = w
@@ -100,6 +106,27 @@ func (p *TextPanel) WithPosition(x, y int) *TextPanel {
 	return p
 }
 
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *TextPanel) RepeatBy(variable string) *TextPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *TextPanel) RepeatVertically() *TextPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *TextPanel) WithMaxPerRow(n int) *TextPanel {
+	p.MaxPerRow = n
+	return p
+}
+
 // WithContent sets the text content.
 func (p *TextPanel) WithContent(content string) *TextPanel {
 	p.Options.Content = content
@@ -56,6 +56,18 @@ func (p *TimeSeriesPanel) WithDescription(desc string) *TimeSeriesPanel {
 	return p
 }
 
+// WithLinks sets the links shown in the panel header.
+func (p *TimeSeriesPanel) WithLinks(links ...*DataLink) *TimeSeriesPanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *TimeSeriesPanel) WithDataLinks(links ...*DataLink) *TimeSeriesPanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
 // WithDatasource sets the data source.
 func (p *TimeSeriesPanel) WithDatasource(ds string) *TimeSeriesPanel {
 	p.Datasource = ds
@@ -76,6 +88,27 @@ func (p *TimeSeriesPanel) WithPosition(x, y int) *TimeSeriesPanel {
 	return p
 }
 
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *TimeSeriesPanel) RepeatBy(variable string) *TimeSeriesPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *TimeSeriesPanel) RepeatVertically() *TimeSeriesPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *TimeSeriesPanel) WithMaxPerRow(n int) *TimeSeriesPanel {
+	p.MaxPerRow = n
+	return p
+}
+
 // WithTargets sets the query targets.
 func (p *TimeSeriesPanel) WithTargets(targets ...any) *TimeSeriesPanel {
 	p.Targets = targets
@@ -88,6 +121,18 @@ func (p *TimeSeriesPanel) AddTarget(target any) *TimeSeriesPanel {
 	return p
 }
 
+// WithTransformations sets the transformations applied to the query results.
+func (p *TimeSeriesPanel) WithTransformations(transformations ...*Transformation) *TimeSeriesPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *TimeSeriesPanel) AddTransformation(transformation *Transformation) *TimeSeriesPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
 // WithLegendPosition sets the legend position.
 func (p *TimeSeriesPanel) WithLegendPosition(placement string) *TimeSeriesPanel {
 	p.Options.Legend.DisplayMode = "list"
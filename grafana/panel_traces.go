@@ -0,0 +1,98 @@
+package grafana
+
+// TracesPanel represents a Grafana traces panel, showing a single trace
+// from a tracing data source such as Tempo or Jaeger.
+type TracesPanel struct {
+	BasePanel
+}
+
+// Traces creates a new TracesPanel.
+func Traces(title string) *TracesPanel {
+	return &TracesPanel{
+		BasePanel: BasePanel{
+			Type:  "traces",
+			Title: title,
+			GridPos: GridPos{
+				W: 24,
+				H: 16,
+			},
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *TracesPanel) WithDescription(desc string) *TracesPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *TracesPanel) WithLinks(links ...*DataLink) *TracesPanel {
+	p.Links = links
+	return p
+}
+
+// WithDatasource sets the data source.
+func (p *TracesPanel) WithDatasource(ds string) *TracesPanel {
+	p.Datasource = ds
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *TracesPanel) WithSize(w, h int) *TracesPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *TracesPanel) WithPosition(x, y int) *TracesPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *TracesPanel) RepeatBy(variable string) *TracesPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *TracesPanel) RepeatVertically() *TracesPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *TracesPanel) WithMaxPerRow(n int) *TracesPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// WithTargets sets the query targets.
+func (p *TracesPanel) WithTargets(targets ...any) *TracesPanel {
+	p.Targets = targets
+	return p
+}
+
+// AddTarget adds a query target.
+func (p *TracesPanel) AddTarget(target any) *TracesPanel {
+	p.Targets = append(p.Targets, target)
+	return p
+}
+
+// WithTransformations sets the transformations applied to the query results.
+func (p *TracesPanel) WithTransformations(transformations ...*Transformation) *TracesPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *TracesPanel) AddTransformation(transformation *Transformation) *TracesPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
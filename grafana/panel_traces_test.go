@@ -0,0 +1,13 @@
+package grafana
+
+import "testing"
+
+func TestTraces(t *testing.T) {
+	p := Traces("Trace").WithDatasource("tempo").AddTarget(map[string]any{"query": "$traceId"})
+	if p.Type != "traces" {
+		t.Errorf("Type = %q, want traces", p.Type)
+	}
+	if p.Datasource != "tempo" || len(p.Targets) != 1 {
+		t.Errorf("Datasource = %q, Targets = %v", p.Datasource, p.Targets)
+	}
+}
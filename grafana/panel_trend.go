@@ -0,0 +1,179 @@
+package grafana
+
+// TrendPanel represents a Grafana trend panel, a time series-like graph
+// whose X axis is a numeric field instead of time.
+type TrendPanel struct {
+	BasePanel
+	Options TrendOptions `json:"options,omitempty"`
+}
+
+// TrendOptions contains trend panel options.
+type TrendOptions struct {
+	XField  string         `json:"xField,omitempty"`
+	Legend  LegendOptions  `json:"legend,omitempty"`
+	Tooltip TooltipOptions `json:"tooltip,omitempty"`
+}
+
+// Trend creates a new TrendPanel.
+func Trend(title string) *TrendPanel {
+	return &TrendPanel{
+		BasePanel: BasePanel{
+			Type:  "trend",
+			Title: title,
+			GridPos: GridPos{
+				W: 12,
+				H: 8,
+			},
+		},
+		Options: TrendOptions{
+			Legend: LegendOptions{
+				DisplayMode: "list",
+				Placement:   LegendBottom,
+				ShowLegend:  true,
+			},
+			Tooltip: TooltipOptions{
+				Mode: TooltipSingle,
+			},
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *TrendPanel) WithDescription(desc string) *TrendPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *TrendPanel) WithLinks(links ...*DataLink) *TrendPanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *TrendPanel) WithDataLinks(links ...*DataLink) *TrendPanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
+// WithDatasource sets the data source.
+func (p *TrendPanel) WithDatasource(ds string) *TrendPanel {
+	p.Datasource = ds
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *TrendPanel) WithSize(w, h int) *TrendPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *TrendPanel) WithPosition(x, y int) *TrendPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *TrendPanel) RepeatBy(variable string) *TrendPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *TrendPanel) RepeatVertically() *TrendPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *TrendPanel) WithMaxPerRow(n int) *TrendPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// WithTargets sets the query targets.
+func (p *TrendPanel) WithTargets(targets ...any) *TrendPanel {
+	p.Targets = targets
+	return p
+}
+
+// AddTarget adds a query target.
+func (p *TrendPanel) AddTarget(target any) *TrendPanel {
+	p.Targets = append(p.Targets, target)
+	return p
+}
+
+// WithTransformations sets the transformations applied to the query results.
+func (p *TrendPanel) WithTransformations(transformations ...*Transformation) *TrendPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *TrendPanel) AddTransformation(transformation *Transformation) *TrendPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
+// WithUnit sets the display unit.
+func (p *TrendPanel) WithUnit(unit string) *TrendPanel {
+	p.FieldConfig.Defaults.Unit = unit
+	return p
+}
+
+// WithMin sets the minimum value.
+func (p *TrendPanel) WithMin(min float64) *TrendPanel {
+	p.FieldConfig.Defaults.Min = &min
+	return p
+}
+
+// WithMax sets the maximum value.
+func (p *TrendPanel) WithMax(max float64) *TrendPanel {
+	p.FieldConfig.Defaults.Max = &max
+	return p
+}
+
+// WithDecimals sets the number of decimal places.
+func (p *TrendPanel) WithDecimals(decimals int) *TrendPanel {
+	p.FieldConfig.Defaults.Decimals = &decimals
+	return p
+}
+
+// LegendBottom places the legend at the bottom.
+func (p *TrendPanel) LegendBottom() *TrendPanel {
+	p.Options.Legend.Placement = LegendBottom
+	p.Options.Legend.ShowLegend = true
+	return p
+}
+
+// LegendRight places the legend on the right.
+func (p *TrendPanel) LegendRight() *TrendPanel {
+	p.Options.Legend.Placement = LegendRight
+	p.Options.Legend.ShowLegend = true
+	return p
+}
+
+// HideLegend hides the legend.
+func (p *TrendPanel) HideLegend() *TrendPanel {
+	p.Options.Legend.DisplayMode = "hidden"
+	p.Options.Legend.ShowLegend = false
+	return p
+}
+
+// WithTooltip sets the tooltip mode.
+func (p *TrendPanel) WithTooltip(mode string) *TrendPanel {
+	p.Options.Tooltip.Mode = mode
+	return p
+}
+
+// WithXField sets the numeric field used for the X axis.
+func (p *TrendPanel) WithXField(field string) *TrendPanel {
+	p.Options.XField = field
+	return p
+}
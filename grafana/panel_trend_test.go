@@ -0,0 +1,16 @@
+package grafana
+
+import "testing"
+
+func TestTrend(t *testing.T) {
+	p := Trend("Latency by Load").WithXField("rps").WithUnit(UnitSeconds)
+	if p.Type != "trend" {
+		t.Errorf("Type = %q, want trend", p.Type)
+	}
+	if p.Options.XField != "rps" {
+		t.Errorf("XField = %q, want rps", p.Options.XField)
+	}
+	if p.FieldConfig.Defaults.Unit != UnitSeconds {
+		t.Errorf("Unit = %q, want s", p.FieldConfig.Defaults.Unit)
+	}
+}
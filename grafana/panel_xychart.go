@@ -0,0 +1,201 @@
+package grafana
+
+// Series mapping modes for XY chart panels.
+const (
+	SeriesMappingAuto   = "auto"
+	SeriesMappingManual = "manual"
+)
+
+// XYChartPanel represents a Grafana XY chart panel, plotting one field
+// against another.
+type XYChartPanel struct {
+	BasePanel
+	Options XYChartOptions `json:"options,omitempty"`
+}
+
+// XYChartOptions contains XY chart panel options.
+type XYChartOptions struct {
+	SeriesMapping string          `json:"seriesMapping,omitempty"`
+	Series        []XYChartSeries `json:"series,omitempty"`
+	Legend        LegendOptions   `json:"legend,omitempty"`
+	Tooltip       TooltipOptions  `json:"tooltip,omitempty"`
+}
+
+// XYChartSeries is a manually mapped XY chart series.
+type XYChartSeries struct {
+	// Name is the series name.
+	Name string `json:"name,omitempty"`
+
+	// X is the field plotted on the X axis.
+	X string `json:"x"`
+
+	// Y is the field plotted on the Y axis.
+	Y string `json:"y"`
+}
+
+// XYChart creates a new XYChartPanel.
+func XYChart(title string) *XYChartPanel {
+	return &XYChartPanel{
+		BasePanel: BasePanel{
+			Type:  "xychart",
+			Title: title,
+			GridPos: GridPos{
+				W: 12,
+				H: 8,
+			},
+		},
+		Options: XYChartOptions{
+			SeriesMapping: SeriesMappingAuto,
+			Legend: LegendOptions{
+				DisplayMode: "list",
+				Placement:   LegendBottom,
+				ShowLegend:  true,
+			},
+			Tooltip: TooltipOptions{
+				Mode: TooltipSingle,
+			},
+		},
+	}
+}
+
+// WithDescription sets the panel description.
+func (p *XYChartPanel) WithDescription(desc string) *XYChartPanel {
+	p.Description = desc
+	return p
+}
+
+// WithLinks sets the links shown in the panel header.
+func (p *XYChartPanel) WithLinks(links ...*DataLink) *XYChartPanel {
+	p.Links = links
+	return p
+}
+
+// WithDataLinks sets the data links shown when clicking a value.
+func (p *XYChartPanel) WithDataLinks(links ...*DataLink) *XYChartPanel {
+	p.FieldConfig.Defaults.Links = links
+	return p
+}
+
+// WithDatasource sets the data source.
+func (p *XYChartPanel) WithDatasource(ds string) *XYChartPanel {
+	p.Datasource = ds
+	return p
+}
+
+// WithSize sets the panel size.
+func (p *XYChartPanel) WithSize(w, h int) *XYChartPanel {
+	p.GridPos.W = w
+	p.GridPos.H = h
+	return p
+}
+
+// WithPosition sets the panel position.
+func (p *XYChartPanel) WithPosition(x, y int) *XYChartPanel {
+	p.GridPos.X = x
+	p.GridPos.Y = y
+	return p
+}
+
+// RepeatBy repeats the panel side by side for each selected value of a
+// multi-value variable.
+func (p *XYChartPanel) RepeatBy(variable string) *XYChartPanel {
+	p.Repeat = variable
+	p.RepeatDirection = RepeatHorizontal
+	return p
+}
+
+// RepeatVertically stacks the repeated panels instead of placing them side
+// by side.
+func (p *XYChartPanel) RepeatVertically() *XYChartPanel {
+	p.RepeatDirection = RepeatVertical
+	return p
+}
+
+// WithMaxPerRow sets the maximum number of repeated panels per line.
+func (p *XYChartPanel) WithMaxPerRow(n int) *XYChartPanel {
+	p.MaxPerRow = n
+	return p
+}
+
+// WithTargets sets the query targets.
+func (p *XYChartPanel) WithTargets(targets ...any) *XYChartPanel {
+	p.Targets = targets
+	return p
+}
+
+// AddTarget adds a query target.
+func (p *XYChartPanel) AddTarget(target any) *XYChartPanel {
+	p.Targets = append(p.Targets, target)
+	return p
+}
+
+// WithTransformations sets the transformations applied to the query results.
+func (p *XYChartPanel) WithTransformations(transformations ...*Transformation) *XYChartPanel {
+	p.Transformations = transformations
+	return p
+}
+
+// AddTransformation adds a transformation.
+func (p *XYChartPanel) AddTransformation(transformation *Transformation) *XYChartPanel {
+	p.Transformations = append(p.Transformations, transformation)
+	return p
+}
+
+// WithUnit sets the display unit.
+func (p *XYChartPanel) WithUnit(unit string) *XYChartPanel {
+	p.FieldConfig.Defaults.Unit = unit
+	return p
+}
+
+// WithMin sets the minimum value.
+func (p *XYChartPanel) WithMin(min float64) *XYChartPanel {
+	p.FieldConfig.Defaults.Min = &min
+	return p
+}
+
+// WithMax sets the maximum value.
+func (p *XYChartPanel) WithMax(max float64) *XYChartPanel {
+	p.FieldConfig.Defaults.Max = &max
+	return p
+}
+
+// WithDecimals sets the number of decimal places.
+func (p *XYChartPanel) WithDecimals(decimals int) *XYChartPanel {
+	p.FieldConfig.Defaults.Decimals = &decimals
+	return p
+}
+
+// LegendBottom places the legend at the bottom.
+func (p *XYChartPanel) LegendBottom() *XYChartPanel {
+	p.Options.Legend.Placement = LegendBottom
+	p.Options.Legend.ShowLegend = true
+	return p
+}
+
+// LegendRight places the legend on the right.
+func (p *XYChartPanel) LegendRight() *XYChartPanel {
+	p.Options.Legend.Placement = LegendRight
+	p.Options.Legend.ShowLegend = true
+	return p
+}
+
+// HideLegend hides the legend.
+func (p *XYChartPanel) HideLegend() *XYChartPanel {
+	p.Options.Legend.DisplayMode = "hidden"
+	p.Options.Legend.ShowLegend = false
+	return p
+}
+
+// WithTooltip sets the tooltip mode.
+func (p *XYChartPanel) WithTooltip(mode string) *XYChartPanel {
+	p.Options.Tooltip.Mode = mode
+	return p
+}
+
+// AddSeries adds a series plotting field y against field x, switching the
+// panel to manual series mapping.
+func (p *XYChartPanel) AddSeries(name, x, y string) *XYChartPanel {
+	p.Options.SeriesMapping = SeriesMappingManual
+	p.Options.Series = append(p.Options.Series, XYChartSeries{Name: name, X: x, Y: y})
+	return p
+}
@@ -0,0 +1,24 @@
+package grafana
+
+import "testing"
+
+func TestXYChart(t *testing.T) {
+	p := XYChart("CPU vs Memory")
+	if p.Type != "xychart" {
+		t.Errorf("Type = %q, want xychart", p.Type)
+	}
+	if p.Options.SeriesMapping != SeriesMappingAuto {
+		t.Errorf("SeriesMapping = %q, want auto", p.Options.SeriesMapping)
+	}
+}
+
+func TestXYChart_AddSeries(t *testing.T) {
+	p := XYChart("Test").AddSeries("Pods", "cpu", "memory")
+	if p.Options.SeriesMapping != SeriesMappingManual {
+		t.Errorf("SeriesMapping = %q, want manual", p.Options.SeriesMapping)
+	}
+	want := XYChartSeries{Name: "Pods", X: "cpu", Y: "memory"}
+	if len(p.Options.Series) != 1 || p.Options.Series[0] != want {
+		t.Errorf("Series = %+v, want [%+v]", p.Options.Series, want)
+	}
+}
@@ -14,6 +14,17 @@ type Row struct {
 
 	// Height is the row height in pixels (optional).
 	Height int `json:"height,omitempty"`
+
+	// Repeat is the name of the variable the row is repeated for, with its
+	// panels, once per selected value.
+	Repeat string `json:"repeat,omitempty"`
+
+	// RepeatDirection is the direction of repeated rows. Grafana stacks
+	// repeated rows, so it is only kept for imported dashboards.
+	RepeatDirection string `json:"repeatDirection,omitempty"`
+
+	// MaxPerRow is kept for imported dashboards; it has no effect on rows.
+	MaxPerRow int `json:"maxPerRow,omitempty"`
 }
 
 // NewRow creates a new Row with the given title.
@@ -50,3 +61,10 @@ func (r *Row) WithHeight(height int) *Row {
 	r.Height = height
 	return r
 }
+
+// RepeatBy repeats the row and its panels for each selected value of a
+// multi-value variable. Grafana adds the repeated rows below this one.
+func (r *Row) RepeatBy(variable string) *Row {
+	r.Repeat = variable
+	return r
+}
@@ -71,3 +71,10 @@ func TestRow_FluentAPI(t *testing.T) {
 		t.Errorf("len(Panels) = %d", len(row.Panels))
 	}
 }
+
+func TestRow_RepeatBy(t *testing.T) {
+	row := NewRow("$namespace").RepeatBy("namespace")
+	if row.Repeat != "namespace" {
+		t.Errorf("Repeat = %q, want namespace", row.Repeat)
+	}
+}
@@ -19,6 +19,7 @@ type dashboardOutput struct {
 	Panels        []any             `json:"panels"`
 	Templating    *templatingOutput `json:"templating,omitempty"`
 	Annotations   *annotationsOutput `json:"annotations,omitempty"`
+	Links         []*DashboardLink  `json:"links,omitempty"`
 	SchemaVersion int               `json:"schemaVersion"`
 	Version       int               `json:"version,omitempty"`
 }
@@ -28,7 +29,7 @@ type templatingOutput struct {
 }
 
 type annotationsOutput struct {
-	List []any `json:"list"`
+	List []*Annotation `json:"list"`
 }
 
 // Serialize converts the Dashboard to JSON bytes.
@@ -50,6 +51,7 @@ func (d *Dashboard) Serialize() ([]byte, error) {
 		Timezone:      d.Timezone,
 		Editable:      d.IsEditable,
 		Panels:        flattenPanels(d),
+		Links:         d.Links,
 		SchemaVersion: d.SchemaVersion,
 		Version:       d.Version,
 	}
@@ -121,6 +123,18 @@ func flattenPanels(d *Dashboard) []any {
 			},
 		}
 
+		// Repeated rows are laid out once; Grafana clones the row and its
+		// panels below it for each value when the dashboard loads.
+		if row.Repeat != "" {
+			rowPanel["repeat"] = row.Repeat
+			if row.RepeatDirection != "" {
+				rowPanel["repeatDirection"] = row.RepeatDirection
+			}
+			if row.MaxPerRow > 0 {
+				rowPanel["maxPerRow"] = row.MaxPerRow
+			}
+		}
+
 		if row.IsCollapsed {
 			// For collapsed rows, panels go inside the row
 			rowPanels := make([]any, 0, len(row.Panels))
@@ -179,7 +193,8 @@ func getRowContentHeight(row *Row) int {
 			h = 8
 		}
 
-		if x+w > 24 {
+		repeated := repeatsHorizontally(p)
+		if x+w > 24 || (repeated && x > 0) {
 			rowHeight += maxHeight
 			maxHeight = h
 			x = w
@@ -189,11 +204,22 @@ func getRowContentHeight(row *Row) int {
 			}
 			x += w
 		}
+		if repeated {
+			x = 24
+		}
 	}
 
 	return rowHeight + maxHeight
 }
 
+// repeatsHorizontally reports whether p is a panel repeated side by side.
+// Such panels fill a line of their own, since Grafana places the repeated
+// panels to the right of the original.
+func repeatsHorizontally(p any) bool {
+	r, ok := p.(interface{ RepeatsHorizontally() bool })
+	return ok && r.RepeatsHorizontally()
+}
+
 // getPanelGridPos returns the GridPos for any panel type.
 func getPanelGridPos(p any) *GridPos {
 	switch panel := p.(type) {
@@ -215,6 +241,34 @@ func getPanelGridPos(p any) *GridPos {
 		return &panel.GridPos
 	case *HeatmapPanel:
 		return &panel.GridPos
+	case *StateTimelinePanel:
+		return &panel.GridPos
+	case *StatusHistoryPanel:
+		return &panel.GridPos
+	case *BarChartPanel:
+		return &panel.GridPos
+	case *HistogramPanel:
+		return &panel.GridPos
+	case *XYChartPanel:
+		return &panel.GridPos
+	case *TrendPanel:
+		return &panel.GridPos
+	case *NodeGraphPanel:
+		return &panel.GridPos
+	case *TracesPanel:
+		return &panel.GridPos
+	case *FlameGraphPanel:
+		return &panel.GridPos
+	case *GeomapPanel:
+		return &panel.GridPos
+	case *CanvasPanel:
+		return &panel.GridPos
+	case *AlertListPanel:
+		return &panel.GridPos
+	case *DashboardListPanel:
+		return &panel.GridPos
+	case *NewsPanel:
+		return &panel.GridPos
 	default:
 		return nil
 	}
@@ -260,6 +314,34 @@ func setPanelID(p any, id int) {
 		panel.ID = id
 	case *HeatmapPanel:
 		panel.ID = id
+	case *StateTimelinePanel:
+		panel.ID = id
+	case *StatusHistoryPanel:
+		panel.ID = id
+	case *BarChartPanel:
+		panel.ID = id
+	case *HistogramPanel:
+		panel.ID = id
+	case *XYChartPanel:
+		panel.ID = id
+	case *TrendPanel:
+		panel.ID = id
+	case *NodeGraphPanel:
+		panel.ID = id
+	case *TracesPanel:
+		panel.ID = id
+	case *FlameGraphPanel:
+		panel.ID = id
+	case *GeomapPanel:
+		panel.ID = id
+	case *CanvasPanel:
+		panel.ID = id
+	case *AlertListPanel:
+		panel.ID = id
+	case *DashboardListPanel:
+		panel.ID = id
+	case *NewsPanel:
+		panel.ID = id
 	}
 }
 
@@ -314,8 +396,10 @@ func calculateGridPositions(d *Dashboard) {
 				height = defaultPanelHeight
 			}
 
-			// Wrap to next line if needed
-			if currentX+width > gridColumns {
+			// Wrap to next line if needed; horizontally repeated panels
+			// start a line of their own
+			repeated := repeatsHorizontally(panelAny)
+			if currentX+width > gridColumns || (repeated && currentX > 0) {
 				currentX = 0
 				currentY += rowMaxHeight
 				rowMaxHeight = 0
@@ -325,6 +409,9 @@ func calculateGridPositions(d *Dashboard) {
 			setGridPos(panelAny, currentX, currentY, width, height)
 
 			currentX += width
+			if repeated {
+				currentX = gridColumns
+			}
 			if height > rowMaxHeight {
 				rowMaxHeight = height
 			}
@@ -357,5 +444,33 @@ func setGridPos(p any, x, y, w, h int) {
 		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
 	case *HeatmapPanel:
 		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *StateTimelinePanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *StatusHistoryPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *BarChartPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *HistogramPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *XYChartPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *TrendPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *NodeGraphPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *TracesPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *FlameGraphPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *GeomapPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *CanvasPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *AlertListPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *DashboardListPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
+	case *NewsPanel:
+		panel.GridPos = GridPos{X: x, Y: y, W: w, H: h}
 	}
 }
@@ -243,3 +243,116 @@ func TestAssignPanelIDs_Deterministic(t *testing.T) {
 		t.Errorf("Panel IDs should be deterministic: %d != %d", p1a.ID, p2a.ID)
 	}
 }
+
+func TestDashboard_Serialize_WithAnnotations(t *testing.T) {
+	d := NewDashboard("test", "Test").
+		WithAnnotations(
+			BuiltInAnnotation(),
+			DeploymentAnnotation("Deploys", "kube_deployment_status_observed_generation").WithDatasource("prom"),
+		)
+
+	data, err := d.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+
+	var result struct {
+		Annotations struct {
+			List []map[string]any `json:"list"`
+		} `json:"annotations"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+
+	list := result.Annotations.List
+	if len(list) != 2 {
+		t.Fatalf("Expected 2 annotations, got %d", len(list))
+	}
+	if list[0]["builtIn"] != float64(1) {
+		t.Errorf("builtIn = %v, want 1", list[0]["builtIn"])
+	}
+	if list[1]["expr"] != "changes(kube_deployment_status_observed_generation[1m]) > 0" {
+		t.Errorf("expr = %v", list[1]["expr"])
+	}
+	if list[1]["step"] != "1m" {
+		t.Errorf("step = %v, want 1m", list[1]["step"])
+	}
+}
+
+func TestDashboard_Serialize_WithLinks(t *testing.T) {
+	d := NewDashboard("test", "Test").
+		WithLinks(DashboardsByTag("Services", "service").AsDropdown()).
+		AddLink(URLLink("Runbook", "https://runbooks.example.com"))
+
+	data, err := d.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+
+	var result struct {
+		Links []map[string]any `json:"links"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+
+	if len(result.Links) != 2 {
+		t.Fatalf("Expected 2 links, got %d", len(result.Links))
+	}
+	if result.Links[0]["asDropdown"] != true {
+		t.Errorf("asDropdown = %v, want true", result.Links[0]["asDropdown"])
+	}
+	if result.Links[1]["url"] != "https://runbooks.example.com" {
+		t.Errorf("url = %v", result.Links[1]["url"])
+	}
+}
+
+func TestDashboard_Serialize_WithRepeats(t *testing.T) {
+	d := NewDashboard("test", "Test").
+		WithRows(
+			NewRow("$namespace").RepeatBy("namespace").WithPanels(
+				Stat("Pods").WithSize(12, 4),
+				Stat("Per Pod").WithSize(6, 4).RepeatBy("pod").WithMaxPerRow(4),
+			),
+			NewRow("Summary").WithPanels(Stat("Total").WithSize(24, 4)),
+		)
+
+	data, err := d.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+
+	var result struct {
+		Panels []struct {
+			Type            string  `json:"type"`
+			Repeat          string  `json:"repeat"`
+			RepeatDirection string  `json:"repeatDirection"`
+			MaxPerRow       int     `json:"maxPerRow"`
+			GridPos         GridPos `json:"gridPos"`
+		} `json:"panels"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+	if len(result.Panels) != 5 {
+		t.Fatalf("Expected 5 panels, got %d", len(result.Panels))
+	}
+
+	if row := result.Panels[0]; row.Type != "row" || row.Repeat != "namespace" {
+		t.Errorf("row = %+v, want a row repeated by namespace", row)
+	}
+	perPod := result.Panels[2]
+	if perPod.Repeat != "pod" || perPod.RepeatDirection != RepeatHorizontal || perPod.MaxPerRow != 4 {
+		t.Errorf("repeated panel = %+v", perPod)
+	}
+	if perPod.GridPos.X != 0 || perPod.GridPos.Y != 5 {
+		t.Errorf("repeated panel GridPos = %+v, want its own line at y=5", perPod.GridPos)
+	}
+	if summary := result.Panels[3]; summary.GridPos.Y != 9 {
+		t.Errorf("next row Y = %d, want 9", summary.GridPos.Y)
+	}
+	if total := result.Panels[4]; total.GridPos.Y != 10 {
+		t.Errorf("next row panel Y = %d, want 10", total.GridPos.Y)
+	}
+}
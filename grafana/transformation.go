@@ -0,0 +1,440 @@
+package grafana
+
+// Join modes for JoinByField.
+const (
+	JoinModeOuter        = "outer"
+	JoinModeInner        = "inner"
+	JoinModeOuterTabular = "outerTabular"
+)
+
+// Filter types and match modes for FilterByValue.
+const (
+	FilterInclude = "include"
+	FilterExclude = "exclude"
+	MatchAll      = "all"
+	MatchAny      = "any"
+)
+
+// Calculation modes for CalculateField.
+const (
+	CalculateBinary    = "binary"
+	CalculateReduceRow = "reduceRow"
+	CalculateUnary     = "unary"
+	CalculateIndex     = "index"
+)
+
+// Label modes for LabelsToFields.
+const (
+	LabelsAsColumns = "columns"
+	LabelsAsRows    = "rows"
+)
+
+// Transformation represents a panel transformation, applied in order to
+// the query results before they are displayed.
+//
+// Example usage:
+//
+//	var PodsTable = grafana.Table("Pods").WithTransformations(
+//	    grafana.JoinByField("pod", grafana.JoinModeOuter),
+//	    grafana.Organize(grafana.OrganizeOptions{
+//	        ExcludeByName: map[string]bool{"Time": true},
+//	        RenameByName:  map[string]string{"Value #A": "CPU"},
+//	    }),
+//	)
+type Transformation struct {
+	// ID is the transformation type (e.g., "organize", "joinByField").
+	ID string `json:"id"`
+
+	// Disabled skips the transformation.
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Options are the transformation options, one of the *Options types of
+	// this package or a map for other transformations.
+	Options any `json:"options"`
+}
+
+// NewTransformation creates a transformation with the given type and
+// options, for transformations without a dedicated builder.
+func NewTransformation(id string, options any) *Transformation {
+	if options == nil {
+		options = map[string]any{}
+	}
+	return &Transformation{ID: id, Options: options}
+}
+
+// Disable skips the transformation.
+func (t *Transformation) Disable() *Transformation {
+	t.Disabled = true
+	return t
+}
+
+// Merge merges the results of all queries into a single table.
+func Merge() *Transformation {
+	return NewTransformation("merge", nil)
+}
+
+// SeriesToRows combines time series into a single table with a Metric
+// column.
+func SeriesToRows() *Transformation {
+	return NewTransformation("seriesToRows", nil)
+}
+
+// JoinByFieldOptions are the options of the joinByField transformation.
+type JoinByFieldOptions struct {
+	// ByField is the field to join on; defaults to the time field.
+	ByField string `json:"byField,omitempty"`
+
+	// Mode is the join mode: outer, inner or outerTabular.
+	Mode string `json:"mode,omitempty"`
+}
+
+// JoinByField joins the results of all queries on a field.
+func JoinByField(field, mode string) *Transformation {
+	return NewTransformation("joinByField", JoinByFieldOptions{ByField: field, Mode: mode})
+}
+
+// OrganizeOptions are the options of the organize transformation.
+type OrganizeOptions struct {
+	// ExcludeByName hides fields.
+	ExcludeByName map[string]bool `json:"excludeByName,omitempty"`
+
+	// IndexByName orders fields.
+	IndexByName map[string]int `json:"indexByName,omitempty"`
+
+	// RenameByName renames fields.
+	RenameByName map[string]string `json:"renameByName,omitempty"`
+}
+
+// Organize hides, orders and renames fields.
+func Organize(opts OrganizeOptions) *Transformation {
+	return NewTransformation("organize", opts)
+}
+
+// BinaryOperation is a binary calculation of a calculated field.
+type BinaryOperation struct {
+	// Left is the left field name or number.
+	Left string `json:"left"`
+
+	// Operator is the operator: +, -, *, / or %.
+	Operator string `json:"operator"`
+
+	// Right is the right field name or number.
+	Right string `json:"right"`
+}
+
+// ReduceRowOperation is a reduction of the fields of each row.
+type ReduceRowOperation struct {
+	// Reducer is the calculation (e.g., ReduceSum, ReduceMean).
+	Reducer string `json:"reducer"`
+
+	// Include restricts the reduced fields; defaults to all numeric fields.
+	Include []string `json:"include,omitempty"`
+}
+
+// CalculateFieldOptions are the options of the calculateField
+// transformation.
+type CalculateFieldOptions struct {
+	// Mode is the calculation mode: binary, reduceRow, unary or index.
+	Mode string `json:"mode"`
+
+	// Alias is the name of the calculated field.
+	Alias string `json:"alias,omitempty"`
+
+	// Binary is the calculation, for binary mode.
+	Binary *BinaryOperation `json:"binary,omitempty"`
+
+	// Reduce is the calculation, for reduceRow mode.
+	Reduce *ReduceRowOperation `json:"reduce,omitempty"`
+
+	// ReplaceFields keeps only the calculated field.
+	ReplaceFields bool `json:"replaceFields,omitempty"`
+}
+
+// CalculateField adds a field calculated from other fields.
+func CalculateField(opts CalculateFieldOptions) *Transformation {
+	return NewTransformation("calculateField", opts)
+}
+
+// CalculateBinaryField adds a field calculated from two fields or numbers,
+// e.g. CalculateBinaryField("Error %", "errors", "/", "requests").
+func CalculateBinaryField(alias, left, operator, right string) *Transformation {
+	return CalculateField(CalculateFieldOptions{
+		Mode:   CalculateBinary,
+		Alias:  alias,
+		Binary: &BinaryOperation{Left: left, Operator: operator, Right: right},
+	})
+}
+
+// CalculateRowField adds a field reducing the fields of each row.
+func CalculateRowField(alias, reducer string, include ...string) *Transformation {
+	return CalculateField(CalculateFieldOptions{
+		Mode:   CalculateReduceRow,
+		Alias:  alias,
+		Reduce: &ReduceRowOperation{Reducer: reducer, Include: include},
+	})
+}
+
+// ValueMatcher is a condition on a field value.
+type ValueMatcher struct {
+	// ID is the condition: greater, greaterOrEqual, lower, lowerOrEqual,
+	// equal, notEqual, isNull, isNotNull, regex or range.
+	ID string `json:"id"`
+
+	// Options are the condition options, e.g. {"value": 0}.
+	Options map[string]any `json:"options"`
+}
+
+// ValueFilter is a condition on the values of a field.
+type ValueFilter struct {
+	// FieldName is the field the condition applies to.
+	FieldName string `json:"fieldName"`
+
+	// Config is the condition.
+	Config ValueMatcher `json:"config"`
+}
+
+// ValueGreater matches values greater than value.
+func ValueGreater(field string, value float64) ValueFilter {
+	return valueFilter(field, "greater", map[string]any{"value": value})
+}
+
+// ValueLower matches values lower than value.
+func ValueLower(field string, value float64) ValueFilter {
+	return valueFilter(field, "lower", map[string]any{"value": value})
+}
+
+// ValueEqual matches values equal to value.
+func ValueEqual(field string, value any) ValueFilter {
+	return valueFilter(field, "equal", map[string]any{"value": value})
+}
+
+// ValueNotEqual matches values not equal to value.
+func ValueNotEqual(field string, value any) ValueFilter {
+	return valueFilter(field, "notEqual", map[string]any{"value": value})
+}
+
+// ValueIsNull matches null values.
+func ValueIsNull(field string) ValueFilter {
+	return valueFilter(field, "isNull", map[string]any{})
+}
+
+// ValueIsNotNull matches values that are not null.
+func ValueIsNotNull(field string) ValueFilter {
+	return valueFilter(field, "isNotNull", map[string]any{})
+}
+
+// ValueRegex matches values matching a regular expression.
+func ValueRegex(field, pattern string) ValueFilter {
+	return valueFilter(field, "regex", map[string]any{"value": pattern})
+}
+
+// ValueRange matches values between from and to.
+func ValueRange(field string, from, to float64) ValueFilter {
+	return valueFilter(field, "range", map[string]any{"from": from, "to": to})
+}
+
+func valueFilter(field, id string, options map[string]any) ValueFilter {
+	return ValueFilter{FieldName: field, Config: ValueMatcher{ID: id, Options: options}}
+}
+
+// FilterByValueOptions are the options of the filterByValue
+// transformation.
+type FilterByValueOptions struct {
+	// Filters are the conditions.
+	Filters []ValueFilter `json:"filters"`
+
+	// Type is include or exclude.
+	Type string `json:"type"`
+
+	// Match is all or any.
+	Match string `json:"match"`
+}
+
+// FilterByValue keeps (FilterInclude) or drops (FilterExclude) the rows
+// matching all (MatchAll) or any (MatchAny) of the filters.
+func FilterByValue(filterType, match string, filters ...ValueFilter) *Transformation {
+	return NewTransformation("filterByValue", FilterByValueOptions{
+		Filters: filters,
+		Type:    filterType,
+		Match:   match,
+	})
+}
+
+// FieldNameMatcher selects fields by name or pattern.
+type FieldNameMatcher struct {
+	// Names are the field names.
+	Names []string `json:"names,omitempty"`
+
+	// Pattern is a regular expression matching field names.
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// FilterFieldsByNameOptions are the options of the filterFieldsByName
+// transformation.
+type FilterFieldsByNameOptions struct {
+	// Include selects the fields to keep.
+	Include *FieldNameMatcher `json:"include,omitempty"`
+
+	// Exclude selects the fields to drop.
+	Exclude *FieldNameMatcher `json:"exclude,omitempty"`
+}
+
+// FilterFieldsByName keeps only the named fields.
+func FilterFieldsByName(names ...string) *Transformation {
+	return NewTransformation("filterFieldsByName", FilterFieldsByNameOptions{
+		Include: &FieldNameMatcher{Names: names},
+	})
+}
+
+// FilterFieldsByRegex keeps only the fields matching a regular expression.
+func FilterFieldsByRegex(pattern string) *Transformation {
+	return NewTransformation("filterFieldsByName", FilterFieldsByNameOptions{
+		Include: &FieldNameMatcher{Pattern: pattern},
+	})
+}
+
+// GroupByField is the operation applied to a field by groupBy.
+type GroupByField struct {
+	// Operation is groupby to group on the field or aggregate to calculate
+	// it.
+	Operation string `json:"operation"`
+
+	// Aggregations are the calculations of aggregated fields.
+	Aggregations []string `json:"aggregations"`
+}
+
+// GroupByOptions are the options of the groupBy transformation.
+type GroupByOptions struct {
+	// Fields are the operations by field name.
+	Fields map[string]GroupByField `json:"fields"`
+}
+
+// GroupBy groups rows by the values of the by fields and calculates the
+// aggregated fields, e.g.
+//
+//	grafana.GroupBy([]string{"namespace"}, map[string][]string{"Value": {grafana.ReduceSum}})
+func GroupBy(by []string, aggregations map[string][]string) *Transformation {
+	fields := make(map[string]GroupByField)
+	for _, name := range by {
+		fields[name] = GroupByField{Operation: "groupby", Aggregations: []string{}}
+	}
+	for name, calcs := range aggregations {
+		fields[name] = GroupByField{Operation: "aggregate", Aggregations: calcs}
+	}
+	return NewTransformation("groupBy", GroupByOptions{Fields: fields})
+}
+
+// LabelsToFieldsOptions are the options of the labelsToFields
+// transformation.
+type LabelsToFieldsOptions struct {
+	// Mode is columns, one field per label, or rows, one row per label.
+	Mode string `json:"mode,omitempty"`
+
+	// KeepLabels restricts the labels converted to fields.
+	KeepLabels []string `json:"keepLabels,omitempty"`
+
+	// ValueLabel names the value fields after this label.
+	ValueLabel string `json:"valueLabel,omitempty"`
+}
+
+// LabelsToFields converts series labels to fields. Without labels, all
+// labels are converted.
+func LabelsToFields(labels ...string) *Transformation {
+	return NewTransformation("labelsToFields", LabelsToFieldsOptions{
+		Mode:       LabelsAsColumns,
+		KeepLabels: labels,
+	})
+}
+
+// ReduceTransformationOptions are the options of the reduce
+// transformation.
+type ReduceTransformationOptions struct {
+	// Reducers are the calculations (e.g., ReduceLast, ReduceMax).
+	Reducers []string `json:"reducers"`
+
+	// Mode is seriesToRows or reduceFields.
+	Mode string `json:"mode,omitempty"`
+
+	// LabelsToFields adds series labels as fields.
+	LabelsToFields bool `json:"labelsToFields,omitempty"`
+}
+
+// Reduce reduces each series to one row with the given calculations.
+func Reduce(reducers ...string) *Transformation {
+	return NewTransformation("reduce", ReduceTransformationOptions{
+		Reducers: reducers,
+		Mode:     "seriesToRows",
+	})
+}
+
+// SortField is a sort key.
+type SortField struct {
+	// Field is the field name.
+	Field string `json:"field"`
+
+	// Desc sorts in descending order.
+	Desc bool `json:"desc,omitempty"`
+}
+
+// SortByOptions are the options of the sortBy transformation.
+type SortByOptions struct {
+	// Sort is the sort key.
+	Sort []SortField `json:"sort"`
+}
+
+// SortBy sorts rows by a field.
+func SortBy(field string, desc bool) *Transformation {
+	return NewTransformation("sortBy", SortByOptions{Sort: []SortField{{Field: field, Desc: desc}}})
+}
+
+// LimitOptions are the options of the limit transformation.
+type LimitOptions struct {
+	// LimitField is the maximum number of rows.
+	LimitField int `json:"limitField"`
+}
+
+// Limit keeps the first n rows.
+func Limit(n int) *Transformation {
+	return NewTransformation("limit", LimitOptions{LimitField: n})
+}
+
+// RenameByRegexOptions are the options of the renameByRegex
+// transformation.
+type RenameByRegexOptions struct {
+	// Regex matches the field names.
+	Regex string `json:"regex"`
+
+	// RenamePattern is the new name, with $1-style group references.
+	RenamePattern string `json:"renamePattern"`
+}
+
+// RenameByRegex renames the fields matching regex.
+func RenameByRegex(regex, renamePattern string) *Transformation {
+	return NewTransformation("renameByRegex", RenameByRegexOptions{Regex: regex, RenamePattern: renamePattern})
+}
+
+// FieldConversion converts a field to another type.
+type FieldConversion struct {
+	// TargetField is the field name.
+	TargetField string `json:"targetField"`
+
+	// DestinationType is number, string, time, boolean or other.
+	DestinationType string `json:"destinationType"`
+
+	// DateFormat is the format of string fields converted to time.
+	DateFormat string `json:"dateFormat,omitempty"`
+}
+
+// ConvertFieldTypeOptions are the options of the convertFieldType
+// transformation.
+type ConvertFieldTypeOptions struct {
+	// Conversions are the conversions.
+	Conversions []FieldConversion `json:"conversions"`
+}
+
+// ConvertFieldType converts a field to another type.
+func ConvertFieldType(field, destinationType string) *Transformation {
+	return NewTransformation("convertFieldType", ConvertFieldTypeOptions{
+		Conversions: []FieldConversion{{TargetField: field, DestinationType: destinationType}},
+	})
+}
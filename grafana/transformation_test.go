@@ -0,0 +1,74 @@
+package grafana
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTransformation_JSON(t *testing.T) {
+	tests := []struct {
+		name           string
+		transformation *Transformation
+		want           string
+	}{
+		{"merge", Merge(), `{"id":"merge","options":{}}`},
+		{"seriesToRows", SeriesToRows(), `{"id":"seriesToRows","options":{}}`},
+		{"joinByField", JoinByField("pod", JoinModeOuter), `{"id":"joinByField","options":{"byField":"pod","mode":"outer"}}`},
+		{
+			"organize",
+			Organize(OrganizeOptions{
+				ExcludeByName: map[string]bool{"Time": true},
+				IndexByName:   map[string]int{"pod": 0},
+				RenameByName:  map[string]string{"Value #A": "CPU"},
+			}),
+			`{"id":"organize","options":{"excludeByName":{"Time":true},"indexByName":{"pod":0},"renameByName":{"Value #A":"CPU"}}}`,
+		},
+		{
+			"calculateField binary",
+			CalculateBinaryField("Error %", "errors", "/", "requests"),
+			`{"id":"calculateField","options":{"mode":"binary","alias":"Error %","binary":{"left":"errors","operator":"/","right":"requests"}}}`,
+		},
+		{
+			"calculateField reduceRow",
+			CalculateRowField("Total", ReduceSum, "a", "b"),
+			`{"id":"calculateField","options":{"mode":"reduceRow","alias":"Total","reduce":{"reducer":"sum","include":["a","b"]}}}`,
+		},
+		{
+			"filterByValue",
+			FilterByValue(FilterInclude, MatchAny, ValueGreater("CPU", 0.5), ValueIsNull("Memory")),
+			`{"id":"filterByValue","options":{"filters":[{"fieldName":"CPU","config":{"id":"greater","options":{"value":0.5}}},{"fieldName":"Memory","config":{"id":"isNull","options":{}}}],"type":"include","match":"any"}}`,
+		},
+		{
+			"groupBy",
+			GroupBy([]string{"namespace"}, map[string][]string{"Value": {ReduceSum}}),
+			`{"id":"groupBy","options":{"fields":{"Value":{"operation":"aggregate","aggregations":["sum"]},"namespace":{"operation":"groupby","aggregations":[]}}}}`,
+		},
+		{"labelsToFields", LabelsToFields("pod"), `{"id":"labelsToFields","options":{"mode":"columns","keepLabels":["pod"]}}`},
+		{"reduce", Reduce(ReduceLast, ReduceMax), `{"id":"reduce","options":{"reducers":["last","max"],"mode":"seriesToRows"}}`},
+		{"filterFieldsByName", FilterFieldsByName("pod", "CPU"), `{"id":"filterFieldsByName","options":{"include":{"names":["pod","CPU"]}}}`},
+		{"sortBy", SortBy("CPU", true), `{"id":"sortBy","options":{"sort":[{"field":"CPU","desc":true}]}}`},
+		{"limit", Limit(10), `{"id":"limit","options":{"limitField":10}}`},
+		{"disabled", SeriesToRows().Disable(), `{"id":"seriesToRows","disabled":true,"options":{}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.transformation)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("JSON = %s, want %s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestTablePanel_WithTransformations(t *testing.T) {
+	p := Table("Pods").
+		WithTransformations(JoinByField("pod", JoinModeOuter)).
+		AddTransformation(SeriesToRows())
+	if len(p.Transformations) != 2 || p.Transformations[1].ID != "seriesToRows" {
+		t.Errorf("Transformations = %+v", p.Transformations)
+	}
+}
@@ -44,6 +44,14 @@ type DiscoveryResult struct {
 	AlertingRules []*ResourceRef `json:"alerting_rules,omitempty"`
 	// RecordingRules are discovered recording rule resources.
 	RecordingRules []*ResourceRef `json:"recording_rules,omitempty"`
+	// TemplateLibraries are discovered notification template libraries.
+	TemplateLibraries []*ResourceRef `json:"template_libraries,omitempty"`
+	// WebConfigs are discovered Prometheus web configuration resources.
+	WebConfigs []*ResourceRef `json:"web_configs,omitempty"`
+	// BlackboxConfigs are discovered blackbox_exporter configurations.
+	BlackboxConfigs []*ResourceRef `json:"blackbox_configs,omitempty"`
+	// BlackboxProbes are discovered blackbox probe jobs.
+	BlackboxProbes []*ResourceRef `json:"blackbox_probes,omitempty"`
 	// Errors encountered during discovery (non-fatal).
 	Errors []string `json:"errors,omitempty"`
 }
@@ -55,6 +63,7 @@ var observabilityTypes = map[string]bool{
 	"GlobalConfig":     true,
 	"ScrapeConfig":     true,
 	"StaticConfig":     true,
+	"WebConfig":        true,
 	// Alertmanager types
 	"AlertmanagerConfig": true,
 	// Rules types
@@ -64,12 +73,36 @@ var observabilityTypes = map[string]bool{
 	"RecordingRule": true,
 }
 
+// templatesImportPath is the import path of the notification templates package.
+const templatesImportPath = "github.com/lex00/wetwire-observability-go/alertmanager/templates"
+
+// prometheusImportPath is the import path of the Prometheus config package.
+const prometheusImportPath = "github.com/lex00/wetwire-observability-go/prometheus"
+
+// blackboxImportPath is the import path of the blackbox exporter package.
+const blackboxImportPath = "github.com/lex00/wetwire-observability-go/blackbox"
+
 // observabilityTypeMatcher creates a TypeMatcher for observability types.
 func observabilityTypeMatcher(pkgName, typeName string, imports map[string]string) (string, bool) {
 	// Check if this is an observability type
 	if observabilityTypes[typeName] {
 		return typeName, true
 	}
+	// Template libraries have a generic type name, so match them by package
+	if imports[pkgName] == templatesImportPath && (typeName == "Library" || typeName == "NewLibrary") {
+		return "TemplateLibrary", true
+	}
+	if imports[pkgName] == prometheusImportPath && typeName == "NewWebConfig" {
+		return "WebConfig", true
+	}
+	if imports[pkgName] == blackboxImportPath {
+		switch typeName {
+		case "Config", "NewConfig":
+			return "BlackboxConfig", true
+		case "Probe", "NewProbe":
+			return "BlackboxProbe", true
+		}
+	}
 	return "", false
 }
 
@@ -133,6 +166,14 @@ func Discover(dir string) (*DiscoveryResult, error) {
 			result.AlertingRules = append(result.AlertingRules, ref)
 		case "RecordingRule":
 			result.RecordingRules = append(result.RecordingRules, ref)
+		case "TemplateLibrary":
+			result.TemplateLibraries = append(result.TemplateLibraries, ref)
+		case "WebConfig":
+			result.WebConfigs = append(result.WebConfigs, ref)
+		case "BlackboxConfig":
+			result.BlackboxConfigs = append(result.BlackboxConfigs, ref)
+		case "BlackboxProbe":
+			result.BlackboxProbes = append(result.BlackboxProbes, ref)
 		}
 	}
 
@@ -172,7 +213,9 @@ func (r *DiscoveryResult) TotalCount() int {
 		len(r.GlobalConfigs) + len(r.StaticConfigs) +
 		len(r.AlertmanagerConfigs) +
 		len(r.RulesFiles) + len(r.RuleGroups) +
-		len(r.AlertingRules) + len(r.RecordingRules)
+		len(r.AlertingRules) + len(r.RecordingRules) +
+		len(r.TemplateLibraries) + len(r.WebConfigs) +
+		len(r.BlackboxConfigs) + len(r.BlackboxProbes)
 }
 
 // All returns all discovered resources as a flat slice.
@@ -187,6 +230,10 @@ func (r *DiscoveryResult) All() []*ResourceRef {
 	all = append(all, r.RuleGroups...)
 	all = append(all, r.AlertingRules...)
 	all = append(all, r.RecordingRules...)
+	all = append(all, r.TemplateLibraries...)
+	all = append(all, r.WebConfigs...)
+	all = append(all, r.BlackboxConfigs...)
+	all = append(all, r.BlackboxProbes...)
 	return all
 }
 
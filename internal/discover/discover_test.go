@@ -435,3 +435,110 @@ var Config = &PrometheusConfig{}
 		t.Errorf("len(PrometheusConfigs) = %d, want 1", len(result.PrometheusConfigs))
 	}
 }
+
+func TestDiscover_TemplateLibraries(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	content := `package monitoring
+
+import (
+	"github.com/lex00/wetwire-observability-go/alertmanager/templates"
+	other "example.com/other/templates"
+)
+
+var Notifications = templates.NewLibrary("notifications")
+
+var Literal = &templates.Library{Name: "literal"}
+
+var Unrelated = other.NewLibrary("unrelated")
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "templates.go"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Discover(tmpDir)
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+
+	if len(result.TemplateLibraries) != 2 {
+		t.Fatalf("len(TemplateLibraries) = %d, want 2", len(result.TemplateLibraries))
+	}
+	for _, ref := range result.TemplateLibraries {
+		if ref.Type != "TemplateLibrary" {
+			t.Errorf("Type = %q, want TemplateLibrary", ref.Type)
+		}
+	}
+}
+
+func TestDiscover_WebConfigs(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	content := `package monitoring
+
+import (
+	"github.com/lex00/wetwire-observability-go/prometheus"
+	other "example.com/other/prometheus"
+)
+
+var Web = prometheus.NewWebConfig()
+
+var Typed *prometheus.WebConfig = prometheus.NewWebConfig().WithTLS("tls.crt", "tls.key")
+
+var Literal = &prometheus.WebConfig{}
+
+var Unrelated = other.NewWebConfig()
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "web.go"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Discover(tmpDir)
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+
+	if len(result.WebConfigs) != 3 {
+		t.Fatalf("len(WebConfigs) = %d, want 3", len(result.WebConfigs))
+	}
+	for _, ref := range result.WebConfigs {
+		if ref.Type != "WebConfig" {
+			t.Errorf("Type = %q, want WebConfig", ref.Type)
+		}
+	}
+}
+
+func TestDiscover_Blackbox(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	content := `package monitoring
+
+import "github.com/lex00/wetwire-observability-go/blackbox"
+
+var Modules *blackbox.Config = blackbox.NewConfig().AddModule("icmp", blackbox.ICMPModule())
+
+var Literal = &blackbox.Config{}
+
+var APIProbe = blackbox.NewProbe("api", "http_2xx")
+
+var Module = blackbox.HTTPModule()
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "blackbox.go"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Discover(tmpDir)
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+
+	if len(result.BlackboxConfigs) != 2 {
+		t.Errorf("len(BlackboxConfigs) = %d, want 2", len(result.BlackboxConfigs))
+	}
+	if len(result.BlackboxProbes) != 1 {
+		t.Errorf("len(BlackboxProbes) = %d, want 1", len(result.BlackboxProbes))
+	}
+	if result.TotalCount() != 3 {
+		t.Errorf("TotalCount() = %d, want 3", result.TotalCount())
+	}
+}
@@ -0,0 +1,159 @@
+// Package exposition parses the Prometheus and OpenMetrics text formats.
+package exposition
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseSeries parses the metric name and labels of a sample line in the
+// Prometheus or OpenMetrics text format. The name is returned as the
+// __name__ label; the value, timestamp and exemplar are ignored.
+func ParseSeries(text string) (map[string]string, error) {
+	s := map[string]string{}
+	i := strings.IndexAny(text, "{ \t")
+	if i < 0 {
+		return nil, fmt.Errorf("missing value in %q", text)
+	}
+	if i > 0 {
+		s["__name__"] = text[:i]
+	}
+	if text[i] != '{' {
+		if s["__name__"] == "" {
+			return nil, fmt.Errorf("missing metric name in %q", text)
+		}
+		return s, nil
+	}
+
+	p := &labelParser{text: text, pos: i + 1}
+	for {
+		p.skipSpace()
+		if p.peek() == '}' {
+			p.pos++
+			break
+		}
+		var name string
+		var err error
+		if p.peek() == '"' {
+			name, err = p.quoted()
+		} else {
+			name = p.ident()
+		}
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.peek() != '=' {
+			// A quoted string without a value is a UTF-8 metric name.
+			if name == "" || s["__name__"] != "" {
+				return nil, fmt.Errorf("expected '=' after label name at column %d in %q", p.pos+1, text)
+			}
+			s["__name__"] = name
+		} else {
+			p.pos++
+			p.skipSpace()
+			value, err := p.quoted()
+			if err != nil {
+				return nil, err
+			}
+			s[name] = value
+		}
+		p.skipSpace()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '}':
+		default:
+			return nil, fmt.Errorf("expected ',' or '}' at column %d in %q", p.pos+1, text)
+		}
+	}
+	if s["__name__"] == "" {
+		return nil, fmt.Errorf("missing metric name in %q", text)
+	}
+	return s, nil
+}
+
+// labelParser reads the label set of a sample line.
+type labelParser struct {
+	text string
+	pos  int
+}
+
+func (p *labelParser) peek() byte {
+	if p.pos >= len(p.text) {
+		return 0
+	}
+	return p.text[p.pos]
+}
+
+func (p *labelParser) skipSpace() {
+	for p.peek() == ' ' || p.peek() == '\t' {
+		p.pos++
+	}
+}
+
+func (p *labelParser) ident() string {
+	start := p.pos
+	for {
+		c := p.peek()
+		if c == '_' || c == ':' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' {
+			p.pos++
+			continue
+		}
+		return p.text[start:p.pos]
+	}
+}
+
+// quoted reads a double-quoted string with \\, \" and \n escapes.
+func (p *labelParser) quoted() (string, error) {
+	if p.peek() != '"' {
+		return "", fmt.Errorf("expected '\"' at column %d in %q", p.pos+1, p.text)
+	}
+	p.pos++
+	var b strings.Builder
+	for p.pos < len(p.text) {
+		c := p.text[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return b.String(), nil
+		case '\\':
+			if p.pos >= len(p.text) {
+				break
+			}
+			switch e := p.text[p.pos]; e {
+			case 'n':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(e)
+			}
+			p.pos++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", fmt.Errorf("unterminated string in %q", p.text)
+}
+
+// ParseComment parses a HELP, TYPE or UNIT line into its keyword, metric
+// family name and text. Escapes in HELP text are resolved. ok is false for
+// other comments.
+func ParseComment(text string) (keyword, name, value string, ok bool) {
+	fields := strings.SplitN(strings.TrimSpace(strings.TrimPrefix(text, "#")), " ", 3)
+	if !strings.HasPrefix(text, "#") || len(fields) < 2 {
+		return "", "", "", false
+	}
+	switch fields[0] {
+	case "HELP", "TYPE", "UNIT":
+	default:
+		return "", "", "", false
+	}
+	keyword, name = fields[0], fields[1]
+	if len(fields) == 3 {
+		value = strings.TrimSpace(fields[2])
+	}
+	if keyword == "HELP" {
+		value = strings.NewReplacer(`\\`, `\`, `\n`, "\n", `\"`, `"`).Replace(value)
+	}
+	return keyword, name, value, true
+}
@@ -0,0 +1,71 @@
+package exposition
+
+import "testing"
+
+func TestParseSeries(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  map[string]string
+	}{
+		{name: "bare", input: "up 1", want: map[string]string{"__name__": "up"}},
+		{
+			name:  "labels",
+			input: `http_requests_total{method="GET", code="200",} 10 1395066363000`,
+			want:  map[string]string{"__name__": "http_requests_total", "method": "GET", "code": "200"},
+		},
+		{
+			name:  "utf-8 name",
+			input: `{"my.metric", "my.label"="a\"b\\c\nd"} 1 # {trace_id="x"} 1`,
+			want:  map[string]string{"__name__": "my.metric", "my.label": "a\"b\\c\nd"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSeries(tt.input)
+			if err != nil {
+				t.Fatalf("ParseSeries() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseSeries() = %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("ParseSeries()[%s] = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestParseSeries_Errors(t *testing.T) {
+	for _, input := range []string{"up", `{job="a"} 1`, `up{job="a} 1`, `up{job="a" x="b"} 1`, `up{job} 1`} {
+		if _, err := ParseSeries(input); err == nil {
+			t.Errorf("ParseSeries(%q) error = nil, want error", input)
+		}
+	}
+}
+
+func TestParseComment(t *testing.T) {
+	tests := []struct {
+		input   string
+		keyword string
+		name    string
+		value   string
+		ok      bool
+	}{
+		{input: `# HELP up Whether the target is up.\nSecond line.`, keyword: "HELP", name: "up", value: "Whether the target is up.\nSecond line.", ok: true},
+		{input: "# TYPE http_requests_total counter", keyword: "TYPE", name: "http_requests_total", value: "counter", ok: true},
+		{input: "# UNIT request_duration_seconds seconds", keyword: "UNIT", name: "request_duration_seconds", value: "seconds", ok: true},
+		{input: "# HELP up", keyword: "HELP", name: "up", ok: true},
+		{input: "# EOF"},
+		{input: "# just a comment"},
+	}
+	for _, tt := range tests {
+		keyword, name, value, ok := ParseComment(tt.input)
+		if keyword != tt.keyword || name != tt.name || value != tt.value || ok != tt.ok {
+			t.Errorf("ParseComment(%q) = %q, %q, %q, %v, want %q, %q, %q, %v",
+				tt.input, keyword, name, value, ok, tt.keyword, tt.name, tt.value, tt.ok)
+		}
+	}
+}
@@ -31,34 +31,18 @@ func ParseAlertmanagerConfigFromBytes(data []byte) (*alertmanager.AlertmanagerCo
 	return &config, nil
 }
 
-// ValidateAlertmanagerConfig validates a parsed Alertmanager configuration.
+// ValidateAlertmanagerConfig validates a parsed Alertmanager configuration
+// using the in-process validator and returns its findings as warnings.
 func ValidateAlertmanagerConfig(config *alertmanager.AlertmanagerConfig) []string {
 	var warnings []string
 
-	// Check for required route
-	if config.Route == nil {
-		warnings = append(warnings, "missing route configuration")
-	} else if config.Route.Receiver == "" {
-		warnings = append(warnings, "root route missing receiver")
-	}
-
 	// Check for at least one receiver
 	if len(config.Receivers) == 0 {
 		warnings = append(warnings, "no receivers defined")
 	}
 
-	// Check that route receiver exists in receivers list
-	if config.Route != nil && config.Route.Receiver != "" {
-		found := false
-		for _, r := range config.Receivers {
-			if r.Name == config.Route.Receiver {
-				found = true
-				break
-			}
-		}
-		if !found {
-			warnings = append(warnings, fmt.Sprintf("route receiver %q not found in receivers list", config.Route.Receiver))
-		}
+	for _, err := range config.Validate() {
+		warnings = append(warnings, err.Error())
 	}
 
 	return warnings
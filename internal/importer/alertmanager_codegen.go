@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"go/format"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -63,7 +66,14 @@ func (g *alertmanagerCodeGenerator) generate() ([]byte, error) {
 
 	// Generate mute time intervals
 	for _, mti := range g.config.MuteTimeIntervals {
-		if err := g.writeMuteTimeInterval(&buf, mti); err != nil {
+		if err := g.writeMuteTimeInterval(&buf, mti, "MuteTime"); err != nil {
+			return nil, err
+		}
+	}
+
+	// Generate time intervals
+	for _, ti := range g.config.TimeIntervals {
+		if err := g.writeMuteTimeInterval(&buf, ti, "TimeInterval"); err != nil {
 			return nil, err
 		}
 	}
@@ -107,6 +117,57 @@ func (g *alertmanagerCodeGenerator) writeGlobalConfig(buf *bytes.Buffer) error {
 	if gc.OpsGenieAPIURL != "" {
 		buf.WriteString(fmt.Sprintf("\tOpsGenieAPIURL: %q,\n", gc.OpsGenieAPIURL))
 	}
+	if gc.SMTPAuthPasswordFile != "" {
+		buf.WriteString(fmt.Sprintf("\tSMTPAuthPasswordFile: %q,\n", gc.SMTPAuthPasswordFile))
+	}
+	if gc.SMTPRequireTLS != nil {
+		buf.WriteString("\tSMTPRequireTLS: " + formatBoolPtr(*gc.SMTPRequireTLS) + ",\n")
+	}
+	if gc.SlackAPIURLFile != "" {
+		buf.WriteString(fmt.Sprintf("\tSlackAPIURLFile: %q,\n", gc.SlackAPIURLFile))
+	}
+	if gc.OpsGenieAPIKeyFile != "" {
+		buf.WriteString(fmt.Sprintf("\tOpsGenieAPIKeyFile: %q,\n", gc.OpsGenieAPIKeyFile))
+	}
+	if gc.TelegramAPIURL != "" {
+		buf.WriteString(fmt.Sprintf("\tTelegramAPIURL: %q,\n", gc.TelegramAPIURL))
+	}
+	if gc.VictorOpsAPIURL != "" {
+		buf.WriteString(fmt.Sprintf("\tVictorOpsAPIURL: %q,\n", gc.VictorOpsAPIURL))
+	}
+	if gc.VictorOpsAPIKey != "" {
+		buf.WriteString(fmt.Sprintf("\tVictorOpsAPIKey: %q,\n", gc.VictorOpsAPIKey))
+	}
+	if gc.VictorOpsAPIKeyFile != "" {
+		buf.WriteString(fmt.Sprintf("\tVictorOpsAPIKeyFile: %q,\n", gc.VictorOpsAPIKeyFile))
+	}
+	if gc.WeChatAPIURL != "" {
+		buf.WriteString(fmt.Sprintf("\tWeChatAPIURL: %q,\n", gc.WeChatAPIURL))
+	}
+	if gc.WeChatAPISecret != "" {
+		buf.WriteString(fmt.Sprintf("\tWeChatAPISecret: %q,\n", gc.WeChatAPISecret))
+	}
+	if gc.WeChatAPICorpID != "" {
+		buf.WriteString(fmt.Sprintf("\tWeChatAPICorpID: %q,\n", gc.WeChatAPICorpID))
+	}
+	if gc.WebexAPIURL != "" {
+		buf.WriteString(fmt.Sprintf("\tWebexAPIURL: %q,\n", gc.WebexAPIURL))
+	}
+	if gc.JiraAPIURL != "" {
+		buf.WriteString(fmt.Sprintf("\tJiraAPIURL: %q,\n", gc.JiraAPIURL))
+	}
+	if gc.RocketChatAPIURL != "" {
+		buf.WriteString(fmt.Sprintf("\tRocketChatAPIURL: %q,\n", gc.RocketChatAPIURL))
+	}
+	if gc.RocketChatToken != "" {
+		buf.WriteString(fmt.Sprintf("\tRocketChatToken: %q,\n", gc.RocketChatToken))
+	}
+	if gc.RocketChatTokenID != "" {
+		buf.WriteString(fmt.Sprintf("\tRocketChatTokenID: %q,\n", gc.RocketChatTokenID))
+	}
+	if gc.HTTPConfig != nil {
+		buf.WriteString("\tHTTPConfig: " + g.formatValue(reflect.ValueOf(gc.HTTPConfig), "\t") + ",\n")
+	}
 	if gc.ResolveTimeout != 0 {
 		buf.WriteString(fmt.Sprintf("\tResolveTimeout: %s,\n", g.formatDuration(gc.ResolveTimeout)))
 	}
@@ -208,6 +269,18 @@ func (g *alertmanagerCodeGenerator) writeReceiver(buf *bytes.Buffer, r *alertman
 		buf.WriteString("\t},\n")
 	}
 
+	// The newer integrations are generated from their struct fields so
+	// that none of their settings are dropped
+	for _, field := range []string{
+		"MSTeamsConfigs", "MSTeamsV2Configs", "TelegramConfigs", "DiscordConfigs",
+		"SNSConfigs", "WebexConfigs", "VictorOpsConfigs", "PushoverConfigs",
+		"WeChatConfigs", "JiraConfigs", "RocketChatConfigs",
+	} {
+		if configs := reflect.ValueOf(r).Elem().FieldByName(field); configs.Len() > 0 {
+			buf.WriteString("\t" + field + ": " + g.formatValue(configs, "\t") + ",\n")
+		}
+	}
+
 	buf.WriteString("}\n\n")
 	return nil
 }
@@ -269,6 +342,10 @@ func (g *alertmanagerCodeGenerator) writeRoute(buf *bytes.Buffer, r *alertmanage
 		buf.WriteString(fmt.Sprintf("\tMuteTimeIntervals: []string{%s},\n", strings.Join(mti, ", ")))
 	}
 
+	if len(r.ActiveTimeIntervals) > 0 {
+		buf.WriteString(fmt.Sprintf("\tActiveTimeIntervals: []string%s,\n", quoteList(r.ActiveTimeIntervals)))
+	}
+
 	buf.WriteString("}\n\n")
 	return nil
 }
@@ -301,6 +378,14 @@ func (g *alertmanagerCodeGenerator) writeInlineRoute(buf *bytes.Buffer, r *alert
 		buf.WriteString(indent + "\t},\n")
 	}
 
+	if len(r.MuteTimeIntervals) > 0 {
+		buf.WriteString(fmt.Sprintf("%s\tMuteTimeIntervals: []string%s,\n", indent, quoteList(r.MuteTimeIntervals)))
+	}
+
+	if len(r.ActiveTimeIntervals) > 0 {
+		buf.WriteString(fmt.Sprintf("%s\tActiveTimeIntervals: []string%s,\n", indent, quoteList(r.ActiveTimeIntervals)))
+	}
+
 	// Recursive child routes
 	if len(r.Routes) > 0 {
 		buf.WriteString(indent + "\tRoutes: []*alertmanager.Route{\n")
@@ -349,18 +434,39 @@ func (g *alertmanagerCodeGenerator) writeInhibitRule(buf *bytes.Buffer, ir *aler
 	return nil
 }
 
-func (g *alertmanagerCodeGenerator) writeMuteTimeInterval(buf *bytes.Buffer, mti *alertmanager.MuteTimeInterval) error {
-	varName := g.sanitizeVarName(mti.Name) + "MuteTime"
+func (g *alertmanagerCodeGenerator) writeMuteTimeInterval(buf *bytes.Buffer, mti *alertmanager.MuteTimeInterval, suffix string) error {
+	varName := g.sanitizeVarName(mti.Name) + suffix
 
-	buf.WriteString(fmt.Sprintf("// %s defines a mute time interval.\n", varName))
+	buf.WriteString(fmt.Sprintf("// %s defines the %s time interval.\n", varName, mti.Name))
 	buf.WriteString(fmt.Sprintf("var %s = &alertmanager.MuteTimeInterval{\n", varName))
 	buf.WriteString(fmt.Sprintf("\tName: %q,\n", mti.Name))
 
 	if len(mti.TimeIntervals) > 0 {
 		buf.WriteString("\tTimeIntervals: []alertmanager.TimeInterval{\n")
-		for range mti.TimeIntervals {
+		for _, ti := range mti.TimeIntervals {
 			buf.WriteString("\t\t{\n")
-			// TODO: Write TimeInterval fields
+			if len(ti.Times) > 0 {
+				buf.WriteString("\t\t\tTimes: []alertmanager.TimeRange{\n")
+				for _, tr := range ti.Times {
+					buf.WriteString(fmt.Sprintf("\t\t\t\t{StartTime: %q, EndTime: %q},\n", tr.StartTime, tr.EndTime))
+				}
+				buf.WriteString("\t\t\t},\n")
+			}
+			if len(ti.Weekdays) > 0 {
+				buf.WriteString(fmt.Sprintf("\t\t\tWeekdays: []alertmanager.WeekdayRange%s,\n", quoteList(ti.Weekdays)))
+			}
+			if len(ti.DaysOfMonth) > 0 {
+				buf.WriteString(fmt.Sprintf("\t\t\tDaysOfMonth: []alertmanager.DayOfMonthRange%s,\n", quoteList(ti.DaysOfMonth)))
+			}
+			if len(ti.Months) > 0 {
+				buf.WriteString(fmt.Sprintf("\t\t\tMonths: []alertmanager.MonthRange%s,\n", quoteList(ti.Months)))
+			}
+			if len(ti.Years) > 0 {
+				buf.WriteString(fmt.Sprintf("\t\t\tYears: []alertmanager.YearRange%s,\n", quoteList(ti.Years)))
+			}
+			if ti.Location != "" {
+				buf.WriteString(fmt.Sprintf("\t\t\tLocation: %q,\n", ti.Location))
+			}
 			buf.WriteString("\t\t},\n")
 		}
 		buf.WriteString("\t},\n")
@@ -409,6 +515,15 @@ func (g *alertmanagerCodeGenerator) writeMainConfig(buf *bytes.Buffer) error {
 		buf.WriteString("\t},\n")
 	}
 
+	if len(g.config.TimeIntervals) > 0 {
+		buf.WriteString("\tTimeIntervals: []*alertmanager.MuteTimeInterval{\n")
+		for _, ti := range g.config.TimeIntervals {
+			varName := g.sanitizeVarName(ti.Name) + "TimeInterval"
+			buf.WriteString(fmt.Sprintf("\t\t%s,\n", varName))
+		}
+		buf.WriteString("\t},\n")
+	}
+
 	if len(g.config.Templates) > 0 {
 		buf.WriteString("\tTemplates: []string{\n")
 		for _, t := range g.config.Templates {
@@ -450,6 +565,112 @@ func (g *alertmanagerCodeGenerator) formatDuration(d alertmanager.Duration) stri
 	return fmt.Sprintf("alertmanager.Duration(%d * time.Millisecond)", ms)
 }
 
+// durationType is the type of Duration fields, formatted with formatDuration.
+var durationType = reflect.TypeOf(alertmanager.Duration(0))
+
+// formatValue formats a config value as a Go expression, writing the
+// non-zero fields of structs. Secrets are formatted through their String
+// method, so their values are redacted.
+func (g *alertmanagerCodeGenerator) formatValue(v reflect.Value, indent string) string {
+	if v.Type() == durationType {
+		return g.formatDuration(alertmanager.Duration(v.Int()))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return fmt.Sprintf("%q", v.Interface())
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case reflect.Interface:
+		return goLiteral(v.Interface())
+	case reflect.Pointer:
+		switch v.Elem().Kind() {
+		case reflect.Bool:
+			return formatBoolPtr(v.Elem().Bool())
+		case reflect.Struct:
+			return "&" + g.formatStruct(v.Elem(), indent)
+		}
+	case reflect.Struct:
+		return g.formatStruct(v, indent)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.String {
+			values := make([]string, v.Len())
+			for i := range values {
+				values[i] = v.Index(i).String()
+			}
+			return alertmanagerTypeName(v.Type()) + quoteList(values)
+		}
+		var buf bytes.Buffer
+		buf.WriteString(alertmanagerTypeName(v.Type()) + "{\n")
+		for i := 0; i < v.Len(); i++ {
+			elem := v.Index(i)
+			value := g.formatValue(elem, indent+"\t")
+			if elem.Kind() == reflect.Pointer && elem.Elem().Kind() == reflect.Struct {
+				// The element type is implied by the slice type
+				value = g.formatFields(elem.Elem(), indent+"\t")
+			}
+			buf.WriteString(indent + "\t" + value + ",\n")
+		}
+		buf.WriteString(indent + "}")
+		return buf.String()
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		var buf bytes.Buffer
+		buf.WriteString(alertmanagerTypeName(v.Type()) + "{\n")
+		for _, k := range keys {
+			buf.WriteString(indent + "\t" + g.formatValue(k, indent+"\t") + ": " + g.formatValue(v.MapIndex(k), indent+"\t") + ",\n")
+		}
+		buf.WriteString(indent + "}")
+		return buf.String()
+	}
+	return fmt.Sprintf("%#v", v.Interface())
+}
+
+// formatStruct formats a struct as a composite literal.
+func (g *alertmanagerCodeGenerator) formatStruct(v reflect.Value, indent string) string {
+	return alertmanagerTypeName(v.Type()) + g.formatFields(v, indent)
+}
+
+// formatFields formats the non-zero exported fields of a struct as the
+// braced elements of a composite literal.
+func (g *alertmanagerCodeGenerator) formatFields(v reflect.Value, indent string) string {
+	var buf bytes.Buffer
+	buf.WriteString("{\n")
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if f := v.Field(i); t.Field(i).IsExported() && !f.IsZero() {
+			buf.WriteString(indent + "\t" + t.Field(i).Name + ": " + g.formatValue(f, indent+"\t") + ",\n")
+		}
+	}
+	buf.WriteString(indent + "}")
+	return buf.String()
+}
+
+// alertmanagerTypeName returns the Go name of a config type, qualified with
+// the alertmanager package for named types.
+func alertmanagerTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return "*" + alertmanagerTypeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + alertmanagerTypeName(t.Elem())
+	case reflect.Map:
+		return "map[" + alertmanagerTypeName(t.Key()) + "]" + alertmanagerTypeName(t.Elem())
+	case reflect.Interface:
+		return "any"
+	}
+	if t.PkgPath() == "" {
+		return t.Name()
+	}
+	return "alertmanager." + t.Name()
+}
+
 func (g *alertmanagerCodeGenerator) sanitizeVarName(name string) string {
 	result := strings.Builder{}
 	capitalize := true
@@ -474,6 +695,15 @@ func (g *alertmanagerCodeGenerator) sanitizeVarName(name string) string {
 	return s
 }
 
+// quoteList formats values as the braces of a Go string slice literal.
+func quoteList[T ~string](values []T) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return "{" + strings.Join(quoted, ", ") + "}"
+}
+
 func toUpperRune(r rune) rune {
 	if r >= 'a' && r <= 'z' {
 		return r - 'a' + 'A'
@@ -1,6 +1,7 @@
 package importer
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -86,6 +87,22 @@ receivers:
 `,
 			wantWarn: 1, // receiver not found
 		},
+		{
+			name: "undefined time interval",
+			yaml: `
+route:
+  receiver: 'default'
+  routes:
+    - receiver: 'default'
+      active_time_intervals: ['office']
+      mute_time_intervals: ['holidays']
+receivers:
+  - name: 'default'
+time_intervals:
+  - name: 'office'
+`,
+			wantWarn: 1, // holidays not defined
+		},
 	}
 
 	for _, tt := range tests {
@@ -150,3 +167,444 @@ receivers:
 		t.Error("expected Config variable")
 	}
 }
+
+func TestGenerateAlertmanagerGoCode_ExtendedReceivers(t *testing.T) {
+	yaml := `
+route:
+  receiver: 'chat'
+receivers:
+  - name: 'chat'
+    msteams_configs:
+      - webhook_url: 'https://outlook.office.com/webhook/xxx'
+    telegram_configs:
+      - bot_token_file: '/etc/secrets/telegram'
+        chat_id: -100123
+    discord_configs:
+      - webhook_url: 'https://discord.com/api/webhooks/1/abc'
+  - name: 'oncall'
+    victorops_configs:
+      - routing_key: 'platform'
+        api_key: 'vo-key'
+    pushover_configs:
+      - user_key: 'user'
+        token: 'token'
+    sns_configs:
+      - topic_arn: 'arn:aws:sns:us-east-1:123456789012:alerts'
+  - name: 'tickets'
+    jira_configs:
+      - project: 'OPS'
+        issue_type: 'Bug'
+    webex_configs:
+      - room_id: 'room-1'
+    wechat_configs:
+      - corp_id: 'corp'
+    rocketchat_configs:
+      - channel: '#alerts'
+    msteamsv2_configs:
+      - webhook_url: 'https://example.com/workflows/xxx'
+`
+	config, err := ParseAlertmanagerConfigFromBytes([]byte(yaml))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+
+	code, err := GenerateAlertmanagerGoCode(config, "monitoring")
+	if err != nil {
+		t.Fatalf("generate failed: %v", err)
+	}
+
+	// Normalize whitespace so gofmt field alignment does not matter.
+	codeStr := strings.Join(strings.Fields(string(code)), " ")
+	expectations := []string{
+		"MSTeamsConfigs: []*alertmanager.MSTeamsConfig{",
+		"MSTeamsV2Configs: []*alertmanager.MSTeamsV2Config{",
+		`BotTokenFile: "/etc/secrets/telegram",`,
+		"ChatID: -100123,",
+		"DiscordConfigs: []*alertmanager.DiscordConfig{",
+		`RoutingKey: "platform",`,
+		`UserKey: "<secret>",`,
+		`TopicARN: "arn:aws:sns:us-east-1:123456789012:alerts",`,
+		`Project: "OPS",`,
+		`RoomID: "room-1",`,
+		`CorpID: "corp",`,
+		`Channel: "#alerts",`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(codeStr, exp) {
+			t.Errorf("generated code missing %q\nGot:\n%s", exp, code)
+		}
+	}
+}
+
+func TestGenerateAlertmanagerGoCode_ReceiverFields(t *testing.T) {
+	httpConfig := `
+        http_config:
+          basic_auth:
+            username: 'alert'
+            password_file: '/etc/secrets/password'
+          follow_redirects: false`
+
+	tests := []struct {
+		name   string
+		field  string
+		config string
+		want   []string
+	}{
+		{
+			name:  "msteams",
+			field: "MSTeamsConfigs",
+			config: `
+    msteams_configs:
+      - send_resolved: false
+        webhook_url_file: '/etc/secrets/teams'
+        title: 'Alert'
+        summary: 'Summary'
+        text: 'Text'` + httpConfig,
+			want: []string{"SendResolved: new(bool),", `Text: "Text",`},
+		},
+		{
+			name:  "msteamsv2",
+			field: "MSTeamsV2Configs",
+			config: `
+    msteamsv2_configs:
+      - send_resolved: true
+        webhook_url: 'https://example.com/workflows/xxx'
+        title: 'Alert'
+        text: 'Text'` + httpConfig,
+			want: []string{"SendResolved: &[]bool{true}[0],", `WebhookURL: "<secret>",`},
+		},
+		{
+			name:  "telegram",
+			field: "TelegramConfigs",
+			config: `
+    telegram_configs:
+      - send_resolved: false
+        api_url: 'https://api.telegram.org'
+        bot_token: 'token'
+        chat_id: -100123
+        message_thread_id: 7
+        message: 'Message'
+        disable_notifications: true
+        parse_mode: 'HTML'` + httpConfig,
+			want: []string{`Message: "Message",`, "MessageThreadID: 7,", "DisableNotifications: true,"},
+		},
+		{
+			name:  "discord",
+			field: "DiscordConfigs",
+			config: `
+    discord_configs:
+      - send_resolved: false
+        webhook_url: 'https://discord.com/api/webhooks/1/abc'
+        title: 'Alert'
+        message: 'Message'
+        content: 'Content'
+        username: 'alertmanager'
+        avatar_url: 'https://example.com/avatar.png'` + httpConfig,
+			want: []string{`Message: "Message",`, `AvatarURL: "https://example.com/avatar.png",`},
+		},
+		{
+			name:  "sns",
+			field: "SNSConfigs",
+			config: `
+    sns_configs:
+      - send_resolved: false
+        api_url: 'https://sns.us-east-1.amazonaws.com'
+        sigv4:
+          region: 'us-east-1'
+          access_key: 'key'
+          secret_key: 'secret'
+          profile: 'default'
+          role_arn: 'arn:aws:iam::123456789012:role/sns'
+        topic_arn: 'arn:aws:sns:us-east-1:123456789012:alerts'
+        subject: 'Subject'
+        message: 'Message'
+        attributes:
+          team: 'platform'` + httpConfig,
+			want: []string{"SigV4: &alertmanager.SigV4Config{", `SecretKey: "<secret>",`, `Attributes: map[string]string{ "team": "platform", },`},
+		},
+		{
+			name:  "webex",
+			field: "WebexConfigs",
+			config: `
+    webex_configs:
+      - send_resolved: false
+        api_url: 'https://webexapis.com/v1/messages'
+        room_id: 'room-1'
+        message: 'Message'` + httpConfig,
+			want: []string{`Message: "Message",`},
+		},
+		{
+			name:  "victorops",
+			field: "VictorOpsConfigs",
+			config: `
+    victorops_configs:
+      - send_resolved: false
+        api_key_file: '/etc/secrets/victorops'
+        api_url: 'https://alert.victorops.com/integrations/generic/20131114/alert/'
+        routing_key: 'platform'
+        message_type: 'CRITICAL'
+        entity_display_name: 'Entity'
+        state_message: 'State'
+        monitoring_tool: 'prometheus'
+        custom_fields:
+          team: 'platform'` + httpConfig,
+			want: []string{`CustomFields: map[string]string{ "team": "platform", },`},
+		},
+		{
+			name:  "pushover",
+			field: "PushoverConfigs",
+			config: `
+    pushover_configs:
+      - send_resolved: false
+        user_key_file: '/etc/secrets/user'
+        token_file: '/etc/secrets/token'
+        title: 'Alert'
+        message: 'Message'
+        url: 'https://example.com'
+        url_title: 'Example'
+        device: 'phone'
+        sound: 'siren'
+        priority: '2'
+        retry: 1m
+        expire: 1h
+        ttl: 30m
+        html: true` + httpConfig,
+			want: []string{"Retry: alertmanager.Minute,", "TTL: 30 * alertmanager.Minute,", "HTML: true,"},
+		},
+		{
+			name:  "wechat",
+			field: "WeChatConfigs",
+			config: `
+    wechat_configs:
+      - send_resolved: false
+        api_secret: 'secret'
+        api_url: 'https://qyapi.weixin.qq.com/cgi-bin/'
+        corp_id: 'corp'
+        agent_id: '1000002'
+        to_user: '@all'
+        to_party: 'party'
+        to_tag: 'tag'
+        message: 'Message'
+        message_type: 'markdown'` + httpConfig,
+			want: []string{`APISecret: "<secret>",`, `ToTag: "tag",`},
+		},
+		{
+			name:  "jira",
+			field: "JiraConfigs",
+			config: `
+    jira_configs:
+      - send_resolved: false
+        api_url: 'https://example.atlassian.net'
+        project: 'OPS'
+        issue_type: 'Bug'
+        summary: 'Summary'
+        description: 'Description'
+        labels: ['alertmanager', 'platform']
+        priority: 'High'
+        reopen_transition: 'Reopen'
+        resolve_transition: 'Resolve'
+        wont_fix_resolution: "Won't Fix"
+        reopen_duration: 1h
+        fields:
+          customfield_10001: 'value'` + httpConfig,
+			want: []string{
+				`Labels: []string{"alertmanager", "platform"},`,
+				"HTTPConfig: &alertmanager.HTTPConfig{ BasicAuth: &alertmanager.BasicAuth{",
+				`Fields: map[string]any{ "customfield_10001": "value", },`,
+			},
+		},
+		{
+			name:  "rocketchat",
+			field: "RocketChatConfigs",
+			config: `
+    rocketchat_configs:
+      - send_resolved: false
+        api_url: 'https://open.rocket.chat'
+        channel: '#alerts'
+        token_file: '/etc/secrets/token'
+        token_id_file: '/etc/secrets/token_id'
+        color: 'red'
+        emoji: ':fire:'
+        icon_url: 'https://example.com/icon.png'
+        title: 'Alert'
+        title_link: 'https://example.com'
+        text: 'Text'
+        fields:
+          - title: 'Severity'
+            value: 'critical'
+            short: true
+        short_fields: true
+        image_url: 'https://example.com/image.png'
+        thumb_url: 'https://example.com/thumb.png'
+        link_names: true
+        actions:
+          - type: 'button'
+            text: 'Silence'
+            url: 'https://example.com/silence'` + httpConfig,
+			want: []string{
+				`Fields: []*alertmanager.RocketChatField{ { Title: "Severity", Value: "critical", Short: &[]bool{true}[0], }, },`,
+				`Actions: []*alertmanager.RocketChatAction{ { Type: "button",`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			yaml := "route:\n  receiver: 'default'\nreceivers:\n  - name: 'default'" + tt.config + "\n"
+			config, err := ParseAlertmanagerConfigFromBytes([]byte(yaml))
+			if err != nil {
+				t.Fatalf("parse failed: %v", err)
+			}
+
+			code, err := GenerateAlertmanagerGoCode(config, "monitoring")
+			if err != nil {
+				t.Fatalf("generate failed: %v", err)
+			}
+
+			// Normalize whitespace so gofmt field alignment does not matter.
+			codeStr := strings.Join(strings.Fields(string(code)), " ")
+
+			// Every field set in the YAML must be generated.
+			configs := reflect.ValueOf(config.Receivers[0]).Elem().FieldByName(tt.field)
+			if configs.Len() != 1 {
+				t.Fatalf("len(%s) = %d, want 1", tt.field, configs.Len())
+			}
+			for _, name := range setFieldNames(configs.Index(0)) {
+				if !strings.Contains(codeStr, name+":") {
+					t.Errorf("generated code missing field %s\nGot:\n%s", name, code)
+				}
+			}
+
+			want := append([]string{
+				"HTTPConfig: &alertmanager.HTTPConfig{",
+				`Username: "alert",`,
+				`PasswordFile: "/etc/secrets/password",`,
+				"FollowRedirects: new(bool),",
+			}, tt.want...)
+			for _, exp := range want {
+				if !strings.Contains(codeStr, exp) {
+					t.Errorf("generated code missing %q\nGot:\n%s", exp, code)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateAlertmanagerGoCode_GlobalFields(t *testing.T) {
+	yaml := `
+global:
+  smtp_require_tls: false
+  slack_api_url_file: '/etc/secrets/slack'
+  opsgenie_api_key_file: '/etc/secrets/opsgenie'
+  telegram_api_url: 'https://api.telegram.org'
+  victorops_api_url: 'https://alert.victorops.com/integrations/generic/20131114/alert/'
+  victorops_api_key: 'vo-key'
+  wechat_api_url: 'https://qyapi.weixin.qq.com/cgi-bin/'
+  wechat_api_secret: 'secret'
+  wechat_api_corp_id: 'corp'
+  webex_api_url: 'https://webexapis.com/v1/messages'
+  jira_api_url: 'https://example.atlassian.net'
+  rocketchat_api_url: 'https://open.rocket.chat'
+  rocketchat_token: 'token'
+  rocketchat_token_id: 'token-id'
+  http_config:
+    bearer_token_file: '/etc/secrets/bearer'
+route:
+  receiver: 'default'
+receivers:
+  - name: 'default'
+`
+	config, err := ParseAlertmanagerConfigFromBytes([]byte(yaml))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+
+	code, err := GenerateAlertmanagerGoCode(config, "monitoring")
+	if err != nil {
+		t.Fatalf("generate failed: %v", err)
+	}
+
+	codeStr := strings.Join(strings.Fields(string(code)), " ")
+	for _, name := range setFieldNames(reflect.ValueOf(config.Global)) {
+		if !strings.Contains(codeStr, name+":") {
+			t.Errorf("generated code missing field %s\nGot:\n%s", name, code)
+		}
+	}
+	expectations := []string{
+		"SMTPRequireTLS: new(bool),",
+		`TelegramAPIURL: "https://api.telegram.org",`,
+		`WebexAPIURL: "https://webexapis.com/v1/messages",`,
+		`VictorOpsAPIKey: "<secret>",`,
+		`RocketChatTokenID: "<secret>",`,
+		`HTTPConfig: &alertmanager.HTTPConfig{ BearerTokenFile: "/etc/secrets/bearer", },`,
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(codeStr, exp) {
+			t.Errorf("generated code missing %q\nGot:\n%s", exp, code)
+		}
+	}
+}
+
+// setFieldNames returns the names of the non-zero fields of the struct v
+// points to, including those of nested structs.
+func setFieldNames(v reflect.Value) []string {
+	for v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	var names []string
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.IsZero() {
+			continue
+		}
+		names = append(names, v.Type().Field(i).Name)
+		if f.Kind() == reflect.Pointer && f.Elem().Kind() == reflect.Struct {
+			names = append(names, setFieldNames(f)...)
+		}
+	}
+	return names
+}
+
+func TestGenerateAlertmanagerGoCode_TimeIntervals(t *testing.T) {
+	yaml := `
+route:
+  receiver: 'default'
+  routes:
+    - receiver: 'default'
+      active_time_intervals: ['office']
+receivers:
+  - name: 'default'
+time_intervals:
+  - name: 'office'
+    time_intervals:
+      - weekdays: ['monday:friday']
+        times:
+          - start_time: '09:00'
+            end_time: '17:00'
+        location: 'Europe/Berlin'
+`
+	config, err := ParseAlertmanagerConfigFromBytes([]byte(yaml))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+
+	code, err := GenerateAlertmanagerGoCode(config, "monitoring")
+	if err != nil {
+		t.Fatalf("generate failed: %v", err)
+	}
+
+	codeStr := strings.Join(strings.Fields(string(code)), " ")
+	expectations := []string{
+		"var OfficeTimeInterval = &alertmanager.MuteTimeInterval{",
+		`{StartTime: "09:00", EndTime: "17:00"},`,
+		`Weekdays: []alertmanager.WeekdayRange{"monday:friday"},`,
+		`Location: "Europe/Berlin",`,
+		`ActiveTimeIntervals: []string{"office"},`,
+		"TimeIntervals: []*alertmanager.MuteTimeInterval{ OfficeTimeInterval, },",
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(codeStr, exp) {
+			t.Errorf("generated code missing %q\nGot:\n%s", exp, code)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"go/format"
+	"sort"
 	"strings"
 	"text/template"
 	"time"
@@ -62,6 +63,9 @@ func (g *codeGenerator) generate() ([]byte, error) {
 		}
 	}
 
+	// Generate server sections
+	g.writeServerSections(&buf)
+
 	// Generate the main config
 	if err := g.writeMainConfig(&buf); err != nil {
 		return nil, err
@@ -99,6 +103,12 @@ func (g *codeGenerator) writeGlobalConfig(buf *bytes.Buffer) error {
 		}
 		buf.WriteString("\t},\n")
 	}
+	if gc.QueryLogFile != "" {
+		buf.WriteString(fmt.Sprintf("\tQueryLogFile: %q,\n", gc.QueryLogFile))
+	}
+	if len(gc.ScrapeProtocols) > 0 {
+		buf.WriteString("\tScrapeProtocols: []prometheus.ScrapeProtocol{" + g.formatScrapeProtocols(gc.ScrapeProtocols) + "},\n")
+	}
 
 	buf.WriteString("}\n\n")
 	return nil
@@ -117,6 +127,30 @@ func (g *codeGenerator) writeScrapeConfig(buf *bytes.Buffer, sc *prometheus.Scra
 	if sc.ScrapeTimeout != 0 {
 		buf.WriteString(fmt.Sprintf(".\n\tWithTimeout(%s)", g.formatDuration(sc.ScrapeTimeout)))
 	}
+	g.writeHTTPClient(buf, &sc.HTTPClientConfig, "\t", true)
+
+	// Scrape protocols and native histograms
+	if len(sc.ScrapeProtocols) > 0 {
+		buf.WriteString(".\n\tWithScrapeProtocols(" + g.formatScrapeProtocols(sc.ScrapeProtocols) + ")")
+	}
+	if sc.FallbackScrapeProtocol != "" {
+		buf.WriteString(".\n\tWithFallbackScrapeProtocol(" + g.formatScrapeProtocol(sc.FallbackScrapeProtocol) + ")")
+	}
+	if sc.ScrapeNativeHistograms != nil && *sc.ScrapeNativeHistograms {
+		buf.WriteString(".\n\tWithNativeHistograms()")
+	}
+	if sc.AlwaysScrapeClassicHistograms {
+		buf.WriteString(".\n\tWithAlwaysScrapeClassicHistograms()")
+	}
+	if sc.NativeHistogramBucketLimit != 0 {
+		buf.WriteString(fmt.Sprintf(".\n\tWithNativeHistogramBucketLimit(%d)", sc.NativeHistogramBucketLimit))
+	}
+	if sc.NativeHistogramMinBucketFactor != 0 {
+		buf.WriteString(fmt.Sprintf(".\n\tWithNativeHistogramMinBucketFactor(%v)", sc.NativeHistogramMinBucketFactor))
+	}
+	if sc.ConvertClassicHistogramsToNHCB {
+		buf.WriteString(".\n\tWithConvertClassicHistogramsToNHCB()")
+	}
 
 	// Static configs
 	for _, static := range sc.StaticConfigs {
@@ -136,6 +170,38 @@ func (g *codeGenerator) writeScrapeConfig(buf *bytes.Buffer, sc *prometheus.Scra
 		buf.WriteString(")")
 	}
 
+	// Other service discovery mechanisms
+	for _, sd := range sc.HTTPSDConfigs {
+		buf.WriteString(".\n\tWithHTTPSD(" + g.formatHTTPSD(sd) + ")")
+	}
+	for _, sd := range sc.GCESDConfigs {
+		buf.WriteString(".\n\tWithGCESD(" + g.formatGCESD(sd) + ")")
+	}
+	for _, sd := range sc.AzureSDConfigs {
+		buf.WriteString(".\n\tWithAzureSD(" + g.formatAzureSD(sd) + ")")
+	}
+	for _, sd := range sc.DockerSDConfigs {
+		buf.WriteString(".\n\tWithDockerSD(" + g.formatDockerSD(sd) + ")")
+	}
+	for _, sd := range sc.DockerSwarmSDConfigs {
+		buf.WriteString(".\n\tWithDockerSwarmSD(" + g.formatDockerSwarmSD(sd) + ")")
+	}
+	for _, sd := range sc.NomadSDConfigs {
+		buf.WriteString(".\n\tWithNomadSD(" + g.formatNomadSD(sd) + ")")
+	}
+	for _, sd := range sc.EurekaSDConfigs {
+		buf.WriteString(".\n\tWithEurekaSD(" + g.formatEurekaSD(sd) + ")")
+	}
+	for _, sd := range sc.OpenStackSDConfigs {
+		buf.WriteString(".\n\tWithOpenStackSD(" + g.formatOpenStackSD(sd) + ")")
+	}
+	for _, sd := range sc.HetznerSDConfigs {
+		buf.WriteString(".\n\tWithHetznerSD(" + g.formatHetznerSD(sd) + ")")
+	}
+	for _, sd := range sc.LinodeSDConfigs {
+		buf.WriteString(".\n\tWithLinodeSD(" + g.formatLinodeSD(sd) + ")")
+	}
+
 	buf.WriteString("\n\n")
 	return nil
 }
@@ -165,13 +231,337 @@ func (g *codeGenerator) formatKubernetesSD(sd *prometheus.KubernetesSD) string {
 		}
 	}
 
-	if sd.BearerTokenFile != "" {
-		buf.WriteString(fmt.Sprintf(".\n\t\tWithBearerTokenFile(%q)", sd.BearerTokenFile))
+	g.writeHTTPClient(&buf, &sd.HTTPClientConfig, "\t\t", false)
+
+	return buf.String()
+}
+
+func (g *codeGenerator) formatHTTPSD(sd *prometheus.HTTPSD) string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("prometheus.NewHTTPSD(%q)", sd.URL))
+	g.writeRefreshInterval(&buf, sd.RefreshInterval)
+	g.writeHTTPClient(&buf, &sd.HTTPClientConfig, "\t\t", false)
+	return buf.String()
+}
+
+func (g *codeGenerator) formatGCESD(sd *prometheus.GCESD) string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("prometheus.NewGCESD(%q, %q)", sd.Project, sd.Zone))
+	if sd.Filter != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithFilter(%q)", sd.Filter))
+	}
+	g.writeRefreshInterval(&buf, sd.RefreshInterval)
+	g.writePort(&buf, sd.Port)
+	if sd.TagSeparator != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithTagSeparator(%q)", sd.TagSeparator))
+	}
+	return buf.String()
+}
+
+func (g *codeGenerator) formatAzureSD(sd *prometheus.AzureSD) string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("prometheus.NewAzureSD(%q)", sd.SubscriptionID))
+	switch sd.AuthenticationMethod {
+	case prometheus.AzureAuthManagedIdentity:
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithManagedIdentity(%q)", sd.ClientID))
+	case prometheus.AzureAuthSDK:
+		buf.WriteString(".\n\t\tWithSDKAuth()")
+	default:
+		if sd.TenantID != "" || sd.ClientID != "" || sd.ClientSecret != "" {
+			buf.WriteString(fmt.Sprintf(".\n\t\tWithOAuth(%q, %q, %q)", sd.TenantID, sd.ClientID, sd.ClientSecret))
+		}
+	}
+	if sd.Environment != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithEnvironment(%q)", sd.Environment))
+	}
+	if sd.ResourceGroup != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithResourceGroup(%q)", sd.ResourceGroup))
+	}
+	g.writeRefreshInterval(&buf, sd.RefreshInterval)
+	g.writePort(&buf, sd.Port)
+	// Azure authenticates with its own settings above
+	client := sd.HTTPClientConfig
+	client.BasicAuth, client.Authorization, client.OAuth2 = nil, nil, nil
+	client.BearerToken, client.BearerTokenFile = "", ""
+	g.writeHTTPClient(&buf, &client, "\t\t", false)
+	return buf.String()
+}
+
+func (g *codeGenerator) formatDockerSD(sd *prometheus.DockerSD) string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("prometheus.NewDockerSD(%q)", sd.Host))
+	g.writePort(&buf, sd.Port)
+	if sd.HostNetworkingHost != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithHostNetworkingHost(%q)", sd.HostNetworkingHost))
+	}
+	if sd.MatchFirstNetwork != nil {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithMatchFirstNetwork(%t)", *sd.MatchFirstNetwork))
+	}
+	g.writeDockerFilters(&buf, sd.Filters)
+	g.writeRefreshInterval(&buf, sd.RefreshInterval)
+	g.writeHTTPClient(&buf, &sd.HTTPClientConfig, "\t\t", false)
+	return buf.String()
+}
+
+func (g *codeGenerator) formatDockerSwarmSD(sd *prometheus.DockerSwarmSD) string {
+	var buf bytes.Buffer
+	role := "prometheus.DockerSwarmRoleTasks"
+	switch sd.Role {
+	case prometheus.DockerSwarmRoleServices:
+		role = "prometheus.DockerSwarmRoleServices"
+	case prometheus.DockerSwarmRoleNodes:
+		role = "prometheus.DockerSwarmRoleNodes"
+	case prometheus.DockerSwarmRoleTasks:
+	default:
+		role = fmt.Sprintf("prometheus.DockerSwarmRole(%q)", sd.Role)
+	}
+	buf.WriteString(fmt.Sprintf("prometheus.NewDockerSwarmSD(%q, %s)", sd.Host, role))
+	g.writePort(&buf, sd.Port)
+	g.writeDockerFilters(&buf, sd.Filters)
+	g.writeRefreshInterval(&buf, sd.RefreshInterval)
+	g.writeHTTPClient(&buf, &sd.HTTPClientConfig, "\t\t", false)
+	return buf.String()
+}
+
+func (g *codeGenerator) formatNomadSD(sd *prometheus.NomadSD) string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("prometheus.NewNomadSD(%q)", sd.Server))
+	if sd.Namespace != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithNamespace(%q)", sd.Namespace))
+	}
+	if sd.Region != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithRegion(%q)", sd.Region))
+	}
+	if sd.AllowStale != nil {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithAllowStale(%t)", *sd.AllowStale))
+	}
+	if sd.TagSeparator != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithTagSeparator(%q)", sd.TagSeparator))
+	}
+	g.writeRefreshInterval(&buf, sd.RefreshInterval)
+	g.writeHTTPClient(&buf, &sd.HTTPClientConfig, "\t\t", false)
+	return buf.String()
+}
+
+func (g *codeGenerator) formatEurekaSD(sd *prometheus.EurekaSD) string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("prometheus.NewEurekaSD(%q)", sd.Server))
+	g.writeRefreshInterval(&buf, sd.RefreshInterval)
+	g.writeHTTPClient(&buf, &sd.HTTPClientConfig, "\t\t", false)
+	return buf.String()
+}
+
+func (g *codeGenerator) formatOpenStackSD(sd *prometheus.OpenStackSD) string {
+	var buf bytes.Buffer
+	role := "prometheus.OpenStackRoleInstance"
+	switch sd.Role {
+	case prometheus.OpenStackRoleHypervisor:
+		role = "prometheus.OpenStackRoleHypervisor"
+	case prometheus.OpenStackRoleLoadBalancer:
+		role = "prometheus.OpenStackRoleLoadBalancer"
+	case prometheus.OpenStackRoleInstance:
+	default:
+		role = fmt.Sprintf("prometheus.OpenStackRole(%q)", sd.Role)
+	}
+	buf.WriteString(fmt.Sprintf("prometheus.NewOpenStackSD(%s, %q)", role, sd.Region))
+	if sd.IdentityEndpoint != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithIdentityEndpoint(%q)", sd.IdentityEndpoint))
+	}
+	if sd.Username != "" || sd.Password != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithPassword(%q, %q)", sd.Username, sd.Password))
+	}
+	if sd.DomainName != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithDomainName(%q)", sd.DomainName))
+	}
+	if sd.ProjectName != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithProjectName(%q)", sd.ProjectName))
+	}
+	if sd.ApplicationCredentialID != "" || sd.ApplicationCredentialSecret != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithApplicationCredential(%q, %q)", sd.ApplicationCredentialID, sd.ApplicationCredentialSecret))
+	}
+	if sd.AllTenants {
+		buf.WriteString(".\n\t\tWithAllTenants()")
+	}
+	g.writeRefreshInterval(&buf, sd.RefreshInterval)
+	g.writePort(&buf, sd.Port)
+	if sd.Availability != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithAvailability(%q)", sd.Availability))
 	}
+	g.writeHTTPClient(&buf, &prometheus.HTTPClientConfig{TLSConfig: sd.TLSConfig}, "\t\t", false)
+	return buf.String()
+}
 
+func (g *codeGenerator) formatHetznerSD(sd *prometheus.HetznerSD) string {
+	var buf bytes.Buffer
+	role := "prometheus.HetznerRoleHcloud"
+	switch sd.Role {
+	case prometheus.HetznerRoleRobot:
+		role = "prometheus.HetznerRoleRobot"
+	case prometheus.HetznerRoleHcloud:
+	default:
+		role = fmt.Sprintf("prometheus.HetznerRole(%q)", sd.Role)
+	}
+	buf.WriteString(fmt.Sprintf("prometheus.NewHetznerSD(%s)", role))
+	g.writePort(&buf, sd.Port)
+	g.writeRefreshInterval(&buf, sd.RefreshInterval)
+	g.writeHTTPClient(&buf, &sd.HTTPClientConfig, "\t\t", false)
 	return buf.String()
 }
 
+func (g *codeGenerator) formatLinodeSD(sd *prometheus.LinodeSD) string {
+	var buf bytes.Buffer
+	buf.WriteString("prometheus.NewLinodeSD()")
+	if sd.Region != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithRegion(%q)", sd.Region))
+	}
+	g.writePort(&buf, sd.Port)
+	if sd.TagSeparator != "" {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithTagSeparator(%q)", sd.TagSeparator))
+	}
+	g.writeRefreshInterval(&buf, sd.RefreshInterval)
+	g.writeHTTPClient(&buf, &sd.HTTPClientConfig, "\t\t", false)
+	return buf.String()
+}
+
+func (g *codeGenerator) writeRefreshInterval(buf *bytes.Buffer, d prometheus.Duration) {
+	if d != 0 {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithRefreshInterval(%s)", g.formatDuration(d)))
+	}
+}
+
+func (g *codeGenerator) writePort(buf *bytes.Buffer, port int) {
+	if port != 0 {
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithPort(%d)", port))
+	}
+}
+
+func (g *codeGenerator) writeDockerFilters(buf *bytes.Buffer, filters []prometheus.DockerFilter) {
+	for _, f := range filters {
+		args := []string{fmt.Sprintf("%q", f.Name)}
+		for _, v := range f.Values {
+			args = append(args, fmt.Sprintf("%q", v))
+		}
+		buf.WriteString(fmt.Sprintf(".\n\t\tWithFilter(%s)", strings.Join(args, ", ")))
+	}
+}
+
+// writeHTTPClient emits the builders for the HTTP client settings shared by
+// scrape, service discovery and remote configurations. Endpoint builders take
+// basic auth credentials as arguments; service discovery builders take a
+// *prometheus.BasicAuth.
+func (g *codeGenerator) writeHTTPClient(buf *bytes.Buffer, c *prometheus.HTTPClientConfig, indent string, endpoint bool) {
+	if auth := c.BasicAuth; auth != nil {
+		switch {
+		case !endpoint:
+			buf.WriteString(".\n" + indent + "WithBasicAuth(" + g.formatBasicAuth(auth) + ")")
+		case auth.PasswordFile != "":
+			buf.WriteString(fmt.Sprintf(".\n%sWithBasicAuthFile(%q, %q)", indent, auth.Username, auth.PasswordFile))
+		default:
+			buf.WriteString(fmt.Sprintf(".\n%sWithBasicAuth(%q, %q)", indent, auth.Username, auth.Password))
+		}
+	}
+	if authz := c.Authorization; authz != nil {
+		if authz.CredentialsFile != "" {
+			buf.WriteString(fmt.Sprintf(".\n%sWithAuthorizationFile(%q)", indent, authz.CredentialsFile))
+		} else {
+			buf.WriteString(fmt.Sprintf(".\n%sWithAuthorization(%q)", indent, authz.Credentials))
+		}
+	}
+	if c.OAuth2 != nil {
+		buf.WriteString(".\n" + indent + "WithOAuth2(" + g.formatOAuth2(c.OAuth2) + ")")
+	}
+	if c.BearerToken != "" {
+		buf.WriteString(fmt.Sprintf(".\n%sWithBearerToken(%q)", indent, c.BearerToken))
+	}
+	if c.BearerTokenFile != "" {
+		buf.WriteString(fmt.Sprintf(".\n%sWithBearerTokenFile(%q)", indent, c.BearerTokenFile))
+	}
+	if c.TLSConfig != nil {
+		buf.WriteString(".\n" + indent + "WithTLSConfig(" + g.formatTLSConfig(c.TLSConfig) + ")")
+	}
+	if c.ProxyURL != "" {
+		buf.WriteString(fmt.Sprintf(".\n%sWithProxyURL(%q)", indent, c.ProxyURL))
+	}
+	if c.NoProxy != "" {
+		buf.WriteString(fmt.Sprintf(".\n%sWithNoProxy(%q)", indent, c.NoProxy))
+	}
+	if c.ProxyFromEnvironment {
+		buf.WriteString(".\n" + indent + "WithProxyFromEnvironment()")
+	}
+	headers := make([]string, 0, len(c.ProxyConnectHeader))
+	for name := range c.ProxyConnectHeader {
+		headers = append(headers, name)
+	}
+	sort.Strings(headers)
+	for _, name := range headers {
+		args := []string{fmt.Sprintf("%q", name)}
+		for _, v := range c.ProxyConnectHeader[name] {
+			args = append(args, fmt.Sprintf("%q", v))
+		}
+		buf.WriteString(fmt.Sprintf(".\n%sWithProxyConnectHeader(%s)", indent, strings.Join(args, ", ")))
+	}
+	if c.FollowRedirects != nil {
+		buf.WriteString(fmt.Sprintf(".\n%sWithFollowRedirects(%t)", indent, *c.FollowRedirects))
+	}
+	if c.EnableHTTP2 != nil {
+		buf.WriteString(fmt.Sprintf(".\n%sWithEnableHTTP2(%t)", indent, *c.EnableHTTP2))
+	}
+}
+
+func (g *codeGenerator) formatOAuth2(o *prometheus.OAuth2) string {
+	s := fmt.Sprintf("prometheus.NewOAuth2(%q, %q, %q)", o.ClientID, o.ClientSecret, o.TokenURL)
+	if o.ClientSecretFile != "" {
+		s += fmt.Sprintf(".WithClientSecretFile(%q)", o.ClientSecretFile)
+	}
+	if len(o.Scopes) > 0 {
+		s += ".WithScopes(" + g.formatStrings(o.Scopes) + ")"
+	}
+	if len(o.EndpointParams) > 0 {
+		s += ".WithEndpointParams(" + g.formatStringMap(o.EndpointParams) + ")"
+	}
+	if o.TLSConfig != nil {
+		s += ".WithTLSConfig(" + g.formatTLSConfig(o.TLSConfig) + ")"
+	}
+	if o.ProxyURL != "" {
+		s += fmt.Sprintf(".WithProxyURL(%q)", o.ProxyURL)
+	}
+	return s
+}
+
+func (g *codeGenerator) formatBasicAuth(auth *prometheus.BasicAuth) string {
+	var fields []string
+	if auth.Username != "" {
+		fields = append(fields, fmt.Sprintf("Username: %q", auth.Username))
+	}
+	if auth.Password != "" {
+		fields = append(fields, fmt.Sprintf("Password: %q", auth.Password))
+	}
+	if auth.PasswordFile != "" {
+		fields = append(fields, fmt.Sprintf("PasswordFile: %q", auth.PasswordFile))
+	}
+	return "&prometheus.BasicAuth{" + strings.Join(fields, ", ") + "}"
+}
+
+func (g *codeGenerator) formatTLSConfig(tls *prometheus.TLSConfig) string {
+	var fields []string
+	if tls.CAFile != "" {
+		fields = append(fields, fmt.Sprintf("CAFile: %q", tls.CAFile))
+	}
+	if tls.CertFile != "" {
+		fields = append(fields, fmt.Sprintf("CertFile: %q", tls.CertFile))
+	}
+	if tls.KeyFile != "" {
+		fields = append(fields, fmt.Sprintf("KeyFile: %q", tls.KeyFile))
+	}
+	if tls.ServerName != "" {
+		fields = append(fields, fmt.Sprintf("ServerName: %q", tls.ServerName))
+	}
+	if tls.InsecureSkipVerify {
+		fields = append(fields, "InsecureSkipVerify: true")
+	}
+	return "&prometheus.TLSConfig{" + strings.Join(fields, ", ") + "}"
+}
+
 func (g *codeGenerator) capitalizeRole(role string) string {
 	switch role {
 	case "pod":
@@ -208,14 +598,55 @@ func (g *codeGenerator) writeRemoteWrite(buf *bytes.Buffer, rw *prometheus.Remot
 	if rw.RemoteTimeout != 0 {
 		buf.WriteString(fmt.Sprintf(".\n\tWithTimeout(%s)", g.formatDuration(rw.RemoteTimeout)))
 	}
-	if rw.BearerTokenFile != "" {
-		buf.WriteString(fmt.Sprintf(".\n\tWithBearerTokenFile(%q)", rw.BearerTokenFile))
+	g.writeHTTPClient(buf, &rw.HTTPClientConfig, "\t", true)
+	if rw.SigV4 != nil {
+		buf.WriteString(".\n\tWithSigV4(" + g.formatSigV4(rw.SigV4) + ")")
+	}
+	if rw.AzureAD != nil {
+		buf.WriteString(".\n\tWithAzureAD(" + g.formatAzureAD(rw.AzureAD) + ")")
+	}
+	if rw.GoogleIAM != nil {
+		buf.WriteString(fmt.Sprintf(".\n\tWithGoogleIAM(prometheus.NewGoogleIAM(%q))", rw.GoogleIAM.CredentialsFile))
 	}
 
 	buf.WriteString("\n\n")
 	return nil
 }
 
+func (g *codeGenerator) formatSigV4(s *prometheus.SigV4Config) string {
+	out := fmt.Sprintf("prometheus.NewSigV4(%q)", s.Region)
+	if s.AccessKey != "" || s.SecretKey != "" {
+		out += fmt.Sprintf(".WithAccessKey(%q, %q)", s.AccessKey, s.SecretKey)
+	}
+	if s.Profile != "" {
+		out += fmt.Sprintf(".WithProfile(%q)", s.Profile)
+	}
+	if s.RoleARN != "" {
+		out += fmt.Sprintf(".WithRoleARN(%q)", s.RoleARN)
+	}
+	return out
+}
+
+func (g *codeGenerator) formatAzureAD(a *prometheus.AzureADConfig) string {
+	var out string
+	switch {
+	case a.ManagedIdentity != nil:
+		out = fmt.Sprintf("prometheus.NewAzureADManagedIdentity(%q)", a.ManagedIdentity.ClientID)
+	case a.OAuth != nil:
+		out = fmt.Sprintf("prometheus.NewAzureADOAuth(%q, %q, %q)", a.OAuth.ClientID, a.OAuth.ClientSecret, a.OAuth.TenantID)
+	default:
+		tenant := ""
+		if a.SDK != nil {
+			tenant = a.SDK.TenantID
+		}
+		out = fmt.Sprintf("prometheus.NewAzureADSDK(%q)", tenant)
+	}
+	if a.Cloud != "" {
+		out += fmt.Sprintf(".WithCloud(%q)", a.Cloud)
+	}
+	return out
+}
+
 func (g *codeGenerator) writeRemoteRead(buf *bytes.Buffer, rr *prometheus.RemoteReadConfig, index int) error {
 	varName := "RemoteRead"
 	if rr.Name != "" {
@@ -236,11 +667,291 @@ func (g *codeGenerator) writeRemoteRead(buf *bytes.Buffer, rr *prometheus.Remote
 	if rr.ReadRecent {
 		buf.WriteString(".\n\tWithReadRecent(true)")
 	}
+	g.writeHTTPClient(buf, &rr.HTTPClientConfig, "\t", true)
 
 	buf.WriteString("\n\n")
 	return nil
 }
 
+// writeServerSections emits the runtime, alerting, storage, tracing and OTLP
+// sections as package-level variables referenced by the main config.
+func (g *codeGenerator) writeServerSections(buf *bytes.Buffer) {
+	if rt := g.config.Runtime; rt != nil {
+		buf.WriteString("// Runtime configures the Prometheus Go runtime.\n")
+		buf.WriteString("var Runtime = prometheus.NewRuntimeConfig()")
+		if rt.GOGC != nil {
+			buf.WriteString(fmt.Sprintf(".\n\tWithGOGC(%d)", *rt.GOGC))
+		}
+		buf.WriteString("\n\n")
+	}
+
+	if al := g.config.Alerting; al != nil {
+		buf.WriteString("// Alerting configures Alertmanager communication.\n")
+		buf.WriteString("var Alerting = &prometheus.AlertingConfig{\n")
+		if len(al.Alertmanagers) > 0 {
+			buf.WriteString("\tAlertmanagers: []*prometheus.AlertmanagerConfig{\n")
+			for _, am := range al.Alertmanagers {
+				g.writeAlertmanagerConfig(buf, am)
+			}
+			buf.WriteString("\t},\n")
+		}
+		if len(al.AlertRelabelConfigs) > 0 {
+			buf.WriteString("\tAlertRelabelConfigs: []*prometheus.RelabelConfig{\n")
+			for _, rc := range al.AlertRelabelConfigs {
+				buf.WriteString("\t\t" + g.formatRelabelConfig(rc) + ",\n")
+			}
+			buf.WriteString("\t},\n")
+		}
+		buf.WriteString("}\n\n")
+	}
+
+	if st := g.config.Storage; st != nil {
+		buf.WriteString("// Storage configures the local TSDB and exemplar store.\n")
+		buf.WriteString("var Storage = prometheus.NewStorageConfig()")
+		if st.TSDB != nil && st.TSDB.OutOfOrderTimeWindow != 0 {
+			buf.WriteString(fmt.Sprintf(".\n\tWithOutOfOrderTimeWindow(%s)", g.formatDuration(st.TSDB.OutOfOrderTimeWindow)))
+		}
+		if st.Exemplars != nil && st.Exemplars.MaxExemplars != 0 {
+			buf.WriteString(fmt.Sprintf(".\n\tWithMaxExemplars(%d)", st.Exemplars.MaxExemplars))
+		}
+		buf.WriteString("\n\n")
+	}
+
+	if tr := g.config.Tracing; tr != nil {
+		buf.WriteString("// Tracing configures export of Prometheus traces.\n")
+		buf.WriteString(fmt.Sprintf("var Tracing = prometheus.NewTracingConfig(%q)", tr.Endpoint))
+		switch tr.ClientType {
+		case "":
+		case prometheus.TracingClientGRPC:
+			buf.WriteString(".\n\tWithClientType(prometheus.TracingClientGRPC)")
+		case prometheus.TracingClientHTTP:
+			buf.WriteString(".\n\tWithClientType(prometheus.TracingClientHTTP)")
+		default:
+			buf.WriteString(fmt.Sprintf(".\n\tWithClientType(%q)", tr.ClientType))
+		}
+		if tr.SamplingFraction != 0 {
+			buf.WriteString(fmt.Sprintf(".\n\tWithSamplingFraction(%v)", tr.SamplingFraction))
+		}
+		if tr.Insecure {
+			buf.WriteString(".\n\tWithInsecure()")
+		}
+		if tr.TLSConfig != nil {
+			buf.WriteString(".\n\tWithTLSConfig(" + g.formatTLSConfig(tr.TLSConfig) + ")")
+		}
+		if len(tr.Headers) > 0 {
+			buf.WriteString(".\n\tWithHeaders(" + g.formatStringMap(tr.Headers) + ")")
+		}
+		if tr.Compression != "" {
+			buf.WriteString(fmt.Sprintf(".\n\tWithCompression(%q)", tr.Compression))
+		}
+		if tr.Timeout != 0 {
+			buf.WriteString(fmt.Sprintf(".\n\tWithTimeout(%s)", g.formatDuration(tr.Timeout)))
+		}
+		buf.WriteString("\n\n")
+	}
+
+	if o := g.config.OTLP; o != nil {
+		buf.WriteString("// OTLP configures the OTLP receiver.\n")
+		buf.WriteString("var OTLP = prometheus.NewOTLPConfig()")
+		if len(o.PromoteResourceAttributes) > 0 {
+			buf.WriteString(".\n\tWithPromoteResourceAttributes(" + g.formatStrings(o.PromoteResourceAttributes) + ")")
+		}
+		if o.PromoteAllResourceAttributes {
+			buf.WriteString(".\n\tWithPromoteAllResourceAttributes(" + g.formatStrings(o.IgnoreResourceAttributes) + ")")
+		}
+		if o.TranslationStrategy != "" {
+			buf.WriteString(fmt.Sprintf(".\n\tWithTranslationStrategy(%q)", o.TranslationStrategy))
+		}
+		if o.KeepIdentifyingResourceAttributes {
+			buf.WriteString(".\n\tWithKeepIdentifyingResourceAttributes()")
+		}
+		if o.ConvertHistogramsToNHCB {
+			buf.WriteString(".\n\tWithConvertHistogramsToNHCB()")
+		}
+		buf.WriteString("\n\n")
+	}
+}
+
+func (g *codeGenerator) writeAlertmanagerConfig(buf *bytes.Buffer, am *prometheus.AlertmanagerConfig) {
+	buf.WriteString("\t\t{\n")
+	if len(am.StaticConfigs) > 0 {
+		buf.WriteString("\t\t\tStaticConfigs: []*prometheus.StaticConfig{\n")
+		for _, sc := range am.StaticConfigs {
+			buf.WriteString("\t\t\t\t{Targets: []string{" + g.formatStrings(sc.Targets) + "}")
+			if len(sc.Labels) > 0 {
+				buf.WriteString(", Labels: " + g.formatStringMap(sc.Labels))
+			}
+			buf.WriteString("},\n")
+		}
+		buf.WriteString("\t\t\t},\n")
+	}
+	if am.Scheme != "" {
+		buf.WriteString(fmt.Sprintf("\t\t\tScheme: %q,\n", am.Scheme))
+	}
+	if am.PathPrefix != "" {
+		buf.WriteString(fmt.Sprintf("\t\t\tPathPrefix: %q,\n", am.PathPrefix))
+	}
+	if am.Timeout != 0 {
+		buf.WriteString(fmt.Sprintf("\t\t\tTimeout: %s,\n", g.formatDuration(am.Timeout)))
+	}
+	if am.APIVersion != "" {
+		buf.WriteString(fmt.Sprintf("\t\t\tAPIVersion: %q,\n", am.APIVersion))
+	}
+	if client := g.formatHTTPClientFields(&am.HTTPClientConfig); client != "" {
+		buf.WriteString("\t\t\tHTTPClientConfig: prometheus.HTTPClientConfig{" + client + "},\n")
+	}
+	if am.SigV4 != nil {
+		buf.WriteString("\t\t\tSigV4: " + g.formatSigV4(am.SigV4) + ",\n")
+	}
+	buf.WriteString("\t\t},\n")
+}
+
+// formatHTTPClientFields formats the fields of an HTTPClientConfig literal,
+// for configs without HTTP client builders.
+func (g *codeGenerator) formatHTTPClientFields(c *prometheus.HTTPClientConfig) string {
+	var fields []string
+	if c.BasicAuth != nil {
+		fields = append(fields, "BasicAuth: "+g.formatBasicAuth(c.BasicAuth))
+	}
+	if a := c.Authorization; a != nil {
+		var auth []string
+		if a.Type != "" {
+			auth = append(auth, fmt.Sprintf("Type: %q", a.Type))
+		}
+		if a.Credentials != "" {
+			auth = append(auth, fmt.Sprintf("Credentials: %q", a.Credentials))
+		}
+		if a.CredentialsFile != "" {
+			auth = append(auth, fmt.Sprintf("CredentialsFile: %q", a.CredentialsFile))
+		}
+		fields = append(fields, "Authorization: &prometheus.Authorization{"+strings.Join(auth, ", ")+"}")
+	}
+	if c.OAuth2 != nil {
+		fields = append(fields, "OAuth2: "+g.formatOAuth2(c.OAuth2))
+	}
+	if c.BearerToken != "" {
+		fields = append(fields, fmt.Sprintf("BearerToken: %q", c.BearerToken))
+	}
+	if c.BearerTokenFile != "" {
+		fields = append(fields, fmt.Sprintf("BearerTokenFile: %q", c.BearerTokenFile))
+	}
+	if c.TLSConfig != nil {
+		fields = append(fields, "TLSConfig: "+g.formatTLSConfig(c.TLSConfig))
+	}
+	if c.ProxyURL != "" {
+		fields = append(fields, fmt.Sprintf("ProxyURL: %q", c.ProxyURL))
+	}
+	if c.NoProxy != "" {
+		fields = append(fields, fmt.Sprintf("NoProxy: %q", c.NoProxy))
+	}
+	if c.ProxyFromEnvironment {
+		fields = append(fields, "ProxyFromEnvironment: true")
+	}
+	if len(c.ProxyConnectHeader) > 0 {
+		names := make([]string, 0, len(c.ProxyConnectHeader))
+		for name := range c.ProxyConnectHeader {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		entries := make([]string, len(names))
+		for i, name := range names {
+			values := make([]string, len(c.ProxyConnectHeader[name]))
+			for j, v := range c.ProxyConnectHeader[name] {
+				values[j] = fmt.Sprintf("%q", v)
+			}
+			entries[i] = fmt.Sprintf("%q: {%s}", name, strings.Join(values, ", "))
+		}
+		fields = append(fields, "ProxyConnectHeader: map[string][]prometheus.Secret{"+strings.Join(entries, ", ")+"}")
+	}
+	if c.FollowRedirects != nil {
+		fields = append(fields, "FollowRedirects: "+formatBoolPtr(*c.FollowRedirects))
+	}
+	if c.EnableHTTP2 != nil {
+		fields = append(fields, "EnableHTTP2: "+formatBoolPtr(*c.EnableHTTP2))
+	}
+	return strings.Join(fields, ", ")
+}
+
+// formatBoolPtr formats a *bool expression for struct literals.
+func formatBoolPtr(b bool) string {
+	if !b {
+		return "new(bool)"
+	}
+	return "&[]bool{true}[0]"
+}
+
+func (g *codeGenerator) formatRelabelConfig(rc *prometheus.RelabelConfig) string {
+	var fields []string
+	if len(rc.SourceLabels) > 0 {
+		fields = append(fields, "SourceLabels: []string{"+g.formatStrings(rc.SourceLabels)+"}")
+	}
+	if rc.Separator != "" {
+		fields = append(fields, fmt.Sprintf("Separator: %q", rc.Separator))
+	}
+	if rc.Regex != "" {
+		fields = append(fields, fmt.Sprintf("Regex: %q", rc.Regex))
+	}
+	if rc.Modulus != 0 {
+		fields = append(fields, fmt.Sprintf("Modulus: %d", rc.Modulus))
+	}
+	if rc.TargetLabel != "" {
+		fields = append(fields, fmt.Sprintf("TargetLabel: %q", rc.TargetLabel))
+	}
+	if rc.Replacement != "" {
+		fields = append(fields, fmt.Sprintf("Replacement: %q", rc.Replacement))
+	}
+	if rc.Action != "" {
+		fields = append(fields, fmt.Sprintf("Action: %q", rc.Action))
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+func (g *codeGenerator) formatScrapeProtocols(protocols []prometheus.ScrapeProtocol) string {
+	parts := make([]string, len(protocols))
+	for i, p := range protocols {
+		parts[i] = g.formatScrapeProtocol(p)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (g *codeGenerator) formatScrapeProtocol(p prometheus.ScrapeProtocol) string {
+	switch p {
+	case prometheus.ScrapeProtocolPrometheusProto:
+		return "prometheus.ScrapeProtocolPrometheusProto"
+	case prometheus.ScrapeProtocolOpenMetricsText100:
+		return "prometheus.ScrapeProtocolOpenMetricsText100"
+	case prometheus.ScrapeProtocolOpenMetricsText001:
+		return "prometheus.ScrapeProtocolOpenMetricsText001"
+	case prometheus.ScrapeProtocolPrometheusText100:
+		return "prometheus.ScrapeProtocolPrometheusText100"
+	case prometheus.ScrapeProtocolPrometheusText004:
+		return "prometheus.ScrapeProtocolPrometheusText004"
+	default:
+		return fmt.Sprintf("%q", p)
+	}
+}
+
+func (g *codeGenerator) formatStrings(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func (g *codeGenerator) formatStringMap(m map[string]string) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	entries := make([]string, len(keys))
+	for i, k := range keys {
+		entries[i] = fmt.Sprintf("%q: %q", k, m[k])
+	}
+	return "map[string]string{" + strings.Join(entries, ", ") + "}"
+}
+
 func (g *codeGenerator) writeMainConfig(buf *bytes.Buffer) error {
 	buf.WriteString("// Config is the main Prometheus configuration.\n")
 	buf.WriteString("var Config = &prometheus.PrometheusConfig{\n")
@@ -248,6 +959,9 @@ func (g *codeGenerator) writeMainConfig(buf *bytes.Buffer) error {
 	if g.config.Global != nil {
 		buf.WriteString("\tGlobal: GlobalConfig,\n")
 	}
+	if g.config.Runtime != nil {
+		buf.WriteString("\tRuntime: Runtime,\n")
+	}
 
 	if len(g.config.ScrapeConfigs) > 0 {
 		buf.WriteString("\tScrapeConfigs: []*prometheus.ScrapeConfig{\n")
@@ -294,6 +1008,27 @@ func (g *codeGenerator) writeMainConfig(buf *bytes.Buffer) error {
 		buf.WriteString("\t},\n")
 	}
 
+	if len(g.config.ScrapeConfigFiles) > 0 {
+		buf.WriteString("\tScrapeConfigFiles: []string{\n")
+		for _, f := range g.config.ScrapeConfigFiles {
+			buf.WriteString(fmt.Sprintf("\t\t%q,\n", f))
+		}
+		buf.WriteString("\t},\n")
+	}
+
+	if g.config.Alerting != nil {
+		buf.WriteString("\tAlerting: Alerting,\n")
+	}
+	if g.config.Storage != nil {
+		buf.WriteString("\tStorage: Storage,\n")
+	}
+	if g.config.Tracing != nil {
+		buf.WriteString("\tTracing: Tracing,\n")
+	}
+	if g.config.OTLP != nil {
+		buf.WriteString("\tOTLP: OTLP,\n")
+	}
+
 	buf.WriteString("}\n")
 	return nil
 }
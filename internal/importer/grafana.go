@@ -22,6 +22,8 @@ type GrafanaDashboard struct {
 	Editable      bool           `json:"editable"`
 	Panels        []GrafanaPanel `json:"panels"`
 	Templating    *Templating    `json:"templating,omitempty"`
+	Annotations   *Annotations   `json:"annotations,omitempty"`
+	Links         []GrafanaDashboardLink `json:"links,omitempty"`
 	SchemaVersion int            `json:"schemaVersion"`
 	Version       int            `json:"version,omitempty"`
 }
@@ -37,6 +39,50 @@ type Templating struct {
 	List []GrafanaVariable `json:"list"`
 }
 
+// Annotations represents the dashboard annotation queries.
+type Annotations struct {
+	List []GrafanaAnnotation `json:"list"`
+}
+
+// GrafanaAnnotation represents an annotation query.
+type GrafanaAnnotation struct {
+	Name            string         `json:"name"`
+	Datasource      any            `json:"datasource,omitempty"`
+	Enable          bool           `json:"enable"`
+	Hide            bool           `json:"hide,omitempty"`
+	IconColor       string         `json:"iconColor,omitempty"`
+	BuiltIn         int            `json:"builtIn,omitempty"`
+	Type            string         `json:"type,omitempty"`
+	Target          map[string]any `json:"target,omitempty"`
+	Expr            string         `json:"expr,omitempty"`
+	Step            string         `json:"step,omitempty"`
+	TitleFormat     string         `json:"titleFormat,omitempty"`
+	TextFormat      string         `json:"textFormat,omitempty"`
+	TagKeys         string         `json:"tagKeys,omitempty"`
+	UseValueForTime bool           `json:"useValueForTime,omitempty"`
+}
+
+// GrafanaDashboardLink represents a link in the dashboard header.
+type GrafanaDashboardLink struct {
+	Title       string   `json:"title"`
+	Type        string   `json:"type"`
+	Tags        []string `json:"tags,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Tooltip     string   `json:"tooltip,omitempty"`
+	Icon        string   `json:"icon,omitempty"`
+	AsDropdown  bool     `json:"asDropdown,omitempty"`
+	IncludeVars bool     `json:"includeVars,omitempty"`
+	KeepTime    bool     `json:"keepTime,omitempty"`
+	TargetBlank bool     `json:"targetBlank,omitempty"`
+}
+
+// GrafanaDataLink represents a panel link or a field data link.
+type GrafanaDataLink struct {
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	TargetBlank bool   `json:"targetBlank,omitempty"`
+}
+
 // GrafanaVariable represents a template variable.
 type GrafanaVariable struct {
 	Name        string `json:"name"`
@@ -69,9 +115,21 @@ type GrafanaPanel struct {
 	Collapsed   bool                   `json:"collapsed,omitempty"`
 	Panels      []GrafanaPanel         `json:"panels,omitempty"` // For collapsed rows
 	Transparent bool                   `json:"transparent,omitempty"`
+	Links       []GrafanaDataLink      `json:"links,omitempty"`
+	Transformations []GrafanaTransformation `json:"transformations,omitempty"`
+	Repeat          string                  `json:"repeat,omitempty"`
+	RepeatDirection string                  `json:"repeatDirection,omitempty"`
+	MaxPerRow       int                     `json:"maxPerRow,omitempty"`
 	Extra       map[string]any         `json:"-"` // Capture unknown fields
 }
 
+// GrafanaTransformation represents a panel transformation.
+type GrafanaTransformation struct {
+	ID       string `json:"id"`
+	Disabled bool   `json:"disabled,omitempty"`
+	Options  any    `json:"options,omitempty"`
+}
+
 // GridPos represents panel position.
 type GridPos struct {
 	X int `json:"x"`
@@ -107,6 +165,7 @@ type FieldDefaults struct {
 	Color      map[string]any  `json:"color,omitempty"`
 	Thresholds map[string]any  `json:"thresholds,omitempty"`
 	Custom     map[string]any  `json:"custom,omitempty"`
+	Links      []GrafanaDataLink `json:"links,omitempty"`
 }
 
 // FieldOverride represents a field override.
@@ -179,6 +238,21 @@ func ValidateGrafanaDashboard(dashboard *GrafanaDashboard) []string {
 		"logs":       true,
 		"heatmap":    true,
 		"graph":      true, // Legacy, will be converted to timeseries
+
+		"state-timeline": true,
+		"status-history": true,
+		"barchart":       true,
+		"histogram":      true,
+		"xychart":        true,
+		"trend":          true,
+		"nodeGraph":      true,
+		"traces":         true,
+		"flamegraph":     true,
+		"geomap":         true,
+		"canvas":         true,
+		"alertlist":      true,
+		"dashlist":       true,
+		"news":           true,
 	}
 
 	for _, panel := range dashboard.Panels {
@@ -231,6 +305,18 @@ func ConvertToWetwire(gd *GrafanaDashboard) *grafana.Dashboard {
 		}
 	}
 
+	// Convert links
+	for _, l := range gd.Links {
+		d.Links = append(d.Links, convertDashboardLink(l))
+	}
+
+	// Convert annotations
+	if gd.Annotations != nil {
+		for _, a := range gd.Annotations.List {
+			d.Annotations = append(d.Annotations, convertAnnotation(a))
+		}
+	}
+
 	return d
 }
 
@@ -246,6 +332,9 @@ func convertPanelsToRows(panels []GrafanaPanel) []*grafana.Row {
 			if panel.Collapsed {
 				currentRow.Collapsed()
 			}
+			currentRow.Repeat = panel.Repeat
+			currentRow.RepeatDirection = panel.RepeatDirection
+			currentRow.MaxPerRow = panel.MaxPerRow
 			rows = append(rows, currentRow)
 
 			// Add collapsed row panels
@@ -317,6 +406,79 @@ func convertPanel(panel GrafanaPanel) any {
 		p := grafana.Heatmap(panel.Title)
 		applyBasePanel(&p.BasePanel, panel)
 		return p
+	case "state-timeline":
+		p := grafana.StateTimeline(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		decodeOptions(panel.Options, &p.Options)
+		return p
+	case "status-history":
+		p := grafana.StatusHistory(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		decodeOptions(panel.Options, &p.Options)
+		return p
+	case "barchart":
+		p := grafana.BarChart(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		decodeOptions(panel.Options, &p.Options)
+		return p
+	case "histogram":
+		p := grafana.Histogram(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		decodeOptions(panel.Options, &p.Options)
+		return p
+	case "xychart":
+		p := grafana.XYChart(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		decodeOptions(panel.Options, &p.Options)
+		return p
+	case "trend":
+		p := grafana.Trend(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		decodeOptions(panel.Options, &p.Options)
+		return p
+	case "nodeGraph":
+		p := grafana.NodeGraph(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		decodeOptions(panel.Options, &p.Options)
+		return p
+	case "traces":
+		p := grafana.Traces(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		return p
+	case "flamegraph":
+		p := grafana.FlameGraph(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		return p
+	case "geomap":
+		p := grafana.Geomap(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		decodeOptions(panel.Options, &p.Options)
+		return p
+	case "canvas":
+		p := grafana.Canvas(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		decodeOptions(panel.Options, &p.Options)
+		return p
+	case "alertlist":
+		p := grafana.AlertList(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		// Imported state filters replace the default one instead of
+		// adding to it.
+		if _, ok := panel.Options["stateFilter"]; ok {
+			p.Options.StateFilter = grafana.AlertListStateFilter{}
+		}
+		decodeOptions(panel.Options, &p.Options)
+		return p
+	case "dashlist":
+		p := grafana.DashboardList(panel.Title)
+		applyBasePanel(&p.BasePanel, panel)
+		decodeOptions(panel.Options, &p.Options)
+		return p
+	case "news":
+		p := grafana.News(panel.Title, "")
+		applyBasePanel(&p.BasePanel, panel)
+		decodeOptions(panel.Options, &p.Options)
+		return p
 	default:
 		// Unknown panel type - use timeseries as fallback
 		p := grafana.TimeSeries(panel.Title)
@@ -325,6 +487,19 @@ func convertPanel(panel GrafanaPanel) any {
 	}
 }
 
+// decodeOptions decodes panel options over the constructor defaults of a
+// typed options struct. Options that cannot be decoded are ignored.
+func decodeOptions(options map[string]any, target any) {
+	if len(options) == 0 {
+		return
+	}
+	data, err := json.Marshal(options)
+	if err != nil {
+		return
+	}
+	_ = json.Unmarshal(data, target)
+}
+
 // applyBasePanel applies common panel fields.
 func applyBasePanel(base *grafana.BasePanel, panel GrafanaPanel) {
 	base.ID = panel.ID
@@ -336,6 +511,11 @@ func applyBasePanel(base *grafana.BasePanel, panel GrafanaPanel) {
 		H: panel.GridPos.H,
 	}
 	base.Transparent = panel.Transparent
+	base.Links = convertDataLinks(panel.Links)
+	base.Transformations = convertTransformations(panel.Transformations)
+	base.Repeat = panel.Repeat
+	base.RepeatDirection = panel.RepeatDirection
+	base.MaxPerRow = panel.MaxPerRow
 
 	// Convert datasource
 	if ds, ok := panel.Datasource.(string); ok {
@@ -366,7 +546,57 @@ func applyBasePanel(base *grafana.BasePanel, panel GrafanaPanel) {
 		base.FieldConfig.Defaults.Min = panel.FieldConfig.Defaults.Min
 		base.FieldConfig.Defaults.Max = panel.FieldConfig.Defaults.Max
 		base.FieldConfig.Defaults.Decimals = panel.FieldConfig.Defaults.Decimals
+		base.FieldConfig.Defaults.Links = convertDataLinks(panel.FieldConfig.Defaults.Links)
+	}
+}
+
+// newDashboardLink creates the wetwire dashboard link matching the type of
+// a GrafanaDashboardLink, with the constructor defaults.
+func newDashboardLink(l GrafanaDashboardLink) *grafana.DashboardLink {
+	if l.Type == grafana.LinkTypeDashboards {
+		return grafana.DashboardsByTag(l.Title, l.Tags...)
+	}
+	return grafana.URLLink(l.Title, l.URL)
+}
+
+// convertDashboardLink converts a GrafanaDashboardLink to a wetwire
+// dashboard link.
+func convertDashboardLink(l GrafanaDashboardLink) *grafana.DashboardLink {
+	link := newDashboardLink(l)
+	link.Type = l.Type
+	link.URL = l.URL
+	link.Tooltip = l.Tooltip
+	link.Icon = l.Icon
+	link.Dropdown = l.AsDropdown
+	link.IncludeVariables = l.IncludeVars
+	link.KeepTimeRange = l.KeepTime
+	link.TargetBlank = l.TargetBlank
+	return link
+}
+
+// convertDataLinks converts panel or field data links.
+func convertDataLinks(links []GrafanaDataLink) []*grafana.DataLink {
+	var result []*grafana.DataLink
+	for _, l := range links {
+		link := grafana.NewDataLink(l.Title, l.URL)
+		if l.TargetBlank {
+			link.OpenInNewTab()
+		}
+		result = append(result, link)
+	}
+	return result
+}
+
+// convertTransformations converts panel transformations. Options are kept
+// as decoded so that every transformation round-trips unchanged.
+func convertTransformations(transformations []GrafanaTransformation) []*grafana.Transformation {
+	var result []*grafana.Transformation
+	for _, t := range transformations {
+		transformation := grafana.NewTransformation(t.ID, t.Options)
+		transformation.Disabled = t.Disabled
+		result = append(result, transformation)
 	}
+	return result
 }
 
 // convertVariable converts a GrafanaVariable to a wetwire variable.
@@ -433,3 +663,80 @@ func convertVariable(v GrafanaVariable) *grafana.Variable {
 
 	return variable
 }
+
+// datasourceRef returns the type and UID of a data source reference, which
+// is either a UID string or a {type, uid} object.
+func datasourceRef(ds any) (dsType, uid string) {
+	switch v := ds.(type) {
+	case string:
+		return "", v
+	case map[string]any:
+		dsType, _ = v["type"].(string)
+		uid, _ = v["uid"].(string)
+	}
+	return dsType, uid
+}
+
+// expr returns the query of an annotation, set at the top level or, in
+// newer dashboards, in its target.
+func (a GrafanaAnnotation) expr() string {
+	if a.Expr != "" {
+		return a.Expr
+	}
+	expr, _ := a.Target["expr"].(string)
+	return expr
+}
+
+// tags returns the tags of a Grafana annotation target.
+func (a GrafanaAnnotation) tags() []string {
+	var tags []string
+	list, _ := a.Target["tags"].([]any)
+	for _, t := range list {
+		if s, ok := t.(string); ok {
+			tags = append(tags, s)
+		}
+	}
+	return tags
+}
+
+// newAnnotation creates the wetwire annotation matching the kind of a
+// GrafanaAnnotation, with the constructor defaults.
+func newAnnotation(a GrafanaAnnotation) *grafana.Annotation {
+	dsType, _ := datasourceRef(a.Datasource)
+
+	switch {
+	case a.BuiltIn == 1:
+		return grafana.BuiltInAnnotation()
+	case a.Type == "tags":
+		return grafana.TagAnnotation(a.Name, a.tags()...)
+	case dsType == grafana.DataSourceTypePrometheus:
+		return grafana.PrometheusAnnotation(a.Name, a.expr())
+	case dsType == grafana.DataSourceTypeLoki:
+		return grafana.LokiAnnotation(a.Name, a.expr())
+	}
+	annotation := &grafana.Annotation{Name: a.Name, IsEnabled: true, Type: a.Type, Expr: a.expr()}
+	if a.Datasource != nil {
+		annotation.Datasource = &grafana.DataSourceRef{Type: dsType}
+	}
+	return annotation
+}
+
+// convertAnnotation converts a GrafanaAnnotation to a wetwire annotation.
+func convertAnnotation(a GrafanaAnnotation) *grafana.Annotation {
+	annotation := newAnnotation(a)
+	annotation.Name = a.Name
+
+	if _, uid := datasourceRef(a.Datasource); uid != "" && a.BuiltIn != 1 {
+		annotation.WithDatasource(uid)
+	}
+	annotation.IconColor = a.IconColor
+	annotation.Step = a.Step
+	annotation.TitleFormat = a.TitleFormat
+	annotation.TextFormat = a.TextFormat
+	annotation.TagKeys = a.TagKeys
+	annotation.ValueForTime = a.UseValueForTime
+	annotation.IsEnabled = a.Enable
+	annotation.Hidden = a.Hide
+
+	return annotation
+}
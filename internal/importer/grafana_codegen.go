@@ -4,7 +4,12 @@ import (
 	"bytes"
 	"fmt"
 	"go/format"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode"
+
+	"github.com/lex00/wetwire-observability-go/grafana"
 )
 
 // GenerateGrafanaGoCode generates Go source code from a GrafanaDashboard.
@@ -41,6 +46,13 @@ func (g *grafanaCodeGenerator) generate() ([]byte, error) {
 		}
 	}
 
+	// Generate annotations if present
+	if g.dashboard.Annotations != nil {
+		for _, a := range g.dashboard.Annotations.List {
+			g.writeAnnotation(&buf, a)
+		}
+	}
+
 	// Generate panels by row
 	panelVars, rowVars := g.collectPanelAndRowVars()
 
@@ -235,6 +247,275 @@ func (g *grafanaCodeGenerator) writeVariable(buf *bytes.Buffer, v GrafanaVariabl
 	return nil
 }
 
+// panelsWithoutQueries are the panel types without data source, queries
+// and transformations.
+var panelsWithoutQueries = map[string]bool{
+	"text":      true,
+	"alertlist": true,
+	"dashlist":  true,
+	"news":      true,
+}
+
+// alertSortOrders are the names of the alert list sort order constants.
+var alertSortOrders = map[int]string{
+	grafana.AlertSortAlphabeticalAsc:  "AlertSortAlphabeticalAsc",
+	grafana.AlertSortAlphabeticalDesc: "AlertSortAlphabeticalDesc",
+	grafana.AlertSortImportance:       "AlertSortImportance",
+	grafana.AlertSortTimeAsc:          "AlertSortTimeAsc",
+	grafana.AlertSortTimeDesc:         "AlertSortTimeDesc",
+}
+
+// panelsWithoutFieldConfig are the panel types without data link, unit,
+// min, max and decimals setters.
+var panelsWithoutFieldConfig = map[string]bool{
+	"text":       true,
+	"alertlist":  true,
+	"dashlist":   true,
+	"news":       true,
+	"nodeGraph":  true,
+	"traces":     true,
+	"flamegraph": true,
+}
+
+// writePanelOptions writes the method chain calls setting the imported
+// options of a panel that differ from its constructor defaults.
+func writePanelOptions(buf *bytes.Buffer, panel any) {
+	chain := func(format string, args ...any) {
+		buf.WriteString(".\n\t" + fmt.Sprintf(format, args...))
+	}
+
+	switch p := panel.(type) {
+	case *grafana.StateTimelinePanel:
+		if !p.Options.MergeValues {
+			chain("SplitValues()")
+		}
+		if p.Options.ShowValue != grafana.ShowValueAuto {
+			chain("ShowValues(%q)", p.Options.ShowValue)
+		}
+		if p.Options.AlignValue != "left" {
+			chain("WithAlignValue(%q)", p.Options.AlignValue)
+		}
+		if p.Options.RowHeight != 0.9 {
+			chain("WithRowHeight(%v)", p.Options.RowHeight)
+		}
+	case *grafana.StatusHistoryPanel:
+		if p.Options.ShowValue != grafana.ShowValueAuto {
+			chain("ShowValues(%q)", p.Options.ShowValue)
+		}
+		if p.Options.RowHeight != 0.9 {
+			chain("WithRowHeight(%v)", p.Options.RowHeight)
+		}
+		if p.Options.ColumnWidth != 0.9 {
+			chain("WithColumnWidth(%v)", p.Options.ColumnWidth)
+		}
+	case *grafana.BarChartPanel:
+		switch p.Options.Orientation {
+		case grafana.OrientationHorizontal:
+			chain("Horizontal()")
+		case grafana.OrientationVertical:
+			chain("Vertical()")
+		}
+		if p.Options.XField != "" {
+			chain("WithXField(%q)", p.Options.XField)
+		}
+		switch p.Options.Stacking {
+		case grafana.StackingNormal:
+			chain("Stacked()")
+		case grafana.StackingPercent:
+			chain("StackedPercent()")
+		}
+		if p.Options.ShowValue != grafana.ShowValueAuto {
+			chain("ShowValues(%q)", p.Options.ShowValue)
+		}
+		if p.Options.GroupWidth != 0.7 {
+			chain("WithGroupWidth(%v)", p.Options.GroupWidth)
+		}
+		if p.Options.BarWidth != 0.97 {
+			chain("WithBarWidth(%v)", p.Options.BarWidth)
+		}
+		if p.Options.XTickLabelRotation != 0 {
+			chain("WithLabelRotation(%d)", p.Options.XTickLabelRotation)
+		}
+	case *grafana.HistogramPanel:
+		if p.Options.BucketCount > 0 {
+			chain("WithBucketCount(%d)", p.Options.BucketCount)
+		}
+		if p.Options.BucketSize != nil {
+			chain("WithBucketSize(%v)", *p.Options.BucketSize)
+		}
+		if p.Options.BucketOffset != 0 {
+			chain("WithBucketOffset(%v)", p.Options.BucketOffset)
+		}
+		if p.Options.Combine {
+			chain("Combine()")
+		}
+	case *grafana.XYChartPanel:
+		if p.Options.SeriesMapping == grafana.SeriesMappingManual {
+			for _, s := range p.Options.Series {
+				chain("AddSeries(%q, %q, %q)", s.Name, s.X, s.Y)
+			}
+		}
+	case *grafana.TrendPanel:
+		if p.Options.XField != "" {
+			chain("WithXField(%q)", p.Options.XField)
+		}
+	case *grafana.NodeGraphPanel:
+		if nodes := p.Options.Nodes; nodes.MainStatUnit != "" || nodes.SecondaryStatUnit != "" {
+			chain("WithNodeStatUnits(%q, %q)", nodes.MainStatUnit, nodes.SecondaryStatUnit)
+		}
+		if edges := p.Options.Edges; edges.MainStatUnit != "" || edges.SecondaryStatUnit != "" {
+			chain("WithEdgeStatUnits(%q, %q)", edges.MainStatUnit, edges.SecondaryStatUnit)
+		}
+		for _, arc := range p.Options.Nodes.Arcs {
+			chain("AddArc(%q, %q)", arc.Field, arc.Color)
+		}
+	case *grafana.GeomapPanel:
+		switch v := p.Options.View; {
+		case v.ID == "coords":
+			chain("WithView(%v, %v, %v)", v.Lat, v.Lon, v.Zoom)
+		case v != (grafana.GeomapView{ID: "zero"}):
+			chain("WithPresetView(%q, %v)", v.ID, v.Zoom)
+		}
+		for _, l := range p.Options.Layers {
+			chain("AddLayer(%s)", geomapLayerExpr(l))
+		}
+		if p.Options.Controls.ShowScale {
+			chain("ShowScale()")
+		}
+		if !p.Options.Controls.MouseWheelZoom {
+			chain("DisableMouseWheelZoom()")
+		}
+	case *grafana.CanvasPanel:
+		if p.Options.Root != nil && len(p.Options.Root.Elements) > 0 {
+			buf.WriteString(".\n\tWithElements(\n")
+			for _, e := range p.Options.Root.Elements {
+				buf.WriteString(fmt.Sprintf("\t\tgrafana.NewCanvasElement(%q, %q, %s)", e.Type, e.Name, goLiteral(e.Config)))
+				if pl := e.Placement; pl != nil {
+					buf.WriteString(fmt.Sprintf(".At(%v, %v, %v, %v)", pl.Left, pl.Top, pl.Width, pl.Height))
+				}
+				buf.WriteString(",\n")
+			}
+			buf.WriteString("\t)")
+		}
+		if !p.Options.InlineEditing {
+			chain("DisableInlineEditing()")
+		}
+	case *grafana.AlertListPanel:
+		if f := p.Options.StateFilter; f != (grafana.AlertListStateFilter{Firing: true, Pending: true}) {
+			var states []string
+			for _, s := range []struct {
+				set   bool
+				state string
+			}{
+				{f.Firing, "AlertStateFiring"},
+				{f.Pending, "AlertStatePending"},
+				{f.NoData, "AlertStateNoData"},
+				{f.Normal, "AlertStateNormal"},
+				{f.Error, "AlertStateError"},
+			} {
+				if s.set {
+					states = append(states, "grafana."+s.state)
+				}
+			}
+			chain("WithStates(%s)", strings.Join(states, ", "))
+		}
+		if p.Options.MaxItems != 20 {
+			chain("WithMaxItems(%d)", p.Options.MaxItems)
+		}
+		if order := p.Options.SortOrder; order != grafana.AlertSortAlphabeticalAsc {
+			if name, ok := alertSortOrders[order]; ok {
+				chain("WithSortOrder(grafana.%s)", name)
+			} else {
+				chain("WithSortOrder(%d)", order)
+			}
+		}
+		if p.Options.AlertName != "" {
+			chain("WithAlertName(%q)", p.Options.AlertName)
+		}
+		if p.Options.AlertInstanceLabelFilter != "" {
+			chain("WithLabelFilter(%q)", p.Options.AlertInstanceLabelFilter)
+		}
+		if p.Options.GroupMode == "custom" {
+			chain("GroupBy(%s)", quoteAll(p.Options.GroupBy))
+		}
+		if p.Options.DashboardAlerts {
+			chain("CurrentDashboardOnly()")
+		}
+		if p.Options.ViewMode == "stat" {
+			chain("StatView()")
+		}
+	case *grafana.DashboardListPanel:
+		if !p.Options.ShowStarred {
+			chain("HideStarred()")
+		}
+		if p.Options.ShowRecentlyViewed {
+			chain("ShowRecentlyViewed()")
+		}
+		if p.Options.ShowSearch {
+			chain("Search(%s)", quoteAll(append([]string{p.Options.Query}, p.Options.Tags...)))
+		}
+		if !p.Options.ShowHeadings {
+			chain("HideHeadings()")
+		}
+		if p.Options.MaxItems != 10 {
+			chain("WithMaxItems(%d)", p.Options.MaxItems)
+		}
+		if p.Options.IncludeVars {
+			chain("IncludeVars()")
+		}
+		if p.Options.KeepTime {
+			chain("KeepTime()")
+		}
+	case *grafana.NewsPanel:
+		if !p.Options.ShowImage {
+			chain("HideImages()")
+		}
+	}
+}
+
+// geomapLayerExpr returns the Go expression creating a geomap layer.
+func geomapLayerExpr(l *grafana.GeomapLayer) string {
+	var b strings.Builder
+	switch l.Type {
+	case "markers":
+		b.WriteString(fmt.Sprintf("grafana.MarkersLayer(%q)", l.Name))
+	case "heatmap":
+		b.WriteString(fmt.Sprintf("grafana.HeatmapLayer(%q)", l.Name))
+	default:
+		fields := fmt.Sprintf("Type: %q, Name: %q", l.Type, l.Name)
+		if l.Location != nil && l.Location.Mode == grafana.LocationAuto {
+			fields += ", Location: &grafana.GeomapLocation{Mode: grafana.LocationAuto}"
+		}
+		if l.Tooltip {
+			fields += ", Tooltip: true"
+		}
+		b.WriteString("(&grafana.GeomapLayer{" + fields + "})")
+	}
+	if loc := l.Location; loc != nil {
+		switch loc.Mode {
+		case grafana.LocationCoords:
+			b.WriteString(fmt.Sprintf(".WithCoords(%q, %q)", loc.Latitude, loc.Longitude))
+		case grafana.LocationGeohash:
+			b.WriteString(fmt.Sprintf(".WithGeohash(%q)", loc.Geohash))
+		case grafana.LocationLookup:
+			b.WriteString(fmt.Sprintf(".WithLookup(%q, %q)", loc.Lookup, loc.Gazetteer))
+		}
+	}
+	if len(l.Config) > 0 {
+		b.WriteString(fmt.Sprintf(".WithConfig(%s)", goLiteral(l.Config)))
+	}
+	return b.String()
+}
+
+// quoteAll returns the comma-separated Go string literals of values.
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
 func (g *grafanaCodeGenerator) writePanel(buf *bytes.Buffer, panel GrafanaPanel, varName string) error {
 	buf.WriteString(fmt.Sprintf("// %s is a %s panel.\n", varName, panel.Type))
 
@@ -264,21 +545,54 @@ func (g *grafanaCodeGenerator) writePanel(buf *bytes.Buffer, panel GrafanaPanel,
 		buf.WriteString(fmt.Sprintf("var %s = grafana.Logs(%q)", varName, panel.Title))
 	case "heatmap":
 		buf.WriteString(fmt.Sprintf("var %s = grafana.Heatmap(%q)", varName, panel.Title))
+	case "state-timeline":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.StateTimeline(%q)", varName, panel.Title))
+	case "status-history":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.StatusHistory(%q)", varName, panel.Title))
+	case "barchart":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.BarChart(%q)", varName, panel.Title))
+	case "histogram":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.Histogram(%q)", varName, panel.Title))
+	case "xychart":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.XYChart(%q)", varName, panel.Title))
+	case "trend":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.Trend(%q)", varName, panel.Title))
+	case "nodeGraph":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.NodeGraph(%q)", varName, panel.Title))
+	case "traces":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.Traces(%q)", varName, panel.Title))
+	case "flamegraph":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.FlameGraph(%q)", varName, panel.Title))
+	case "geomap":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.Geomap(%q)", varName, panel.Title))
+	case "canvas":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.Canvas(%q)", varName, panel.Title))
+	case "alertlist":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.AlertList(%q)", varName, panel.Title))
+	case "dashlist":
+		buf.WriteString(fmt.Sprintf("var %s = grafana.DashboardList(%q)", varName, panel.Title))
+	case "news":
+		feedURL, _ := panel.Options["feedUrl"].(string)
+		buf.WriteString(fmt.Sprintf("var %s = grafana.News(%q, %q)", varName, panel.Title, feedURL))
 	default:
 		buf.WriteString(fmt.Sprintf("var %s = grafana.TimeSeries(%q)", varName, panel.Title))
 	}
 
+	writePanelOptions(buf, convertPanel(panel))
+
 	// Add method chains
 	if panel.Description != "" {
 		buf.WriteString(fmt.Sprintf(".\n\tWithDescription(%q)", panel.Description))
 	}
 
 	// Datasource
-	if ds, ok := panel.Datasource.(string); ok && ds != "" {
-		buf.WriteString(fmt.Sprintf(".\n\tWithDatasource(%q)", ds))
-	} else if dsMap, ok := panel.Datasource.(map[string]any); ok {
-		if uid, ok := dsMap["uid"].(string); ok {
-			buf.WriteString(fmt.Sprintf(".\n\tWithDatasource(%q)", uid))
+	if !panelsWithoutQueries[panel.Type] {
+		if ds, ok := panel.Datasource.(string); ok && ds != "" {
+			buf.WriteString(fmt.Sprintf(".\n\tWithDatasource(%q)", ds))
+		} else if dsMap, ok := panel.Datasource.(map[string]any); ok {
+			if uid, ok := dsMap["uid"].(string); ok {
+				buf.WriteString(fmt.Sprintf(".\n\tWithDatasource(%q)", uid))
+			}
 		}
 	}
 
@@ -287,13 +601,37 @@ func (g *grafanaCodeGenerator) writePanel(buf *bytes.Buffer, panel GrafanaPanel,
 		buf.WriteString(fmt.Sprintf(".\n\tWithSize(%d, %d)", panel.GridPos.W, panel.GridPos.H))
 	}
 
+	// Repeat
+	if panel.Repeat != "" {
+		buf.WriteString(fmt.Sprintf(".\n\tRepeatBy(%q)", panel.Repeat))
+		if panel.RepeatDirection == grafana.RepeatVertical {
+			buf.WriteString(".\n\tRepeatVertically()")
+		}
+		if panel.MaxPerRow > 0 {
+			buf.WriteString(fmt.Sprintf(".\n\tWithMaxPerRow(%d)", panel.MaxPerRow))
+		}
+	}
+
 	// Transparent
 	if panel.Transparent {
 		buf.WriteString(".\n\tTransparent()")
 	}
 
+	// Links
+	if len(panel.Links) > 0 {
+		g.writeDataLinks(buf, "WithLinks", panel.Links)
+	}
+	if panel.FieldConfig != nil && panel.FieldConfig.Defaults != nil && len(panel.FieldConfig.Defaults.Links) > 0 && !panelsWithoutFieldConfig[panel.Type] {
+		g.writeDataLinks(buf, "WithDataLinks", panel.FieldConfig.Defaults.Links)
+	}
+
+	// Transformations
+	if len(panel.Transformations) > 0 && !panelsWithoutQueries[panel.Type] {
+		g.writeTransformations(buf, panel.Transformations)
+	}
+
 	// Field config - unit
-	if panel.FieldConfig != nil && panel.FieldConfig.Defaults != nil {
+	if panel.FieldConfig != nil && panel.FieldConfig.Defaults != nil && !panelsWithoutFieldConfig[panel.Type] {
 		if panel.FieldConfig.Defaults.Unit != "" {
 			buf.WriteString(fmt.Sprintf(".\n\tWithUnit(%q)", panel.FieldConfig.Defaults.Unit))
 		}
@@ -311,7 +649,7 @@ func (g *grafanaCodeGenerator) writePanel(buf *bytes.Buffer, panel GrafanaPanel,
 	buf.WriteString("\n\n")
 
 	// Write targets separately if present
-	if len(panel.Targets) > 0 {
+	if len(panel.Targets) > 0 && !panelsWithoutQueries[panel.Type] {
 		for i, t := range panel.Targets {
 			targetVarName := fmt.Sprintf("%sTarget%d", varName, i)
 			g.writeTarget(buf, t, targetVarName)
@@ -362,6 +700,10 @@ func (g *grafanaCodeGenerator) writeRow(buf *bytes.Buffer, row GrafanaPanel, var
 		buf.WriteString(".\n\tCollapsed()")
 	}
 
+	if row.Repeat != "" {
+		buf.WriteString(fmt.Sprintf(".\n\tRepeatBy(%q)", row.Repeat))
+	}
+
 	if len(panelVarNames) > 0 {
 		buf.WriteString(".\n\tWithPanels(\n")
 		for _, pv := range panelVarNames {
@@ -408,6 +750,15 @@ func (g *grafanaCodeGenerator) writeDashboard(buf *bytes.Buffer, rowVars []rowVa
 		buf.WriteString(".\n\tReadOnly()")
 	}
 
+	// Add links
+	if len(g.dashboard.Links) > 0 {
+		buf.WriteString(".\n\tWithLinks(\n")
+		for _, l := range g.dashboard.Links {
+			buf.WriteString(fmt.Sprintf("\t\t%s,\n", g.dashboardLinkExpr(l)))
+		}
+		buf.WriteString("\t)")
+	}
+
 	// Add rows
 	if len(rowVars) > 0 {
 		buf.WriteString(".\n\tWithRows(\n")
@@ -427,16 +778,201 @@ func (g *grafanaCodeGenerator) writeDashboard(buf *bytes.Buffer, rowVars []rowVa
 		buf.WriteString("\t)")
 	}
 
+	// Add annotations
+	if g.dashboard.Annotations != nil && len(g.dashboard.Annotations.List) > 0 {
+		buf.WriteString(".\n\tWithAnnotations(\n")
+		for _, a := range g.dashboard.Annotations.List {
+			buf.WriteString(fmt.Sprintf("\t\t%s,\n", g.getAnnotationVarName(a)))
+		}
+		buf.WriteString("\t)")
+	}
+
 	buf.WriteString("\n")
 	return nil
 }
 
+// dashboardLinkExpr returns the builder expression of a dashboard link.
+func (g *grafanaCodeGenerator) dashboardLinkExpr(l GrafanaDashboardLink) string {
+	var b strings.Builder
+	if l.Type == grafana.LinkTypeDashboards {
+		args := []string{fmt.Sprintf("%q", l.Title)}
+		for _, t := range l.Tags {
+			args = append(args, fmt.Sprintf("%q", t))
+		}
+		b.WriteString(fmt.Sprintf("grafana.DashboardsByTag(%s)", strings.Join(args, ", ")))
+	} else {
+		b.WriteString(fmt.Sprintf("grafana.URLLink(%q, %q)", l.Title, l.URL))
+	}
+
+	if l.Icon != newDashboardLink(l).Icon {
+		b.WriteString(fmt.Sprintf(".WithIcon(%q)", l.Icon))
+	}
+	if l.Tooltip != "" {
+		b.WriteString(fmt.Sprintf(".WithTooltip(%q)", l.Tooltip))
+	}
+	if l.AsDropdown {
+		b.WriteString(".AsDropdown()")
+	}
+	if l.IncludeVars {
+		b.WriteString(".IncludeVars()")
+	}
+	if l.KeepTime {
+		b.WriteString(".KeepTime()")
+	}
+	if l.TargetBlank {
+		b.WriteString(".OpenInNewTab()")
+	}
+	return b.String()
+}
+
+// writeDataLinks writes a method chain call setting panel or data links.
+func (g *grafanaCodeGenerator) writeDataLinks(buf *bytes.Buffer, method string, links []GrafanaDataLink) {
+	buf.WriteString(fmt.Sprintf(".\n\t%s(\n", method))
+	for _, l := range links {
+		buf.WriteString(fmt.Sprintf("\t\tgrafana.NewDataLink(%q, %q)", l.Title, l.URL))
+		if l.TargetBlank {
+			buf.WriteString(".OpenInNewTab()")
+		}
+		buf.WriteString(",\n")
+	}
+	buf.WriteString("\t)")
+}
+
+// writeTransformations writes a method chain call setting panel
+// transformations, with the options as Go literals.
+func (g *grafanaCodeGenerator) writeTransformations(buf *bytes.Buffer, transformations []GrafanaTransformation) {
+	buf.WriteString(".\n\tWithTransformations(\n")
+	for _, t := range transformations {
+		options := "nil"
+		if t.Options != nil {
+			options = goLiteral(t.Options)
+		}
+		buf.WriteString(fmt.Sprintf("\t\tgrafana.NewTransformation(%q, %s)", t.ID, options))
+		if t.Disabled {
+			buf.WriteString(".Disable()")
+		}
+		buf.WriteString(",\n")
+	}
+	buf.WriteString("\t)")
+}
+
+// goLiteral returns the Go literal of a decoded JSON value. Object keys are
+// sorted for stable output.
+func goLiteral(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return "nil"
+	case bool:
+		return strconv.FormatBool(val)
+	case float64:
+		return strconv.FormatFloat(val, 'g', -1, 64)
+	case string:
+		return strconv.Quote(val)
+	case []any:
+		elems := make([]string, len(val))
+		for i, e := range val {
+			elems[i] = goLiteral(e)
+		}
+		return "[]any{" + strings.Join(elems, ", ") + "}"
+	case map[string]any:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		elems := make([]string, len(keys))
+		for i, k := range keys {
+			elems[i] = fmt.Sprintf("%q: %s", k, goLiteral(val[k]))
+		}
+		return "map[string]any{" + strings.Join(elems, ", ") + "}"
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+func (g *grafanaCodeGenerator) getAnnotationVarName(a GrafanaAnnotation) string {
+	if a.BuiltIn == 1 {
+		return "BuiltInAnnotation"
+	}
+	return g.sanitizeVarName(a.Name) + "Annotation"
+}
+
+func (g *grafanaCodeGenerator) writeAnnotation(buf *bytes.Buffer, a GrafanaAnnotation) {
+	varName := g.getAnnotationVarName(a)
+	dsType, uid := datasourceRef(a.Datasource)
+
+	buf.WriteString(fmt.Sprintf("// %s is the %s annotation.\n", varName, a.Name))
+	switch {
+	case a.BuiltIn == 1:
+		buf.WriteString(fmt.Sprintf("var %s = grafana.BuiltInAnnotation()", varName))
+	case a.Type == "tags":
+		args := []string{fmt.Sprintf("%q", a.Name)}
+		for _, t := range a.tags() {
+			args = append(args, fmt.Sprintf("%q", t))
+		}
+		buf.WriteString(fmt.Sprintf("var %s = grafana.TagAnnotation(%s)", varName, strings.Join(args, ", ")))
+	case dsType == grafana.DataSourceTypePrometheus:
+		buf.WriteString(fmt.Sprintf("var %s = grafana.PrometheusAnnotation(%q, %q)", varName, a.Name, a.expr()))
+	case dsType == grafana.DataSourceTypeLoki:
+		buf.WriteString(fmt.Sprintf("var %s = grafana.LokiAnnotation(%q, %q)", varName, a.Name, a.expr()))
+	default:
+		buf.WriteString(fmt.Sprintf("var %s = &grafana.Annotation{Name: %q, IsEnabled: true, Type: %q, Expr: %q", varName, a.Name, a.Type, a.expr()))
+		if a.Datasource != nil {
+			buf.WriteString(fmt.Sprintf(", Datasource: &grafana.DataSourceRef{Type: %q}", dsType))
+		}
+		buf.WriteString("}")
+	}
+
+	// Add method chains for settings differing from the constructor defaults
+	defaults := newAnnotation(a)
+	if uid != "" && a.BuiltIn != 1 {
+		buf.WriteString(fmt.Sprintf(".\n\tWithDatasource(%q)", uid))
+	}
+	if a.IconColor != defaults.IconColor {
+		buf.WriteString(fmt.Sprintf(".\n\tWithIconColor(%q)", a.IconColor))
+	}
+	if a.Step != "" {
+		buf.WriteString(fmt.Sprintf(".\n\tWithStep(%q)", a.Step))
+	}
+	if a.TitleFormat != "" {
+		buf.WriteString(fmt.Sprintf(".\n\tWithTitleFormat(%q)", a.TitleFormat))
+	}
+	if a.TextFormat != "" {
+		buf.WriteString(fmt.Sprintf(".\n\tWithTextFormat(%q)", a.TextFormat))
+	}
+	if a.TagKeys != "" {
+		keys := strings.Split(a.TagKeys, ",")
+		for i, k := range keys {
+			keys[i] = fmt.Sprintf("%q", strings.TrimSpace(k))
+		}
+		buf.WriteString(fmt.Sprintf(".\n\tWithTagKeys(%s)", strings.Join(keys, ", ")))
+	}
+	if a.UseValueForTime {
+		buf.WriteString(".\n\tUseValueForTime()")
+	}
+	if a.Enable != defaults.IsEnabled {
+		if a.Enable {
+			buf.WriteString(".\n\tEnable()")
+		} else {
+			buf.WriteString(".\n\tDisable()")
+		}
+	}
+	if a.Hide != defaults.Hidden {
+		if a.Hide {
+			buf.WriteString(".\n\tHide()")
+		} else {
+			buf.WriteString(".\n\tShow()")
+		}
+	}
+
+	buf.WriteString("\n\n")
+}
+
 func (g *grafanaCodeGenerator) sanitizeVarName(name string) string {
 	result := strings.Builder{}
 	capitalize := true
 
 	for _, c := range name {
-		if c == '-' || c == '_' || c == '.' || c == '/' || c == ' ' || c == ':' {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) {
 			capitalize = true
 			continue
 		}
@@ -1,8 +1,12 @@
 package importer
 
 import (
+	"encoding/json"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/lex00/wetwire-observability-go/grafana"
 )
 
 func TestParseGrafanaDashboardFromBytes(t *testing.T) {
@@ -362,3 +366,457 @@ func TestConvertToWetwire(t *testing.T) {
 		t.Errorf("expected 1 panel in first row, got %d", len(dashboard.Rows[0].Panels))
 	}
 }
+
+const annotationsDashboard = `{
+	"uid": "annotated",
+	"title": "Annotated",
+	"panels": [],
+	"annotations": {
+		"list": [
+			{
+				"builtIn": 1,
+				"datasource": {"type": "grafana", "uid": "-- Grafana --"},
+				"enable": true,
+				"hide": false,
+				"iconColor": "rgba(0, 211, 255, 1)",
+				"name": "Annotations & Alerts",
+				"type": "dashboard",
+				"target": {"limit": 100, "matchAny": false, "tags": [], "type": "dashboard"}
+			},
+			{
+				"datasource": {"type": "prometheus", "uid": "prom"},
+				"enable": true,
+				"expr": "changes(kube_deployment_status_observed_generation{namespace=\"$namespace\"}[1m]) > 0",
+				"iconColor": "blue",
+				"name": "Deploys",
+				"step": "1m",
+				"titleFormat": "Deploy",
+				"textFormat": "{{deployment}}",
+				"tagKeys": "namespace,deployment"
+			},
+			{
+				"datasource": {"type": "loki", "uid": "loki"},
+				"enable": false,
+				"expr": "{app=\"api\"} |= \"panic\"",
+				"iconColor": "orange",
+				"name": "Panics"
+			}
+		]
+	}
+}`
+
+func TestConvertToWetwireAnnotations(t *testing.T) {
+	gd, err := ParseGrafanaDashboardFromBytes([]byte(annotationsDashboard))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	data, err := ConvertToWetwire(gd).Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+
+	var original, exported struct {
+		Annotations struct {
+			List []map[string]any `json:"list"`
+		} `json:"annotations"`
+	}
+	if err := json.Unmarshal([]byte(annotationsDashboard), &original); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &exported); err != nil {
+		t.Fatal(err)
+	}
+	// hide: false is omitted on export
+	delete(original.Annotations.List[0], "hide")
+
+	if !reflect.DeepEqual(original.Annotations.List, exported.Annotations.List) {
+		t.Errorf("annotations changed on re-export:\ngot  %v\nwant %v", exported.Annotations.List, original.Annotations.List)
+	}
+}
+
+func TestGenerateGrafanaGoCodeAnnotations(t *testing.T) {
+	gd, err := ParseGrafanaDashboardFromBytes([]byte(annotationsDashboard))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	code, err := GenerateGrafanaGoCode(gd, "dashboards")
+	if err != nil {
+		t.Fatalf("GenerateGrafanaGoCode() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"var BuiltInAnnotation = grafana.BuiltInAnnotation().\n\tShow()",
+		`var DeploysAnnotation = grafana.PrometheusAnnotation("Deploys", "changes(kube_deployment_status_observed_generation{namespace=\"$namespace\"}[1m]) > 0").`,
+		`WithDatasource("prom").`,
+		`WithIconColor("blue").`,
+		`WithTagKeys("namespace", "deployment")`,
+		"var PanicsAnnotation = grafana.LokiAnnotation(\"Panics\", \"{app=\\\"api\\\"} |= \\\"panic\\\"\").\n\tWithDatasource(\"loki\").\n\tDisable()\n",
+		"WithAnnotations(\n\t\tBuiltInAnnotation,\n\t\tDeploysAnnotation,\n\t\tPanicsAnnotation,\n\t)",
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("generated code missing %q:\n%s", want, code)
+		}
+	}
+}
+
+const linksDashboard = `{
+	"uid": "linked",
+	"title": "Linked",
+	"links": [
+		{"title": "Services", "type": "dashboards", "tags": ["service"], "icon": "external link", "asDropdown": true, "includeVars": true, "keepTime": true, "targetBlank": false},
+		{"title": "Runbook", "type": "link", "tags": [], "url": "https://runbooks.example.com", "icon": "doc", "tooltip": "Runbooks", "asDropdown": false, "includeVars": false, "keepTime": false, "targetBlank": true}
+	],
+	"panels": [
+		{"type": "row", "title": "Pods"},
+		{
+			"type": "table",
+			"title": "Pods",
+			"gridPos": {"x": 0, "y": 1, "w": 24, "h": 8},
+			"links": [{"title": "Docs", "url": "https://docs.example.com", "targetBlank": true}],
+			"fieldConfig": {
+				"defaults": {
+					"links": [{"title": "Pod details", "url": "/d/pod?var-pod=${__field.labels.pod}&${__url_time_range}"}]
+				}
+			}
+		}
+	]
+}`
+
+func TestConvertToWetwireLinks(t *testing.T) {
+	gd, err := ParseGrafanaDashboardFromBytes([]byte(linksDashboard))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	d := ConvertToWetwire(gd)
+	data, err := d.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+
+	var original, exported struct {
+		Links []map[string]any `json:"links"`
+	}
+	if err := json.Unmarshal([]byte(linksDashboard), &original); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &exported); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(original.Links, exported.Links) {
+		t.Errorf("links changed on re-export:\ngot  %v\nwant %v", exported.Links, original.Links)
+	}
+
+	table, ok := d.Rows[0].Panels[0].(*grafana.TablePanel)
+	if !ok {
+		t.Fatalf("panel = %T, want *grafana.TablePanel", d.Rows[0].Panels[0])
+	}
+	if len(table.Links) != 1 || !table.Links[0].TargetBlank {
+		t.Errorf("Links = %+v", table.Links)
+	}
+	if len(table.FieldConfig.Defaults.Links) != 1 || table.FieldConfig.Defaults.Links[0].Title != "Pod details" {
+		t.Errorf("FieldConfig.Defaults.Links = %+v", table.FieldConfig.Defaults.Links)
+	}
+}
+
+func TestGenerateGrafanaGoCodeLinks(t *testing.T) {
+	gd, err := ParseGrafanaDashboardFromBytes([]byte(linksDashboard))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	code, err := GenerateGrafanaGoCode(gd, "dashboards")
+	if err != nil {
+		t.Fatalf("GenerateGrafanaGoCode() error = %v", err)
+	}
+
+	for _, want := range []string{
+		`grafana.DashboardsByTag("Services", "service").AsDropdown().IncludeVars().KeepTime(),`,
+		`grafana.URLLink("Runbook", "https://runbooks.example.com").WithIcon("doc").WithTooltip("Runbooks").OpenInNewTab(),`,
+		"WithLinks(\n\t\tgrafana.NewDataLink(\"Docs\", \"https://docs.example.com\").OpenInNewTab(),\n\t)",
+		"WithDataLinks(\n\t\tgrafana.NewDataLink(\"Pod details\", \"/d/pod?var-pod=${__field.labels.pod}&${__url_time_range}\"),\n\t)",
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("generated code missing %q:\n%s", want, code)
+		}
+	}
+}
+
+const transformationsDashboard = `{
+	"uid": "pods",
+	"title": "Pods",
+	"panels": [
+		{"type": "row", "title": "Pods"},
+		{
+			"type": "table",
+			"title": "Pods",
+			"gridPos": {"x": 0, "y": 1, "w": 24, "h": 8},
+			"transformations": [
+				{"id": "joinByField", "options": {"byField": "pod", "mode": "outer"}},
+				{"id": "organize", "options": {"excludeByName": {"Time": true}, "indexByName": {"pod": 0}, "renameByName": {"Value #A": "CPU"}}},
+				{"id": "filterByValue", "disabled": true, "options": {"filters": [{"fieldName": "CPU", "config": {"id": "greater", "options": {"value": 0.5}}}], "match": "any", "type": "include"}}
+			]
+		}
+	]
+}`
+
+func TestConvertToWetwireTransformations(t *testing.T) {
+	gd, err := ParseGrafanaDashboardFromBytes([]byte(transformationsDashboard))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	d := ConvertToWetwire(gd)
+	data, err := d.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+
+	type panels struct {
+		Panels []struct {
+			Transformations []map[string]any `json:"transformations"`
+		} `json:"panels"`
+	}
+	var original, exported panels
+	if err := json.Unmarshal([]byte(transformationsDashboard), &original); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &exported); err != nil {
+		t.Fatal(err)
+	}
+	if len(exported.Panels) != 2 {
+		t.Fatalf("exported %d panels, want 2", len(exported.Panels))
+	}
+	if !reflect.DeepEqual(original.Panels[1].Transformations, exported.Panels[1].Transformations) {
+		t.Errorf("transformations changed on re-export:\ngot  %v\nwant %v", exported.Panels[1].Transformations, original.Panels[1].Transformations)
+	}
+
+	table, ok := d.Rows[0].Panels[0].(*grafana.TablePanel)
+	if !ok {
+		t.Fatalf("panel = %T, want *grafana.TablePanel", d.Rows[0].Panels[0])
+	}
+	if len(table.Transformations) != 3 || !table.Transformations[2].Disabled {
+		t.Errorf("Transformations = %+v", table.Transformations)
+	}
+}
+
+func TestGenerateGrafanaGoCodeTransformations(t *testing.T) {
+	gd, err := ParseGrafanaDashboardFromBytes([]byte(transformationsDashboard))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	code, err := GenerateGrafanaGoCode(gd, "dashboards")
+	if err != nil {
+		t.Fatalf("GenerateGrafanaGoCode() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"WithTransformations(\n",
+		`grafana.NewTransformation("joinByField", map[string]any{"byField": "pod", "mode": "outer"}),`,
+		`grafana.NewTransformation("organize", map[string]any{"excludeByName": map[string]any{"Time": true}, "indexByName": map[string]any{"pod": 0}, "renameByName": map[string]any{"Value #A": "CPU"}}),`,
+		`"options": map[string]any{"value": 0.5}`,
+		`}).Disable(),`,
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("generated code missing %q:\n%s", want, code)
+		}
+	}
+}
+
+const panelTypesDashboard = `{
+	"uid": "panel-types",
+	"title": "Panel Types",
+	"panels": [
+		{"type": "row", "title": "Panels"},
+		{"type": "state-timeline", "title": "Pod Phase", "gridPos": {"x": 0, "y": 1, "w": 24, "h": 8}, "options": {"mergeValues": false, "showValue": "never", "rowHeight": 0.8}},
+		{"type": "status-history", "title": "Node Health", "gridPos": {"x": 0, "y": 9, "w": 24, "h": 8}, "options": {"colWidth": 0.5}},
+		{"type": "barchart", "title": "Requests by Route", "gridPos": {"x": 0, "y": 17, "w": 12, "h": 8}, "options": {"orientation": "horizontal", "xField": "route", "stacking": "normal"}},
+		{"type": "histogram", "title": "Latency", "gridPos": {"x": 12, "y": 17, "w": 12, "h": 8}, "options": {"bucketSize": 0.05, "combine": true}},
+		{"type": "xychart", "title": "CPU vs Memory", "gridPos": {"x": 0, "y": 25, "w": 12, "h": 8}, "options": {"seriesMapping": "manual", "series": [{"name": "Pods", "x": "cpu", "y": "memory"}]}},
+		{"type": "trend", "title": "Latency by Load", "gridPos": {"x": 12, "y": 25, "w": 12, "h": 8}, "options": {"xField": "rps"}},
+		{"type": "nodeGraph", "title": "Service Map", "gridPos": {"x": 0, "y": 33, "w": 24, "h": 12}, "options": {"nodes": {"mainStatUnit": "ms", "arcs": [{"field": "arc__failed", "color": "red"}]}}},
+		{"type": "traces", "title": "Trace", "datasource": {"type": "tempo", "uid": "tempo"}, "gridPos": {"x": 0, "y": 45, "w": 24, "h": 16}},
+		{"type": "flamegraph", "title": "CPU Profile", "gridPos": {"x": 0, "y": 61, "w": 24, "h": 16}},
+		{"type": "geomap", "title": "Sites", "gridPos": {"x": 0, "y": 77, "w": 12, "h": 12}, "options": {"view": {"id": "coords", "lat": 48.85, "lon": 2.35, "zoom": 5}, "layers": [{"type": "markers", "name": "Sites", "location": {"mode": "coords", "latitude": "lat", "longitude": "lon"}, "tooltip": true, "config": {"showLegend": true}}]}},
+		{"type": "canvas", "title": "Overview", "gridPos": {"x": 12, "y": 77, "w": 12, "h": 12}, "options": {"inlineEditing": false, "root": {"type": "frame", "name": "Root", "elements": [{"type": "text", "name": "Title", "config": {"text": {"mode": "fixed", "fixed": "API"}}, "placement": {"top": 10, "left": 20, "width": 200, "height": 40}}]}}},
+		{"type": "alertlist", "title": "Alerts", "gridPos": {"x": 0, "y": 89, "w": 12, "h": 8}, "options": {"maxItems": 50, "sortOrder": 3, "groupMode": "custom", "groupBy": ["namespace"], "stateFilter": {"firing": true, "error": true}}},
+		{"type": "dashlist", "title": "Services", "gridPos": {"x": 12, "y": 89, "w": 8, "h": 8}, "options": {"showStarred": false, "showSearch": true, "query": "api", "tags": ["service"]}},
+		{"type": "news", "title": "Status", "gridPos": {"x": 20, "y": 89, "w": 4, "h": 8}, "options": {"feedUrl": "https://status.example.com/feed.atom", "showImage": false}}
+	]
+}`
+
+func TestConvertToWetwirePanelTypes(t *testing.T) {
+	gd, err := ParseGrafanaDashboardFromBytes([]byte(panelTypesDashboard))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if warnings := ValidateGrafanaDashboard(gd); len(warnings) > 0 {
+		t.Errorf("ValidateGrafanaDashboard() = %v, want no warnings", warnings)
+	}
+
+	d := ConvertToWetwire(gd)
+	panels := d.Rows[0].Panels
+	if len(panels) != 14 {
+		t.Fatalf("converted %d panels, want 14", len(panels))
+	}
+	for i, want := range []string{
+		"state-timeline", "status-history", "barchart", "histogram", "xychart", "trend", "nodeGraph",
+		"traces", "flamegraph", "geomap", "canvas", "alertlist", "dashlist", "news",
+	} {
+		if got := panels[i].(grafana.Panel).GetType(); got != want {
+			t.Errorf("panel %d type = %q, want %q", i, got, want)
+		}
+	}
+
+	if p := panels[0].(*grafana.StateTimelinePanel); p.Options.MergeValues || p.Options.ShowValue != "never" || p.Options.AlignValue != "left" {
+		t.Errorf("StateTimeline options = %+v", p.Options)
+	}
+	if p := panels[2].(*grafana.BarChartPanel); p.Options.XField != "route" || p.Options.GroupWidth != 0.7 {
+		t.Errorf("BarChart options = %+v", p.Options)
+	}
+	if p := panels[9].(*grafana.GeomapPanel); len(p.Options.Layers) != 1 || p.Options.Layers[0].Location.Latitude != "lat" {
+		t.Errorf("Geomap layers = %+v", p.Options.Layers)
+	}
+	if p := panels[11].(*grafana.AlertListPanel); !p.Options.StateFilter.Error || p.Options.StateFilter.Pending || p.Options.SortOrder != grafana.AlertSortImportance {
+		t.Errorf("AlertList state filter = %+v", p.Options.StateFilter)
+	}
+	if p := panels[13].(*grafana.NewsPanel); p.Options.FeedURL != "https://status.example.com/feed.atom" || p.Options.ShowImage {
+		t.Errorf("News options = %+v", p.Options)
+	}
+
+	data, err := d.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	var exported struct {
+		Panels []GrafanaPanel `json:"panels"`
+	}
+	if err := json.Unmarshal(data, &exported); err != nil {
+		t.Fatal(err)
+	}
+	if len(exported.Panels) != 15 {
+		t.Fatalf("exported %d panels, want 15", len(exported.Panels))
+	}
+	if gp := exported.Panels[14].GridPos; gp.W != 4 || gp.H != 8 || gp.Y == 0 {
+		t.Errorf("news gridPos = %+v, want a laid out 4x8 panel", gp)
+	}
+}
+
+func TestGenerateGrafanaGoCodePanelTypes(t *testing.T) {
+	gd, err := ParseGrafanaDashboardFromBytes([]byte(panelTypesDashboard))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	code, err := GenerateGrafanaGoCode(gd, "dashboards")
+	if err != nil {
+		t.Fatalf("GenerateGrafanaGoCode() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"grafana.StateTimeline(\"Pod Phase\").\n\tSplitValues().\n\tShowValues(\"never\").\n\tWithRowHeight(0.8)",
+		"grafana.StatusHistory(\"Node Health\").\n\tWithColumnWidth(0.5)",
+		"grafana.BarChart(\"Requests by Route\").\n\tHorizontal().\n\tWithXField(\"route\").\n\tStacked()",
+		"grafana.Histogram(\"Latency\").\n\tWithBucketSize(0.05).\n\tCombine()",
+		`AddSeries("Pods", "cpu", "memory")`,
+		`WithXField("rps")`,
+		`WithNodeStatUnits("ms", "")`,
+		`AddArc("arc__failed", "red")`,
+		`grafana.Traces("Trace")`,
+		`grafana.FlameGraph("CPU Profile")`,
+		`WithView(48.85, 2.35, 5)`,
+		`AddLayer(grafana.MarkersLayer("Sites").WithCoords("lat", "lon").WithConfig(map[string]any{"showLegend": true}))`,
+		`grafana.NewCanvasElement("text", "Title", map[string]any{"text": map[string]any{"fixed": "API", "mode": "fixed"}}).At(20, 10, 200, 40),`,
+		`DisableInlineEditing()`,
+		`WithStates(grafana.AlertStateFiring, grafana.AlertStateError)`,
+		`WithMaxItems(50)`,
+		`WithSortOrder(grafana.AlertSortImportance)`,
+		`GroupBy("namespace")`,
+		`HideStarred()`,
+		`Search("api", "service")`,
+		`grafana.News("Status", "https://status.example.com/feed.atom").` + "\n\tHideImages()",
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("generated code missing %q:\n%s", want, code)
+		}
+	}
+}
+
+func TestGenerateGrafanaGoCodeGeomapViews(t *testing.T) {
+	gd, err := ParseGrafanaDashboardFromBytes([]byte(`{
+	"uid": "maps",
+	"title": "Maps",
+	"panels": [
+		{"type": "geomap", "title": "Fit", "options": {"view": {"id": "fit", "zoom": 4}}},
+		{"type": "geomap", "title": "Europe", "options": {"view": {"id": "europe"}}},
+		{"type": "geomap", "title": "World", "options": {"view": {"id": "zero"}}}
+	]
+}`))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	code, err := GenerateGrafanaGoCode(gd, "dashboards")
+	if err != nil {
+		t.Fatalf("GenerateGrafanaGoCode() error = %v", err)
+	}
+	for _, want := range []string{
+		"grafana.Geomap(\"Fit\").\n\tWithPresetView(\"fit\", 4)",
+		"grafana.Geomap(\"Europe\").\n\tWithPresetView(\"europe\", 0)",
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("generated code missing %q:\n%s", want, code)
+		}
+	}
+	if strings.Contains(string(code), `WithPresetView("zero"`) {
+		t.Errorf("generated code sets the default view:\n%s", code)
+	}
+}
+
+const repeatDashboard = `{
+	"uid": "pods",
+	"title": "Pods",
+	"templating": {"list": [{"name": "namespace", "type": "query", "query": "label_values(namespace)", "multi": true}]},
+	"panels": [
+		{"type": "row", "title": "$namespace", "repeat": "namespace", "gridPos": {"x": 0, "y": 0, "w": 24, "h": 1}},
+		{"type": "stat", "title": "Pods", "repeat": "namespace", "repeatDirection": "h", "maxPerRow": 4, "gridPos": {"x": 0, "y": 1, "w": 6, "h": 4}},
+		{"type": "timeseries", "title": "CPU", "repeat": "namespace", "repeatDirection": "v", "gridPos": {"x": 0, "y": 5, "w": 12, "h": 8}}
+	]
+}`
+
+func TestConvertToWetwireRepeats(t *testing.T) {
+	gd, err := ParseGrafanaDashboardFromBytes([]byte(repeatDashboard))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	d := ConvertToWetwire(gd)
+	row := d.Rows[0]
+	if row.Repeat != "namespace" {
+		t.Errorf("row Repeat = %q, want namespace", row.Repeat)
+	}
+	stat := row.Panels[0].(*grafana.StatPanel)
+	if stat.Repeat != "namespace" || stat.RepeatDirection != grafana.RepeatHorizontal || stat.MaxPerRow != 4 {
+		t.Errorf("stat Repeat = %q, RepeatDirection = %q, MaxPerRow = %d", stat.Repeat, stat.RepeatDirection, stat.MaxPerRow)
+	}
+	if ts := row.Panels[1].(*grafana.TimeSeriesPanel); ts.RepeatDirection != grafana.RepeatVertical {
+		t.Errorf("timeseries RepeatDirection = %q, want v", ts.RepeatDirection)
+	}
+
+	code, err := GenerateGrafanaGoCode(gd, "dashboards")
+	if err != nil {
+		t.Fatalf("GenerateGrafanaGoCode() error = %v", err)
+	}
+	for _, want := range []string{
+		"grafana.NewRow(\"$namespace\").\n\tRepeatBy(\"namespace\")",
+		"RepeatBy(\"namespace\").\n\tWithMaxPerRow(4)",
+		"RepeatBy(\"namespace\").\n\tRepeatVertically()",
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("generated code missing %q:\n%s", want, code)
+		}
+	}
+}
@@ -201,6 +201,321 @@ func TestGenerateGoCode_WithKubernetesSD(t *testing.T) {
 	}
 }
 
+func TestGenerateGoCode_WithServiceDiscovery(t *testing.T) {
+	yaml := `
+scrape_configs:
+  - job_name: discovery
+    http_sd_configs:
+      - url: https://inventory.example.com/targets
+        bearer_token_file: /etc/token
+    gce_sd_configs:
+      - project: infra
+        zone: us-central1-a
+        port: 9100
+    azure_sd_configs:
+      - subscription_id: sub-1
+        authentication_method: ManagedIdentity
+        resource_group: production
+    docker_sd_configs:
+      - host: unix:///var/run/docker.sock
+        filters:
+          - name: label
+            values: [prometheus.scrape=true]
+    dockerswarm_sd_configs:
+      - host: unix:///var/run/docker.sock
+        role: nodes
+    nomad_sd_configs:
+      - server: http://nomad:4646
+        namespace: platform
+    eureka_sd_configs:
+      - server: http://eureka:8761/eureka
+        basic_auth:
+          username: prom
+          password_file: /etc/eureka
+    openstack_sd_configs:
+      - role: hypervisor
+        region: RegionOne
+        identity_endpoint: https://keystone:5000/v3
+        tls_config:
+          ca_file: /etc/ca.pem
+    hetzner_sd_configs:
+      - role: robot
+    linode_sd_configs:
+      - region: us-east
+`
+	config, err := ParsePrometheusConfigFromBytes([]byte(yaml))
+	if err != nil {
+		t.Fatalf("ParsePrometheusConfigFromBytes() error = %v", err)
+	}
+
+	code, err := GenerateGoCode(config, "monitoring")
+	if err != nil {
+		t.Fatalf("GenerateGoCode() error = %v", err)
+	}
+
+	codeStr := string(code)
+
+	expectations := []string{
+		`WithHTTPSD(prometheus.NewHTTPSD("https://inventory.example.com/targets").`,
+		`WithBearerTokenFile("/etc/token")`,
+		`WithGCESD(prometheus.NewGCESD("infra", "us-central1-a").`,
+		`WithPort(9100)`,
+		`WithAzureSD(prometheus.NewAzureSD("sub-1").`,
+		`WithManagedIdentity("")`,
+		`WithResourceGroup("production")`,
+		`WithDockerSD(prometheus.NewDockerSD("unix:///var/run/docker.sock").`,
+		`WithFilter("label", "prometheus.scrape=true")`,
+		`WithDockerSwarmSD(prometheus.NewDockerSwarmSD("unix:///var/run/docker.sock", prometheus.DockerSwarmRoleNodes))`,
+		`WithNomadSD(prometheus.NewNomadSD("http://nomad:4646").`,
+		`WithNamespace("platform")`,
+		`WithBasicAuth(&prometheus.BasicAuth{Username: "prom", PasswordFile: "/etc/eureka"})`,
+		`WithOpenStackSD(prometheus.NewOpenStackSD(prometheus.OpenStackRoleHypervisor, "RegionOne").`,
+		`WithTLSConfig(&prometheus.TLSConfig{CAFile: "/etc/ca.pem"})`,
+		`WithHetznerSD(prometheus.NewHetznerSD(prometheus.HetznerRoleRobot))`,
+		`WithLinodeSD(prometheus.NewLinodeSD().`,
+		`WithRegion("us-east")`,
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(codeStr, exp) {
+			t.Errorf("GenerateGoCode() missing %q\nGot:\n%s", exp, codeStr)
+		}
+	}
+}
+
+func TestGenerateGoCode_WithHTTPClientConfig(t *testing.T) {
+	yaml := `
+scrape_configs:
+  - job_name: api
+    authorization:
+      credentials_file: /etc/api-token
+    tls_config:
+      ca_file: /etc/ca.pem
+    kubernetes_sd_configs:
+      - role: pod
+        oauth2:
+          client_id: prometheus
+          client_secret_file: /etc/oauth-secret
+          token_url: https://auth.example.com/token
+          scopes: [metrics.read]
+remote_write:
+  - url: https://aps-workspaces.us-east-1.amazonaws.com/workspaces/ws-1/api/v1/remote_write
+    sigv4:
+      region: us-east-1
+  - url: https://monitor.azure.com/write
+    azuread:
+      cloud: AzurePublic
+      managed_identity:
+        client_id: mi-1
+  - url: https://monitoring.googleapis.com/write
+    google_iam:
+      credentials_file: /etc/gcp.json
+remote_read:
+  - url: http://thanos:10902/api/v1/read
+    basic_auth:
+      username: prom
+      password_file: /etc/thanos-password
+`
+	config, err := ParsePrometheusConfigFromBytes([]byte(yaml))
+	if err != nil {
+		t.Fatalf("ParsePrometheusConfigFromBytes() error = %v", err)
+	}
+
+	code, err := GenerateGoCode(config, "monitoring")
+	if err != nil {
+		t.Fatalf("GenerateGoCode() error = %v", err)
+	}
+
+	codeStr := string(code)
+
+	expectations := []string{
+		`WithAuthorizationFile("/etc/api-token")`,
+		`WithTLSConfig(&prometheus.TLSConfig{CAFile: "/etc/ca.pem"})`,
+		`WithOAuth2(prometheus.NewOAuth2("prometheus", "", "https://auth.example.com/token").WithClientSecretFile("/etc/oauth-secret").WithScopes("metrics.read"))`,
+		`WithSigV4(prometheus.NewSigV4("us-east-1"))`,
+		`WithAzureAD(prometheus.NewAzureADManagedIdentity("mi-1").WithCloud("AzurePublic"))`,
+		`WithGoogleIAM(prometheus.NewGoogleIAM("/etc/gcp.json"))`,
+		`WithBasicAuthFile("prom", "/etc/thanos-password")`,
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(codeStr, exp) {
+			t.Errorf("GenerateGoCode() missing %q\nGot:\n%s", exp, codeStr)
+		}
+	}
+}
+
+func TestGenerateGoCode_WithHTTPClientProxySettings(t *testing.T) {
+	yaml := `
+scrape_configs:
+  - job_name: api
+    bearer_token: token
+    follow_redirects: false
+    enable_http2: false
+    proxy_url: http://proxy:3128
+    no_proxy: localhost,10.0.0.0/8
+    proxy_connect_header:
+      Proxy-Authorization: [Basic abc]
+    http_sd_configs:
+      - url: http://sd/targets
+        proxy_from_environment: true
+        follow_redirects: true
+`
+	config, err := ParsePrometheusConfigFromBytes([]byte(yaml))
+	if err != nil {
+		t.Fatalf("ParsePrometheusConfigFromBytes() error = %v", err)
+	}
+
+	code, err := GenerateGoCode(config, "monitoring")
+	if err != nil {
+		t.Fatalf("GenerateGoCode() error = %v", err)
+	}
+
+	codeStr := string(code)
+
+	expectations := []string{
+		`WithBearerToken("token")`,
+		`WithFollowRedirects(false)`,
+		`WithEnableHTTP2(false)`,
+		`WithNoProxy("localhost,10.0.0.0/8")`,
+		`WithProxyConnectHeader("Proxy-Authorization", "Basic abc")`,
+		`WithProxyFromEnvironment()`,
+		`WithFollowRedirects(true)`,
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(codeStr, exp) {
+			t.Errorf("GenerateGoCode() missing %q\nGot:\n%s", exp, codeStr)
+		}
+	}
+}
+
+func TestGenerateGoCode_WithAuthenticatedAlertmanagers(t *testing.T) {
+	yaml := `
+alerting:
+  alertmanagers:
+    - static_configs:
+        - targets: [alertmanager:9093]
+      scheme: https
+      basic_auth:
+        username: prometheus
+        password_file: /etc/alertmanager-password
+      tls_config:
+        ca_file: /etc/ca.pem
+      follow_redirects: false
+    - static_configs:
+        - targets: [aps-workspaces.us-east-1.amazonaws.com]
+      scheme: https
+      path_prefix: /workspaces/ws-1/alertmanager
+      sigv4:
+        region: us-east-1
+        role_arn: arn:aws:iam::123456789012:role/prometheus
+`
+	config, err := ParsePrometheusConfigFromBytes([]byte(yaml))
+	if err != nil {
+		t.Fatalf("ParsePrometheusConfigFromBytes() error = %v", err)
+	}
+
+	code, err := GenerateGoCode(config, "monitoring")
+	if err != nil {
+		t.Fatalf("GenerateGoCode() error = %v", err)
+	}
+
+	codeStr := string(code)
+
+	expectations := []string{
+		`HTTPClientConfig: prometheus.HTTPClientConfig{BasicAuth: &prometheus.BasicAuth{Username: "prometheus", PasswordFile: "/etc/alertmanager-password"}, TLSConfig: &prometheus.TLSConfig{CAFile: "/etc/ca.pem"}, FollowRedirects: new(bool)}`,
+		`prometheus.NewSigV4("us-east-1").WithRoleARN("arn:aws:iam::123456789012:role/prometheus")`,
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(codeStr, exp) {
+			t.Errorf("GenerateGoCode() missing %q\nGot:\n%s", exp, codeStr)
+		}
+	}
+}
+
+func TestGenerateGoCode_WithServerSections(t *testing.T) {
+	yaml := `
+global:
+  query_log_file: /prometheus/query.log
+  scrape_protocols: [PrometheusProto, OpenMetricsText1.0.0]
+runtime:
+  gogc: 50
+scrape_config_files:
+  - scrapes/*.yml
+scrape_configs:
+  - job_name: histograms
+    scrape_protocols: [PrometheusProto]
+    scrape_native_histograms: true
+    native_histogram_bucket_limit: 160
+    convert_classic_histograms_to_nhcb: true
+alerting:
+  alert_relabel_configs:
+    - regex: replica
+      action: labeldrop
+  alertmanagers:
+    - static_configs:
+        - targets: [alertmanager:9093]
+      api_version: v2
+storage:
+  tsdb:
+    out_of_order_time_window: 30m
+  exemplars:
+    max_exemplars: 200000
+tracing:
+  endpoint: otel-collector:4317
+  client_type: grpc
+  sampling_fraction: 0.1
+otlp:
+  promote_resource_attributes: [service.namespace]
+  translation_strategy: NoUTF8EscapingWithSuffixes
+`
+	config, err := ParsePrometheusConfigFromBytes([]byte(yaml))
+	if err != nil {
+		t.Fatalf("ParsePrometheusConfigFromBytes() error = %v", err)
+	}
+
+	code, err := GenerateGoCode(config, "monitoring")
+	if err != nil {
+		t.Fatalf("GenerateGoCode() error = %v", err)
+	}
+
+	codeStr := string(code)
+
+	expectations := []string{
+		`QueryLogFile:`,
+		`"/prometheus/query.log"`,
+		`ScrapeProtocols: []prometheus.ScrapeProtocol{prometheus.ScrapeProtocolPrometheusProto, prometheus.ScrapeProtocolOpenMetricsText100}`,
+		`WithScrapeProtocols(prometheus.ScrapeProtocolPrometheusProto)`,
+		`WithNativeHistograms()`,
+		`WithNativeHistogramBucketLimit(160)`,
+		`WithConvertClassicHistogramsToNHCB()`,
+		`var Runtime = prometheus.NewRuntimeConfig().`,
+		`WithGOGC(50)`,
+		`"scrapes/*.yml"`,
+		`AlertRelabelConfigs: []*prometheus.RelabelConfig{`,
+		`{Regex: "replica", Action: "labeldrop"}`,
+		`{Targets: []string{"alertmanager:9093"}}`,
+		`WithOutOfOrderTimeWindow(30 * prometheus.Minute)`,
+		`WithMaxExemplars(200000)`,
+		`var Tracing = prometheus.NewTracingConfig("otel-collector:4317").`,
+		`WithClientType(prometheus.TracingClientGRPC)`,
+		`WithSamplingFraction(0.1)`,
+		`WithPromoteResourceAttributes("service.namespace")`,
+		`WithTranslationStrategy("NoUTF8EscapingWithSuffixes")`,
+		`Alerting:`,
+		`Storage:`,
+		`OTLP:`,
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(codeStr, exp) {
+			t.Errorf("GenerateGoCode() missing %q\nGot:\n%s", exp, codeStr)
+		}
+	}
+}
+
 func TestGenerateGoCode_WithRemoteWrite(t *testing.T) {
 	config := &prometheus.PrometheusConfig{
 		RemoteWrite: []*prometheus.RemoteWriteConfig{
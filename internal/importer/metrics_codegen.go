@@ -0,0 +1,153 @@
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"unicode"
+
+	"github.com/lex00/wetwire-observability-go/promql/metrics"
+)
+
+// metricInitialisms are metric name words generated in upper case.
+var metricInitialisms = map[string]bool{
+	"api": true, "cpu": true, "dns": true, "gc": true, "grpc": true,
+	"http": true, "id": true, "io": true, "ip": true, "jvm": true,
+	"os": true, "rpc": true, "sql": true, "ssl": true, "tcp": true,
+	"tls": true, "ttl": true, "udp": true, "uid": true, "url": true,
+	"uuid": true, "vm": true,
+}
+
+// metricTypeConsts are the Go constants of the metric types.
+var metricTypeConsts = map[metrics.Type]string{
+	metrics.Counter:        "metrics.Counter",
+	metrics.Gauge:          "metrics.Gauge",
+	metrics.Histogram:      "metrics.Histogram",
+	metrics.GaugeHistogram: "metrics.GaugeHistogram",
+	metrics.Summary:        "metrics.Summary",
+	metrics.Info:           "metrics.Info",
+	metrics.StateSet:       "metrics.StateSet",
+	metrics.Unknown:        "metrics.Unknown",
+}
+
+// GenerateMetricsGoCode generates a Go package of metric descriptors, one
+// variable per metric, and a Catalog variable containing all of them.
+func GenerateMetricsGoCode(descs []*metrics.Descriptor, packageName string) ([]byte, error) {
+	gen := &metricsCodeGenerator{
+		packageName: packageName,
+		descs:       descs,
+	}
+	return gen.generate()
+}
+
+type metricsCodeGenerator struct {
+	packageName string
+	descs       []*metrics.Descriptor
+}
+
+func (g *metricsCodeGenerator) generate() ([]byte, error) {
+	var buf bytes.Buffer
+
+	// Write header, package and imports
+	buf.WriteString("// Code generated by wetwire-obs metrics gen. DO NOT EDIT.\n\n")
+	buf.WriteString(fmt.Sprintf("package %s\n\n", g.packageName))
+	buf.WriteString("import (\n")
+	buf.WriteString("\t\"github.com/lex00/wetwire-observability-go/promql/metrics\"\n")
+	buf.WriteString(")\n\n")
+
+	used := map[string]bool{"Catalog": true}
+	var varNames []string
+	for _, d := range g.descs {
+		varName := g.uniqueVarName(d.Name, used)
+		varNames = append(varNames, varName)
+		g.writeDescriptor(&buf, d, varName)
+	}
+
+	buf.WriteString("// Catalog contains every metric of the package.\n")
+	buf.WriteString("var Catalog = metrics.NewCatalog(\n")
+	for _, varName := range varNames {
+		buf.WriteString(fmt.Sprintf("\t%s,\n", varName))
+	}
+	buf.WriteString(")\n")
+
+	// Format the generated code
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		// Return unformatted code with error for debugging
+		return buf.Bytes(), fmt.Errorf("failed to format generated code: %w", err)
+	}
+
+	return formatted, nil
+}
+
+func (g *metricsCodeGenerator) writeDescriptor(buf *bytes.Buffer, d *metrics.Descriptor, varName string) {
+	typ := d.Type
+	if typ == "" {
+		typ = metrics.Unknown
+	}
+	buf.WriteString(fmt.Sprintf("// %s is the %s %s.\n", varName, d.Name, typ))
+	if help := strings.Join(strings.Fields(d.Help), " "); help != "" {
+		buf.WriteString("//\n")
+		buf.WriteString(fmt.Sprintf("// %s\n", help))
+	}
+
+	buf.WriteString(fmt.Sprintf("var %s = &metrics.Descriptor{\n", varName))
+	buf.WriteString(fmt.Sprintf("\tName: %q,\n", d.Name))
+	constName, ok := metricTypeConsts[typ]
+	if !ok {
+		constName = fmt.Sprintf("metrics.Type(%q)", typ)
+	}
+	buf.WriteString(fmt.Sprintf("\tType: %s,\n", constName))
+	if d.Help != "" {
+		buf.WriteString(fmt.Sprintf("\tHelp: %q,\n", d.Help))
+	}
+	if d.Unit != "" {
+		buf.WriteString(fmt.Sprintf("\tUnit: %q,\n", d.Unit))
+	}
+	if d.Labels != nil {
+		quoted := make([]string, len(d.Labels))
+		for i, label := range d.Labels {
+			quoted[i] = fmt.Sprintf("%q", label)
+		}
+		buf.WriteString(fmt.Sprintf("\tLabels: []string{%s},\n", strings.Join(quoted, ", ")))
+	}
+	buf.WriteString("}\n\n")
+}
+
+// uniqueVarName returns the variable name of a metric, suffixed with a
+// number if another metric already uses it.
+func (g *metricsCodeGenerator) uniqueVarName(name string, used map[string]bool) string {
+	base := g.sanitizeVarName(name)
+	varName := base
+	for i := 2; used[varName]; i++ {
+		varName = fmt.Sprintf("%s%d", base, i)
+	}
+	used[varName] = true
+	return varName
+}
+
+func (g *metricsCodeGenerator) sanitizeVarName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return r > unicode.MaxASCII || !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	result := strings.Builder{}
+	for _, word := range words {
+		if metricInitialisms[strings.ToLower(word)] {
+			result.WriteString(strings.ToUpper(word))
+			continue
+		}
+		result.WriteString(strings.ToUpper(word[:1]))
+		result.WriteString(word[1:])
+	}
+
+	s := result.String()
+	if s == "" {
+		return "Metric"
+	}
+	if unicode.IsDigit(rune(s[0])) {
+		return "Metric" + s
+	}
+	return s
+}
@@ -0,0 +1,58 @@
+package importer
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/promql/metrics"
+)
+
+func TestGenerateMetricsGoCode(t *testing.T) {
+	descs := []*metrics.Descriptor{
+		metrics.New("node_cpu_seconds_total", metrics.Counter).
+			WithHelp("Seconds the CPUs spent\nin each mode.").
+			WithUnit("seconds").
+			WithLabels("cpu", "mode"),
+		metrics.New("node_memory_MemAvailable_bytes", metrics.Gauge).WithLabels(),
+		metrics.New("http_request_duration_seconds", metrics.Histogram),
+		metrics.New("http_request_duration:seconds", metrics.Histogram),
+		metrics.New("catalog", metrics.Unknown),
+		metrics.New("2xx_responses", ""),
+	}
+
+	code, err := GenerateMetricsGoCode(descs, "nodemetrics")
+	if err != nil {
+		t.Fatalf("GenerateMetricsGoCode() error = %v\n%s", err, code)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "metrics.go", code, 0); err != nil {
+		t.Fatalf("generated code does not parse: %v\n%s", err, code)
+	}
+
+	codeStr := string(code)
+	for _, want := range []string{
+		"// Code generated by wetwire-obs metrics gen. DO NOT EDIT.",
+		"package nodemetrics",
+		`"github.com/lex00/wetwire-observability-go/promql/metrics"`,
+		"// NodeCPUSecondsTotal is the node_cpu_seconds_total counter.",
+		"// Seconds the CPUs spent in each mode.",
+		"var NodeCPUSecondsTotal = &metrics.Descriptor{",
+		`Help:   "Seconds the CPUs spent\nin each mode.",`,
+		`Unit:   "seconds",`,
+		`Labels: []string{"cpu", "mode"},`,
+		"var NodeMemoryMemAvailableBytes = &metrics.Descriptor{",
+		"Labels: []string{},",
+		"var HTTPRequestDurationSeconds = &metrics.Descriptor{",
+		"var HTTPRequestDurationSeconds2 = &metrics.Descriptor{",
+		"var Catalog2 = &metrics.Descriptor{",
+		"var Metric2xxResponses = &metrics.Descriptor{",
+		"Type: metrics.Unknown,",
+		"var Catalog = metrics.NewCatalog(",
+		"\tNodeCPUSecondsTotal,\n",
+	} {
+		if !strings.Contains(codeStr, want) {
+			t.Errorf("GenerateMetricsGoCode() missing %q\nGot:\n%s", want, codeStr)
+		}
+	}
+}
@@ -31,25 +31,12 @@ func ParsePrometheusConfigFromBytes(data []byte) (*prometheus.PrometheusConfig,
 	return &config, nil
 }
 
-// ValidatePrometheusConfig validates a parsed Prometheus configuration.
+// ValidatePrometheusConfig validates a parsed Prometheus configuration
+// with the in-process validator.
 func ValidatePrometheusConfig(config *prometheus.PrometheusConfig) []string {
 	var warnings []string
-
-	// Check for features that may need manual adjustment
-	for _, sc := range config.ScrapeConfigs {
-		if sc.JobName == "" {
-			warnings = append(warnings, "scrape_config missing job_name")
-		}
-
-		// Check for service discovery types
-		if len(sc.KubernetesSDConfigs) > 0 {
-			for i, k := range sc.KubernetesSDConfigs {
-				if k.Role == "" {
-					warnings = append(warnings, fmt.Sprintf("scrape_config[%s].kubernetes_sd_configs[%d] missing role", sc.JobName, i))
-				}
-			}
-		}
+	for _, err := range config.Validate() {
+		warnings = append(warnings, err.Error())
 	}
-
 	return warnings
 }
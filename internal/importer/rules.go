@@ -10,6 +10,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/lex00/wetwire-observability-go/prometheus"
+	"github.com/lex00/wetwire-observability-go/promql/parser"
 	"github.com/lex00/wetwire-observability-go/rules"
 )
 
@@ -80,10 +81,14 @@ func ValidateRulesFile(rf *RulesFile) []string {
 		warnings = append(warnings, "rules file has no groups")
 	}
 
+	names := make(map[string]bool)
 	for _, group := range rf.Groups {
 		if group.Name == "" {
 			warnings = append(warnings, "rule group has no name")
+		} else if names[group.Name] {
+			warnings = append(warnings, fmt.Sprintf("rule group %q is repeated in the same file", group.Name))
 		}
+		names[group.Name] = true
 
 		if len(group.Rules) == 0 {
 			warnings = append(warnings, fmt.Sprintf("rule group %q has no rules", group.Name))
@@ -96,6 +101,17 @@ func ValidateRulesFile(rf *RulesFile) []string {
 
 			if rule.Expr == "" {
 				warnings = append(warnings, fmt.Sprintf("rule %d in group %q has no expression", i, group.Name))
+			} else if _, err := parser.ParseExpr(rule.Expr); err != nil {
+				warnings = append(warnings, fmt.Sprintf("rule %d in group %q has an invalid expression: %v", i, group.Name, err))
+			}
+
+			for _, d := range []struct{ key, value string }{{"for", rule.For}, {"keep_firing_for", rule.KeepFiringFor}} {
+				if d.value == "" {
+					continue
+				}
+				if _, err := prometheus.ParseDuration(d.value); err != nil {
+					warnings = append(warnings, fmt.Sprintf("rule %d in group %q has an invalid '%s' duration: %v", i, group.Name, d.key, err))
+				}
 			}
 
 			// Check alerting rule specific issues
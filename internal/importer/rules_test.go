@@ -133,6 +133,28 @@ groups:
 `,
 			wantWarn: []string{"has no severity label"},
 		},
+		{
+			name: "invalid expression and duration",
+			input: `
+groups:
+  - name: test
+    rules:
+      - alert: BadExpr
+        expr: rate(up)
+        for: 5min
+        labels:
+          severity: warning
+  - name: test
+    rules:
+      - record: job:up:sum
+        expr: sum(up)
+`,
+			wantWarn: []string{
+				`has an invalid expression: 1:6: parse error: expected type range vector in call to function "rate"`,
+				"has an invalid 'for' duration",
+				`rule group "test" is repeated in the same file`,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -43,6 +43,8 @@ func QuarterWidth() int {
 
 // CalculateGridPositions calculates grid positions for all panels in a dashboard.
 // Panels are laid out from top to bottom, left to right, with automatic wrapping.
+// Horizontally repeated panels fill a line of their own. Repeated rows are laid
+// out once; Grafana adds the copies below the original when the dashboard loads.
 func CalculateGridPositions(dashboard *grafana.Dashboard) error {
 	if dashboard == nil {
 		return nil
@@ -90,8 +92,11 @@ func CalculateGridPositions(dashboard *grafana.Dashboard) error {
 				height = DefaultPanelHeight
 			}
 
-			// Check if we need to wrap to next line
-			if currentX+width > GridColumns {
+			// Check if we need to wrap to next line. Horizontally repeated
+			// panels fill a line of their own, since Grafana places the
+			// repeated panels to the right of the original.
+			repeated := repeatsHorizontally(panelAny)
+			if currentX+width > GridColumns || (repeated && currentX > 0) {
 				currentX = 0
 				currentY += rowMaxHeight
 				rowMaxHeight = 0
@@ -102,6 +107,9 @@ func CalculateGridPositions(dashboard *grafana.Dashboard) error {
 
 			// Advance X position
 			currentX += width
+			if repeated {
+				currentX = GridColumns
+			}
 
 			// Track max height for this visual row
 			if height > rowMaxHeight {
@@ -120,6 +128,12 @@ func CalculateGridPositions(dashboard *grafana.Dashboard) error {
 	return nil
 }
 
+// repeatsHorizontally reports whether panel is repeated side by side.
+func repeatsHorizontally(panel any) bool {
+	r, ok := panel.(interface{ RepeatsHorizontally() bool })
+	return ok && r.RepeatsHorizontally()
+}
+
 // getPanelGridPos returns a pointer to the panel's GridPos field.
 func getPanelGridPos(panel any) *grafana.GridPos {
 	switch p := panel.(type) {
@@ -141,6 +155,34 @@ func getPanelGridPos(panel any) *grafana.GridPos {
 		return &p.GridPos
 	case *grafana.HeatmapPanel:
 		return &p.GridPos
+	case *grafana.StateTimelinePanel:
+		return &p.GridPos
+	case *grafana.StatusHistoryPanel:
+		return &p.GridPos
+	case *grafana.BarChartPanel:
+		return &p.GridPos
+	case *grafana.HistogramPanel:
+		return &p.GridPos
+	case *grafana.XYChartPanel:
+		return &p.GridPos
+	case *grafana.TrendPanel:
+		return &p.GridPos
+	case *grafana.NodeGraphPanel:
+		return &p.GridPos
+	case *grafana.TracesPanel:
+		return &p.GridPos
+	case *grafana.FlameGraphPanel:
+		return &p.GridPos
+	case *grafana.GeomapPanel:
+		return &p.GridPos
+	case *grafana.CanvasPanel:
+		return &p.GridPos
+	case *grafana.AlertListPanel:
+		return &p.GridPos
+	case *grafana.DashboardListPanel:
+		return &p.GridPos
+	case *grafana.NewsPanel:
+		return &p.GridPos
 	default:
 		return nil
 	}
@@ -167,5 +209,33 @@ func setPanelPosition(panel any, x, y, w, h int) {
 		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
 	case *grafana.HeatmapPanel:
 		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.StateTimelinePanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.StatusHistoryPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.BarChartPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.HistogramPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.XYChartPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.TrendPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.NodeGraphPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.TracesPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.FlameGraphPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.GeomapPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.CanvasPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.AlertListPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.DashboardListPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
+	case *grafana.NewsPanel:
+		p.GridPos = grafana.GridPos{X: x, Y: y, W: w, H: h}
 	}
 }
@@ -110,6 +110,36 @@ func TestCalculateGridPositions_MultipleRows(t *testing.T) {
 	}
 }
 
+func TestCalculateGridPositions_RepeatedPanels(t *testing.T) {
+	dashboard := grafana.NewDashboard("test", "Test").
+		WithRows(
+			grafana.NewRow("Row 1").WithPanels(
+				grafana.Stat("Before").WithSize(6, 4),
+				grafana.Stat("Per Pod").WithSize(6, 4).RepeatBy("pod").WithMaxPerRow(4),
+				grafana.Stat("After").WithSize(6, 4),
+				grafana.Stat("Stacked").WithSize(6, 4).RepeatBy("pod").RepeatVertically(),
+				grafana.Stat("Beside").WithSize(6, 4),
+			),
+		)
+
+	if err := CalculateGridPositions(dashboard); err != nil {
+		t.Fatalf("CalculateGridPositions() error = %v", err)
+	}
+
+	want := []grafana.GridPos{
+		{X: 0, Y: 1, W: 6, H: 4},
+		{X: 0, Y: 5, W: 6, H: 4},
+		{X: 0, Y: 9, W: 6, H: 4},
+		{X: 6, Y: 9, W: 6, H: 4},
+		{X: 12, Y: 9, W: 6, H: 4},
+	}
+	for i, p := range dashboard.Rows[0].Panels {
+		if got := p.(*grafana.StatPanel).GridPos; got != want[i] {
+			t.Errorf("panel %d GridPos = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
+
 func TestCalculateGridPositions_MixedPanelSizes(t *testing.T) {
 	dashboard := grafana.NewDashboard("test", "Test").
 		WithRows(
@@ -0,0 +1,162 @@
+package lint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// goFile is a parsed Go source file inspected by AST-based lint rules.
+type goFile struct {
+	Path string
+	Fset *token.FileSet
+	AST  *ast.File
+}
+
+// parseGoFiles parses the non-test Go files at path, which may be a file or
+// a directory searched recursively. Files that fail to parse are skipped;
+// discovery reports those errors.
+func parseGoFiles(path string) ([]*goFile, error) {
+	var files []*goFile
+	fset := token.NewFileSet()
+
+	err := filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if p != path && (strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(p, ".go") || strings.HasSuffix(p, "_test.go") {
+			return nil
+		}
+		f, err := parser.ParseFile(fset, p, nil, 0)
+		if err != nil {
+			return nil
+		}
+		files = append(files, &goFile{Path: p, Fset: fset, AST: f})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// stringConstants returns the string constants declared at package level
+// across files, keyed by name.
+func stringConstants(files []*goFile) map[string]string {
+	consts := make(map[string]string)
+	for _, f := range files {
+		for _, decl := range f.AST.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					if i < len(vs.Values) {
+						if s, ok := basicString(vs.Values[i]); ok {
+							consts[name.Name] = s
+						}
+					}
+				}
+			}
+		}
+	}
+	return consts
+}
+
+// basicString returns the value of a string literal expression.
+func basicString(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+// stringValue resolves a string literal or a reference to a known string
+// constant.
+func stringValue(expr ast.Expr, consts map[string]string) (string, bool) {
+	if s, ok := basicString(expr); ok {
+		return s, true
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		s, ok := consts[ident.Name]
+		return s, ok
+	}
+	if sel, ok := expr.(*ast.SelectorExpr); ok {
+		s, ok := consts[sel.Sel.Name]
+		return s, ok
+	}
+	return "", false
+}
+
+// calleeName returns the function or method name of a call expression.
+func calleeName(call *ast.CallExpr) string {
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		return fn.Name
+	case *ast.SelectorExpr:
+		return fn.Sel.Name
+	}
+	return ""
+}
+
+// typeName returns the unqualified type name of a composite literal type.
+func typeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.StarExpr:
+		return typeName(t.X)
+	}
+	return ""
+}
+
+// alertmanagerImportPath is the import path of the alertmanager package.
+const alertmanagerImportPath = "github.com/lex00/wetwire-observability-go/alertmanager"
+
+// packageFunc returns the function name of a call to a package-level
+// function imported from importPath (e.g. "Regex" for alertmanager.Regex),
+// or "" if call is not such a call.
+func packageFunc(f *goFile, call *ast.CallExpr, importPath string) string {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	for _, imp := range f.AST.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || path != importPath {
+			continue
+		}
+		name := filepath.Base(path)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		if name == pkg.Name {
+			return sel.Sel.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,124 @@
+package lint
+
+import (
+	"fmt"
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+// grafanaImportPath is the import path of the grafana package.
+const grafanaImportPath = "github.com/lex00/wetwire-observability-go/grafana"
+
+// dashboardURLPattern matches the dashboard UID of a relative /d/<uid>
+// URL. Absolute URLs may point to other Grafana instances and are not
+// checked.
+var dashboardURLPattern = regexp.MustCompile(`^/d/([^/?#&]+)`)
+
+// dashboardRef is a dashboard UID referenced by a link in source.
+type dashboardRef struct {
+	uid  string
+	file string
+	line int
+}
+
+// checkUndefinedDashboardLinks implements WOB122: dashboard links, panel
+// links and data links must only reference dashboards defined in the
+// project.
+//
+// Dashboard UIDs are collected from NewDashboard calls and Dashboard
+// literals. References are the UIDs passed to DashboardUIDLink and
+// DashboardURL, and relative /d/<uid> URLs passed to URLLink and
+// NewDataLink or set as the URL of DashboardLink and DataLink literals.
+// UIDs containing variables are not checked. The rule is skipped when no dashboards are
+// found or when any dashboard UID cannot be resolved statically.
+func checkUndefinedDashboardLinks(files []*goFile) []LintIssue {
+	consts := stringConstants(files)
+	defined := make(map[string]bool)
+	dynamic := false
+	var refs []dashboardRef
+
+	define := func(expr ast.Expr) {
+		if s, ok := stringValue(expr, consts); ok {
+			defined[s] = true
+		} else {
+			dynamic = true
+		}
+	}
+	refUID := func(f *goFile, expr ast.Expr) {
+		if s, ok := stringValue(expr, consts); ok {
+			refs = append(refs, dashboardRef{s, f.Path, f.Fset.Position(expr.Pos()).Line})
+		}
+	}
+	refURL := func(f *goFile, expr ast.Expr) {
+		s, ok := stringValue(expr, consts)
+		if !ok {
+			return
+		}
+		if m := dashboardURLPattern.FindStringSubmatch(s); m != nil {
+			refs = append(refs, dashboardRef{m[1], f.Path, f.Fset.Position(expr.Pos()).Line})
+		}
+	}
+
+	for _, f := range files {
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CallExpr:
+				if len(node.Args) == 0 {
+					break
+				}
+				switch packageFunc(f, node, grafanaImportPath) {
+				case "NewDashboard":
+					define(node.Args[0])
+				case "DashboardURL":
+					refUID(f, node.Args[0])
+				case "DashboardUIDLink":
+					if len(node.Args) > 1 {
+						refUID(f, node.Args[1])
+					}
+				case "URLLink", "NewDataLink":
+					if len(node.Args) > 1 {
+						refURL(f, node.Args[1])
+					}
+				}
+			case *ast.CompositeLit:
+				typ := typeName(node.Type)
+				if typ != "Dashboard" && typ != "DashboardLink" && typ != "DataLink" {
+					break
+				}
+				for _, elt := range node.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if !ok {
+						continue
+					}
+					switch {
+					case typ == "Dashboard" && isIdent(kv.Key, "UID"):
+						define(kv.Value)
+					case typ != "Dashboard" && isIdent(kv.Key, "URL"):
+						refURL(f, kv.Value)
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	if dynamic || len(defined) == 0 {
+		return nil
+	}
+
+	var issues []LintIssue
+	for _, ref := range refs {
+		if defined[ref.uid] || strings.Contains(ref.uid, "$") {
+			continue
+		}
+		issues = append(issues, LintIssue{
+			RuleID:   "WOB122",
+			Severity: "error",
+			Message:  fmt.Sprintf("link references undefined dashboard UID %q", ref.uid),
+			File:     ref.file,
+			Line:     ref.line,
+		})
+	}
+	return issues
+}
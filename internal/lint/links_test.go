@@ -0,0 +1,82 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+)
+
+const linksSource = `package monitoring
+
+import "github.com/lex00/wetwire-observability-go/grafana"
+
+const PodsUID = "pods"
+
+var Overview = grafana.NewDashboard("overview", "Overview").
+	WithLinks(
+		grafana.DashboardUIDLink("Pods", PodsUID),
+		grafana.DashboardUIDLink("Nodes", "nodes"),
+		grafana.URLLink("Runbook", "https://runbooks.example.com/d/not-a-dashboard"),
+		grafana.URLLink("Old", "/d/legacy?orgId=1"),
+	)
+
+var Pods = &grafana.Dashboard{UID: PodsUID, Title: "Pods"}
+
+var Panel = grafana.Table("Pods").WithDataLinks(
+	grafana.NewDataLink("Pod", grafana.DashboardURL("pod-details", grafana.VarParam("pod", grafana.FieldLabel("pod")))),
+	grafana.NewDataLink("Pods", grafana.DashboardURL(PodsUID)),
+	grafana.NewDataLink("Dynamic", grafana.DashboardURL("${target}")),
+)
+
+var Literal = grafana.DataLink{Title: "Overview", URL: "/d/overvw"}
+`
+
+func TestCheckUndefinedDashboardLinks(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, linksSource))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	issues := checkUndefinedDashboardLinks(files)
+
+	want := []struct {
+		line int
+		uid  string
+	}{
+		{10, "nodes"},
+		{12, "legacy"},
+		{18, "pod-details"},
+		{23, "overvw"},
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("len(issues) = %d, want %d: %+v", len(issues), len(want), issues)
+	}
+	for i, issue := range issues {
+		if issue.RuleID != "WOB122" || issue.Severity != "error" {
+			t.Errorf("issue = %+v, want WOB122 error", issue)
+		}
+		if issue.Line != want[i].line {
+			t.Errorf("issues[%d].Line = %d, want %d (%s)", i, issue.Line, want[i].line, issue.Message)
+		}
+		if !strings.Contains(issue.Message, `"`+want[i].uid+`"`) {
+			t.Errorf("issues[%d].Message = %q, want UID %q", i, issue.Message, want[i].uid)
+		}
+	}
+}
+
+func TestCheckUndefinedDashboardLinksDynamicUID(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, `package monitoring
+
+import "github.com/lex00/wetwire-observability-go/grafana"
+
+func uid() string { return "generated" }
+
+var A = grafana.NewDashboard(uid(), "A").WithLinks(grafana.DashboardUIDLink("B", "b"))
+`))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	if issues := checkUndefinedDashboardLinks(files); len(issues) != 0 {
+		t.Errorf("issues = %+v, want none when a dashboard UID is dynamic", issues)
+	}
+}
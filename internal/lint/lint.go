@@ -75,7 +75,12 @@ func (l *Linter) LintAll(path string) (*LintResult, error) {
 		return nil, fmt.Errorf("discovery failed: %w", err)
 	}
 
-	return l.lintResources(resources)
+	files, err := parseGoFiles(path)
+	if err != nil {
+		return nil, fmt.Errorf("parsing sources failed: %w", err)
+	}
+
+	return l.lintResources(resources, files)
 }
 
 // LintAllWithOptions lints all resources with the specified options.
@@ -84,8 +89,9 @@ func LintAllWithOptions(path string, opts LintOptions) (*LintResult, error) {
 	return linter.LintAll(path)
 }
 
-// lintResources performs the actual linting on discovered resources.
-func (l *Linter) lintResources(resources *discover.DiscoveryResult) (*LintResult, error) {
+// lintResources performs the actual linting on discovered resources and the
+// source files they were discovered in.
+func (l *Linter) lintResources(resources *discover.DiscoveryResult, files []*goFile) (*LintResult, error) {
 	result := &LintResult{
 		ResourceCount: resources.TotalCount(),
 		FixRequested:  l.options.Fix,
@@ -99,9 +105,20 @@ func (l *Linter) lintResources(resources *discover.DiscoveryResult) (*LintResult
 		disabledSet[strings.ToUpper(rule)] = true
 	}
 
-	// Run lint rules on each resource
-	// TODO: Implement actual lint rules (WOB001-WOB219)
-	// For now, we have no implemented rules, so no issues will be reported
+	// Run lint rules
+	// TODO: Implement remaining lint rules (WOB001-WOB219)
+	if len(resources.AlertmanagerConfigs) > 0 {
+		result.Issues = append(result.Issues, checkUndefinedTimeIntervals(files)...)
+		result.Issues = append(result.Issues, checkUndefinedReceivers(files)...)
+		result.Issues = append(result.Issues, checkDuplicateReceivers(files)...)
+		result.Issues = append(result.Issues, checkInvalidMatchers(files)...)
+		result.Issues = append(result.Issues, checkInvalidGroupBy(files)...)
+	}
+	result.Issues = append(result.Issues, checkPromQLSyntax(files)...)
+	result.Issues = append(result.Issues, checkMetricTypes(files)...)
+	result.Issues = append(result.Issues, checkRateWindows(files)...)
+	result.Issues = append(result.Issues, checkUndefinedDashboardLinks(files)...)
+	result.Issues = append(result.Issues, checkRepeatVariables(files)...)
 
 	// Filter out issues from disabled rules
 	filteredIssues := []LintIssue{}
@@ -0,0 +1,131 @@
+package lint
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/lex00/wetwire-observability-go/alertmanager"
+)
+
+// matcherOps maps alertmanager matcher constructors to their operators.
+var matcherOps = map[string]alertmanager.MatchOp{
+	"Match":    alertmanager.MatchEqual,
+	"Eq":       alertmanager.MatchEqual,
+	"NotEq":    alertmanager.MatchNotEqual,
+	"Regex":    alertmanager.MatchRegex,
+	"NotRegex": alertmanager.MatchNotRegex,
+}
+
+// checkInvalidMatchers implements WOB055: alertmanager matchers must have a
+// label name and, for =~ and !~, a regular expression that compiles.
+//
+// Matchers are checked when built with the alertmanager Match, Eq, NotEq,
+// Regex and NotRegex helpers or parsed with ParseMatcher from constant
+// strings.
+func checkInvalidMatchers(files []*goFile) []LintIssue {
+	consts := stringConstants(files)
+	var issues []LintIssue
+
+	for _, f := range files {
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			name := packageFunc(f, call, alertmanagerImportPath)
+
+			var m *alertmanager.Matcher
+			var err error
+			if op, ok := matcherOps[name]; ok && len(call.Args) == 2 {
+				label, ok1 := stringValue(call.Args[0], consts)
+				value, ok2 := stringValue(call.Args[1], consts)
+				if !ok1 || !ok2 {
+					return true
+				}
+				m = &alertmanager.Matcher{Label: label, Op: op, Value: value}
+			} else if name == "ParseMatcher" && len(call.Args) == 1 {
+				s, ok := stringValue(call.Args[0], consts)
+				if !ok {
+					return true
+				}
+				m, err = alertmanager.ParseMatcher(s)
+			} else {
+				return true
+			}
+
+			if err == nil {
+				err = m.Validate()
+			}
+			if err != nil {
+				issues = append(issues, LintIssue{
+					RuleID:   "WOB055",
+					Severity: "error",
+					Message:  fmt.Sprintf("invalid matcher: %v", err),
+					File:     f.Path,
+					Line:     f.Fset.Position(call.Pos()).Line,
+				})
+			}
+			return true
+		})
+	}
+	return issues
+}
+
+// checkInvalidGroupBy implements WOB056: a route's group_by must not repeat
+// labels, and the "..." wildcard must be its only entry.
+//
+// Labels are collected from WithGroupBy calls and GroupBy fields in Route
+// literals; the check is skipped if any label is not a constant string.
+func checkInvalidGroupBy(files []*goFile) []LintIssue {
+	consts := stringConstants(files)
+	var issues []LintIssue
+
+	check := func(f *goFile, exprs []ast.Expr) {
+		if len(exprs) == 0 {
+			return
+		}
+		labels := make([]string, 0, len(exprs))
+		for _, expr := range exprs {
+			s, ok := stringValue(expr, consts)
+			if !ok {
+				return
+			}
+			labels = append(labels, s)
+		}
+		if err := alertmanager.ValidateGroupBy(labels); err != nil {
+			issues = append(issues, LintIssue{
+				RuleID:   "WOB056",
+				Severity: "error",
+				Message:  fmt.Sprintf("invalid group_by: %v", err),
+				File:     f.Path,
+				Line:     f.Fset.Position(exprs[0].Pos()).Line,
+			})
+		}
+	}
+
+	for _, f := range files {
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CallExpr:
+				if calleeName(node) == "WithGroupBy" && node.Ellipsis == 0 {
+					check(f, node.Args)
+				}
+			case *ast.CompositeLit:
+				if typeName(node.Type) != "Route" {
+					break
+				}
+				for _, elt := range node.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if !ok || !isIdent(kv.Key, "GroupBy") {
+						continue
+					}
+					if list, ok := kv.Value.(*ast.CompositeLit); ok {
+						check(f, list.Elts)
+					}
+				}
+			}
+			return true
+		})
+	}
+	return issues
+}
@@ -0,0 +1,75 @@
+package lint
+
+import "testing"
+
+const matchersSource = `package monitoring
+
+import (
+	"github.com/lex00/wetwire-observability-go/alertmanager"
+	"github.com/lex00/wetwire-observability-go/promql"
+)
+
+const Services = "api|(web"
+
+var RootRoute = alertmanager.NewRoute("default").
+	WithGroupBy("...", "alertname").
+	WithRoutes(
+		alertmanager.NewRoute("api").
+			WithMatchers(alertmanager.Regex("service", Services), alertmanager.Eq("team", "api")),
+		alertmanager.NewRoute("db").
+			WithMatchers(alertmanager.Eq("", "db")),
+		&alertmanager.Route{Receiver: "web", GroupBy: []string{"alertname", "alertname"}},
+		alertmanager.NewRoute("ok").WithGroupBy("..."),
+	)
+
+var Inhibit = alertmanager.NewInhibitRule().
+	WithSourceMatchers(alertmanager.NotRegex("severity", "[crit"))
+
+var Parsed, _ = alertmanager.ParseMatcher("severity!~\"(warn\"")
+
+var Query = promql.Match("job", "(unrelated")
+`
+
+func TestCheckInvalidMatchers(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, matchersSource))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	issues := checkInvalidMatchers(files)
+
+	wantLines := []int{14, 16, 22, 24}
+	if len(issues) != len(wantLines) {
+		t.Fatalf("len(issues) = %d, want %d: %+v", len(issues), len(wantLines), issues)
+	}
+	for i, issue := range issues {
+		if issue.RuleID != "WOB055" || issue.Severity != "error" {
+			t.Errorf("issue = %+v, want WOB055 error", issue)
+		}
+		if issue.Line != wantLines[i] {
+			t.Errorf("issues[%d].Line = %d, want %d (%s)", i, issue.Line, wantLines[i], issue.Message)
+		}
+	}
+}
+
+func TestCheckInvalidGroupBy(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, matchersSource))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	issues := checkInvalidGroupBy(files)
+
+	want := map[int]string{
+		11: "invalid group_by: cannot have wildcard group_by (`...`) and other labels at the same time",
+		17: `invalid group_by: duplicated label "alertname" in group_by`,
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("len(issues) = %d, want %d: %+v", len(issues), len(want), issues)
+	}
+	for _, issue := range issues {
+		if issue.RuleID != "WOB056" || want[issue.Line] != issue.Message {
+			t.Errorf("issue = %+v, want WOB056 %q", issue, want[issue.Line])
+		}
+	}
+}
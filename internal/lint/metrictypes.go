@@ -0,0 +1,318 @@
+package lint
+
+import (
+	"fmt"
+	"go/ast"
+	"slices"
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/promql/metrics"
+	"github.com/lex00/wetwire-observability-go/promql/parser"
+)
+
+// metricsImportPath is the import path of the promql/metrics package.
+const metricsImportPath = "github.com/lex00/wetwire-observability-go/promql/metrics"
+
+// metricTypes maps the Type constants of the metrics package to types.
+var metricTypes = map[string]metrics.Type{
+	"Counter":        metrics.Counter,
+	"Gauge":          metrics.Gauge,
+	"Histogram":      metrics.Histogram,
+	"GaugeHistogram": metrics.GaugeHistogram,
+	"Summary":        metrics.Summary,
+	"Info":           metrics.Info,
+	"StateSet":       metrics.StateSet,
+	"Unknown":        metrics.Unknown,
+}
+
+// metricCatalog returns a catalog of the metric descriptors declared in
+// source: metrics.Descriptor literals, as generated by "metrics gen", and
+// metrics.New calls with their WithLabels. Labels passed to
+// WithTargetLabels anywhere are accepted on every metric.
+func metricCatalog(files []*goFile) *metrics.Catalog {
+	consts := stringConstants(files)
+	catalog := metrics.NewCatalog()
+	labels := make(map[*ast.CallExpr][]string)
+	var calls []*ast.CallExpr
+
+	for _, f := range files {
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CompositeLit:
+				if typeName(node.Type) == "Descriptor" {
+					if d := descriptorLiteral(node, consts); d != nil {
+						catalog.Add(d)
+					}
+				}
+			case *ast.CallExpr:
+				switch calleeName(node) {
+				case "WithLabels":
+					if root := chainRoot(node); root != nil && packageFunc(f, root, metricsImportPath) == "New" {
+						labels[root] = stringArgs(node.Args, consts)
+					}
+				case "WithTargetLabels":
+					catalog.WithTargetLabels(stringArgs(node.Args, consts)...)
+				}
+				if packageFunc(f, node, metricsImportPath) == "New" {
+					calls = append(calls, node)
+				}
+			}
+			return true
+		})
+	}
+
+	for _, call := range calls {
+		if len(call.Args) != 2 {
+			continue
+		}
+		name, ok := stringValue(call.Args[0], consts)
+		if !ok {
+			continue
+		}
+		d := metrics.New(name, metricTypeOf(call.Args[1]))
+		if l, ok := labels[call]; ok {
+			d.WithLabels(l...)
+		}
+		catalog.Add(d)
+	}
+	return catalog
+}
+
+// descriptorLiteral returns the descriptor of a metrics.Descriptor literal
+// with a constant name.
+func descriptorLiteral(lit *ast.CompositeLit, consts map[string]string) *metrics.Descriptor {
+	d := &metrics.Descriptor{Type: metrics.Unknown}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		switch {
+		case isIdent(kv.Key, "Name"):
+			d.Name, _ = stringValue(kv.Value, consts)
+		case isIdent(kv.Key, "Type"):
+			d.Type = metricTypeOf(kv.Value)
+		case isIdent(kv.Key, "Labels"):
+			if list, ok := kv.Value.(*ast.CompositeLit); ok {
+				d.Labels = stringArgs(list.Elts, consts)
+			}
+		}
+	}
+	if d.Name == "" {
+		return nil
+	}
+	return d
+}
+
+// metricTypeOf resolves a metrics.Type constant or conversion.
+func metricTypeOf(expr ast.Expr) metrics.Type {
+	switch e := expr.(type) {
+	case *ast.SelectorExpr:
+		if t, ok := metricTypes[e.Sel.Name]; ok {
+			return t
+		}
+	case *ast.Ident:
+		if t, ok := metricTypes[e.Name]; ok {
+			return t
+		}
+	case *ast.CallExpr:
+		if len(e.Args) == 1 {
+			if s, ok := basicString(e.Args[0]); ok {
+				return metrics.Type(s)
+			}
+		}
+	}
+	return metrics.Unknown
+}
+
+// chainRoot returns the innermost call of a method chain such as
+// metrics.New(...).WithHelp(...).WithLabels(...).
+func chainRoot(call *ast.CallExpr) *ast.CallExpr {
+	for {
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return call
+		}
+		inner, ok := sel.X.(*ast.CallExpr)
+		if !ok {
+			return call
+		}
+		call = inner
+	}
+}
+
+// stringArgs returns the constant string values of exprs.
+func stringArgs(exprs []ast.Expr, consts map[string]string) []string {
+	values := []string{}
+	for _, expr := range exprs {
+		if s, ok := stringValue(expr, consts); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+// checkMetricTypes implements the rules cross-checking PromQL expressions
+// against metric types:
+//
+//   - WOB102: rate, irate, increase or resets applied to a gauge
+//   - WOB103: label matchers or by/without labels the metric does not have
+//   - WOB104: histogram_quantile over a metric that is not a histogram, or
+//     over classic buckets aggregated without le
+//   - WOB105: sum of a counter without rate or increase
+//   - WOB106: avg of quantiles
+//
+// Metric types and labels come from the descriptors declared in source (see
+// metricCatalog). Without a descriptor, metrics ending in _total are taken
+// to be counters, _bucket series to be classic histogram buckets and
+// selectors with a quantile matcher to be summary quantiles.
+func checkMetricTypes(files []*goFile) []LintIssue {
+	catalog := metricCatalog(files)
+	var issues []LintIssue
+	for _, e := range promqlExprs(files) {
+		parsed, err := parser.ParseExpr(e.value)
+		if err != nil {
+			continue
+		}
+		add := func(ruleID, severity, format string, args ...any) {
+			issues = append(issues, LintIssue{
+				RuleID:   ruleID,
+				Severity: severity,
+				Message:  fmt.Sprintf(format, args...),
+				File:     e.file.Path,
+				Line:     e.line(),
+			})
+		}
+
+		if len(catalog.Metrics) > 0 {
+			problems, _ := catalog.Check(e.value)
+			for _, p := range problems {
+				switch p.Kind {
+				case metrics.CounterFunctionOnGauge:
+					add("WOB102", "error", "%s", p.Message)
+				case metrics.UnknownLabel:
+					add("WOB103", "warning", "%s", p.Message)
+				}
+			}
+		}
+
+		parser.Inspect(parsed, func(node parser.Node, _ []parser.Node) bool {
+			switch n := node.(type) {
+			case *parser.Call:
+				if n.Func != nil && n.Func.Name == "histogram_quantile" && len(n.Args) == 2 {
+					for _, msg := range checkHistogramQuantile(n.Args[1], catalog) {
+						add("WOB104", "error", "%s", msg)
+					}
+				}
+				if n.Func != nil && n.Func.Name == "avg_over_time" && len(n.Args) == 1 && hasQuantiles(n.Args[0], catalog) {
+					add("WOB106", "warning", "avg_over_time of quantiles is not a quantile; aggregate histogram buckets over time with rate() before histogram_quantile")
+				}
+			case *parser.AggregateExpr:
+				if n.Op == "sum" {
+					if name, ok := rawCounter(n.Expr, catalog); ok {
+						add("WOB105", "warning", "sum of counter %s without rate(); counters reset on restart, use sum(rate(%s[5m])) or sum(increase(...))", name, name)
+					}
+				}
+				if n.Op == "avg" && hasQuantiles(n.Expr, catalog) {
+					add("WOB106", "warning", "avg of quantiles is not a quantile; aggregate histogram buckets with sum by (le) before histogram_quantile")
+				}
+			}
+			return true
+		})
+	}
+	return issues
+}
+
+// checkHistogramQuantile checks the vector argument of histogram_quantile.
+func checkHistogramQuantile(arg parser.Expr, catalog *metrics.Catalog) []string {
+	var msgs []string
+	classic := false
+	for _, vs := range parser.VectorSelectors(arg) {
+		name := vs.MetricName()
+		if name == "" {
+			continue
+		}
+		if strings.HasSuffix(name, "_bucket") {
+			classic = true
+			continue
+		}
+		if d := catalog.Lookup(name); d != nil && d.Type != metrics.Histogram && d.Type != metrics.GaugeHistogram {
+			msgs = append(msgs, fmt.Sprintf("histogram_quantile over %s, which is a %s and not a histogram _bucket series", name, d.Type))
+		}
+	}
+	if !classic {
+		return msgs
+	}
+
+	agg := outermostAggregate(arg)
+	if agg == nil {
+		return msgs
+	}
+	hasLe := slices.Contains(agg.Grouping, "le")
+	switch {
+	case !agg.Without && !hasLe:
+		msgs = append(msgs, fmt.Sprintf("histogram_quantile over buckets aggregated by (%s) without le; add le to the by clause", strings.Join(agg.Grouping, ", ")))
+	case agg.Without && hasLe:
+		msgs = append(msgs, "histogram_quantile over buckets aggregated without (le); keep le in the result")
+	}
+	return msgs
+}
+
+// outermostAggregate returns the first aggregation in expr, if any.
+func outermostAggregate(expr parser.Expr) *parser.AggregateExpr {
+	var agg *parser.AggregateExpr
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) bool {
+		if agg != nil {
+			return false
+		}
+		if a, ok := node.(*parser.AggregateExpr); ok {
+			agg = a
+			return false
+		}
+		return true
+	})
+	return agg
+}
+
+// rawCounter returns the name of the counter expr selects directly, without
+// a function applied.
+func rawCounter(expr parser.Expr, catalog *metrics.Catalog) (string, bool) {
+	for {
+		paren, ok := expr.(*parser.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = paren.Expr
+	}
+	vs, ok := expr.(*parser.VectorSelector)
+	if !ok {
+		return "", false
+	}
+	name := vs.MetricName()
+	if d := catalog.Lookup(name); d != nil {
+		return name, d.Type == metrics.Counter
+	}
+	return name, strings.HasSuffix(name, "_total")
+}
+
+// hasQuantiles reports whether expr computes or selects quantiles:
+// histogram_quantile, or summary quantile series.
+func hasQuantiles(expr parser.Expr, catalog *metrics.Catalog) bool {
+	found := false
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) bool {
+		switch n := node.(type) {
+		case *parser.Call:
+			if n.Func != nil && n.Func.Name == "histogram_quantile" {
+				found = true
+			}
+		case *parser.VectorSelector:
+			if slices.ContainsFunc(n.LabelMatchers, func(m *parser.LabelMatcher) bool { return m.Name == "quantile" }) {
+				found = true
+			} else if d := catalog.Lookup(n.MetricName()); d != nil && d.Type == metrics.Summary {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
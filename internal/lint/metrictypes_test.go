@@ -0,0 +1,95 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+)
+
+const metricTypesSource = `package monitoring
+
+import (
+	"github.com/lex00/wetwire-observability-go/promql/metrics"
+	"github.com/lex00/wetwire-observability-go/rules"
+)
+
+var Load = &metrics.Descriptor{
+	Name:   "node_load1",
+	Type:   metrics.Gauge,
+	Labels: []string{},
+}
+
+var Latency = metrics.New("http_request_duration_seconds", metrics.Histogram).
+	WithLabels("method", "route")
+
+var RPCLatency = metrics.New("rpc_latency_seconds", metrics.Summary)
+
+var Catalog = metrics.NewCatalog(Load, Latency).WithTargetLabels("namespace")
+
+var Rules = rules.NewRuleGroup("types").WithRules(
+	rules.NewRecordingRule("a").WithExpr("rate(node_load1[5m])"),
+	rules.NewRecordingRule("b").WithExpr("node_load1{cpu=\"0\", namespace=\"x\"}"),
+	rules.NewRecordingRule("c").WithExpr("histogram_quantile(0.9, sum by (route) (rate(http_request_duration_seconds_bucket[5m])))"),
+	rules.NewRecordingRule("d").WithExpr("histogram_quantile(0.9, node_load1)"),
+	rules.NewRecordingRule("e").WithExpr("sum(requests_total)"),
+	rules.NewRecordingRule("f").WithExpr("avg(histogram_quantile(0.9, sum by (le) (rate(http_request_duration_seconds_bucket[5m]))))"),
+	rules.NewRecordingRule("g").WithExpr("avg(rpc_latency_seconds)"),
+	rules.NewRecordingRule("h").WithExpr("histogram_quantile(0.9, sum without (le) (rate(x_bucket[5m])))"),
+	rules.NewRecordingRule("ok").WithExpr("histogram_quantile(0.9, sum by (le, route) (rate(http_request_duration_seconds_bucket[5m])))"),
+	rules.NewRecordingRule("ok").WithExpr("sum(rate(requests_total[5m])) + sum by (namespace) (node_load1)"),
+)
+`
+
+func TestCheckMetricTypes(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, metricTypesSource))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	issues := checkMetricTypes(files)
+
+	want := []struct {
+		ruleID  string
+		line    int
+		message string
+	}{
+		{"WOB102", 22, "rate() applied to gauge node_load1"},
+		{"WOB103", 23, `node_load1 has no label "cpu"`},
+		{"WOB104", 24, "aggregated by (route) without le"},
+		{"WOB104", 25, "node_load1, which is a gauge"},
+		{"WOB105", 26, "sum of counter requests_total without rate()"},
+		{"WOB106", 27, "avg of quantiles"},
+		{"WOB106", 28, "avg of quantiles"},
+		{"WOB104", 29, "aggregated without (le)"},
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("len(issues) = %d, want %d: %+v", len(issues), len(want), issues)
+	}
+	for i, issue := range issues {
+		if issue.RuleID != want[i].ruleID {
+			t.Errorf("issues[%d].RuleID = %s, want %s (%s)", i, issue.RuleID, want[i].ruleID, issue.Message)
+		}
+		if issue.Line != want[i].line {
+			t.Errorf("issues[%d].Line = %d, want %d (%s)", i, issue.Line, want[i].line, issue.Message)
+		}
+		if !strings.Contains(issue.Message, want[i].message) {
+			t.Errorf("issues[%d].Message = %q, want containing %q", i, issue.Message, want[i].message)
+		}
+	}
+}
+
+func TestCheckMetricTypesWithoutCatalog(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, `package monitoring
+
+import "github.com/lex00/wetwire-observability-go/rules"
+
+var A = rules.NewRecordingRule("a").WithExpr("rate(node_load1[5m]) + avg(latency{quantile=\"0.99\"})")
+`))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	issues := checkMetricTypes(files)
+	if len(issues) != 1 || issues[0].RuleID != "WOB106" {
+		t.Fatalf("issues = %+v, want one WOB106", issues)
+	}
+}
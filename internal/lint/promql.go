@@ -0,0 +1,102 @@
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/promql/parser"
+)
+
+// promqlImportPath is the import path of the promql package.
+const promqlImportPath = "github.com/lex00/wetwire-observability-go/promql"
+
+// promqlExpr is a constant PromQL expression in source.
+type promqlExpr struct {
+	file  *goFile
+	node  ast.Expr
+	value string
+}
+
+// line returns the line of the expression in source.
+func (e promqlExpr) line() int {
+	return e.file.Fset.Position(e.node.Pos()).Line
+}
+
+// promqlExprs returns the PromQL expressions that are constant strings
+// passed to WithExpr or promql.Raw, or set as the Expr field of
+// AlertingRule and RecordingRule literals. Strings containing "${" are
+// assumed to be substituted at build time and are skipped.
+func promqlExprs(files []*goFile) []promqlExpr {
+	consts := stringConstants(files)
+	var exprs []promqlExpr
+
+	add := func(f *goFile, expr ast.Expr) {
+		s, ok := stringValue(expr, consts)
+		if !ok || strings.Contains(s, "${") {
+			return
+		}
+		exprs = append(exprs, promqlExpr{file: f, node: expr, value: s})
+	}
+
+	for _, f := range files {
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CallExpr:
+				if len(node.Args) != 1 {
+					break
+				}
+				if calleeName(node) == "WithExpr" || packageFunc(f, node, promqlImportPath) == "Raw" {
+					add(f, node.Args[0])
+				}
+			case *ast.CompositeLit:
+				switch typeName(node.Type) {
+				case "AlertingRule", "RecordingRule":
+				default:
+					return true
+				}
+				for _, elt := range node.Elts {
+					if kv, ok := elt.(*ast.KeyValueExpr); ok && isIdent(kv.Key, "Expr") {
+						add(f, kv.Value)
+					}
+				}
+			}
+			return true
+		})
+	}
+	return exprs
+}
+
+// checkPromQLSyntax implements WOB101: PromQL expressions must parse and
+// type-check. Expressions are collected by promqlExprs.
+func checkPromQLSyntax(files []*goFile) []LintIssue {
+	var issues []LintIssue
+	for _, e := range promqlExprs(files) {
+		_, err := parser.ParseExpr(e.value)
+		if err == nil {
+			continue
+		}
+
+		// Report multi-line expressions at the offending line when the
+		// string is written inline.
+		line := e.line()
+		msg := err.Error()
+		var perr *parser.ParseError
+		if errors.As(err, &perr) {
+			errLine, _ := perr.Position()
+			if _, isLit := e.node.(*ast.BasicLit); isLit {
+				line += errLine - 1
+			}
+			msg = perr.Err.Error()
+		}
+		issues = append(issues, LintIssue{
+			RuleID:   "WOB101",
+			Severity: "error",
+			Message:  fmt.Sprintf("invalid PromQL expression %q: %s", e.value, msg),
+			File:     e.file.Path,
+			Line:     line,
+		})
+	}
+	return issues
+}
@@ -0,0 +1,68 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+)
+
+const promqlSource = `package monitoring
+
+import (
+	pq "github.com/lex00/wetwire-observability-go/promql"
+	"github.com/lex00/wetwire-observability-go/rules"
+)
+
+const ErrorRatio = "sum(rate(errors_total[5m])) / sum(rate(requests_total[5m]))"
+
+const BadRatio = "sum(rate(errors_total)) / 1"
+
+var Good = rules.NewAlertingRule("HighErrors").
+	WithExpr(ErrorRatio + " > 0.05")
+
+var Bad = rules.NewAlertingRule("Broken").
+	WithExpr(BadRatio)
+
+var Literal = &rules.RecordingRule{Record: "job:up:sum", Expr: "sum by (job (up)"}
+
+var Multi = rules.RecordingRule{Record: "x", Expr: ` + "`" + `
+sum(
+  rate(x)
+)` + "`" + `}
+
+var Raw = pq.Raw("up{job=}")
+
+var Templated = rules.NewAlertingRule("Env").WithExpr("up{env=\"${ENV}\"} == 0")
+`
+
+func TestCheckPromQLSyntax(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, promqlSource))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	issues := checkPromQLSyntax(files)
+
+	want := []struct {
+		line    int
+		message string
+	}{
+		{16, `expected type range vector in call to function "rate", got instant vector`},
+		{18, `unexpected "(" in by clause, expected ")"`},
+		{22, `expected type range vector in call to function "rate", got instant vector`},
+		{25, `unexpected "}" in label matching, expected string`},
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("len(issues) = %d, want %d: %+v", len(issues), len(want), issues)
+	}
+	for i, issue := range issues {
+		if issue.RuleID != "WOB101" || issue.Severity != "error" {
+			t.Errorf("issue = %+v, want WOB101 error", issue)
+		}
+		if issue.Line != want[i].line {
+			t.Errorf("issues[%d].Line = %d, want %d (%s)", i, issue.Line, want[i].line, issue.Message)
+		}
+		if !strings.Contains(issue.Message, want[i].message) {
+			t.Errorf("issues[%d].Message = %q, want containing %q", i, issue.Message, want[i].message)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package lint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+	"github.com/lex00/wetwire-observability-go/promql/parser"
+)
+
+// defaultScrapeInterval is the Prometheus default global scrape interval.
+const defaultScrapeInterval = time.Minute
+
+// rateFunctions are the functions whose range must cover several scrapes.
+var rateFunctions = []string{"rate", "irate", "increase", "deriv", "delta", "idelta"}
+
+// scrapeIntervals are the scrape intervals declared in source.
+type scrapeIntervals struct {
+	// jobs are the intervals by job name; zero means the global interval.
+	jobs map[string]time.Duration
+
+	// global is the global scrape interval, zero if not set.
+	global time.Duration
+}
+
+// of returns the interval of a job.
+func (s *scrapeIntervals) of(job string) time.Duration {
+	if d := s.jobs[job]; d > 0 {
+		return d
+	}
+	if s.global > 0 {
+		return s.global
+	}
+	return defaultScrapeInterval
+}
+
+// collectScrapeIntervals returns the intervals of ScrapeConfig literals,
+// NewScrapeConfig(...).WithInterval(...) chains and GlobalConfig literals.
+// Intervals that are not constant are treated as unset.
+func collectScrapeIntervals(files []*goFile) *scrapeIntervals {
+	consts := stringConstants(files)
+	s := &scrapeIntervals{jobs: make(map[string]time.Duration)}
+
+	for _, f := range files {
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CompositeLit:
+				switch typeName(node.Type) {
+				case "ScrapeConfig":
+					var job string
+					var interval time.Duration
+					for _, elt := range node.Elts {
+						kv, ok := elt.(*ast.KeyValueExpr)
+						if !ok {
+							continue
+						}
+						switch {
+						case isIdent(kv.Key, "JobName"):
+							job, _ = stringValue(kv.Value, consts)
+						case isIdent(kv.Key, "ScrapeInterval"):
+							interval, _ = durationValue(kv.Value, consts)
+						}
+					}
+					if job != "" {
+						s.jobs[job] = interval
+					}
+				case "GlobalConfig":
+					for _, elt := range node.Elts {
+						if kv, ok := elt.(*ast.KeyValueExpr); ok && isIdent(kv.Key, "ScrapeInterval") {
+							s.global, _ = durationValue(kv.Value, consts)
+						}
+					}
+				}
+			case *ast.CallExpr:
+				if calleeName(node) != "NewScrapeConfig" || len(node.Args) != 1 {
+					break
+				}
+				if job, ok := stringValue(node.Args[0], consts); ok {
+					if _, seen := s.jobs[job]; !seen {
+						s.jobs[job] = 0
+					}
+				}
+			}
+			return true
+		})
+
+		// WithInterval calls are matched to the NewScrapeConfig call at the
+		// root of their chain.
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || calleeName(call) != "WithInterval" || len(call.Args) != 1 {
+				return true
+			}
+			root := chainRoot(call)
+			if calleeName(root) != "NewScrapeConfig" || len(root.Args) != 1 {
+				return true
+			}
+			job, ok := stringValue(root.Args[0], consts)
+			if !ok {
+				return true
+			}
+			if d, ok := durationValue(call.Args[0], consts); ok {
+				s.jobs[job] = d
+			}
+			return true
+		})
+	}
+	return s
+}
+
+// durationValue evaluates a constant duration expression such as
+// 15 * prometheus.Second, prometheus.Duration(30 * time.Second) or a
+// prometheus.ParseDuration call.
+func durationValue(expr ast.Expr, consts map[string]string) (time.Duration, bool) {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return durationValue(e.X, consts)
+	case *ast.BasicLit:
+		if e.Kind != token.INT && e.Kind != token.FLOAT {
+			return 0, false
+		}
+		v, err := strconv.ParseFloat(e.Value, 64)
+		return time.Duration(v), err == nil
+	case *ast.SelectorExpr:
+		switch e.Sel.Name {
+		case "Millisecond":
+			return time.Millisecond, true
+		case "Second":
+			return time.Second, true
+		case "Minute":
+			return time.Minute, true
+		case "Hour":
+			return time.Hour, true
+		}
+	case *ast.BinaryExpr:
+		if e.Op != token.MUL {
+			return 0, false
+		}
+		x, ok := durationValue(e.X, consts)
+		if !ok {
+			return 0, false
+		}
+		y, ok := durationValue(e.Y, consts)
+		return x * y, ok
+	case *ast.CallExpr:
+		if len(e.Args) != 1 {
+			return 0, false
+		}
+		switch calleeName(e) {
+		case "Duration":
+			return durationValue(e.Args[0], consts)
+		case "ParseDuration":
+			s, ok := stringValue(e.Args[0], consts)
+			if !ok {
+				return 0, false
+			}
+			d, err := prometheus.ParseDuration(s)
+			return time.Duration(d), err == nil
+		}
+	}
+	return 0, false
+}
+
+// checkRateWindows implements WOB107: the range of rate, increase and the
+// other rate functions must be at least four times the scrape interval of
+// the selected jobs, so every window holds enough samples to survive a
+// missed scrape. Jobs are selected with a job matcher on the metric; without
+// one, the expression is checked against an explicitly set global interval.
+func checkRateWindows(files []*goFile) []LintIssue {
+	intervals := collectScrapeIntervals(files)
+	jobs := make([]string, 0, len(intervals.jobs))
+	for job := range intervals.jobs {
+		jobs = append(jobs, job)
+	}
+	sort.Strings(jobs)
+
+	var issues []LintIssue
+	for _, e := range promqlExprs(files) {
+		parsed, err := parser.ParseExpr(e.value)
+		if err != nil {
+			continue
+		}
+		parser.Inspect(parsed, func(node parser.Node, _ []parser.Node) bool {
+			call, ok := node.(*parser.Call)
+			if !ok || call.Func == nil || !slices.Contains(rateFunctions, call.Func.Name) || len(call.Args) == 0 {
+				return true
+			}
+			ms, ok := call.Args[0].(*parser.MatrixSelector)
+			if !ok || ms.VectorSelector == nil {
+				return true
+			}
+
+			job, interval := slowestJob(ms.VectorSelector, jobs, intervals)
+			if interval == 0 || ms.Range >= 4*interval {
+				return true
+			}
+			source := "the global scrape interval"
+			if job != "" {
+				source = fmt.Sprintf("the scrape interval of job %q", job)
+			}
+			issues = append(issues, LintIssue{
+				RuleID:   "WOB107",
+				Severity: "warning",
+				Message: fmt.Sprintf("%s() range [%s] of %s is shorter than 4x %s (%s); use at least [%s]",
+					call.Func.Name, prometheus.Duration(ms.Range), ms.VectorSelector.MetricName(), source,
+					prometheus.Duration(interval), prometheus.Duration(4*interval)),
+				File: e.file.Path,
+				Line: e.line(),
+			})
+			return true
+		})
+	}
+	return issues
+}
+
+// slowestJob returns the job with the longest scrape interval among the
+// jobs selected by the job matchers of vs. Without a job matcher, it
+// returns the global interval if set, and zero otherwise.
+func slowestJob(vs *parser.VectorSelector, jobs []string, intervals *scrapeIntervals) (string, time.Duration) {
+	var matchers []*parser.LabelMatcher
+	for _, m := range vs.LabelMatchers {
+		if m.Name == "job" {
+			matchers = append(matchers, m)
+		}
+	}
+	if len(matchers) == 0 {
+		return "", intervals.global
+	}
+
+	var slowest string
+	var longest time.Duration
+	for _, job := range jobs {
+		if !slices.ContainsFunc(matchers, func(m *parser.LabelMatcher) bool { return !m.Matches(job) }) {
+			if d := intervals.of(job); d > longest {
+				slowest, longest = job, d
+			}
+		}
+	}
+	return slowest, longest
+}
@@ -0,0 +1,112 @@
+package lint
+
+import (
+	goparser "go/parser"
+	"strings"
+	"testing"
+	"time"
+)
+
+const rateWindowSource = `package monitoring
+
+import (
+	"time"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+	"github.com/lex00/wetwire-observability-go/rules"
+)
+
+var API = prometheus.ScrapeConfig{JobName: "api", ScrapeInterval: 15 * prometheus.Second}
+
+var Batch = prometheus.NewScrapeConfig("batch").WithInterval(prometheus.Duration(2 * time.Minute))
+
+var Slow = prometheus.NewScrapeConfig("slow")
+
+var Rules = rules.NewRuleGroup("rates").WithRules(
+	rules.NewRecordingRule("a").WithExpr("rate(requests_total{job=\"api\"}[30s])"),
+	rules.NewRecordingRule("b").WithExpr("increase(jobs_total{job=~\"api|batch\"}[5m])"),
+	rules.NewRecordingRule("c").WithExpr("rate(x_total{job=\"slow\"}[2m])"),
+	rules.NewRecordingRule("ok").WithExpr("rate(requests_total{job=\"api\"}[1m]) + rate(x_total[10s])"),
+	rules.NewRecordingRule("ok").WithExpr("rate(x_total{job=\"slow\"}[4m]) + rate(x_total{job=\"other\"}[10s])"),
+)
+`
+
+func TestCheckRateWindows(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, rateWindowSource))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	issues := checkRateWindows(files)
+
+	want := []struct {
+		line    int
+		message string
+	}{
+		{17, `rate() range [30s] of requests_total is shorter than 4x the scrape interval of job "api" (15s); use at least [1m]`},
+		{18, `job "batch" (2m); use at least [8m]`},
+		{19, `job "slow" (1m); use at least [4m]`},
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("len(issues) = %d, want %d: %+v", len(issues), len(want), issues)
+	}
+	for i, issue := range issues {
+		if issue.RuleID != "WOB107" || issue.Severity != "warning" {
+			t.Errorf("issue = %+v, want WOB107 warning", issue)
+		}
+		if issue.Line != want[i].line {
+			t.Errorf("issues[%d].Line = %d, want %d (%s)", i, issue.Line, want[i].line, issue.Message)
+		}
+		if !strings.Contains(issue.Message, want[i].message) {
+			t.Errorf("issues[%d].Message = %q, want containing %q", i, issue.Message, want[i].message)
+		}
+	}
+}
+
+func TestCheckRateWindowsGlobalInterval(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, `package monitoring
+
+import (
+	"github.com/lex00/wetwire-observability-go/prometheus"
+	"github.com/lex00/wetwire-observability-go/rules"
+)
+
+var Global = prometheus.GlobalConfig{ScrapeInterval: prometheus.Minute}
+
+var A = rules.NewRecordingRule("a").WithExpr("rate(x_total[2m])")
+`))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	issues := checkRateWindows(files)
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, "4x the global scrape interval (1m)") {
+		t.Fatalf("issues = %+v, want one WOB107 against the global interval", issues)
+	}
+}
+
+func TestDurationValue(t *testing.T) {
+	tests := []struct {
+		src  string
+		want time.Duration
+		ok   bool
+	}{
+		{"15 * prometheus.Second", 15 * time.Second, true},
+		{"prometheus.Duration(2 * time.Minute)", 2 * time.Minute, true},
+		{"(prometheus.Minute)", time.Minute, true},
+		{`prometheus.ParseDuration("1m30s")`, 90 * time.Second, true},
+		{"interval * prometheus.Second", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			expr, err := goparser.ParseExpr(tt.src)
+			if err != nil {
+				t.Fatalf("ParseExpr() error = %v", err)
+			}
+			got, ok := durationValue(expr, nil)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("durationValue() = %v, %v, want %v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package lint
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// receiverRef is a named receiver reference or definition in source.
+type receiverRef struct {
+	name string
+	file string
+	line int
+}
+
+// checkUndefinedReceivers implements WOB053: routes must only send to
+// receivers defined in the configuration.
+//
+// Receiver names are collected from NewReceiver and the *Receiver helper
+// constructors, Receiver literals, and the receivers a RoutingPolicy
+// generates for its teams. References are collected from NewRoute calls and
+// Receiver fields in Route literals. The rule is skipped when no receivers
+// are found or when any receiver name cannot be resolved statically, since
+// the defined set is then unknown.
+func checkUndefinedReceivers(files []*goFile) []LintIssue {
+	consts := stringConstants(files)
+	defined := make(map[string]bool)
+	dynamic := false
+	var refs []receiverRef
+
+	define := func(expr ast.Expr) {
+		if s, ok := stringValue(expr, consts); ok {
+			defined[s] = true
+		} else {
+			dynamic = true
+		}
+	}
+
+	for _, f := range files {
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CallExpr:
+				name := packageFunc(f, node, alertmanagerImportPath)
+				switch {
+				case name == "NewRoute":
+					if len(node.Args) > 0 {
+						if s, ok := stringValue(node.Args[0], consts); ok {
+							refs = append(refs, receiverRef{s, f.Path, f.Fset.Position(node.Args[0].Pos()).Line})
+						}
+					}
+				case name == "NewRoutingPolicy":
+					defined["default"] = true
+					defined["null"] = true
+				case name == "NewTeamDefinition":
+					if len(node.Args) > 0 {
+						if s, ok := stringValue(node.Args[0], consts); ok {
+							defined[s+"-slack"] = true
+							defined[s+"-pagerduty"] = true
+						} else {
+							dynamic = true
+						}
+					}
+				case strings.HasSuffix(name, "Receiver") || strings.HasSuffix(name, "ReceiverFromFile"):
+					if len(node.Args) > 0 {
+						define(node.Args[0])
+					}
+				}
+			case *ast.CompositeLit:
+				switch typeName(node.Type) {
+				case "Receiver":
+					for _, elt := range node.Elts {
+						if kv, ok := elt.(*ast.KeyValueExpr); ok && isIdent(kv.Key, "Name") {
+							define(kv.Value)
+						}
+					}
+				case "Route":
+					for _, elt := range node.Elts {
+						if kv, ok := elt.(*ast.KeyValueExpr); ok && isIdent(kv.Key, "Receiver") {
+							if s, ok := stringValue(kv.Value, consts); ok {
+								refs = append(refs, receiverRef{s, f.Path, f.Fset.Position(kv.Value.Pos()).Line})
+							}
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	if dynamic || len(defined) == 0 {
+		return nil
+	}
+
+	var issues []LintIssue
+	for _, ref := range refs {
+		if ref.name == "" || defined[ref.name] {
+			continue
+		}
+		issues = append(issues, LintIssue{
+			RuleID:   "WOB053",
+			Severity: "error",
+			Message:  fmt.Sprintf("route references undefined receiver %q", ref.name),
+			File:     ref.file,
+			Line:     ref.line,
+		})
+	}
+	return issues
+}
+
+// checkDuplicateReceivers implements WOB054: receiver names must be unique
+// within a configuration.
+//
+// Each WithReceivers call and Receivers literal is checked on its own, so
+// separate configurations may reuse names. Receivers passed by variable are
+// resolved through package-level var declarations in the same files.
+func checkDuplicateReceivers(files []*goFile) []LintIssue {
+	consts := stringConstants(files)
+	vars := receiverVars(files, consts)
+
+	receiverName := func(expr ast.Expr) (string, bool) {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			s, ok := vars[e.Name]
+			return s, ok
+		case *ast.SelectorExpr:
+			s, ok := vars[e.Sel.Name]
+			return s, ok
+		}
+		return receiverLiteralName(expr, consts)
+	}
+
+	var issues []LintIssue
+	checkList := func(f *goFile, exprs []ast.Expr) {
+		seen := make(map[string]bool)
+		for _, expr := range exprs {
+			name, ok := receiverName(expr)
+			if !ok {
+				continue
+			}
+			if seen[name] {
+				issues = append(issues, LintIssue{
+					RuleID:   "WOB054",
+					Severity: "error",
+					Message:  fmt.Sprintf("receiver name %q is not unique", name),
+					File:     f.Path,
+					Line:     f.Fset.Position(expr.Pos()).Line,
+				})
+			}
+			seen[name] = true
+		}
+	}
+
+	for _, f := range files {
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CallExpr:
+				if calleeName(node) == "WithReceivers" {
+					checkList(f, node.Args)
+				}
+			case *ast.KeyValueExpr:
+				if !isIdent(node.Key, "Receivers") {
+					break
+				}
+				if list, ok := node.Value.(*ast.CompositeLit); ok && typeName(eltType(list.Type)) == "Receiver" {
+					checkList(f, list.Elts)
+				}
+			}
+			return true
+		})
+	}
+	return issues
+}
+
+// receiverVars maps package-level variables initialised with a receiver to
+// the receiver's name.
+func receiverVars(files []*goFile, consts map[string]string) map[string]string {
+	vars := make(map[string]string)
+	for _, f := range files {
+		for _, decl := range f.AST.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs, ok := spec.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+				for i, name := range vs.Names {
+					if i >= len(vs.Values) {
+						continue
+					}
+					if s, ok := receiverLiteralName(vs.Values[i], consts); ok {
+						vars[name.Name] = s
+					}
+				}
+			}
+		}
+	}
+	return vars
+}
+
+// receiverLiteralName returns the name of a receiver built inline, either
+// by a Receiver literal or by a receiver constructor call, possibly followed
+// by chained builder methods.
+func receiverLiteralName(expr ast.Expr, consts map[string]string) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.UnaryExpr:
+		return receiverLiteralName(e.X, consts)
+	case *ast.CompositeLit:
+		// Elements of a []*Receiver literal may elide the type.
+		if e.Type != nil && typeName(e.Type) != "Receiver" {
+			return "", false
+		}
+		for _, elt := range e.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok && isIdent(kv.Key, "Name") {
+				return stringValue(kv.Value, consts)
+			}
+		}
+	case *ast.CallExpr:
+		name := calleeName(e)
+		if strings.HasPrefix(name, "With") {
+			if sel, ok := e.Fun.(*ast.SelectorExpr); ok {
+				return receiverLiteralName(sel.X, consts)
+			}
+			return "", false
+		}
+		if (strings.HasSuffix(name, "Receiver") || strings.HasSuffix(name, "ReceiverFromFile")) && len(e.Args) > 0 {
+			return stringValue(e.Args[0], consts)
+		}
+	}
+	return "", false
+}
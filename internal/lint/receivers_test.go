@@ -0,0 +1,114 @@
+package lint
+
+import "testing"
+
+const receiversSource = `package monitoring
+
+import am "github.com/lex00/wetwire-observability-go/alertmanager"
+
+const Pager = "pager"
+
+var Default = am.NewReceiver("default")
+
+var Slack = am.SlackReceiver("slack", "#alerts").
+	WithSlackConfigs(am.NewSlackConfig())
+
+var Policy = am.NewRoutingPolicy().
+	WithTeams(am.NewTeamDefinition("platform"))
+
+var RootRoute = am.NewRoute("default").
+	WithRoutes(
+		am.NewRoute(Pager),
+		am.NewRoute("platform-slack"),
+		&am.Route{Receiver: "null"},
+		&am.Route{Receiver: "email"},
+	)
+
+var Config = am.NewAlertmanagerConfig().
+	WithRoute(RootRoute).
+	WithReceivers(Default, Slack, am.NewReceiver("default"))
+
+var Other = &am.AlertmanagerConfig{
+	Route: RootRoute,
+	Receivers: []*am.Receiver{
+		Default,
+		{Name: Pager},
+		&am.Receiver{Name: "pager"},
+	},
+}
+`
+
+func TestCheckUndefinedReceivers(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, receiversSource))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	issues := checkUndefinedReceivers(files)
+
+	if len(issues) != 1 {
+		t.Fatalf("len(issues) = %d, want 1: %+v", len(issues), issues)
+	}
+	issue := issues[0]
+	if issue.RuleID != "WOB053" || issue.Severity != "error" {
+		t.Errorf("issue = %+v, want WOB053 error", issue)
+	}
+	if want := `route references undefined receiver "email"`; issue.Message != want {
+		t.Errorf("Message = %q, want %q", issue.Message, want)
+	}
+	if issue.Line != 20 {
+		t.Errorf("Line = %d, want 20", issue.Line)
+	}
+}
+
+func TestCheckUndefinedReceivers_SkipsDynamicNames(t *testing.T) {
+	source := `package monitoring
+
+import "github.com/lex00/wetwire-observability-go/alertmanager"
+
+var Receivers = func() []*alertmanager.Receiver {
+	var out []*alertmanager.Receiver
+	for _, team := range []string{"a", "b"} {
+		out = append(out, alertmanager.NewReceiver(team))
+	}
+	return out
+}()
+
+var RootRoute = alertmanager.NewRoute("a")
+`
+	files, err := parseGoFiles(writeLintSource(t, source))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	if issues := checkUndefinedReceivers(files); len(issues) != 0 {
+		t.Errorf("issues = %+v, want none", issues)
+	}
+}
+
+func TestCheckDuplicateReceivers(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, receiversSource))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	issues := checkDuplicateReceivers(files)
+
+	want := map[string]int{
+		`receiver name "default" is not unique`: 25,
+		`receiver name "pager" is not unique`:   32,
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("len(issues) = %d, want %d: %+v", len(issues), len(want), issues)
+	}
+	for _, issue := range issues {
+		line, ok := want[issue.Message]
+		if !ok {
+			t.Errorf("unexpected issue %q", issue.Message)
+			continue
+		}
+		if issue.RuleID != "WOB054" || issue.Line != line {
+			t.Errorf("issue = %+v, want WOB054 at line %d", issue, line)
+		}
+	}
+}
@@ -0,0 +1,295 @@
+package lint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+)
+
+// variableConstructors are the grafana functions creating a dashboard
+// variable named by their first argument.
+var variableConstructors = map[string]bool{
+	"QueryVar":      true,
+	"CustomVar":     true,
+	"IntervalVar":   true,
+	"DatasourceVar": true,
+	"TextboxVar":    true,
+	"ConstantVar":   true,
+}
+
+// repeatUse is a RepeatBy call or Repeat field of a row or panel.
+type repeatUse struct {
+	variable string
+	pos      token.Pos
+}
+
+// dashboardRepeats are the variables of a dashboard and the repeats of its
+// rows and panels.
+type dashboardRepeats struct {
+	uid string
+
+	// variables maps variable names to whether they are multi-value.
+	variables map[string]bool
+
+	// dynamic is set when a variable cannot be resolved statically.
+	dynamic bool
+
+	repeats []repeatUse
+}
+
+// checkRepeatVariables implements WOB123: rows and panels must only repeat
+// by a variable of their dashboard that accepts multiple values, with
+// MultiSelect or IncludeAll. Grafana silently shows a single copy
+// otherwise.
+//
+// Dashboards are NewDashboard chains and Dashboard literals. Variables,
+// rows and panels are resolved through package-level variables; dashboards
+// with variables that cannot be resolved statically are skipped.
+func checkRepeatVariables(files []*goFile) []LintIssue {
+	if len(files) == 0 {
+		return nil
+	}
+	consts := stringConstants(files)
+	decls := packageVars(files)
+	resolve := func(expr ast.Expr) ast.Expr {
+		// The depth limit guards against initialization cycles.
+		for depth := 0; depth < len(decls)+1; depth++ {
+			switch e := expr.(type) {
+			case *ast.ParenExpr:
+				expr = e.X
+			case *ast.UnaryExpr:
+				expr = e.X
+			case *ast.Ident:
+				value, ok := decls[e.Name]
+				if !ok {
+					return expr
+				}
+				expr = value
+			default:
+				return expr
+			}
+		}
+		return expr
+	}
+
+	// addVariable records a dashboard variable expression.
+	addVariable := func(d *dashboardRepeats, expr ast.Expr) {
+		switch e := resolve(expr).(type) {
+		case *ast.CallExpr:
+			root := chainRoot(e)
+			if !variableConstructors[calleeName(root)] || len(root.Args) == 0 {
+				d.dynamic = true
+				return
+			}
+			name, ok := stringValue(root.Args[0], consts)
+			if !ok {
+				d.dynamic = true
+				return
+			}
+			multi := false
+			for _, call := range chainCalls(e) {
+				if n := calleeName(call); n == "MultiSelect" || n == "IncludeAll" {
+					multi = true
+				}
+			}
+			d.variables[name] = d.variables[name] || multi
+		case *ast.CompositeLit:
+			if typeName(e.Type) != "Variable" {
+				d.dynamic = true
+				return
+			}
+			var name string
+			multi := false
+			for _, elt := range e.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				switch {
+				case isIdent(kv.Key, "Name"):
+					name, _ = stringValue(kv.Value, consts)
+				case isIdent(kv.Key, "Multi"), isIdent(kv.Key, "IncludeAllOption"):
+					multi = multi || isIdent(kv.Value, "true")
+				}
+			}
+			if name == "" {
+				d.dynamic = true
+				return
+			}
+			d.variables[name] = d.variables[name] || multi
+		default:
+			d.dynamic = true
+		}
+	}
+
+	// addRepeats records the repeats of a row or panel expression and, for
+	// rows, of their panels.
+	var addRepeats func(d *dashboardRepeats, expr ast.Expr)
+	addRepeats = func(d *dashboardRepeats, expr ast.Expr) {
+		switch e := resolve(expr).(type) {
+		case *ast.CallExpr:
+			for _, call := range chainCalls(e) {
+				switch calleeName(call) {
+				case "RepeatBy":
+					if len(call.Args) == 1 {
+						if name, ok := stringValue(call.Args[0], consts); ok {
+							d.repeats = append(d.repeats, repeatUse{name, call.Args[0].Pos()})
+						}
+					}
+				case "WithPanels", "AddPanel":
+					for _, arg := range call.Args {
+						addRepeats(d, arg)
+					}
+				}
+			}
+		case *ast.CompositeLit:
+			for _, elt := range e.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					addRepeats(d, elt)
+					continue
+				}
+				switch {
+				case isIdent(kv.Key, "Repeat"):
+					if name, ok := stringValue(kv.Value, consts); ok && name != "" {
+						d.repeats = append(d.repeats, repeatUse{name, kv.Value.Pos()})
+					}
+				case isIdent(kv.Key, "Panels"), isIdent(kv.Key, "BasePanel"):
+					addRepeats(d, kv.Value)
+				}
+			}
+		}
+	}
+
+	var dashboards []*dashboardRepeats
+	for _, f := range files {
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CallExpr:
+				// Only the outermost call of each NewDashboard chain.
+				root := chainRoot(node)
+				if packageFunc(f, root, grafanaImportPath) != "NewDashboard" || len(root.Args) == 0 {
+					break
+				}
+				d := &dashboardRepeats{variables: make(map[string]bool)}
+				d.uid, _ = stringValue(root.Args[0], consts)
+				for _, call := range chainCalls(node) {
+					switch calleeName(call) {
+					case "WithVariables", "AddVariable":
+						if call.Ellipsis.IsValid() {
+							d.dynamic = true
+						}
+						for _, arg := range call.Args {
+							addVariable(d, arg)
+						}
+					case "WithRows", "AddRow":
+						for _, arg := range call.Args {
+							addRepeats(d, arg)
+						}
+					}
+				}
+				dashboards = append(dashboards, d)
+				return false
+			case *ast.CompositeLit:
+				if typeName(node.Type) != "Dashboard" {
+					break
+				}
+				d := &dashboardRepeats{variables: make(map[string]bool)}
+				for _, elt := range node.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if !ok {
+						continue
+					}
+					switch {
+					case isIdent(kv.Key, "UID"):
+						d.uid, _ = stringValue(kv.Value, consts)
+					case isIdent(kv.Key, "Variables"):
+						lit, ok := kv.Value.(*ast.CompositeLit)
+						if !ok {
+							d.dynamic = true
+							continue
+						}
+						for _, v := range lit.Elts {
+							addVariable(d, v)
+						}
+					case isIdent(kv.Key, "Rows"):
+						addRepeats(d, kv.Value)
+					}
+				}
+				dashboards = append(dashboards, d)
+				return false
+			}
+			return true
+		})
+	}
+
+	fset := files[0].Fset
+	var issues []LintIssue
+	for _, d := range dashboards {
+		for _, r := range d.repeats {
+			multi, defined := d.variables[r.variable]
+			var message string
+			switch {
+			case !defined && !d.dynamic:
+				message = fmt.Sprintf("repeat variable %q is not a variable of dashboard %q", r.variable, d.uid)
+			case defined && !multi:
+				message = fmt.Sprintf("repeat variable %q of dashboard %q is not multi-value; use MultiSelect() or IncludeAll()", r.variable, d.uid)
+			default:
+				continue
+			}
+			pos := fset.Position(r.pos)
+			issues = append(issues, LintIssue{
+				RuleID:   "WOB123",
+				Severity: "error",
+				Message:  message,
+				File:     pos.Filename,
+				Line:     pos.Line,
+			})
+		}
+	}
+	return issues
+}
+
+// packageVars returns the values of the package-level variables of files
+// by name.
+func packageVars(files []*goFile) map[string]ast.Expr {
+	vars := make(map[string]ast.Expr)
+	for _, f := range files {
+		for _, decl := range f.AST.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs, ok := spec.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+				for i, name := range vs.Names {
+					if i < len(vs.Values) {
+						vars[name.Name] = vs.Values[i]
+					}
+				}
+			}
+		}
+	}
+	return vars
+}
+
+// chainCalls returns the calls of a method chain, from the outermost call
+// to its root.
+func chainCalls(call *ast.CallExpr) []*ast.CallExpr {
+	calls := []*ast.CallExpr{call}
+	for {
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return calls
+		}
+		inner, ok := sel.X.(*ast.CallExpr)
+		if !ok {
+			return calls
+		}
+		call = inner
+		calls = append(calls, call)
+	}
+}
@@ -0,0 +1,89 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+)
+
+const repeatSource = `package monitoring
+
+import "github.com/lex00/wetwire-observability-go/grafana"
+
+var Namespace = grafana.QueryVar("namespace", "label_values(namespace)").MultiSelect()
+
+var Pod = grafana.QueryVar("pod", "label_values(pod)")
+
+var PodCPU = grafana.TimeSeries("CPU").RepeatBy("pod")
+
+var Overview = grafana.NewDashboard("overview", "Overview").
+	WithVariables(Namespace, Pod, grafana.CustomVar("env", "prod", "dev").IncludeAll()).
+	WithRows(
+		grafana.NewRow("Namespace $namespace").RepeatBy("namespace").WithPanels(
+			grafana.Stat("Pods").RepeatBy("env"),
+			PodCPU,
+			grafana.Gauge("Memory").RepeatBy("node"),
+		),
+		&grafana.Row{Title: "Cluster", Repeat: "cluster"},
+	)
+
+var Pods = &grafana.Dashboard{
+	UID:       "pods",
+	Variables: []any{&grafana.Variable{Name: "pod", Multi: true}},
+	Rows: []*grafana.Row{
+		grafana.NewRow("Pod").RepeatBy("pod").WithPanels(grafana.Stat("Up").RepeatBy("namespace")),
+	},
+}
+`
+
+func TestCheckRepeatVariables(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, repeatSource))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	issues := checkRepeatVariables(files)
+
+	want := []struct {
+		line    int
+		message string
+	}{
+		{9, `repeat variable "pod" of dashboard "overview" is not multi-value`},
+		{17, `repeat variable "node" is not a variable of dashboard "overview"`},
+		{19, `repeat variable "cluster" is not a variable of dashboard "overview"`},
+		{26, `repeat variable "namespace" is not a variable of dashboard "pods"`},
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("len(issues) = %d, want %d: %+v", len(issues), len(want), issues)
+	}
+	for i, issue := range issues {
+		if issue.RuleID != "WOB123" || issue.Severity != "error" {
+			t.Errorf("issue = %+v, want WOB123 error", issue)
+		}
+		if issue.Line != want[i].line {
+			t.Errorf("issues[%d].Line = %d, want %d (%s)", i, issue.Line, want[i].line, issue.Message)
+		}
+		if !strings.HasPrefix(issue.Message, want[i].message) {
+			t.Errorf("issues[%d].Message = %q, want prefix %q", i, issue.Message, want[i].message)
+		}
+	}
+}
+
+func TestCheckRepeatVariablesDynamicVariables(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, `package monitoring
+
+import "github.com/lex00/wetwire-observability-go/grafana"
+
+func variables() []any { return nil }
+
+var A = grafana.NewDashboard("a", "A").
+	WithVariables(variables()...).
+	WithRows(grafana.NewRow("Pod").RepeatBy("pod"))
+`))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	if issues := checkRepeatVariables(files); len(issues) != 0 {
+		t.Errorf("issues = %+v, want none when dashboard variables are dynamic", issues)
+	}
+}
@@ -0,0 +1,118 @@
+package lint
+
+import (
+	"fmt"
+	"go/ast"
+)
+
+// presetTimeIntervals maps alertmanager helper constructors to the interval
+// names they define.
+var presetTimeIntervals = map[string]string{
+	"WeekendsMuteInterval":             "weekends",
+	"BusinessHoursMuteInterval":        "business-hours",
+	"OutsideBusinessHoursMuteInterval": "outside-business-hours",
+	"NightsMuteInterval":               "nights",
+}
+
+// timeIntervalRef is a route reference to a named time interval.
+type timeIntervalRef struct {
+	name string
+	file string
+	line int
+}
+
+// checkUndefinedTimeIntervals implements WOB052: routes must only reference
+// time intervals defined in the configuration.
+//
+// Interval names are collected statically from NewMuteTimeInterval calls,
+// MuteTimeInterval literals and preset helpers. References are collected
+// from WithMuteTimeIntervals/WithActiveTimeIntervals calls with string
+// arguments and MuteTimeIntervals/ActiveTimeIntervals fields in literals.
+// Names that cannot be resolved statically are ignored.
+func checkUndefinedTimeIntervals(files []*goFile) []LintIssue {
+	consts := stringConstants(files)
+	defined := make(map[string]bool)
+	var refs []timeIntervalRef
+
+	for _, f := range files {
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CallExpr:
+				name := calleeName(node)
+				switch name {
+				case "NewMuteTimeInterval", "NewAMMuteTimeInterval":
+					if len(node.Args) > 0 {
+						if s, ok := stringValue(node.Args[0], consts); ok {
+							defined[s] = true
+						}
+					}
+				case "WithMuteTimeIntervals", "WithActiveTimeIntervals":
+					for _, arg := range node.Args {
+						if s, ok := stringValue(arg, consts); ok {
+							refs = append(refs, timeIntervalRef{s, f.Path, f.Fset.Position(arg.Pos()).Line})
+						}
+					}
+				default:
+					if preset, ok := presetTimeIntervals[name]; ok {
+						defined[preset] = true
+					}
+				}
+			case *ast.CompositeLit:
+				if t := typeName(node.Type); t == "MuteTimeInterval" || t == "AMMuteTimeInterval" {
+					for _, elt := range node.Elts {
+						if kv, ok := elt.(*ast.KeyValueExpr); ok && isIdent(kv.Key, "Name") {
+							if s, ok := stringValue(kv.Value, consts); ok {
+								defined[s] = true
+							}
+						}
+					}
+				}
+				for _, elt := range node.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if !ok || !(isIdent(kv.Key, "MuteTimeIntervals") || isIdent(kv.Key, "ActiveTimeIntervals")) {
+						continue
+					}
+					list, ok := kv.Value.(*ast.CompositeLit)
+					if !ok || typeName(eltType(list.Type)) != "string" {
+						continue
+					}
+					for _, item := range list.Elts {
+						if s, ok := stringValue(item, consts); ok {
+							refs = append(refs, timeIntervalRef{s, f.Path, f.Fset.Position(item.Pos()).Line})
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	var issues []LintIssue
+	for _, ref := range refs {
+		if defined[ref.name] {
+			continue
+		}
+		issues = append(issues, LintIssue{
+			RuleID:   "WOB052",
+			Severity: "error",
+			Message:  fmt.Sprintf("route references undefined time interval %q", ref.name),
+			File:     ref.file,
+			Line:     ref.line,
+		})
+	}
+	return issues
+}
+
+// isIdent reports whether expr is the identifier name.
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+// eltType returns the element type of a slice type expression.
+func eltType(expr ast.Expr) ast.Expr {
+	if arr, ok := expr.(*ast.ArrayType); ok {
+		return arr.Elt
+	}
+	return nil
+}
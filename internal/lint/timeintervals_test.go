@@ -0,0 +1,90 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const timeIntervalsSource = `package monitoring
+
+import "github.com/lex00/wetwire-observability-go/alertmanager"
+
+const Office = "office"
+
+var OfficeHours = alertmanager.NewMuteTimeInterval(Office)
+
+var Holidays = &alertmanager.MuteTimeInterval{Name: "holidays"}
+
+var RootRoute = alertmanager.NewRoute("default").
+	WithMuteTimeIntervals("weekends", "holidays").
+	WithRoutes(
+		alertmanager.NewRoute("oncall").WithActiveTimeIntervals(Office, "on-call"),
+		&alertmanager.Route{Receiver: "pager", MuteTimeIntervals: []string{"maintenance"}},
+	)
+
+var Config = &alertmanager.AlertmanagerConfig{
+	Route:             RootRoute,
+	MuteTimeIntervals: []*alertmanager.MuteTimeInterval{alertmanager.WeekendsMuteInterval(), Holidays},
+	TimeIntervals:     []*alertmanager.MuteTimeInterval{OfficeHours},
+}
+`
+
+func writeLintSource(t *testing.T, source string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "alertmanager.go"), []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestCheckUndefinedTimeIntervals(t *testing.T) {
+	files, err := parseGoFiles(writeLintSource(t, timeIntervalsSource))
+	if err != nil {
+		t.Fatalf("parseGoFiles() error = %v", err)
+	}
+
+	issues := checkUndefinedTimeIntervals(files)
+
+	want := map[string]int{
+		`route references undefined time interval "on-call"`:     14,
+		`route references undefined time interval "maintenance"`: 15,
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("len(issues) = %d, want %d: %+v", len(issues), len(want), issues)
+	}
+	for _, issue := range issues {
+		line, ok := want[issue.Message]
+		if !ok {
+			t.Errorf("unexpected issue %q", issue.Message)
+			continue
+		}
+		if issue.RuleID != "WOB052" || issue.Severity != "error" {
+			t.Errorf("issue = %+v, want WOB052 error", issue)
+		}
+		if issue.Line != line {
+			t.Errorf("Line = %d, want %d for %q", issue.Line, line, issue.Message)
+		}
+	}
+}
+
+func TestLintAll_UndefinedTimeIntervals(t *testing.T) {
+	dir := writeLintSource(t, timeIntervalsSource)
+
+	result, err := NewLinter().LintAll(dir)
+	if err != nil {
+		t.Fatalf("LintAll() error = %v", err)
+	}
+	if len(result.Issues) != 2 {
+		t.Errorf("len(Issues) = %d, want 2: %+v", len(result.Issues), result.Issues)
+	}
+
+	result, err = LintAllWithOptions(dir, LintOptions{DisabledRules: []string{"WOB052"}})
+	if err != nil {
+		t.Fatalf("LintAllWithOptions() error = %v", err)
+	}
+	if len(result.Issues) != 0 {
+		t.Errorf("len(Issues) = %d, want 0 with WOB052 disabled", len(result.Issues))
+	}
+}
@@ -0,0 +1,92 @@
+// Package yamlpath locates nodes in a YAML document by validation path.
+//
+// Paths use YAML keys separated by dots with list indexes in brackets, for
+// example "scrape_configs[0].relabel_configs[1].regex".
+package yamlpath
+
+import (
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Lookup returns the node at path in the document rooted at root. If the
+// path does not exist in full, the deepest existing node along it is
+// returned, so that a missing field is reported at its parent.
+func Lookup(root *yaml.Node, path string) *yaml.Node {
+	node := root
+	if node != nil && node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	if node == nil || path == "" {
+		return node
+	}
+
+	for _, seg := range split(path) {
+		next := child(node, seg)
+		if next == nil {
+			return node
+		}
+		node = next
+	}
+	return node
+}
+
+// Position returns the 1-based line and column of the node at path, or
+// 0, 0 if root is nil.
+func Position(root *yaml.Node, path string) (line, col int) {
+	node := Lookup(root, path)
+	if node == nil {
+		return 0, 0
+	}
+	return node.Line, node.Column
+}
+
+// split splits a path into keys and "[n]" index segments.
+func split(path string) []string {
+	var segs []string
+	for _, part := range strings.Split(path, ".") {
+		for part != "" {
+			i := strings.IndexByte(part, '[')
+			if i < 0 {
+				segs = append(segs, part)
+				break
+			}
+			if i > 0 {
+				segs = append(segs, part[:i])
+			}
+			j := strings.IndexByte(part[i:], ']')
+			if j < 0 {
+				segs = append(segs, part[i:])
+				break
+			}
+			segs = append(segs, part[i:i+j+1])
+			part = part[i+j+1:]
+		}
+	}
+	return segs
+}
+
+// child returns the child of node named by seg, or nil.
+func child(node *yaml.Node, seg string) *yaml.Node {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	if strings.HasPrefix(seg, "[") && strings.HasSuffix(seg, "]") {
+		i, err := strconv.Atoi(seg[1 : len(seg)-1])
+		if err != nil || node.Kind != yaml.SequenceNode || i < 0 || i >= len(node.Content) {
+			return nil
+		}
+		return node.Content[i]
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == seg {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package yamlpath
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const doc = `global:
+  scrape_interval: 30s
+scrape_configs:
+  - job_name: api
+    relabel_configs:
+      - action: keep
+        regex: "[a"
+  - job_name: db
+`
+
+func parse(t *testing.T) *yaml.Node {
+	t.Helper()
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(doc), &root); err != nil {
+		t.Fatal(err)
+	}
+	return &root
+}
+
+func TestPosition(t *testing.T) {
+	root := parse(t)
+	tests := []struct {
+		path      string
+		line, col int
+	}{
+		{"", 1, 1},
+		{"global.scrape_interval", 2, 20},
+		{"scrape_configs[0].relabel_configs[0].regex", 7, 16},
+		{"scrape_configs[1]", 8, 5},
+		// Missing fields resolve to the nearest existing parent.
+		{"scrape_configs[1].scrape_timeout", 8, 5},
+		{"scrape_configs[5].job_name", 4, 3},
+	}
+	for _, tt := range tests {
+		line, col := Position(root, tt.path)
+		if line != tt.line || col != tt.col {
+			t.Errorf("Position(%q) = %d:%d, want %d:%d", tt.path, line, col, tt.line, tt.col)
+		}
+	}
+}
+
+func TestPosition_NilRoot(t *testing.T) {
+	if line, col := Position(nil, "a.b"); line != 0 || col != 0 {
+		t.Errorf("Position(nil) = %d:%d, want 0:0", line, col)
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"path/filepath"
 
 	"github.com/lex00/wetwire-observability-go/internal/discover"
+	"github.com/lex00/wetwire-observability-go/internal/importer"
+	"github.com/lex00/wetwire-observability-go/prometheus"
+	"github.com/lex00/wetwire-observability-go/rules"
 )
 
 // RegisterTools registers all wetwire-obs tools with the server.
@@ -263,15 +266,42 @@ func handleValidate(params json.RawMessage) (any, error) {
 
 	// Check what files exist
 	var found []string
+	var errs []string
 
-	// Check for prometheus.yml
-	if _, err := os.Stat(filepath.Join(p.Output, "prometheus.yml")); err == nil {
-		found = append(found, "prometheus.yml")
+	// Check for prometheus.yml and rule files and validate them in-process
+	validateFile := func(name string, validate func([]byte) ([]prometheus.ValidationError, error)) {
+		data, err := os.ReadFile(filepath.Join(p.Output, name))
+		if err != nil {
+			return
+		}
+		found = append(found, name)
+		verrs, err := validate(data)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", name, err))
+		}
+		for _, verr := range verrs {
+			errs = append(errs, fmt.Sprintf("%s:%v", name, verr))
+		}
+	}
+	validateFile("prometheus.yml", prometheus.ValidateYAML)
+	if files, err := filepath.Glob(filepath.Join(p.Output, "rules", "*.yml")); err == nil {
+		for _, f := range files {
+			validateFile("rules/"+filepath.Base(f), rules.ValidateYAML)
+		}
 	}
 
-	// Check for alertmanager.yml
-	if _, err := os.Stat(filepath.Join(p.Output, "alertmanager.yml")); err == nil {
+	// Check for alertmanager.yml and validate it in-process
+	alertmanagerYml := filepath.Join(p.Output, "alertmanager.yml")
+	if _, err := os.Stat(alertmanagerYml); err == nil {
 		found = append(found, "alertmanager.yml")
+		config, err := importer.ParseAlertmanagerConfig(alertmanagerYml)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("alertmanager.yml: %v", err))
+		} else {
+			for _, verr := range config.Validate() {
+				errs = append(errs, fmt.Sprintf("alertmanager.yml: %v", verr))
+			}
+		}
 	}
 
 	// Check for operator manifests
@@ -289,6 +319,16 @@ func handleValidate(params json.RawMessage) (any, error) {
 		}, nil
 	}
 
+	if len(errs) > 0 {
+		return map[string]any{
+			"message": "validation failed",
+			"output":  p.Output,
+			"files":   found,
+			"errors":  errs,
+			"status":  "fail",
+		}, nil
+	}
+
 	return map[string]any{
 		"message": "validation complete",
 		"output":  p.Output,
@@ -97,6 +97,61 @@ func TestValidateTool(t *testing.T) {
 	_ = result
 }
 
+func TestValidateTool_AlertmanagerConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	config := "route:\n  receiver: missing\nreceivers:\n  - name: default\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, "alertmanager.yml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	params := json.RawMessage(`{"output": "` + tmpDir + `"}`)
+	result, err := handleValidate(params)
+	if err != nil {
+		t.Fatalf("validate returned error: %v", err)
+	}
+	m := result.(map[string]any)
+	if m["status"] != "fail" {
+		t.Errorf("status = %v, want fail", m["status"])
+	}
+	errs, _ := m["errors"].([]string)
+	if len(errs) != 1 {
+		t.Errorf("errors = %v, want 1 error", errs)
+	}
+}
+
+func TestValidateTool_PrometheusConfigAndRules(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "prometheus.yml"), []byte("scrape_configs:\n  - job_name: api\n    scrape_interval: 10s\n    scrape_timeout: 30s\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(tmpDir, "rules"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "rules", "api.yml"), []byte("groups:\n  - name: api\n  - name: api\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	params := json.RawMessage(`{"output": "` + tmpDir + `"}`)
+	result, err := handleValidate(params)
+	if err != nil {
+		t.Fatalf("validate returned error: %v", err)
+	}
+	m := result.(map[string]any)
+	if m["status"] != "fail" {
+		t.Errorf("status = %v, want fail", m["status"])
+	}
+	errs, _ := m["errors"].([]string)
+	if len(errs) != 2 {
+		t.Fatalf("errors = %v, want 2 errors", errs)
+	}
+	if errs[0] != `prometheus.yml:4:21: scrape_configs[0].scrape_timeout: scrape timeout greater than scrape interval for scrape config with job name "api"` {
+		t.Errorf("errors[0] = %v", errs[0])
+	}
+	if errs[1] != `rules/api.yml:3:11: groups[1].name: groupname: "api" is repeated in the same file` {
+		t.Errorf("errors[1] = %v", errs[1])
+	}
+}
+
 func TestListTool(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "mcp-test-*")
 	if err != nil {
@@ -172,9 +172,11 @@ func AllScrapeConfigs() []prometheus.ScrapeConfig {
 }
 
 // AWSManagedPrometheusRemoteWrite returns remote_write config for AWS Managed Prometheus.
-// Note: SigV4 authentication is configured externally via environment or IAM role.
+// Requests are signed with SigV4 using credentials from the AWS SDK chain
+// (IRSA, instance profile or environment).
 func AWSManagedPrometheusRemoteWrite(workspaceID, region string) *prometheus.RemoteWriteConfig {
 	return prometheus.NewRemoteWrite("https://aps-workspaces." + region + ".amazonaws.com/workspaces/" + workspaceID + "/api/v1/remote_write").
+		WithSigV4(prometheus.NewSigV4(region)).
 		WithQueueConfig(&prometheus.QueueConfig{
 			MaxSamplesPerSend: 1000,
 			MaxShards:         200,
@@ -48,12 +48,23 @@ type AMMatcher struct {
 
 // AMReceiver represents a receiver in the AlertmanagerConfig.
 type AMReceiver struct {
-	Name             string              `yaml:"name"`
-	SlackConfigs     []*AMSlackConfig    `yaml:"slackConfigs,omitempty"`
-	PagerDutyConfigs []*AMPagerDutyConfig `yaml:"pagerdutyConfigs,omitempty"`
-	EmailConfigs     []*AMEmailConfig    `yaml:"emailConfigs,omitempty"`
-	WebhookConfigs   []*AMWebhookConfig  `yaml:"webhookConfigs,omitempty"`
-	OpsGenieConfigs  []*AMOpsGenieConfig `yaml:"opsgenieConfigs,omitempty"`
+	Name              string                `yaml:"name"`
+	SlackConfigs      []*AMSlackConfig      `yaml:"slackConfigs,omitempty"`
+	PagerDutyConfigs  []*AMPagerDutyConfig  `yaml:"pagerdutyConfigs,omitempty"`
+	EmailConfigs      []*AMEmailConfig      `yaml:"emailConfigs,omitempty"`
+	WebhookConfigs    []*AMWebhookConfig    `yaml:"webhookConfigs,omitempty"`
+	OpsGenieConfigs   []*AMOpsGenieConfig   `yaml:"opsgenieConfigs,omitempty"`
+	MSTeamsConfigs    []*AMMSTeamsConfig    `yaml:"msteamsConfigs,omitempty"`
+	MSTeamsV2Configs  []*AMMSTeamsV2Config  `yaml:"msteamsv2Configs,omitempty"`
+	TelegramConfigs   []*AMTelegramConfig   `yaml:"telegramConfigs,omitempty"`
+	DiscordConfigs    []*AMDiscordConfig    `yaml:"discordConfigs,omitempty"`
+	SNSConfigs        []*AMSNSConfig        `yaml:"snsConfigs,omitempty"`
+	WebexConfigs      []*AMWebexConfig      `yaml:"webexConfigs,omitempty"`
+	VictorOpsConfigs  []*AMVictorOpsConfig  `yaml:"victoropsConfigs,omitempty"`
+	PushoverConfigs   []*AMPushoverConfig   `yaml:"pushoverConfigs,omitempty"`
+	WeChatConfigs     []*AMWeChatConfig     `yaml:"wechatConfigs,omitempty"`
+	JiraConfigs       []*AMJiraConfig       `yaml:"jiraConfigs,omitempty"`
+	RocketChatConfigs []*AMRocketChatConfig `yaml:"rocketchatConfigs,omitempty"`
 }
 
 // AMSlackConfig configures Slack notifications for AlertmanagerConfig.
@@ -134,6 +145,188 @@ type AMOpsGenieConfig struct {
 	HTTPConfig   *AMHTTPConfig      `yaml:"httpConfig,omitempty"`
 }
 
+// AMMSTeamsConfig configures Microsoft Teams notifications for AlertmanagerConfig.
+type AMMSTeamsConfig struct {
+	SendResolved *bool             `yaml:"sendResolved,omitempty"`
+	WebhookURL   SecretKeySelector `yaml:"webhookUrl"`
+	Title        string            `yaml:"title,omitempty"`
+	Summary      string            `yaml:"summary,omitempty"`
+	Text         string            `yaml:"text,omitempty"`
+	HTTPConfig   *AMHTTPConfig     `yaml:"httpConfig,omitempty"`
+}
+
+// AMMSTeamsV2Config configures Microsoft Teams (Power Automate) notifications for AlertmanagerConfig.
+type AMMSTeamsV2Config struct {
+	SendResolved *bool              `yaml:"sendResolved,omitempty"`
+	WebhookURL   *SecretKeySelector `yaml:"webhookURL,omitempty"`
+	Title        string             `yaml:"title,omitempty"`
+	Text         string             `yaml:"text,omitempty"`
+	HTTPConfig   *AMHTTPConfig      `yaml:"httpConfig,omitempty"`
+}
+
+// AMTelegramConfig configures Telegram notifications for AlertmanagerConfig.
+type AMTelegramConfig struct {
+	SendResolved         *bool              `yaml:"sendResolved,omitempty"`
+	APIURL               string             `yaml:"apiURL,omitempty"`
+	BotToken             *SecretKeySelector `yaml:"botToken,omitempty"`
+	BotTokenFile         string             `yaml:"botTokenFile,omitempty"`
+	ChatID               int64              `yaml:"chatID"`
+	MessageThreadID      int                `yaml:"messageThreadID,omitempty"`
+	Message              string             `yaml:"message,omitempty"`
+	DisableNotifications *bool              `yaml:"disableNotifications,omitempty"`
+	ParseMode            string             `yaml:"parseMode,omitempty"`
+	HTTPConfig           *AMHTTPConfig      `yaml:"httpConfig,omitempty"`
+}
+
+// AMDiscordConfig configures Discord notifications for AlertmanagerConfig.
+type AMDiscordConfig struct {
+	SendResolved *bool             `yaml:"sendResolved,omitempty"`
+	APIURL       SecretKeySelector `yaml:"apiURL"`
+	Title        string            `yaml:"title,omitempty"`
+	Message      string            `yaml:"message,omitempty"`
+	Content      string            `yaml:"content,omitempty"`
+	Username     string            `yaml:"username,omitempty"`
+	AvatarURL    string            `yaml:"avatarURL,omitempty"`
+	HTTPConfig   *AMHTTPConfig     `yaml:"httpConfig,omitempty"`
+}
+
+// AMSNSConfig configures AWS SNS notifications for AlertmanagerConfig.
+type AMSNSConfig struct {
+	SendResolved *bool             `yaml:"sendResolved,omitempty"`
+	APIURL       string            `yaml:"apiURL,omitempty"`
+	SigV4        *AMSigV4          `yaml:"sigv4,omitempty"`
+	TopicARN     string            `yaml:"topicARN,omitempty"`
+	PhoneNumber  string            `yaml:"phoneNumber,omitempty"`
+	TargetARN    string            `yaml:"targetARN,omitempty"`
+	Subject      string            `yaml:"subject,omitempty"`
+	Message      string            `yaml:"message,omitempty"`
+	Attributes   map[string]string `yaml:"attributes,omitempty"`
+	HTTPConfig   *AMHTTPConfig     `yaml:"httpConfig,omitempty"`
+}
+
+// AMSigV4 configures AWS Signature Version 4 signing.
+type AMSigV4 struct {
+	Region    string             `yaml:"region,omitempty"`
+	AccessKey *SecretKeySelector `yaml:"accessKey,omitempty"`
+	SecretKey *SecretKeySelector `yaml:"secretKey,omitempty"`
+	Profile   string             `yaml:"profile,omitempty"`
+	RoleArn   string             `yaml:"roleArn,omitempty"`
+}
+
+// AMWebexConfig configures Webex notifications for AlertmanagerConfig.
+type AMWebexConfig struct {
+	SendResolved *bool         `yaml:"sendResolved,omitempty"`
+	APIURL       string        `yaml:"apiURL,omitempty"`
+	RoomID       string        `yaml:"roomID"`
+	Message      string        `yaml:"message,omitempty"`
+	HTTPConfig   *AMHTTPConfig `yaml:"httpConfig,omitempty"`
+}
+
+// AMVictorOpsConfig configures VictorOps notifications for AlertmanagerConfig.
+type AMVictorOpsConfig struct {
+	SendResolved      *bool              `yaml:"sendResolved,omitempty"`
+	APIKey            *SecretKeySelector `yaml:"apiKey,omitempty"`
+	APIURL            string             `yaml:"apiUrl,omitempty"`
+	RoutingKey        string             `yaml:"routingKey"`
+	MessageType       string             `yaml:"messageType,omitempty"`
+	EntityDisplayName string             `yaml:"entityDisplayName,omitempty"`
+	StateMessage      string             `yaml:"stateMessage,omitempty"`
+	MonitoringTool    string             `yaml:"monitoringTool,omitempty"`
+	CustomFields      []AMKeyValue       `yaml:"customFields,omitempty"`
+	HTTPConfig        *AMHTTPConfig      `yaml:"httpConfig,omitempty"`
+}
+
+// AMPushoverConfig configures Pushover notifications for AlertmanagerConfig.
+type AMPushoverConfig struct {
+	SendResolved *bool              `yaml:"sendResolved,omitempty"`
+	UserKey      *SecretKeySelector `yaml:"userKey,omitempty"`
+	UserKeyFile  string             `yaml:"userKeyFile,omitempty"`
+	Token        *SecretKeySelector `yaml:"token,omitempty"`
+	TokenFile    string             `yaml:"tokenFile,omitempty"`
+	Title        string             `yaml:"title,omitempty"`
+	Message      string             `yaml:"message,omitempty"`
+	URL          string             `yaml:"url,omitempty"`
+	URLTitle     string             `yaml:"urlTitle,omitempty"`
+	Device       string             `yaml:"device,omitempty"`
+	Sound        string             `yaml:"sound,omitempty"`
+	Priority     string             `yaml:"priority,omitempty"`
+	Retry        string             `yaml:"retry,omitempty"`
+	Expire       string             `yaml:"expire,omitempty"`
+	TTL          string             `yaml:"ttl,omitempty"`
+	HTML         bool               `yaml:"html,omitempty"`
+	HTTPConfig   *AMHTTPConfig      `yaml:"httpConfig,omitempty"`
+}
+
+// AMWeChatConfig configures WeChat notifications for AlertmanagerConfig.
+type AMWeChatConfig struct {
+	SendResolved *bool              `yaml:"sendResolved,omitempty"`
+	APISecret    *SecretKeySelector `yaml:"apiSecret,omitempty"`
+	APIURL       string             `yaml:"apiURL,omitempty"`
+	CorpID       string             `yaml:"corpID,omitempty"`
+	AgentID      string             `yaml:"agentID,omitempty"`
+	ToUser       string             `yaml:"toUser,omitempty"`
+	ToParty      string             `yaml:"toParty,omitempty"`
+	ToTag        string             `yaml:"toTag,omitempty"`
+	Message      string             `yaml:"message,omitempty"`
+	MessageType  string             `yaml:"messageType,omitempty"`
+	HTTPConfig   *AMHTTPConfig      `yaml:"httpConfig,omitempty"`
+}
+
+// AMJiraConfig configures Jira notifications for AlertmanagerConfig.
+type AMJiraConfig struct {
+	SendResolved      *bool          `yaml:"sendResolved,omitempty"`
+	APIURL            string         `yaml:"apiURL,omitempty"`
+	Project           string         `yaml:"project"`
+	IssueType         string         `yaml:"issueType"`
+	Summary           string         `yaml:"summary,omitempty"`
+	Description       string         `yaml:"description,omitempty"`
+	Labels            []string       `yaml:"labels,omitempty"`
+	Priority          string         `yaml:"priority,omitempty"`
+	ReopenTransition  string         `yaml:"reopenTransition,omitempty"`
+	ResolveTransition string         `yaml:"resolveTransition,omitempty"`
+	WontFixResolution string         `yaml:"wontFixResolution,omitempty"`
+	ReopenDuration    string         `yaml:"reopenDuration,omitempty"`
+	Fields            map[string]any `yaml:"fields,omitempty"`
+	HTTPConfig        *AMHTTPConfig  `yaml:"httpConfig,omitempty"`
+}
+
+// AMRocketChatConfig configures Rocket.Chat notifications for AlertmanagerConfig.
+type AMRocketChatConfig struct {
+	SendResolved *bool                `yaml:"sendResolved,omitempty"`
+	APIURL       string               `yaml:"apiURL,omitempty"`
+	Channel      string               `yaml:"channel,omitempty"`
+	Token        *SecretKeySelector   `yaml:"token,omitempty"`
+	TokenID      *SecretKeySelector   `yaml:"tokenID,omitempty"`
+	Color        string               `yaml:"color,omitempty"`
+	Emoji        string               `yaml:"emoji,omitempty"`
+	IconURL      string               `yaml:"iconURL,omitempty"`
+	Text         string               `yaml:"text,omitempty"`
+	Title        string               `yaml:"title,omitempty"`
+	TitleLink    string               `yaml:"titleLink,omitempty"`
+	Fields       []AMRocketChatField  `yaml:"fields,omitempty"`
+	ShortFields  bool                 `yaml:"shortFields,omitempty"`
+	ImageURL     string               `yaml:"imageURL,omitempty"`
+	ThumbURL     string               `yaml:"thumbURL,omitempty"`
+	LinkNames    bool                 `yaml:"linkNames,omitempty"`
+	Actions      []AMRocketChatAction `yaml:"actions,omitempty"`
+	HTTPConfig   *AMHTTPConfig        `yaml:"httpConfig,omitempty"`
+}
+
+// AMRocketChatField represents a field in a Rocket.Chat message.
+type AMRocketChatField struct {
+	Title string `yaml:"title,omitempty"`
+	Value string `yaml:"value,omitempty"`
+	Short *bool  `yaml:"short,omitempty"`
+}
+
+// AMRocketChatAction represents a button in a Rocket.Chat message.
+type AMRocketChatAction struct {
+	Type string `yaml:"type,omitempty"`
+	Text string `yaml:"text,omitempty"`
+	URL  string `yaml:"url,omitempty"`
+	Msg  string `yaml:"msg,omitempty"`
+}
+
 // AMOpsGenieResponder represents an OpsGenie responder.
 type AMOpsGenieResponder struct {
 	ID       string `yaml:"id,omitempty"`
@@ -150,10 +343,29 @@ type AMKeyValue struct {
 
 // AMHTTPConfig configures HTTP client settings.
 type AMHTTPConfig struct {
-	BasicAuth       *AMBasicAuth       `yaml:"basicAuth,omitempty"`
+	Authorization     *AMAuthorization   `yaml:"authorization,omitempty"`
+	BasicAuth         *AMBasicAuth       `yaml:"basicAuth,omitempty"`
+	OAuth2            *AMOAuth2          `yaml:"oauth2,omitempty"`
 	BearerTokenSecret *SecretKeySelector `yaml:"bearerTokenSecret,omitempty"`
-	TLSConfig       *AMTLSConfig       `yaml:"tlsConfig,omitempty"`
-	ProxyURL        string             `yaml:"proxyURL,omitempty"`
+	TLSConfig         *AMTLSConfig       `yaml:"tlsConfig,omitempty"`
+	ProxyURL          string             `yaml:"proxyURL,omitempty"`
+	FollowRedirects   *bool              `yaml:"followRedirects,omitempty"`
+	EnableHTTP2       *bool              `yaml:"enableHttp2,omitempty"`
+}
+
+// AMAuthorization configures the HTTP Authorization header.
+type AMAuthorization struct {
+	Type        string             `yaml:"type,omitempty"`
+	Credentials *SecretKeySelector `yaml:"credentials,omitempty"`
+}
+
+// AMOAuth2 configures OAuth 2.0 client credentials authentication.
+type AMOAuth2 struct {
+	ClientID       SecretOrConfigMap `yaml:"clientId"`
+	ClientSecret   SecretKeySelector `yaml:"clientSecret"`
+	TokenURL       string            `yaml:"tokenUrl"`
+	Scopes         []string          `yaml:"scopes,omitempty"`
+	EndpointParams map[string]string `yaml:"endpointParams,omitempty"`
 }
 
 // AMBasicAuth configures basic HTTP authentication.
@@ -417,6 +629,72 @@ func (r *AMReceiver) WithOpsGenieConfig(config *AMOpsGenieConfig) *AMReceiver {
 	return r
 }
 
+// WithMSTeamsConfig adds a Microsoft Teams configuration.
+func (r *AMReceiver) WithMSTeamsConfig(config *AMMSTeamsConfig) *AMReceiver {
+	r.MSTeamsConfigs = append(r.MSTeamsConfigs, config)
+	return r
+}
+
+// WithMSTeamsV2Config adds a Microsoft Teams (Power Automate) configuration.
+func (r *AMReceiver) WithMSTeamsV2Config(config *AMMSTeamsV2Config) *AMReceiver {
+	r.MSTeamsV2Configs = append(r.MSTeamsV2Configs, config)
+	return r
+}
+
+// WithTelegramConfig adds a Telegram configuration.
+func (r *AMReceiver) WithTelegramConfig(config *AMTelegramConfig) *AMReceiver {
+	r.TelegramConfigs = append(r.TelegramConfigs, config)
+	return r
+}
+
+// WithDiscordConfig adds a Discord configuration.
+func (r *AMReceiver) WithDiscordConfig(config *AMDiscordConfig) *AMReceiver {
+	r.DiscordConfigs = append(r.DiscordConfigs, config)
+	return r
+}
+
+// WithSNSConfig adds a SNS configuration.
+func (r *AMReceiver) WithSNSConfig(config *AMSNSConfig) *AMReceiver {
+	r.SNSConfigs = append(r.SNSConfigs, config)
+	return r
+}
+
+// WithWebexConfig adds a Webex configuration.
+func (r *AMReceiver) WithWebexConfig(config *AMWebexConfig) *AMReceiver {
+	r.WebexConfigs = append(r.WebexConfigs, config)
+	return r
+}
+
+// WithVictorOpsConfig adds a VictorOps configuration.
+func (r *AMReceiver) WithVictorOpsConfig(config *AMVictorOpsConfig) *AMReceiver {
+	r.VictorOpsConfigs = append(r.VictorOpsConfigs, config)
+	return r
+}
+
+// WithPushoverConfig adds a Pushover configuration.
+func (r *AMReceiver) WithPushoverConfig(config *AMPushoverConfig) *AMReceiver {
+	r.PushoverConfigs = append(r.PushoverConfigs, config)
+	return r
+}
+
+// WithWeChatConfig adds a WeChat configuration.
+func (r *AMReceiver) WithWeChatConfig(config *AMWeChatConfig) *AMReceiver {
+	r.WeChatConfigs = append(r.WeChatConfigs, config)
+	return r
+}
+
+// WithJiraConfig adds a Jira configuration.
+func (r *AMReceiver) WithJiraConfig(config *AMJiraConfig) *AMReceiver {
+	r.JiraConfigs = append(r.JiraConfigs, config)
+	return r
+}
+
+// WithRocketChatConfig adds a Rocket.Chat configuration.
+func (r *AMReceiver) WithRocketChatConfig(config *AMRocketChatConfig) *AMReceiver {
+	r.RocketChatConfigs = append(r.RocketChatConfigs, config)
+	return r
+}
+
 // Slack config helpers
 
 // NewAMSlackConfig creates a new AMSlackConfig.
@@ -620,6 +898,278 @@ func (o *AMOpsGenieConfig) WithSendResolved(send bool) *AMOpsGenieConfig {
 	return o
 }
 
+// MS Teams config helpers
+
+// NewAMMSTeamsConfig creates a new AMMSTeamsConfig with the webhook URL from a Kubernetes secret.
+func NewAMMSTeamsConfig(name, key string) *AMMSTeamsConfig {
+	return &AMMSTeamsConfig{WebhookURL: SecretKeySelector{Name: name, Key: key}}
+}
+
+// WithTitle sets the message title.
+func (m *AMMSTeamsConfig) WithTitle(title string) *AMMSTeamsConfig {
+	m.Title = title
+	return m
+}
+
+// WithText sets the message text.
+func (m *AMMSTeamsConfig) WithText(text string) *AMMSTeamsConfig {
+	m.Text = text
+	return m
+}
+
+// WithSendResolved sets whether to send resolved alerts.
+func (m *AMMSTeamsConfig) WithSendResolved(send bool) *AMMSTeamsConfig {
+	m.SendResolved = &send
+	return m
+}
+
+// NewAMMSTeamsV2Config creates a new AMMSTeamsV2Config.
+func NewAMMSTeamsV2Config() *AMMSTeamsV2Config {
+	return &AMMSTeamsV2Config{}
+}
+
+// WithWebhookURLSecret sets the webhook URL from a Kubernetes secret.
+func (m *AMMSTeamsV2Config) WithWebhookURLSecret(name, key string) *AMMSTeamsV2Config {
+	m.WebhookURL = &SecretKeySelector{Name: name, Key: key}
+	return m
+}
+
+// WithText sets the message text.
+func (m *AMMSTeamsV2Config) WithText(text string) *AMMSTeamsV2Config {
+	m.Text = text
+	return m
+}
+
+// Telegram config helpers
+
+// NewAMTelegramConfig creates a new AMTelegramConfig for the given chat.
+func NewAMTelegramConfig(chatID int64) *AMTelegramConfig {
+	return &AMTelegramConfig{ChatID: chatID}
+}
+
+// WithBotTokenSecret sets the bot token from a Kubernetes secret.
+func (t *AMTelegramConfig) WithBotTokenSecret(name, key string) *AMTelegramConfig {
+	t.BotToken = &SecretKeySelector{Name: name, Key: key}
+	return t
+}
+
+// WithMessage sets the message template.
+func (t *AMTelegramConfig) WithMessage(message string) *AMTelegramConfig {
+	t.Message = message
+	return t
+}
+
+// WithParseMode sets the message parse mode.
+func (t *AMTelegramConfig) WithParseMode(mode string) *AMTelegramConfig {
+	t.ParseMode = mode
+	return t
+}
+
+// Discord config helpers
+
+// NewAMDiscordConfig creates a new AMDiscordConfig with the webhook URL from a Kubernetes secret.
+func NewAMDiscordConfig(name, key string) *AMDiscordConfig {
+	return &AMDiscordConfig{APIURL: SecretKeySelector{Name: name, Key: key}}
+}
+
+// WithTitle sets the message title.
+func (d *AMDiscordConfig) WithTitle(title string) *AMDiscordConfig {
+	d.Title = title
+	return d
+}
+
+// WithMessage sets the message body.
+func (d *AMDiscordConfig) WithMessage(message string) *AMDiscordConfig {
+	d.Message = message
+	return d
+}
+
+// SNS config helpers
+
+// NewAMSNSConfig creates a new AMSNSConfig.
+func NewAMSNSConfig() *AMSNSConfig {
+	return &AMSNSConfig{}
+}
+
+// WithTopicARN sets the SNS topic ARN.
+func (s *AMSNSConfig) WithTopicARN(arn string) *AMSNSConfig {
+	s.TopicARN = arn
+	return s
+}
+
+// WithSigV4 sets AWS signing configuration.
+func (s *AMSNSConfig) WithSigV4(sigv4 *AMSigV4) *AMSNSConfig {
+	s.SigV4 = sigv4
+	return s
+}
+
+// WithSubject sets the message subject.
+func (s *AMSNSConfig) WithSubject(subject string) *AMSNSConfig {
+	s.Subject = subject
+	return s
+}
+
+// Webex config helpers
+
+// NewAMWebexConfig creates a new AMWebexConfig for the given room.
+func NewAMWebexConfig(roomID string) *AMWebexConfig {
+	return &AMWebexConfig{RoomID: roomID}
+}
+
+// WithMessage sets the message template.
+func (w *AMWebexConfig) WithMessage(message string) *AMWebexConfig {
+	w.Message = message
+	return w
+}
+
+// WithHTTPConfig sets HTTP client configuration.
+func (w *AMWebexConfig) WithHTTPConfig(config *AMHTTPConfig) *AMWebexConfig {
+	w.HTTPConfig = config
+	return w
+}
+
+// VictorOps config helpers
+
+// NewAMVictorOpsConfig creates a new AMVictorOpsConfig with the given routing key.
+func NewAMVictorOpsConfig(routingKey string) *AMVictorOpsConfig {
+	return &AMVictorOpsConfig{RoutingKey: routingKey}
+}
+
+// WithAPIKeySecret sets the API key from a Kubernetes secret.
+func (v *AMVictorOpsConfig) WithAPIKeySecret(name, key string) *AMVictorOpsConfig {
+	v.APIKey = &SecretKeySelector{Name: name, Key: key}
+	return v
+}
+
+// WithMessageType sets the message type.
+func (v *AMVictorOpsConfig) WithMessageType(messageType string) *AMVictorOpsConfig {
+	v.MessageType = messageType
+	return v
+}
+
+// Pushover config helpers
+
+// NewAMPushoverConfig creates a new AMPushoverConfig.
+func NewAMPushoverConfig() *AMPushoverConfig {
+	return &AMPushoverConfig{}
+}
+
+// WithUserKeySecret sets the user key from a Kubernetes secret.
+func (p *AMPushoverConfig) WithUserKeySecret(name, key string) *AMPushoverConfig {
+	p.UserKey = &SecretKeySelector{Name: name, Key: key}
+	return p
+}
+
+// WithTokenSecret sets the application token from a Kubernetes secret.
+func (p *AMPushoverConfig) WithTokenSecret(name, key string) *AMPushoverConfig {
+	p.Token = &SecretKeySelector{Name: name, Key: key}
+	return p
+}
+
+// WithPriority sets the notification priority.
+func (p *AMPushoverConfig) WithPriority(priority string) *AMPushoverConfig {
+	p.Priority = priority
+	return p
+}
+
+// WeChat config helpers
+
+// NewAMWeChatConfig creates a new AMWeChatConfig.
+func NewAMWeChatConfig() *AMWeChatConfig {
+	return &AMWeChatConfig{}
+}
+
+// WithAPISecret sets the API secret from a Kubernetes secret.
+func (w *AMWeChatConfig) WithAPISecret(name, key string) *AMWeChatConfig {
+	w.APISecret = &SecretKeySelector{Name: name, Key: key}
+	return w
+}
+
+// WithCorpID sets the corporate ID.
+func (w *AMWeChatConfig) WithCorpID(corpID string) *AMWeChatConfig {
+	w.CorpID = corpID
+	return w
+}
+
+// WithToParty sets the department IDs to notify.
+func (w *AMWeChatConfig) WithToParty(parties string) *AMWeChatConfig {
+	w.ToParty = parties
+	return w
+}
+
+// Jira config helpers
+
+// NewAMJiraConfig creates a new AMJiraConfig for the given project and issue type.
+func NewAMJiraConfig(project, issueType string) *AMJiraConfig {
+	return &AMJiraConfig{Project: project, IssueType: issueType}
+}
+
+// WithAPIURL sets the Jira API URL.
+func (j *AMJiraConfig) WithAPIURL(url string) *AMJiraConfig {
+	j.APIURL = url
+	return j
+}
+
+// WithLabels sets the issue labels.
+func (j *AMJiraConfig) WithLabels(labels ...string) *AMJiraConfig {
+	j.Labels = labels
+	return j
+}
+
+// Rocket.Chat config helpers
+
+// NewAMRocketChatConfig creates a new AMRocketChatConfig.
+func NewAMRocketChatConfig() *AMRocketChatConfig {
+	return &AMRocketChatConfig{}
+}
+
+// WithChannel sets the channel to send messages to.
+func (r *AMRocketChatConfig) WithChannel(channel string) *AMRocketChatConfig {
+	r.Channel = channel
+	return r
+}
+
+// WithTokenSecrets sets the token user ID and access token from Kubernetes secrets.
+func (r *AMRocketChatConfig) WithTokenSecrets(secretName, tokenIDKey, tokenKey string) *AMRocketChatConfig {
+	r.TokenID = &SecretKeySelector{Name: secretName, Key: tokenIDKey}
+	r.Token = &SecretKeySelector{Name: secretName, Key: tokenKey}
+	return r
+}
+
+// HTTP config helpers
+
+// NewAMHTTPConfig creates a new AMHTTPConfig.
+func NewAMHTTPConfig() *AMHTTPConfig {
+	return &AMHTTPConfig{}
+}
+
+// WithAuthorizationSecret sets Bearer credentials from a Kubernetes secret.
+func (h *AMHTTPConfig) WithAuthorizationSecret(name, key string) *AMHTTPConfig {
+	h.Authorization = &AMAuthorization{
+		Type:        "Bearer",
+		Credentials: &SecretKeySelector{Name: name, Key: key},
+	}
+	return h
+}
+
+// WithOAuth2 sets OAuth 2.0 authentication.
+func (h *AMHTTPConfig) WithOAuth2(oauth2 *AMOAuth2) *AMHTTPConfig {
+	h.OAuth2 = oauth2
+	return h
+}
+
+// WithFollowRedirects sets whether HTTP redirects are followed.
+func (h *AMHTTPConfig) WithFollowRedirects(follow bool) *AMHTTPConfig {
+	h.FollowRedirects = &follow
+	return h
+}
+
+// WithEnableHTTP2 sets whether HTTP/2 is enabled.
+func (h *AMHTTPConfig) WithEnableHTTP2(enable bool) *AMHTTPConfig {
+	h.EnableHTTP2 = &enable
+	return h
+}
+
 // Inhibit rule helpers
 
 // NewAMInhibitRule creates a new AMInhibitRule.
@@ -146,6 +146,90 @@ func TestAlertmanagerConfig_AddReceiver(t *testing.T) {
 	}
 }
 
+func TestAlertmanagerConfig_ChatReceivers(t *testing.T) {
+	receiver := NewAMReceiver("chat").
+		WithMSTeamsConfig(NewAMMSTeamsConfig("alertmanager-teams", "webhook-url").
+			WithTitle("{{ .CommonLabels.alertname }}")).
+		WithMSTeamsV2Config(NewAMMSTeamsV2Config().
+			WithWebhookURLSecret("alertmanager-teams", "workflow-url")).
+		WithTelegramConfig(NewAMTelegramConfig(-100123).
+			WithBotTokenSecret("alertmanager-telegram", "token")).
+		WithDiscordConfig(NewAMDiscordConfig("alertmanager-discord", "webhook-url")).
+		WithWebexConfig(NewAMWebexConfig("room-1")).
+		WithWeChatConfig(NewAMWeChatConfig().WithCorpID("corp")).
+		WithRocketChatConfig(NewAMRocketChatConfig().
+			WithChannel("#alerts").
+			WithTokenSecrets("alertmanager-rocketchat", "token-id", "token"))
+
+	if receiver.MSTeamsConfigs[0].WebhookURL.Name != "alertmanager-teams" {
+		t.Errorf("MSTeams WebhookURL = %+v", receiver.MSTeamsConfigs[0].WebhookURL)
+	}
+	if receiver.TelegramConfigs[0].ChatID != -100123 {
+		t.Errorf("ChatID = %d", receiver.TelegramConfigs[0].ChatID)
+	}
+	if receiver.RocketChatConfigs[0].TokenID.Key != "token-id" {
+		t.Errorf("TokenID = %+v", receiver.RocketChatConfigs[0].TokenID)
+	}
+
+	am := AMConfig("chat-alerts", "monitoring").
+		WithRoute(NewAMRoute("chat")).
+		AddReceiver(receiver)
+
+	yamlStr := string(am.MustSerialize())
+	expectations := []string{
+		"msteamsConfigs:",
+		"webhookUrl:",
+		"msteamsv2Configs:",
+		"telegramConfigs:",
+		"chatID: -100123",
+		"discordConfigs:",
+		"webexConfigs:",
+		"roomID: room-1",
+		"wechatConfigs:",
+		"rocketchatConfigs:",
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("Serialize() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestAlertmanagerConfig_OnCallReceivers(t *testing.T) {
+	receiver := NewAMReceiver("oncall").
+		WithVictorOpsConfig(NewAMVictorOpsConfig("platform").
+			WithAPIKeySecret("alertmanager-victorops", "api-key")).
+		WithPushoverConfig(NewAMPushoverConfig().
+			WithUserKeySecret("alertmanager-pushover", "user-key").
+			WithTokenSecret("alertmanager-pushover", "token").
+			WithPriority("2")).
+		WithSNSConfig(NewAMSNSConfig().
+			WithTopicARN("arn:aws:sns:us-east-1:123456789012:alerts").
+			WithSigV4(&AMSigV4{Region: "us-east-1"})).
+		WithJiraConfig(NewAMJiraConfig("OPS", "Bug").WithLabels("alertmanager"))
+
+	am := AMConfig("oncall-alerts", "monitoring").
+		WithRoute(NewAMRoute("oncall")).
+		AddReceiver(receiver)
+
+	yamlStr := string(am.MustSerialize())
+	expectations := []string{
+		"victoropsConfigs:",
+		"routingKey: platform",
+		"pushoverConfigs:",
+		"userKey:",
+		"snsConfigs:",
+		"topicARN: arn:aws:sns:us-east-1:123456789012:alerts",
+		"jiraConfigs:",
+		"issueType: Bug",
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("Serialize() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
 func TestAlertmanagerConfig_Serialize(t *testing.T) {
 	route := NewAMRoute("slack-critical").
 		WithGroupBy("alertname").
@@ -228,3 +312,28 @@ func TestAlertmanagerConfig_MuteTimeInterval(t *testing.T) {
 		t.Errorf("MuteTimeInterval name = %q", am.Spec.MuteTimeIntervals[0].Name)
 	}
 }
+
+func TestAMHTTPConfig_Authorization(t *testing.T) {
+	receiver := NewAMReceiver("webex").
+		WithWebexConfig(NewAMWebexConfig("room-1").
+			WithHTTPConfig(NewAMHTTPConfig().
+				WithAuthorizationSecret("alertmanager-webex", "bot-token").
+				WithFollowRedirects(false)))
+
+	am := AMConfig("webex-alerts", "monitoring").
+		WithRoute(NewAMRoute("webex")).
+		AddReceiver(receiver)
+
+	yamlStr := string(am.MustSerialize())
+	expectations := []string{
+		"authorization:",
+		"type: Bearer",
+		"key: bot-token",
+		"followRedirects: false",
+	}
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("Serialize() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package operator
+
+import "gopkg.in/yaml.v3"
+
+// Probe represents a Prometheus Operator Probe CRD, which probes static
+// targets or ingresses through a prober such as blackbox_exporter.
+type Probe struct {
+	APIVersion string     `yaml:"apiVersion"`
+	Kind       string     `yaml:"kind"`
+	Metadata   ObjectMeta `yaml:"metadata"`
+	Spec       ProbeSpec  `yaml:"spec"`
+
+	// Convenience fields (not serialized)
+	Name      string            `yaml:"-"`
+	Namespace string            `yaml:"-"`
+	Labels    map[string]string `yaml:"-"`
+}
+
+// ProbeSpec contains the Probe specification.
+type ProbeSpec struct {
+	// JobName is the value of the job label.
+	JobName string `yaml:"jobName,omitempty"`
+
+	// Prober is the prober endpoint targets are probed through.
+	Prober *ProberSpec `yaml:"prober,omitempty"`
+
+	// Module is the prober module to use.
+	Module string `yaml:"module,omitempty"`
+
+	// Targets are the targets to probe.
+	Targets ProbeTargets `yaml:"targets"`
+
+	// Interval is the probe interval.
+	Interval string `yaml:"interval,omitempty"`
+
+	// ScrapeTimeout is the timeout for the probe.
+	ScrapeTimeout string `yaml:"scrapeTimeout,omitempty"`
+
+	// TLSConfig contains TLS configuration for the prober endpoint.
+	TLSConfig *TLSConfig `yaml:"tlsConfig,omitempty"`
+
+	// BearerTokenSecret references a secret containing the bearer token.
+	BearerTokenSecret *SecretKeySelector `yaml:"bearerTokenSecret,omitempty"`
+
+	// BasicAuth contains basic auth credentials.
+	BasicAuth *BasicAuth `yaml:"basicAuth,omitempty"`
+
+	// MetricRelabelConfigs contains metric relabeling configuration.
+	MetricRelabelConfigs []*RelabelConfig `yaml:"metricRelabelings,omitempty"`
+
+	// SampleLimit is the maximum number of samples per probe.
+	SampleLimit uint64 `yaml:"sampleLimit,omitempty"`
+
+	// TargetLimit is the maximum number of targets.
+	TargetLimit uint64 `yaml:"targetLimit,omitempty"`
+}
+
+// ProberSpec is the endpoint of the prober.
+type ProberSpec struct {
+	// URL is the host:port of the prober.
+	URL string `yaml:"url"`
+
+	// Scheme is the URL scheme (http or https).
+	Scheme string `yaml:"scheme,omitempty"`
+
+	// Path is the HTTP path of the probe endpoint (default: /probe).
+	Path string `yaml:"path,omitempty"`
+
+	// ProxyURL is the proxy used to reach the prober.
+	ProxyURL string `yaml:"proxyUrl,omitempty"`
+}
+
+// ProbeTargets selects the targets to probe.
+type ProbeTargets struct {
+	// StaticConfig lists targets to probe.
+	StaticConfig *ProbeTargetStaticConfig `yaml:"staticConfig,omitempty"`
+
+	// Ingress discovers targets from Ingress objects.
+	Ingress *ProbeTargetIngress `yaml:"ingress,omitempty"`
+}
+
+// ProbeTargetStaticConfig lists targets to probe.
+type ProbeTargetStaticConfig struct {
+	// Targets are the targets to probe.
+	Targets []string `yaml:"static,omitempty"`
+
+	// Labels are added to all metrics of the targets.
+	Labels map[string]string `yaml:"labels,omitempty"`
+
+	// RelabelConfigs contains relabeling configuration applied to the targets.
+	RelabelConfigs []*RelabelConfig `yaml:"relabelingConfigs,omitempty"`
+}
+
+// ProbeTargetIngress discovers targets from Ingress objects.
+type ProbeTargetIngress struct {
+	// Selector selects ingresses to probe.
+	Selector LabelSelector `yaml:"selector,omitempty"`
+
+	// NamespaceSelector selects namespaces to discover ingresses in.
+	NamespaceSelector NamespaceSelector `yaml:"namespaceSelector,omitempty"`
+
+	// RelabelConfigs contains relabeling configuration applied to the targets.
+	RelabelConfigs []*RelabelConfig `yaml:"relabelingConfigs,omitempty"`
+}
+
+// NewProbe creates a new Probe.
+func NewProbe(name, namespace string) *Probe {
+	return &Probe{
+		APIVersion: "monitoring.coreos.com/v1",
+		Kind:       "Probe",
+		Metadata: ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Name:      name,
+		Namespace: namespace,
+	}
+}
+
+// WithLabels sets the Probe labels.
+func (p *Probe) WithLabels(labels map[string]string) *Probe {
+	p.Labels = labels
+	p.Metadata.Labels = labels
+	return p
+}
+
+// AddLabel adds a label to the Probe.
+func (p *Probe) AddLabel(key, value string) *Probe {
+	if p.Labels == nil {
+		p.Labels = make(map[string]string)
+	}
+	p.Labels[key] = value
+	p.Metadata.Labels = p.Labels
+	return p
+}
+
+// WithAnnotations sets the Probe annotations.
+func (p *Probe) WithAnnotations(annotations map[string]string) *Probe {
+	p.Metadata.Annotations = annotations
+	return p
+}
+
+// WithJobName sets the job label value.
+func (p *Probe) WithJobName(jobName string) *Probe {
+	p.Spec.JobName = jobName
+	return p
+}
+
+// WithProber sets the prober endpoint as host:port.
+func (p *Probe) WithProber(url string) *Probe {
+	if p.Spec.Prober == nil {
+		p.Spec.Prober = &ProberSpec{}
+	}
+	p.Spec.Prober.URL = url
+	return p
+}
+
+// WithProberPath sets the HTTP path of the probe endpoint.
+func (p *Probe) WithProberPath(path string) *Probe {
+	if p.Spec.Prober == nil {
+		p.Spec.Prober = &ProberSpec{}
+	}
+	p.Spec.Prober.Path = path
+	return p
+}
+
+// WithModule sets the prober module.
+func (p *Probe) WithModule(module string) *Probe {
+	p.Spec.Module = module
+	return p
+}
+
+// WithStaticTargets sets the targets to probe.
+func (p *Probe) WithStaticTargets(targets ...string) *Probe {
+	if p.Spec.Targets.StaticConfig == nil {
+		p.Spec.Targets.StaticConfig = &ProbeTargetStaticConfig{}
+	}
+	p.Spec.Targets.StaticConfig.Targets = targets
+	return p
+}
+
+// WithTargetLabels sets labels added to all metrics of the static targets.
+func (p *Probe) WithTargetLabels(labels map[string]string) *Probe {
+	if p.Spec.Targets.StaticConfig == nil {
+		p.Spec.Targets.StaticConfig = &ProbeTargetStaticConfig{}
+	}
+	p.Spec.Targets.StaticConfig.Labels = labels
+	return p
+}
+
+// SelectIngresses probes ingresses matching the given labels.
+func (p *Probe) SelectIngresses(labels map[string]string) *Probe {
+	if p.Spec.Targets.Ingress == nil {
+		p.Spec.Targets.Ingress = &ProbeTargetIngress{}
+	}
+	p.Spec.Targets.Ingress.Selector.MatchLabels = labels
+	return p
+}
+
+// WithInterval sets the probe interval.
+func (p *Probe) WithInterval(interval string) *Probe {
+	p.Spec.Interval = interval
+	return p
+}
+
+// WithScrapeTimeout sets the probe timeout.
+func (p *Probe) WithScrapeTimeout(timeout string) *Probe {
+	p.Spec.ScrapeTimeout = timeout
+	return p
+}
+
+// AddMetricRelabeling adds a metric relabeling configuration.
+func (p *Probe) AddMetricRelabeling(r *RelabelConfig) *Probe {
+	p.Spec.MetricRelabelConfigs = append(p.Spec.MetricRelabelConfigs, r)
+	return p
+}
+
+// Serialize converts the Probe to YAML bytes.
+func (p *Probe) Serialize() ([]byte, error) {
+	return yaml.Marshal(p)
+}
+
+// MustSerialize converts the Probe to YAML bytes, panicking on error.
+func (p *Probe) MustSerialize() []byte {
+	data, err := p.Serialize()
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
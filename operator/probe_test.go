@@ -0,0 +1,94 @@
+package operator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewProbe(t *testing.T) {
+	p := NewProbe("api-http", "monitoring")
+	if p.Name != "api-http" {
+		t.Errorf("Name = %q, want api-http", p.Name)
+	}
+	if p.Namespace != "monitoring" {
+		t.Errorf("Namespace = %q, want monitoring", p.Namespace)
+	}
+	if p.Kind != "Probe" {
+		t.Errorf("Kind = %q, want Probe", p.Kind)
+	}
+	if p.APIVersion != "monitoring.coreos.com/v1" {
+		t.Errorf("APIVersion = %q", p.APIVersion)
+	}
+}
+
+func TestProbe_WithLabels(t *testing.T) {
+	p := NewProbe("api", "default").
+		WithLabels(map[string]string{"team": "backend"}).
+		AddLabel("release", "prometheus")
+	if p.Metadata.Labels["team"] != "backend" {
+		t.Errorf("Labels[team] = %q, want backend", p.Metadata.Labels["team"])
+	}
+	if p.Metadata.Labels["release"] != "prometheus" {
+		t.Errorf("Labels[release] = %q, want prometheus", p.Metadata.Labels["release"])
+	}
+}
+
+func TestProbe_StaticTargets(t *testing.T) {
+	p := NewProbe("api", "default").
+		WithStaticTargets("https://a.example.com", "https://b.example.com").
+		WithTargetLabels(map[string]string{"env": "prod"})
+	sc := p.Spec.Targets.StaticConfig
+	if sc == nil || len(sc.Targets) != 2 {
+		t.Fatalf("StaticConfig = %+v, want 2 targets", sc)
+	}
+	if sc.Labels["env"] != "prod" {
+		t.Errorf("Labels[env] = %q, want prod", sc.Labels["env"])
+	}
+}
+
+func TestProbe_SelectIngresses(t *testing.T) {
+	p := NewProbe("ingresses", "default").
+		SelectIngresses(map[string]string{"probe": "true"})
+	if p.Spec.Targets.Ingress == nil || p.Spec.Targets.Ingress.Selector.MatchLabels["probe"] != "true" {
+		t.Error("Ingress selector should contain probe=true")
+	}
+}
+
+func TestProbe_Serialize(t *testing.T) {
+	p := NewProbe("api-http", "monitoring").
+		WithJobName("api-http").
+		WithProber("blackbox-exporter:9115").
+		WithProberPath("/probe").
+		WithModule("http_2xx").
+		WithStaticTargets("https://api.example.com").
+		WithInterval("30s").
+		WithScrapeTimeout("10s").
+		AddMetricRelabeling(DropMetric("probe_dns_.*"))
+
+	data, err := p.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	yaml := string(data)
+
+	for _, want := range []string{
+		"apiVersion: monitoring.coreos.com/v1",
+		"kind: Probe",
+		"jobName: api-http",
+		"prober:",
+		"url: blackbox-exporter:9115",
+		"path: /probe",
+		"module: http_2xx",
+		"targets:",
+		"staticConfig:",
+		"static:",
+		"- https://api.example.com",
+		"interval: 30s",
+		"scrapeTimeout: 10s",
+		"metricRelabelings:",
+	} {
+		if !strings.Contains(yaml, want) {
+			t.Errorf("Serialize() missing %q\nGot:\n%s", want, yaml)
+		}
+	}
+}
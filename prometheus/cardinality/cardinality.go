@@ -0,0 +1,223 @@
+package cardinality
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+// instancePlaceholder is the instance label of sample series, which are
+// scraped from one target but stand for every target of the job.
+const instancePlaceholder = "<target>"
+
+// JobEstimate is the estimated number of series of a scrape job.
+type JobEstimate struct {
+	// Job is the job name.
+	Job string `json:"job"`
+
+	// Targets is the number of targets the estimate assumes.
+	Targets int `json:"targets"`
+
+	// SeriesPerTarget is the number of series each scrape ingests after
+	// metric relabeling.
+	SeriesPerTarget int `json:"series_per_target"`
+
+	// DroppedPerTarget is the number of series per scrape dropped by metric
+	// relabeling.
+	DroppedPerTarget int `json:"dropped_per_target"`
+
+	// Series is the estimated number of series of the job.
+	Series int `json:"series"`
+
+	// MaxLabels is the largest number of labels of a series, including
+	// __name__, job and instance. Unknown for TSDB stats.
+	MaxLabels int `json:"max_labels,omitempty"`
+
+	// Approximate is set when the estimate is based on TSDB stats, which
+	// only allow relabel rules on __name__ and job to be evaluated.
+	Approximate bool `json:"approximate,omitempty"`
+
+	// Problems lists the scrape limits the job exceeds.
+	Problems []string `json:"problems,omitempty"`
+
+	// kept are the series of one scrape kept by metric relabeling.
+	kept []Series
+
+	// metricCounts are the kept series counts by metric name, from TSDB stats.
+	metricCounts map[string]int
+
+	// labelValues are the value counts by label name, from TSDB stats.
+	labelValues map[string]int
+
+	maxNameLength  int
+	maxValueLength int
+}
+
+// EstimateJob estimates the series of a scrape job from the series of one
+// scrape. The job's metric_relabel_configs are applied to every series and
+// the result is scaled to targets targets; if targets is below 1,
+// StaticTargets(sc) is used, or 1 if the job has no static targets.
+func EstimateJob(sc *prometheus.ScrapeConfig, series []Series, targets int) *JobEstimate {
+	e := &JobEstimate{Job: sc.JobName, Targets: targetCount(sc, targets)}
+	seen := make(map[string]bool)
+	for _, s := range series {
+		lbls, keep := prometheus.ApplyRelabel(sc.MetricRelabelConfigs, withTargetLabels(s, sc.JobName))
+		if !keep || lbls["__name__"] == "" {
+			e.DroppedPerTarget++
+			continue
+		}
+		k := Series(lbls).key()
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		e.kept = append(e.kept, lbls)
+		if len(lbls) > e.MaxLabels {
+			e.MaxLabels = len(lbls)
+		}
+		for name, value := range lbls {
+			e.maxNameLength = max(e.maxNameLength, len(name))
+			e.maxValueLength = max(e.maxValueLength, len(value))
+		}
+	}
+	e.SeriesPerTarget = len(e.kept)
+	e.Series = e.SeriesPerTarget * e.Targets
+	e.check(sc)
+	return e
+}
+
+// EstimateJobFromStats estimates the series of a scrape job from a TSDB
+// stats snapshot of a server scraping it. The counts are totals across all
+// targets; targets is only used to derive series per target for the
+// sample_limit check, as in EstimateJob.
+//
+// Metric relabel configs are evaluated on the metric name and job label
+// only, so rules on other labels are not reflected in the estimate.
+func EstimateJobFromStats(sc *prometheus.ScrapeConfig, stats *TSDBStats, targets int) *JobEstimate {
+	e := &JobEstimate{
+		Job:          sc.JobName,
+		Targets:      targetCount(sc, targets),
+		Approximate:  true,
+		metricCounts: make(map[string]int),
+		labelValues:  stats.labelValues(),
+	}
+	dropped := 0
+	for _, stat := range stats.SeriesCountByMetricName {
+		lbls, keep := prometheus.ApplyRelabel(sc.MetricRelabelConfigs, withTargetLabels(Series{"__name__": stat.Name}, sc.JobName))
+		if !keep || lbls["__name__"] == "" {
+			dropped += stat.Value
+			continue
+		}
+		e.metricCounts[lbls["__name__"]] += stat.Value
+		e.Series += stat.Value
+	}
+	e.SeriesPerTarget = ceilDiv(e.Series, e.Targets)
+	e.DroppedPerTarget = ceilDiv(dropped, e.Targets)
+	e.check(sc)
+	return e
+}
+
+// StaticTargets returns the number of static targets of sc.
+func StaticTargets(sc *prometheus.ScrapeConfig) int {
+	n := 0
+	for _, static := range sc.StaticConfigs {
+		if static != nil {
+			n += len(static.Targets)
+		}
+	}
+	return n
+}
+
+// Total returns the estimated number of series of all jobs.
+func Total(jobs []*JobEstimate) int {
+	total := 0
+	for _, j := range jobs {
+		total += j.Series
+	}
+	return total
+}
+
+// TopMetrics returns the n metric names with the most series per target,
+// most first.
+func (e *JobEstimate) TopMetrics(n int) []Stat {
+	counts := make(map[string]int)
+	if e.metricCounts != nil {
+		for name, c := range e.metricCounts {
+			counts[name] = ceilDiv(c, e.Targets)
+		}
+	}
+	for _, s := range e.kept {
+		counts[s["__name__"]]++
+	}
+	stats := make([]Stat, 0, len(counts))
+	for name, c := range counts {
+		stats = append(stats, Stat{Name: name, Value: c})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Value != stats[j].Value {
+			return stats[i].Value > stats[j].Value
+		}
+		return stats[i].Name < stats[j].Name
+	})
+	if n > 0 && len(stats) > n {
+		stats = stats[:n]
+	}
+	return stats
+}
+
+// check records the scrape limits of sc the estimate exceeds.
+func (e *JobEstimate) check(sc *prometheus.ScrapeConfig) {
+	if sc.SampleLimit > 0 && e.SeriesPerTarget > int(sc.SampleLimit) {
+		e.problem("%d series per target exceed sample_limit %d", e.SeriesPerTarget, sc.SampleLimit)
+	}
+	if sc.TargetLimit > 0 && e.Targets > int(sc.TargetLimit) {
+		e.problem("%d targets exceed target_limit %d", e.Targets, sc.TargetLimit)
+	}
+	if sc.LabelLimit > 0 && e.MaxLabels > int(sc.LabelLimit) {
+		e.problem("series with %d labels exceed label_limit %d", e.MaxLabels, sc.LabelLimit)
+	}
+	if sc.LabelNameLengthLimit > 0 && e.maxNameLength > int(sc.LabelNameLengthLimit) {
+		e.problem("label name of length %d exceeds label_name_length_limit %d", e.maxNameLength, sc.LabelNameLengthLimit)
+	}
+	if sc.LabelValueLengthLimit > 0 && e.maxValueLength > int(sc.LabelValueLengthLimit) {
+		e.problem("label value of length %d exceeds label_value_length_limit %d", e.maxValueLength, sc.LabelValueLengthLimit)
+	}
+}
+
+func (e *JobEstimate) problem(format string, args ...any) {
+	e.Problems = append(e.Problems, fmt.Sprintf(format, args...))
+}
+
+// withTargetLabels returns a copy of s with the job and instance labels
+// Prometheus attaches to scraped series.
+func withTargetLabels(s Series, job string) map[string]string {
+	lbls := make(map[string]string, len(s)+2)
+	for k, v := range s {
+		lbls[k] = v
+	}
+	if _, ok := lbls["job"]; !ok {
+		lbls["job"] = job
+	}
+	if _, ok := lbls["instance"]; !ok {
+		lbls["instance"] = instancePlaceholder
+	}
+	return lbls
+}
+
+func targetCount(sc *prometheus.ScrapeConfig, targets int) int {
+	if targets > 0 {
+		return targets
+	}
+	if n := StaticTargets(sc); n > 0 {
+		return n
+	}
+	return 1
+}
+
+func ceilDiv(a, b int) int {
+	if b <= 0 {
+		return a
+	}
+	return (a + b - 1) / b
+}
@@ -0,0 +1,146 @@
+package cardinality
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+func sample(t *testing.T) []Series {
+	t.Helper()
+	series, err := ParseExposition(strings.NewReader(`
+http_requests_total{method="GET",path="/a"} 1
+http_requests_total{method="GET",path="/b"} 1
+http_requests_total{method="POST",path="/a"} 1
+go_goroutines 12
+go_gc_duration_seconds{quantile="0.5"} 0
+go_gc_duration_seconds{quantile="1"} 0
+`))
+	if err != nil {
+		t.Fatalf("ParseExposition() error = %v", err)
+	}
+	return series
+}
+
+func TestEstimateJob(t *testing.T) {
+	sc := prometheus.NewScrapeConfig("api").WithStaticTargets("a:80", "b:80", "c:80")
+	sc.MetricRelabelConfigs = []*prometheus.RelabelConfig{
+		prometheus.DropByLabel("__name__", "go_gc_.*"),
+	}
+
+	e := EstimateJob(sc, sample(t), 0)
+	if e.Targets != 3 {
+		t.Errorf("Targets = %d, want 3", e.Targets)
+	}
+	if e.SeriesPerTarget != 4 {
+		t.Errorf("SeriesPerTarget = %d, want 4", e.SeriesPerTarget)
+	}
+	if e.DroppedPerTarget != 2 {
+		t.Errorf("DroppedPerTarget = %d, want 2", e.DroppedPerTarget)
+	}
+	if e.Series != 12 {
+		t.Errorf("Series = %d, want 12", e.Series)
+	}
+	if e.MaxLabels != 5 {
+		t.Errorf("MaxLabels = %d, want 5", e.MaxLabels)
+	}
+	if len(e.Problems) != 0 {
+		t.Errorf("Problems = %v, want none", e.Problems)
+	}
+
+	top := e.TopMetrics(1)
+	if len(top) != 1 || top[0].Name != "http_requests_total" || top[0].Value != 3 {
+		t.Errorf("TopMetrics(1) = %v, want [{http_requests_total 3}]", top)
+	}
+}
+
+func TestEstimateJob_LabelDropMergesSeries(t *testing.T) {
+	sc := prometheus.NewScrapeConfig("api")
+	sc.MetricRelabelConfigs = []*prometheus.RelabelConfig{prometheus.DropLabels("path")}
+
+	e := EstimateJob(sc, sample(t), 10)
+	if e.SeriesPerTarget != 5 {
+		t.Errorf("SeriesPerTarget = %d, want 5", e.SeriesPerTarget)
+	}
+	if e.Series != 50 {
+		t.Errorf("Series = %d, want 50", e.Series)
+	}
+}
+
+func TestEstimateJob_Limits(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(sc *prometheus.ScrapeConfig)
+		want  []string
+	}{
+		{name: "within limits", setup: func(sc *prometheus.ScrapeConfig) { sc.SampleLimit = 6; sc.LabelLimit = 5 }},
+		{name: "sample limit", setup: func(sc *prometheus.ScrapeConfig) { sc.SampleLimit = 5 }, want: []string{"6 series per target exceed sample_limit 5"}},
+		{name: "label limit", setup: func(sc *prometheus.ScrapeConfig) { sc.LabelLimit = 4 }, want: []string{"series with 5 labels exceed label_limit 4"}},
+		{name: "target limit", setup: func(sc *prometheus.ScrapeConfig) { sc.TargetLimit = 1 }, want: []string{"2 targets exceed target_limit 1"}},
+		{
+			name: "label length limits",
+			setup: func(sc *prometheus.ScrapeConfig) {
+				sc.LabelNameLengthLimit = 6
+				sc.LabelValueLengthLimit = 10
+			},
+			want: []string{
+				"label name of length 8 exceeds label_name_length_limit 6",
+				"label value of length 22 exceeds label_value_length_limit 10",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := prometheus.NewScrapeConfig("api")
+			tt.setup(sc)
+			e := EstimateJob(sc, sample(t), 2)
+			if len(e.Problems) != len(tt.want) {
+				t.Fatalf("Problems = %v, want %v", e.Problems, tt.want)
+			}
+			for i, want := range tt.want {
+				if e.Problems[i] != want {
+					t.Errorf("Problems[%d] = %q, want %q", i, e.Problems[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestEstimateJobFromStats(t *testing.T) {
+	stats := &TSDBStats{
+		SeriesCountByMetricName: []Stat{
+			{Name: "http_requests_total", Value: 300},
+			{Name: "go_gc_duration_seconds", Value: 50},
+		},
+	}
+	sc := prometheus.NewScrapeConfig("api")
+	sc.SampleLimit = 100
+	sc.MetricRelabelConfigs = []*prometheus.RelabelConfig{
+		prometheus.DropByLabel("__name__", "go_gc_.*"),
+	}
+
+	e := EstimateJobFromStats(sc, stats, 2)
+	if !e.Approximate {
+		t.Error("Approximate should be true")
+	}
+	if e.Series != 300 {
+		t.Errorf("Series = %d, want 300", e.Series)
+	}
+	if e.SeriesPerTarget != 150 {
+		t.Errorf("SeriesPerTarget = %d, want 150", e.SeriesPerTarget)
+	}
+	if e.DroppedPerTarget != 25 {
+		t.Errorf("DroppedPerTarget = %d, want 25", e.DroppedPerTarget)
+	}
+	if len(e.Problems) != 1 || !strings.Contains(e.Problems[0], "sample_limit 100") {
+		t.Errorf("Problems = %v, want sample_limit problem", e.Problems)
+	}
+}
+
+func TestTotal(t *testing.T) {
+	jobs := []*JobEstimate{{Series: 10}, {Series: 32}}
+	if got := Total(jobs); got != 42 {
+		t.Errorf("Total() = %d, want 42", got)
+	}
+}
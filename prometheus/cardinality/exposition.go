@@ -0,0 +1,66 @@
+// Package cardinality estimates the number of series scrape configs and
+// recording rules produce, from captured exposition samples or TSDB stats.
+package cardinality
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/internal/exposition"
+)
+
+// Series is the label set of one series, including __name__.
+type Series map[string]string
+
+// key returns a canonical string identifying the series.
+func (s Series) key() string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name)
+		b.WriteByte(0)
+		b.WriteString(s[name])
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+// ParseExposition parses a scrape in the Prometheus text format or the
+// OpenMetrics text format and returns its distinct series. Comments,
+// HELP, TYPE and UNIT lines, sample values, timestamps and exemplars are
+// ignored.
+func ParseExposition(r io.Reader) ([]Series, error) {
+	var series []Series
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		lbls, err := exposition.ParseSeries(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		s := Series(lbls)
+		if k := s.key(); !seen[k] {
+			seen[k] = true
+			series = append(series, s)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
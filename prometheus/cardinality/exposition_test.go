@@ -0,0 +1,57 @@
+package cardinality
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseExposition(t *testing.T) {
+	input := `# HELP http_requests_total Total requests.
+# TYPE http_requests_total counter
+http_requests_total{method="GET",code="200"} 1027 1395066363000
+http_requests_total{method="POST", code="500"} 3
+http_requests_total{method="GET",code="200"} 1028
+up 1
+{"my.metric", path="C:\\dir\"x\""} 1 # {trace_id="abc"} 1
+`
+	series, err := ParseExposition(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseExposition() error = %v", err)
+	}
+	if len(series) != 4 {
+		t.Fatalf("len(series) = %d, want 4", len(series))
+	}
+	if got := series[1]["code"]; got != "500" {
+		t.Errorf("series[1][code] = %q, want 500", got)
+	}
+	if got := series[2]["__name__"]; got != "up" {
+		t.Errorf("series[2][__name__] = %q, want up", got)
+	}
+	if got := series[3]["__name__"]; got != "my.metric" {
+		t.Errorf("series[3][__name__] = %q, want my.metric", got)
+	}
+	if got := series[3]["path"]; got != `C:\dir"x"` {
+		t.Errorf("series[3][path] = %q, want %q", got, `C:\dir"x"`)
+	}
+}
+
+func TestParseExposition_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "missing value", input: "up", want: "line 1: missing value"},
+		{name: "unterminated", input: "# c\nup{job=\"a} 1", want: "line 2: unterminated string"},
+		{name: "missing name", input: `{job="a"} 1`, want: "missing metric name"},
+		{name: "bad separator", input: `up{job="a" instance="b"} 1`, want: "expected ',' or '}'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseExposition(strings.NewReader(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseExposition() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package cardinality
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/lex00/wetwire-observability-go/promql/parser"
+	"github.com/lex00/wetwire-observability-go/rules"
+)
+
+// RuleEstimate is the estimated number of series a recording rule produces.
+type RuleEstimate struct {
+	// Record is the recorded metric name.
+	Record string `json:"record"`
+
+	// Grouping are the by or without labels of the outermost aggregation.
+	Grouping []string `json:"grouping,omitempty"`
+
+	// Without reports whether Grouping is a without clause.
+	Without bool `json:"without,omitempty"`
+
+	// Input is the estimated number of series the expression selects.
+	Input int `json:"input"`
+
+	// Series is the estimated number of series the rule records.
+	Series int `json:"series"`
+
+	// Approximate is set when the estimate is an upper bound: it depends on
+	// TSDB stats or on the output of other recording rules.
+	Approximate bool `json:"approximate,omitempty"`
+}
+
+// EstimateRules estimates the output series of the recording rules of the
+// groups from the series of jobs. Rules are estimated in order, so a rule
+// selecting the output of an earlier rule uses its estimate.
+func EstimateRules(jobs []*JobEstimate, groups ...*rules.RuleGroup) ([]*RuleEstimate, error) {
+	var estimates []*RuleEstimate
+	recorded := make(map[string]*RuleEstimate)
+	for _, r := range rules.RecordingRules(groups...) {
+		e, err := estimateRule(r.Record, r.Expr, jobs, recorded)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", r.Record, err)
+		}
+		estimates = append(estimates, e)
+		recorded[r.Record] = e
+	}
+	return estimates, nil
+}
+
+// EstimateRule estimates the output series of a recording rule from the
+// series of jobs.
+//
+// The output labels are taken from the outermost aggregation: its by labels,
+// or every label but its without labels and __name__. Without aggregation,
+// each selected series is an output series. When the output labels include
+// instance, each job's distinct label combinations are multiplied by its
+// targets.
+func EstimateRule(record, expr string, jobs []*JobEstimate) (*RuleEstimate, error) {
+	return estimateRule(record, expr, jobs, nil)
+}
+
+func estimateRule(record, expr string, jobs []*JobEstimate, recorded map[string]*RuleEstimate) (*RuleEstimate, error) {
+	parsed, err := parser.ParseExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	g := groupingOf(parsed)
+	e := &RuleEstimate{Record: record, Grouping: g.labels, Without: g.without}
+	selectors := parser.VectorSelectors(parsed)
+
+	union := make(map[string]bool)
+	for _, j := range jobs {
+		if j.metricCounts != nil {
+			input, output := g.estimateStats(j, selectors)
+			e.Input += input
+			e.Series += output
+			if input > 0 {
+				e.Approximate = true
+			}
+			continue
+		}
+
+		combos := make(map[string]bool)
+		matched := 0
+		for _, s := range j.kept {
+			if !selectsAny(selectors, s) {
+				continue
+			}
+			matched++
+			combos[g.project(s)] = true
+		}
+		e.Input += matched * j.Targets
+		if g.keepsInstance() {
+			e.Series += len(combos) * j.Targets
+			continue
+		}
+		for k := range combos {
+			union[k] = true
+		}
+	}
+	e.Series += len(union)
+
+	// Selected recording rule outputs are counted as-is, an upper bound for
+	// everything but a full aggregation.
+	for _, vs := range selectors {
+		prev, ok := recorded[vs.MetricName()]
+		if !ok {
+			continue
+		}
+		e.Input += prev.Series
+		e.Approximate = true
+		if g.aggregated && !g.without && len(g.labels) == 0 {
+			e.Series = max(e.Series, min(prev.Series, 1))
+		} else {
+			e.Series += prev.Series
+		}
+	}
+	return e, nil
+}
+
+// grouping describes the output labels of an expression.
+type grouping struct {
+	aggregated bool
+	without    bool
+	labels     []string
+}
+
+// groupingOf returns the grouping of the outermost aggregation in expr. An
+// le label is dropped from the grouping of an aggregation wrapped in
+// histogram_quantile.
+func groupingOf(expr parser.Expr) grouping {
+	var g grouping
+	parser.Inspect(expr, func(node parser.Node, path []parser.Node) bool {
+		if g.aggregated {
+			return false
+		}
+		agg, ok := node.(*parser.AggregateExpr)
+		if !ok {
+			return true
+		}
+		g = grouping{aggregated: true, without: agg.Without, labels: agg.Grouping}
+		if !agg.Without && inHistogramQuantile(path) {
+			g.labels = slices.DeleteFunc(slices.Clone(agg.Grouping), func(l string) bool { return l == "le" })
+		}
+		return false
+	})
+	return g
+}
+
+func inHistogramQuantile(path []parser.Node) bool {
+	for _, n := range path {
+		if call, ok := n.(*parser.Call); ok && call.Func != nil && call.Func.Name == "histogram_quantile" {
+			return true
+		}
+	}
+	return false
+}
+
+// keepsInstance reports whether output series keep the instance label.
+func (g grouping) keepsInstance() bool {
+	if !g.aggregated {
+		return true
+	}
+	return slices.Contains(g.labels, "instance") != g.without
+}
+
+// project returns the key of the output series s is aggregated into.
+func (g grouping) project(s Series) string {
+	if !g.aggregated {
+		return s.key()
+	}
+	out := Series{}
+	for name, value := range s {
+		if name == "__name__" || name == "instance" {
+			continue
+		}
+		if slices.Contains(g.labels, name) != g.without {
+			out[name] = value
+		}
+	}
+	return out.key()
+}
+
+// estimateStats returns the input and output series of a job estimated
+// from TSDB stats. Only metric name matchers are evaluated, and output
+// series are bounded by the product of the value counts of the by labels.
+func (g grouping) estimateStats(j *JobEstimate, selectors []*parser.VectorSelector) (input, output int) {
+	for name, count := range j.metricCounts {
+		if selectsAny(selectors, Series{"__name__": name}, "__name__") {
+			input += count
+		}
+	}
+	if input == 0 || !g.aggregated || g.without || g.keepsInstance() {
+		return input, input
+	}
+	output = 1
+	for _, l := range g.labels {
+		if n, ok := j.labelValues[l]; ok && l != "job" && n > 0 {
+			output *= n
+		}
+		if output >= input {
+			return input, input
+		}
+	}
+	return input, output
+}
+
+// selectsAny reports whether any of the selectors selects s. If only is
+// set, matchers on other labels are ignored.
+func selectsAny(selectors []*parser.VectorSelector, s Series, only ...string) bool {
+	for _, vs := range selectors {
+		if selects(vs, s, only) {
+			return true
+		}
+	}
+	return false
+}
+
+func selects(vs *parser.VectorSelector, s Series, only []string) bool {
+	if vs.Name != "" && s["__name__"] != vs.Name {
+		return false
+	}
+	for _, m := range vs.LabelMatchers {
+		if len(only) > 0 && !slices.Contains(only, m.Name) {
+			continue
+		}
+		if !m.Matches(s[m.Name]) {
+			return false
+		}
+	}
+	return true
+}
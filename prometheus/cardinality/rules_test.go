@@ -0,0 +1,100 @@
+package cardinality
+
+import (
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+	"github.com/lex00/wetwire-observability-go/rules"
+)
+
+func TestEstimateRule(t *testing.T) {
+	api := EstimateJob(prometheus.NewScrapeConfig("api"), sample(t), 3)
+	web := EstimateJob(prometheus.NewScrapeConfig("web"), sample(t), 2)
+	jobs := []*JobEstimate{api, web}
+
+	tests := []struct {
+		name  string
+		expr  string
+		input int
+		want  int
+	}{
+		{name: "by method", expr: `sum by (method) (rate(http_requests_total[5m]))`, input: 15, want: 2},
+		{name: "by job and method", expr: `sum by (job, method) (rate(http_requests_total[5m]))`, input: 15, want: 4},
+		{name: "by instance", expr: `sum by (instance) (rate(http_requests_total[5m]))`, input: 15, want: 5},
+		{name: "without path", expr: `sum without (path) (rate(http_requests_total[5m]))`, input: 15, want: 10},
+		{name: "no aggregation", expr: `rate(http_requests_total{method="GET"}[5m])`, input: 10, want: 10},
+		{name: "total", expr: `sum(rate(http_requests_total[5m]))`, input: 15, want: 1},
+		{name: "no match", expr: `sum by (job) (up)`, input: 0, want: 0},
+		{
+			name:  "histogram quantile",
+			expr:  `histogram_quantile(0.9, sum by (le, job) (rate(go_gc_duration_seconds[5m])))`,
+			input: 10,
+			want:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := EstimateRule("r", tt.expr, jobs)
+			if err != nil {
+				t.Fatalf("EstimateRule() error = %v", err)
+			}
+			if e.Input != tt.input {
+				t.Errorf("Input = %d, want %d", e.Input, tt.input)
+			}
+			if e.Series != tt.want {
+				t.Errorf("Series = %d, want %d", e.Series, tt.want)
+			}
+		})
+	}
+}
+
+func TestEstimateRule_Stats(t *testing.T) {
+	stats := &TSDBStats{
+		SeriesCountByMetricName:    []Stat{{Name: "http_requests_total", Value: 300}},
+		LabelValueCountByLabelName: []Stat{{Name: "method", Value: 4}, {Name: "code", Value: 5}},
+	}
+	jobs := []*JobEstimate{EstimateJobFromStats(prometheus.NewScrapeConfig("api"), stats, 10)}
+
+	e, err := EstimateRule("r", `sum by (job, method, code) (rate(http_requests_total[5m]))`, jobs)
+	if err != nil {
+		t.Fatalf("EstimateRule() error = %v", err)
+	}
+	if !e.Approximate {
+		t.Error("Approximate should be true")
+	}
+	if e.Input != 300 {
+		t.Errorf("Input = %d, want 300", e.Input)
+	}
+	if e.Series != 20 {
+		t.Errorf("Series = %d, want 20", e.Series)
+	}
+}
+
+func TestEstimateRule_InvalidExpr(t *testing.T) {
+	if _, err := EstimateRule("r", "sum by (", nil); err == nil {
+		t.Error("EstimateRule() error = nil, want error")
+	}
+}
+
+func TestEstimateRules(t *testing.T) {
+	jobs := []*JobEstimate{EstimateJob(prometheus.NewScrapeConfig("api"), sample(t), 3)}
+	group := rules.NewRuleGroup("api").WithRules(
+		rules.NewRecordingRule("job_method:http_requests:rate5m").WithExpr(`sum by (job, method) (rate(http_requests_total[5m]))`),
+		rules.NewAlertingRule("HighRate").WithExpr(`job_method:http_requests:rate5m > 100`),
+		rules.NewRecordingRule("job:http_requests:rate5m").WithExpr(`sum by (job) (job_method:http_requests:rate5m)`),
+	)
+
+	got, err := EstimateRules(jobs, group)
+	if err != nil {
+		t.Fatalf("EstimateRules() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("len(EstimateRules()) = %d, want 2", len(got))
+	}
+	if got[0].Series != 2 || got[0].Approximate {
+		t.Errorf("got[0] = %+v, want 2 exact series", got[0])
+	}
+	if got[1].Input != 2 || !got[1].Approximate {
+		t.Errorf("got[1] = %+v, want input 2, approximate", got[1])
+	}
+}
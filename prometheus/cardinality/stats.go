@@ -0,0 +1,64 @@
+package cardinality
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// TSDBStats is a snapshot of the /api/v1/status/tsdb endpoint of a
+// Prometheus server.
+type TSDBStats struct {
+	// HeadStats are the head block statistics.
+	HeadStats HeadStats `json:"headStats"`
+
+	// SeriesCountByMetricName is the number of series per metric name.
+	SeriesCountByMetricName []Stat `json:"seriesCountByMetricName"`
+
+	// LabelValueCountByLabelName is the number of values per label name.
+	LabelValueCountByLabelName []Stat `json:"labelValueCountByLabelName"`
+}
+
+// HeadStats are the head block statistics of a TSDB stats snapshot.
+type HeadStats struct {
+	// NumSeries is the number of series in the head block.
+	NumSeries int `json:"numSeries"`
+}
+
+// Stat is a name and count pair of a TSDB stats snapshot.
+type Stat struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+}
+
+// ParseTSDBStats parses a TSDB stats snapshot, either the full API response
+// or its data object.
+func ParseTSDBStats(data []byte) (*TSDBStats, error) {
+	var resp struct {
+		Status string          `json:"status"`
+		Data   json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("parsing TSDB stats: %w", err)
+	}
+	if resp.Status != "" {
+		if resp.Status != "success" {
+			return nil, fmt.Errorf("TSDB stats response has status %q", resp.Status)
+		}
+		data = resp.Data
+	}
+
+	var stats TSDBStats
+	if err := json.Unmarshal(data, &stats); err != nil {
+		return nil, fmt.Errorf("parsing TSDB stats: %w", err)
+	}
+	return &stats, nil
+}
+
+// labelValues returns the number of values of each label name.
+func (s *TSDBStats) labelValues() map[string]int {
+	counts := make(map[string]int, len(s.LabelValueCountByLabelName))
+	for _, stat := range s.LabelValueCountByLabelName {
+		counts[stat.Name] = stat.Value
+	}
+	return counts
+}
@@ -0,0 +1,44 @@
+package cardinality
+
+import "testing"
+
+func TestParseTSDBStats(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "api response",
+			input: `{"status":"success","data":{"headStats":{"numSeries":30},"seriesCountByMetricName":[{"name":"http_requests_total","value":20}],"labelValueCountByLabelName":[{"name":"method","value":4}]}}`,
+		},
+		{
+			name:  "data object",
+			input: `{"headStats":{"numSeries":30},"seriesCountByMetricName":[{"name":"http_requests_total","value":20}],"labelValueCountByLabelName":[{"name":"method","value":4}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats, err := ParseTSDBStats([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("ParseTSDBStats() error = %v", err)
+			}
+			if stats.HeadStats.NumSeries != 30 {
+				t.Errorf("NumSeries = %d, want 30", stats.HeadStats.NumSeries)
+			}
+			if len(stats.SeriesCountByMetricName) != 1 || stats.SeriesCountByMetricName[0].Value != 20 {
+				t.Errorf("SeriesCountByMetricName = %v, want [{http_requests_total 20}]", stats.SeriesCountByMetricName)
+			}
+			if got := stats.labelValues()["method"]; got != 4 {
+				t.Errorf("labelValues()[method] = %d, want 4", got)
+			}
+		})
+	}
+}
+
+func TestParseTSDBStats_Errors(t *testing.T) {
+	for _, input := range []string{`{"status":"error","error":"bad"}`, `not json`} {
+		if _, err := ParseTSDBStats([]byte(input)); err == nil {
+			t.Errorf("ParseTSDBStats(%s) error = nil, want error", input)
+		}
+	}
+}
@@ -2,13 +2,34 @@ package prometheus
 
 // PrometheusConfig represents the top-level Prometheus configuration.
 // This is the root structure that serializes to prometheus.yml.
+//
+// Set Agent for a Prometheus started with --agent, which only scrapes and
+// remote writes:
+//
+//	var Agent = &prometheus.PrometheusConfig{
+//	    Agent:         true,
+//	    ScrapeConfigs: []*prometheus.ScrapeConfig{NodeScrape},
+//	    RemoteWrite:   []*prometheus.RemoteWriteConfig{MimirRemoteWrite},
+//	}
 type PrometheusConfig struct {
+	// Agent marks the configuration for a Prometheus running in agent mode.
+	// Validate and the build command reject rule_files, alerting and
+	// remote_read, which agent mode does not support. It is not serialized.
+	Agent bool `yaml:"-"`
+
 	// Global configures values that are shared across all configuration contexts.
 	Global *GlobalConfig `yaml:"global,omitempty"`
 
+	// Runtime configures the Go runtime of the Prometheus server.
+	Runtime *RuntimeConfig `yaml:"runtime,omitempty"`
+
 	// ScrapeConfigs defines the scrape configurations for targets.
 	ScrapeConfigs []*ScrapeConfig `yaml:"scrape_configs,omitempty"`
 
+	// ScrapeConfigFiles specifies a list of globs for files containing
+	// additional scrape configurations.
+	ScrapeConfigFiles []string `yaml:"scrape_config_files,omitempty"`
+
 	// RuleFiles specifies a list of globs for rule file paths.
 	RuleFiles []string `yaml:"rule_files,omitempty"`
 
@@ -20,6 +41,15 @@ type PrometheusConfig struct {
 
 	// RemoteRead specifies settings for remote read.
 	RemoteRead []*RemoteReadConfig `yaml:"remote_read,omitempty"`
+
+	// Storage configures the local TSDB and exemplar store.
+	Storage *StorageConfig `yaml:"storage,omitempty"`
+
+	// Tracing configures exporting of Prometheus's own traces.
+	Tracing *TracingConfig `yaml:"tracing,omitempty"`
+
+	// OTLP configures ingestion through the OTLP receiver.
+	OTLP *OTLPConfig `yaml:"otlp,omitempty"`
 }
 
 // GlobalConfig configures values that apply to all other configuration contexts.
@@ -39,12 +69,23 @@ type GlobalConfig struct {
 	// ExternalLabels are labels to add to any time series or alerts
 	// when communicating with external systems.
 	ExternalLabels map[string]string `yaml:"external_labels,omitempty"`
+
+	// QueryLogFile is the file PromQL queries are logged to.
+	QueryLogFile string `yaml:"query_log_file,omitempty"`
+
+	// ScrapeProtocols is the default content negotiation order for scrapes.
+	// Defaults to OpenMetrics text, then Prometheus text.
+	ScrapeProtocols []ScrapeProtocol `yaml:"scrape_protocols,omitempty"`
 }
 
 // AlertingConfig configures alerting and Alertmanager communication.
 type AlertingConfig struct {
 	// Alertmanagers defines Alertmanager instances.
 	Alertmanagers []*AlertmanagerConfig `yaml:"alertmanagers,omitempty"`
+
+	// AlertRelabelConfigs are relabeling rules applied to alerts before they
+	// are sent to Alertmanager.
+	AlertRelabelConfigs []*RelabelConfig `yaml:"alert_relabel_configs,omitempty"`
 }
 
 // AlertmanagerConfig configures an Alertmanager instance for alert delivery.
@@ -63,6 +104,14 @@ type AlertmanagerConfig struct {
 
 	// APIVersion is the Alertmanager API version to use.
 	APIVersion string `yaml:"api_version,omitempty"`
+
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// requests to Alertmanager.
+	HTTPClientConfig `yaml:",inline"`
+
+	// SigV4 signs requests with AWS Signature Version 4, for Amazon Managed
+	// Service for Prometheus alert managers.
+	SigV4 *SigV4Config `yaml:"sigv4,omitempty"`
 }
 
 // RemoteWriteConfig configures remote write for long-term storage.
@@ -82,20 +131,21 @@ type RemoteWriteConfig struct {
 	// WriteRelabelConfigs is the list of relabel configurations for remote write.
 	WriteRelabelConfigs []*RelabelConfig `yaml:"write_relabel_configs,omitempty"`
 
-	// TLSConfig configures TLS settings for the connection.
-	TLSConfig *TLSConfig `yaml:"tls_config,omitempty"`
-
-	// BasicAuth configures basic authentication.
-	BasicAuth *BasicAuth `yaml:"basic_auth,omitempty"`
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// remote write requests.
+	HTTPClientConfig `yaml:",inline"`
 
-	// BearerToken is the bearer token to use for authentication.
-	BearerToken Secret `yaml:"bearer_token,omitempty"`
+	// SigV4 signs requests with AWS Signature Version 4, for Amazon Managed
+	// Service for Prometheus.
+	SigV4 *SigV4Config `yaml:"sigv4,omitempty"`
 
-	// BearerTokenFile is the path to a file containing the bearer token.
-	BearerTokenFile string `yaml:"bearer_token_file,omitempty"`
+	// AzureAD authenticates requests with Azure AD, for Azure Monitor
+	// workspaces.
+	AzureAD *AzureADConfig `yaml:"azuread,omitempty"`
 
-	// ProxyURL is the URL of a proxy to use for requests.
-	ProxyURL string `yaml:"proxy_url,omitempty"`
+	// GoogleIAM authenticates requests with Google Cloud IAM, for Google
+	// Cloud Managed Service for Prometheus.
+	GoogleIAM *GoogleIAMConfig `yaml:"google_iam,omitempty"`
 
 	// QueueConfig configures the remote write queue for batching and buffering.
 	QueueConfig *QueueConfig `yaml:"queue_config,omitempty"`
@@ -127,20 +177,9 @@ type RemoteReadConfig struct {
 	// ReadRecent determines if remote read should only return data from the recent time range.
 	ReadRecent bool `yaml:"read_recent,omitempty"`
 
-	// TLSConfig configures TLS settings for the connection.
-	TLSConfig *TLSConfig `yaml:"tls_config,omitempty"`
-
-	// BasicAuth configures basic authentication.
-	BasicAuth *BasicAuth `yaml:"basic_auth,omitempty"`
-
-	// BearerToken is the bearer token to use for authentication.
-	BearerToken Secret `yaml:"bearer_token,omitempty"`
-
-	// BearerTokenFile is the path to a file containing the bearer token.
-	BearerTokenFile string `yaml:"bearer_token_file,omitempty"`
-
-	// ProxyURL is the URL of a proxy to use for requests.
-	ProxyURL string `yaml:"proxy_url,omitempty"`
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// remote read requests.
+	HTTPClientConfig `yaml:",inline"`
 
 	// RequiredMatchers specifies label matchers that must be present in all queries.
 	RequiredMatchers map[string]string `yaml:"required_matchers,omitempty"`
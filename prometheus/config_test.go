@@ -179,3 +179,64 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestPrometheusConfig_ServerSections(t *testing.T) {
+	input := `
+global:
+  query_log_file: /prometheus/query.log
+  scrape_protocols: [PrometheusProto, OpenMetricsText1.0.0]
+runtime:
+  gogc: 50
+scrape_config_files:
+  - scrapes/*.yml
+alerting:
+  alert_relabel_configs:
+    - regex: replica
+      action: labeldrop
+storage:
+  tsdb:
+    out_of_order_time_window: 30m
+  exemplars:
+    max_exemplars: 200000
+tracing:
+  endpoint: otel-collector:4317
+  sampling_fraction: 0.1
+otlp:
+  promote_resource_attributes: [service.namespace]
+`
+	var config PrometheusConfig
+	if err := yaml.Unmarshal([]byte(input), &config); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+
+	if config.Global.QueryLogFile != "/prometheus/query.log" {
+		t.Errorf("QueryLogFile = %v, want /prometheus/query.log", config.Global.QueryLogFile)
+	}
+	if len(config.Global.ScrapeProtocols) != 2 || config.Global.ScrapeProtocols[0] != ScrapeProtocolPrometheusProto {
+		t.Errorf("ScrapeProtocols = %v, want [PrometheusProto OpenMetricsText1.0.0]", config.Global.ScrapeProtocols)
+	}
+	if config.Runtime == nil || config.Runtime.GOGC == nil || *config.Runtime.GOGC != 50 {
+		t.Errorf("Runtime = %v, want gogc 50", config.Runtime)
+	}
+	if len(config.ScrapeConfigFiles) != 1 || config.ScrapeConfigFiles[0] != "scrapes/*.yml" {
+		t.Errorf("ScrapeConfigFiles = %v, want [scrapes/*.yml]", config.ScrapeConfigFiles)
+	}
+	if len(config.Alerting.AlertRelabelConfigs) != 1 || config.Alerting.AlertRelabelConfigs[0].Action != "labeldrop" {
+		t.Errorf("AlertRelabelConfigs = %v, want one labeldrop", config.Alerting.AlertRelabelConfigs)
+	}
+	if config.Storage.TSDB.OutOfOrderTimeWindow != Duration(30*time.Minute) {
+		t.Errorf("OutOfOrderTimeWindow = %v, want 30m", config.Storage.TSDB.OutOfOrderTimeWindow)
+	}
+	if config.Storage.Exemplars.MaxExemplars != 200000 {
+		t.Errorf("MaxExemplars = %v, want 200000", config.Storage.Exemplars.MaxExemplars)
+	}
+	if config.Tracing.Endpoint != "otel-collector:4317" || config.Tracing.SamplingFraction != 0.1 {
+		t.Errorf("Tracing = %+v, want otel-collector:4317 at 0.1", config.Tracing)
+	}
+	if len(config.OTLP.PromoteResourceAttributes) != 1 {
+		t.Errorf("PromoteResourceAttributes = %v, want [service.namespace]", config.OTLP.PromoteResourceAttributes)
+	}
+	if errs := config.Validate(); len(errs) > 0 {
+		t.Errorf("Validate() = %v, want no errors", errs)
+	}
+}
@@ -0,0 +1,349 @@
+package prometheus
+
+// HTTPClientConfig configures how Prometheus connects to an HTTP endpoint:
+// authentication, TLS and proxy settings. It is embedded inline by scrape
+// configs, service discovery configs, remote write/read configs and
+// Alertmanager endpoints, so its fields serialize at the same level as the
+// embedding config.
+//
+// At most one of BasicAuth, Authorization, OAuth2 and BearerToken or
+// BearerTokenFile may be set.
+//
+// Example usage:
+//
+//	var Scrape = prometheus.NewScrapeConfig("api").
+//	    WithOAuth2(prometheus.NewOAuth2("prometheus", prometheus.SecretFromEnv("OAUTH_SECRET"), "https://auth.example.com/token").
+//	        WithScopes("metrics.read"))
+type HTTPClientConfig struct {
+	// BasicAuth configures basic authentication.
+	BasicAuth *BasicAuth `yaml:"basic_auth,omitempty"`
+
+	// Authorization configures the Authorization header.
+	Authorization *Authorization `yaml:"authorization,omitempty"`
+
+	// OAuth2 configures OAuth 2.0 client credentials authentication.
+	OAuth2 *OAuth2 `yaml:"oauth2,omitempty"`
+
+	// BearerToken is the bearer token to use for authentication.
+	// Deprecated in Prometheus in favor of Authorization.
+	BearerToken Secret `yaml:"bearer_token,omitempty"`
+
+	// BearerTokenFile is the path to a file containing the bearer token.
+	// Deprecated in Prometheus in favor of Authorization.
+	BearerTokenFile string `yaml:"bearer_token_file,omitempty"`
+
+	// TLSConfig configures TLS settings.
+	TLSConfig *TLSConfig `yaml:"tls_config,omitempty"`
+
+	// ProxyURL is the URL of a proxy to use for requests.
+	ProxyURL string `yaml:"proxy_url,omitempty"`
+
+	// NoProxy is a comma-separated list of hosts excluded from proxying.
+	NoProxy string `yaml:"no_proxy,omitempty"`
+
+	// ProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+	// environment variables.
+	ProxyFromEnvironment bool `yaml:"proxy_from_environment,omitempty"`
+
+	// ProxyConnectHeader sets headers sent to the proxy on CONNECT requests.
+	ProxyConnectHeader map[string][]Secret `yaml:"proxy_connect_header,omitempty"`
+
+	// FollowRedirects controls whether to follow HTTP redirects.
+	// Defaults to true.
+	FollowRedirects *bool `yaml:"follow_redirects,omitempty"`
+
+	// EnableHTTP2 controls whether to use HTTP/2.
+	// Defaults to true.
+	EnableHTTP2 *bool `yaml:"enable_http2,omitempty"`
+}
+
+// BasicAuth configures basic authentication.
+type BasicAuth struct {
+	// Username for basic authentication.
+	Username string `yaml:"username,omitempty"`
+
+	// Password for basic authentication.
+	// Mutually exclusive with PasswordFile.
+	Password string `yaml:"password,omitempty"`
+
+	// PasswordFile is a file containing the password for basic authentication.
+	// Mutually exclusive with Password.
+	PasswordFile string `yaml:"password_file,omitempty"`
+}
+
+// TLSConfig configures TLS connections.
+type TLSConfig struct {
+	// CAFile is the path to the CA certificate file.
+	CAFile string `yaml:"ca_file,omitempty"`
+
+	// CertFile is the path to the client certificate file.
+	CertFile string `yaml:"cert_file,omitempty"`
+
+	// KeyFile is the path to the client key file.
+	KeyFile string `yaml:"key_file,omitempty"`
+
+	// ServerName is used to verify the hostname on the returned certificates.
+	ServerName string `yaml:"server_name,omitempty"`
+
+	// InsecureSkipVerify skips verifying the server's certificate chain and hostname.
+	InsecureSkipVerify bool `yaml:"insecure_skip_verify,omitempty"`
+}
+
+// Authorization configures the HTTP Authorization header.
+type Authorization struct {
+	// Type is the authentication scheme. Defaults to "Bearer".
+	// "Basic" is not allowed; use BasicAuth instead.
+	Type string `yaml:"type,omitempty"`
+
+	// Credentials are the credentials sent in the header.
+	// Mutually exclusive with CredentialsFile.
+	Credentials Secret `yaml:"credentials,omitempty"`
+
+	// CredentialsFile is a file containing the credentials.
+	// Mutually exclusive with Credentials.
+	CredentialsFile string `yaml:"credentials_file,omitempty"`
+}
+
+// OAuth2 configures OAuth 2.0 client credentials authentication.
+type OAuth2 struct {
+	// ClientID is the OAuth 2.0 client ID.
+	ClientID string `yaml:"client_id"`
+
+	// ClientSecret is the OAuth 2.0 client secret.
+	// Mutually exclusive with ClientSecretFile.
+	ClientSecret Secret `yaml:"client_secret,omitempty"`
+
+	// ClientSecretFile is a file containing the client secret.
+	// Mutually exclusive with ClientSecret.
+	ClientSecretFile string `yaml:"client_secret_file,omitempty"`
+
+	// Scopes are the scopes requested for the token.
+	Scopes []string `yaml:"scopes,omitempty"`
+
+	// TokenURL is the URL tokens are fetched from.
+	TokenURL string `yaml:"token_url"`
+
+	// EndpointParams are additional parameters sent to the token URL.
+	EndpointParams map[string]string `yaml:"endpoint_params,omitempty"`
+
+	// TLSConfig configures TLS for token requests.
+	TLSConfig *TLSConfig `yaml:"tls_config,omitempty"`
+
+	// ProxyURL is the URL of a proxy to use for token requests.
+	ProxyURL string `yaml:"proxy_url,omitempty"`
+}
+
+// SigV4Config configures AWS Signature Version 4 request signing, as used by
+// Amazon Managed Service for Prometheus. Credentials default to the AWS SDK
+// credential chain.
+type SigV4Config struct {
+	// Region is the AWS region. Defaults to the region of the AWS SDK
+	// configuration.
+	Region string `yaml:"region,omitempty"`
+
+	// AccessKey is the AWS access key ID.
+	AccessKey string `yaml:"access_key,omitempty"`
+
+	// SecretKey is the AWS secret access key.
+	SecretKey Secret `yaml:"secret_key,omitempty"`
+
+	// Profile is the named AWS profile to use.
+	Profile string `yaml:"profile,omitempty"`
+
+	// RoleARN is an IAM role to assume.
+	RoleARN string `yaml:"role_arn,omitempty"`
+}
+
+// AzureADConfig configures Azure AD authentication for remote write to an
+// Azure Monitor workspace. Exactly one of ManagedIdentity, OAuth and SDK
+// must be set.
+type AzureADConfig struct {
+	// Cloud is the Azure cloud: AzurePublic, AzureChina or AzureGovernment.
+	// Defaults to AzurePublic.
+	Cloud string `yaml:"cloud,omitempty"`
+
+	// ManagedIdentity authenticates with a managed identity.
+	ManagedIdentity *AzureADManagedIdentity `yaml:"managed_identity,omitempty"`
+
+	// OAuth authenticates with a service principal.
+	OAuth *AzureADOAuth `yaml:"oauth,omitempty"`
+
+	// SDK authenticates with the Azure SDK's default credential chain.
+	SDK *AzureADSDK `yaml:"sdk,omitempty"`
+}
+
+// AzureADManagedIdentity identifies a user-assigned managed identity.
+type AzureADManagedIdentity struct {
+	// ClientID is the client ID of the managed identity.
+	ClientID string `yaml:"client_id"`
+}
+
+// AzureADOAuth identifies a service principal.
+type AzureADOAuth struct {
+	// ClientID is the client ID of the application.
+	ClientID string `yaml:"client_id"`
+
+	// ClientSecret is the client secret of the application.
+	ClientSecret Secret `yaml:"client_secret"`
+
+	// TenantID is the tenant of the application.
+	TenantID string `yaml:"tenant_id"`
+}
+
+// AzureADSDK configures Azure SDK authentication.
+type AzureADSDK struct {
+	// TenantID is the tenant to authenticate against.
+	TenantID string `yaml:"tenant_id,omitempty"`
+}
+
+// GoogleIAMConfig configures Google Cloud IAM authentication for remote
+// write to Google Cloud Managed Service for Prometheus.
+type GoogleIAMConfig struct {
+	// CredentialsFile is the path to a service account key file. If empty,
+	// Application Default Credentials are used.
+	CredentialsFile string `yaml:"credentials_file,omitempty"`
+}
+
+// NewAuthorization creates an Authorization header configuration with the
+// Bearer scheme.
+func NewAuthorization(credentials Secret) *Authorization {
+	return &Authorization{
+		Type:        "Bearer",
+		Credentials: credentials,
+	}
+}
+
+// NewAuthorizationFile creates a Bearer Authorization header configuration
+// that reads its credentials from a file.
+func NewAuthorizationFile(path string) *Authorization {
+	return &Authorization{
+		Type:            "Bearer",
+		CredentialsFile: path,
+	}
+}
+
+// WithType sets the authentication scheme.
+func (a *Authorization) WithType(authType string) *Authorization {
+	a.Type = authType
+	return a
+}
+
+// NewOAuth2 creates a new OAuth2 configuration.
+func NewOAuth2(clientID string, clientSecret Secret, tokenURL string) *OAuth2 {
+	return &OAuth2{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+	}
+}
+
+// WithClientSecretFile reads the client secret from a file instead.
+func (o *OAuth2) WithClientSecretFile(path string) *OAuth2 {
+	o.ClientSecret = ""
+	o.ClientSecretFile = path
+	return o
+}
+
+// WithScopes sets the requested scopes.
+func (o *OAuth2) WithScopes(scopes ...string) *OAuth2 {
+	o.Scopes = scopes
+	return o
+}
+
+// WithEndpointParams sets additional token request parameters.
+func (o *OAuth2) WithEndpointParams(params map[string]string) *OAuth2 {
+	o.EndpointParams = params
+	return o
+}
+
+// WithTLSConfig sets TLS settings for token requests.
+func (o *OAuth2) WithTLSConfig(tls *TLSConfig) *OAuth2 {
+	o.TLSConfig = tls
+	return o
+}
+
+// WithProxyURL sets the proxy URL for token requests.
+func (o *OAuth2) WithProxyURL(url string) *OAuth2 {
+	o.ProxyURL = url
+	return o
+}
+
+// NewSigV4 creates a SigV4 configuration for the given AWS region.
+func NewSigV4(region string) *SigV4Config {
+	return &SigV4Config{Region: region}
+}
+
+// WithAccessKey sets static AWS credentials.
+func (s *SigV4Config) WithAccessKey(accessKey string, secretKey Secret) *SigV4Config {
+	s.AccessKey = accessKey
+	s.SecretKey = secretKey
+	return s
+}
+
+// WithProfile sets the named AWS profile.
+func (s *SigV4Config) WithProfile(profile string) *SigV4Config {
+	s.Profile = profile
+	return s
+}
+
+// WithRoleARN sets the IAM role to assume.
+func (s *SigV4Config) WithRoleARN(arn string) *SigV4Config {
+	s.RoleARN = arn
+	return s
+}
+
+// NewAzureADManagedIdentity creates an Azure AD configuration authenticating
+// with the user-assigned managed identity clientID.
+func NewAzureADManagedIdentity(clientID string) *AzureADConfig {
+	return &AzureADConfig{
+		ManagedIdentity: &AzureADManagedIdentity{ClientID: clientID},
+	}
+}
+
+// NewAzureADOAuth creates an Azure AD configuration authenticating with a
+// service principal.
+func NewAzureADOAuth(clientID string, clientSecret Secret, tenantID string) *AzureADConfig {
+	return &AzureADConfig{
+		OAuth: &AzureADOAuth{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			TenantID:     tenantID,
+		},
+	}
+}
+
+// NewAzureADSDK creates an Azure AD configuration authenticating with the
+// Azure SDK's default credential chain.
+func NewAzureADSDK(tenantID string) *AzureADConfig {
+	return &AzureADConfig{
+		SDK: &AzureADSDK{TenantID: tenantID},
+	}
+}
+
+// WithCloud sets the Azure cloud (AzurePublic, AzureChina or AzureGovernment).
+func (a *AzureADConfig) WithCloud(cloud string) *AzureADConfig {
+	a.Cloud = cloud
+	return a
+}
+
+// NewGoogleIAM creates a Google IAM configuration. An empty credentialsFile
+// uses Application Default Credentials.
+func NewGoogleIAM(credentialsFile string) *GoogleIAMConfig {
+	return &GoogleIAMConfig{CredentialsFile: credentialsFile}
+}
+
+// authMethods returns the number of authentication methods configured.
+func (c *HTTPClientConfig) authMethods() int {
+	n := 0
+	for _, set := range []bool{
+		c.BasicAuth != nil,
+		c.Authorization != nil,
+		c.OAuth2 != nil,
+		c.BearerToken != "" || c.BearerTokenFile != "",
+	} {
+		if set {
+			n++
+		}
+	}
+	return n
+}
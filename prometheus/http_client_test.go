@@ -0,0 +1,192 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestScrapeConfig_HTTPClientSerialize(t *testing.T) {
+	sc := NewScrapeConfig("api").
+		WithOAuth2(NewOAuth2("prometheus", "", "https://auth.example.com/token").
+			WithClientSecretFile("/etc/prometheus/oauth-secret").
+			WithScopes("metrics.read").
+			WithEndpointParams(map[string]string{"audience": "metrics"})).
+		WithTLSConfig(&TLSConfig{CAFile: "/etc/prometheus/ca.pem"}).
+		WithProxyURL("http://proxy:3128")
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"job_name: api",
+		"oauth2:",
+		"client_id: prometheus",
+		"client_secret_file: /etc/prometheus/oauth-secret",
+		"- metrics.read",
+		"token_url: https://auth.example.com/token",
+		"audience: metrics",
+		"tls_config:",
+		"ca_file: /etc/prometheus/ca.pem",
+		"proxy_url: http://proxy:3128",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+	if strings.Contains(yamlStr, "httpclientconfig") {
+		t.Errorf("yaml.Marshal() should inline HTTPClientConfig\nGot:\n%s", yamlStr)
+	}
+}
+
+func TestScrapeConfig_HTTPClientUnmarshal(t *testing.T) {
+	input := `
+job_name: api
+authorization:
+  type: Token
+  credentials_file: /etc/token
+follow_redirects: false
+enable_http2: false
+`
+	var sc ScrapeConfig
+	if err := yaml.Unmarshal([]byte(input), &sc); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+
+	if sc.Authorization == nil {
+		t.Fatal("Authorization should not be nil")
+	}
+	if sc.Authorization.Type != "Token" {
+		t.Errorf("Authorization.Type = %v, want Token", sc.Authorization.Type)
+	}
+	if sc.Authorization.CredentialsFile != "/etc/token" {
+		t.Errorf("Authorization.CredentialsFile = %v, want /etc/token", sc.Authorization.CredentialsFile)
+	}
+	if sc.FollowRedirects == nil || *sc.FollowRedirects {
+		t.Errorf("FollowRedirects = %v, want false", sc.FollowRedirects)
+	}
+	if sc.EnableHTTP2 == nil || *sc.EnableHTTP2 {
+		t.Errorf("EnableHTTP2 = %v, want false", sc.EnableHTTP2)
+	}
+}
+
+func TestScrapeConfig_HTTPClientProxyBuilders(t *testing.T) {
+	sc := NewScrapeConfig("api").
+		WithBearerToken("token").
+		WithProxyURL("http://proxy:3128").
+		WithNoProxy("localhost").
+		WithProxyConnectHeader("Proxy-Authorization", "Basic abc").
+		WithFollowRedirects(false).
+		WithEnableHTTP2(false)
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"bearer_token: token",
+		"no_proxy: localhost",
+		"proxy_connect_header:",
+		"Proxy-Authorization:",
+		"- Basic abc",
+		"follow_redirects: false",
+		"enable_http2: false",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+
+	sd := NewHTTPSD("http://sd/targets").WithProxyFromEnvironment()
+	if !sd.ProxyFromEnvironment {
+		t.Error("WithProxyFromEnvironment() should set ProxyFromEnvironment")
+	}
+}
+
+func TestAuthorization_Builders(t *testing.T) {
+	a := NewAuthorization("token")
+	if a.Type != "Bearer" || a.Credentials != "token" {
+		t.Errorf("NewAuthorization() = %+v, want Bearer token", a)
+	}
+
+	f := NewAuthorizationFile("/etc/token").WithType("Token")
+	if f.Type != "Token" || f.CredentialsFile != "/etc/token" {
+		t.Errorf("NewAuthorizationFile().WithType() = %+v, want Token /etc/token", f)
+	}
+}
+
+func TestRemoteWrite_SigV4Serialize(t *testing.T) {
+	rw := NewRemoteWrite("https://aps-workspaces.us-east-1.amazonaws.com/workspaces/ws-1/api/v1/remote_write").
+		WithSigV4(NewSigV4("us-east-1").WithRoleARN("arn:aws:iam::123456789012:role/prometheus"))
+
+	data, err := yaml.Marshal(rw)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"sigv4:",
+		"region: us-east-1",
+		"role_arn: arn:aws:iam::123456789012:role/prometheus",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestRemoteWrite_AzureADSerialize(t *testing.T) {
+	rw := NewRemoteWrite("https://workspace.eastus.metrics.monitor.azure.com/dataCollectionRules/dcr/streams/Microsoft-PrometheusMetrics/api/v1/write").
+		WithAzureAD(NewAzureADOAuth("client", "secret", "tenant").WithCloud("AzureChina"))
+
+	data, err := yaml.Marshal(rw)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"azuread:",
+		"cloud: AzureChina",
+		"oauth:",
+		"client_id: client",
+		"client_secret: secret",
+		"tenant_id: tenant",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestRemoteWrite_GoogleIAMSerialize(t *testing.T) {
+	rw := NewRemoteWrite("https://monitoring.googleapis.com/v1/projects/infra/location/global/prometheus/api/v1/write").
+		WithGoogleIAM(NewGoogleIAM("/etc/prometheus/gcp.json"))
+
+	data, err := yaml.Marshal(rw)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{"google_iam:", "credentials_file: /etc/prometheus/gcp.json"} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
@@ -0,0 +1,619 @@
+package kubesd
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+const metaPrefix = "__meta_kubernetes_"
+
+// supportedFields are the field selector keys the simulation understands
+// for each role.
+var supportedFields = map[prometheus.KubernetesRole][]string{
+	prometheus.KubernetesRolePod:           {"metadata.name", "metadata.namespace", "spec.nodeName", "status.phase", "status.podIP"},
+	prometheus.KubernetesRoleService:       {"metadata.name", "metadata.namespace", "spec.type", "spec.clusterIP"},
+	prometheus.KubernetesRoleEndpoints:     {"metadata.name", "metadata.namespace"},
+	prometheus.KubernetesRoleEndpointSlice: {"metadata.name", "metadata.namespace"},
+	prometheus.KubernetesRoleNode:          {"metadata.name"},
+	prometheus.KubernetesRoleIngress:       {"metadata.name", "metadata.namespace"},
+}
+
+// objectFilter holds the selectors for one role.
+type objectFilter struct {
+	label selector
+	field selector
+}
+
+// discovery evaluates one Kubernetes SD config against a cluster.
+type discovery struct {
+	cluster    *Cluster
+	namespaces map[string]bool
+	filters    map[prometheus.KubernetesRole]*objectFilter
+}
+
+func (c *Cluster) newDiscovery(sd *prometheus.KubernetesSD) (*discovery, error) {
+	d := &discovery{cluster: c, filters: make(map[prometheus.KubernetesRole]*objectFilter)}
+
+	if ns := sd.Namespaces; ns != nil && (ns.OwnNamespace || len(ns.Names) > 0) {
+		d.namespaces = make(map[string]bool)
+		for _, name := range ns.Names {
+			d.namespaces[name] = true
+		}
+		if ns.OwnNamespace {
+			if c.OwnNamespace == "" {
+				return nil, fmt.Errorf("namespaces.own_namespace is set but the cluster has no OwnNamespace")
+			}
+			d.namespaces[c.OwnNamespace] = true
+		}
+	}
+
+	for _, s := range sd.Selectors {
+		fields, ok := supportedFields[s.Role]
+		if !ok {
+			return nil, fmt.Errorf("unknown selector role %q", s.Role)
+		}
+		label, err := parseLabelSelector(s.Label)
+		if err != nil {
+			return nil, err
+		}
+		field, err := parseFieldSelector(s.Field)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range field {
+			if !contains(fields, r.key) {
+				return nil, fmt.Errorf("field selector %q is not supported for role %s", r.key, s.Role)
+			}
+		}
+		d.filters[s.Role] = &objectFilter{label: label, field: field}
+	}
+	return d, nil
+}
+
+// accept reports whether an object of the role passes the namespace and
+// selector filters. fields returns the object's selectable fields.
+func (d *discovery) accept(role prometheus.KubernetesRole, meta ObjectMeta, fields map[string]string) bool {
+	if role != prometheus.KubernetesRoleNode && d.namespaces != nil && !d.namespaces[meta.Namespace] {
+		return false
+	}
+	f := d.filters[role]
+	if f == nil {
+		return true
+	}
+	if !f.label.matchesLabels(meta.Labels) {
+		return false
+	}
+	all := map[string]string{"metadata.name": meta.Name, "metadata.namespace": meta.Namespace}
+	for k, v := range fields {
+		all[k] = v
+	}
+	return f.field.matchesLabels(all)
+}
+
+func (d *discovery) acceptPod(p *Pod) bool {
+	return d.accept(prometheus.KubernetesRolePod, p.Metadata, map[string]string{
+		"spec.nodeName": p.Spec.NodeName,
+		"status.phase":  p.Status.Phase,
+		"status.podIP":  p.Status.PodIP,
+	})
+}
+
+func (d *discovery) acceptService(s *Service) bool {
+	return d.accept(prometheus.KubernetesRoleService, s.Metadata, map[string]string{
+		"spec.type":      serviceType(s),
+		"spec.clusterIP": s.Spec.ClusterIP,
+	})
+}
+
+// pod returns the selected pod with the given name, or nil.
+func (d *discovery) pod(namespace, name string) *Pod {
+	for _, p := range d.cluster.Pods {
+		if p.Metadata.Namespace == namespace && p.Metadata.Name == name && d.acceptPod(p) {
+			return p
+		}
+	}
+	return nil
+}
+
+// service returns the selected service with the given name, or nil.
+func (d *discovery) service(namespace, name string) *Service {
+	for _, s := range d.cluster.Services {
+		if s.Metadata.Namespace == namespace && s.Metadata.Name == name && d.acceptService(s) {
+			return s
+		}
+	}
+	return nil
+}
+
+func (d *discovery) nodeTargets() []map[string]string {
+	var targets []map[string]string
+	for _, n := range d.cluster.Nodes {
+		if !d.accept(prometheus.KubernetesRoleNode, n.Metadata, nil) {
+			continue
+		}
+		addr, ok := nodeAddress(n)
+		if !ok {
+			continue
+		}
+		port := n.Status.DaemonEndpoints.KubeletEndpoint.Port
+		if port == 0 {
+			port = 10250
+		}
+		t := map[string]string{
+			"__address__": net.JoinHostPort(addr, strconv.Itoa(int(port))),
+			"instance":    n.Metadata.Name,
+		}
+		set(t, metaPrefix+"node_name", n.Metadata.Name)
+		set(t, metaPrefix+"node_provider_id", n.Spec.ProviderID)
+		addObjectMeta(t, "node", n.Metadata)
+		for _, a := range n.Status.Addresses {
+			name := metaPrefix + "node_address_" + sanitize(a.Type)
+			if _, ok := t[name]; !ok {
+				set(t, name, a.Address)
+			}
+		}
+		targets = append(targets, t)
+	}
+	return targets
+}
+
+// nodeAddress returns a node's address in the order of preference
+// Prometheus uses.
+func nodeAddress(n *Node) (string, bool) {
+	for _, typ := range []string{"InternalIP", "InternalDNS", "ExternalIP", "ExternalDNS", "LegacyHostIP", "Hostname"} {
+		for _, a := range n.Status.Addresses {
+			if a.Type == typ && a.Address != "" {
+				return a.Address, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (d *discovery) podTargets() []map[string]string {
+	var targets []map[string]string
+	for _, p := range d.cluster.Pods {
+		if p.Status.PodIP == "" || !d.acceptPod(p) {
+			continue
+		}
+		labels := podLabels(p)
+		for i, c := range podContainers(p) {
+			isInit := i >= len(p.Spec.Containers)
+			if len(c.Ports) == 0 {
+				t := containerLabels(p, c, isInit)
+				t["__address__"] = p.Status.PodIP
+				targets = append(targets, merge(t, labels))
+				continue
+			}
+			for _, port := range c.Ports {
+				t := containerLabels(p, c, isInit)
+				addContainerPort(t, port)
+				t["__address__"] = net.JoinHostPort(p.Status.PodIP, strconv.Itoa(int(port.ContainerPort)))
+				targets = append(targets, merge(t, labels))
+			}
+		}
+	}
+	return targets
+}
+
+// podLabels returns the labels Prometheus attaches to every target of a pod.
+func podLabels(p *Pod) map[string]string {
+	lbls := make(map[string]string)
+	set(lbls, metaPrefix+"namespace", p.Metadata.Namespace)
+	set(lbls, metaPrefix+"pod_name", p.Metadata.Name)
+	set(lbls, metaPrefix+"pod_ip", p.Status.PodIP)
+	set(lbls, metaPrefix+"pod_ready", podReady(p))
+	set(lbls, metaPrefix+"pod_phase", p.Status.Phase)
+	set(lbls, metaPrefix+"pod_node_name", p.Spec.NodeName)
+	set(lbls, metaPrefix+"pod_host_ip", p.Status.HostIP)
+	set(lbls, metaPrefix+"pod_uid", p.Metadata.UID)
+	for _, ref := range p.Metadata.OwnerReferences {
+		if ref.Controller != nil && *ref.Controller {
+			set(lbls, metaPrefix+"pod_controller_kind", ref.Kind)
+			set(lbls, metaPrefix+"pod_controller_name", ref.Name)
+			break
+		}
+	}
+	addObjectMeta(lbls, "pod", p.Metadata)
+	return lbls
+}
+
+func podReady(p *Pod) string {
+	for _, c := range p.Status.Conditions {
+		if c.Type == "Ready" {
+			return strings.ToLower(c.Status)
+		}
+	}
+	return "unknown"
+}
+
+// podContainers returns a pod's containers followed by its init containers.
+func podContainers(p *Pod) []Container {
+	containers := make([]Container, 0, len(p.Spec.Containers)+len(p.Spec.InitContainers))
+	containers = append(containers, p.Spec.Containers...)
+	return append(containers, p.Spec.InitContainers...)
+}
+
+// containerLabels returns the labels describing a container of a pod.
+func containerLabels(p *Pod, c Container, isInit bool) map[string]string {
+	lbls := make(map[string]string)
+	set(lbls, metaPrefix+"pod_container_name", c.Name)
+	set(lbls, metaPrefix+"pod_container_image", c.Image)
+	set(lbls, metaPrefix+"pod_container_init", strconv.FormatBool(isInit))
+	statuses := p.Status.ContainerStatuses
+	if isInit {
+		statuses = p.Status.InitContainerStatuses
+	}
+	for _, s := range statuses {
+		if s.Name == c.Name {
+			set(lbls, metaPrefix+"pod_container_id", s.ContainerID)
+		}
+	}
+	return lbls
+}
+
+func addContainerPort(lbls map[string]string, port ContainerPort) {
+	set(lbls, metaPrefix+"pod_container_port_name", port.Name)
+	set(lbls, metaPrefix+"pod_container_port_number", strconv.Itoa(int(port.ContainerPort)))
+	set(lbls, metaPrefix+"pod_container_port_protocol", protocol(port.Protocol))
+}
+
+func (d *discovery) serviceTargets() []map[string]string {
+	var targets []map[string]string
+	for _, s := range d.cluster.Services {
+		if !d.acceptService(s) {
+			continue
+		}
+		labels := serviceLabels(s)
+		for _, port := range s.Spec.Ports {
+			t := map[string]string{
+				"__address__": net.JoinHostPort(s.Metadata.Name+"."+s.Metadata.Namespace+".svc", strconv.Itoa(int(port.Port))),
+			}
+			set(t, metaPrefix+"service_port_name", port.Name)
+			set(t, metaPrefix+"service_port_number", strconv.Itoa(int(port.Port)))
+			set(t, metaPrefix+"service_port_protocol", protocol(port.Protocol))
+			set(t, metaPrefix+"service_type", serviceType(s))
+			if serviceType(s) == "ExternalName" {
+				set(t, metaPrefix+"service_external_name", s.Spec.ExternalName)
+			} else {
+				set(t, metaPrefix+"service_cluster_ip", s.Spec.ClusterIP)
+			}
+			targets = append(targets, merge(t, labels))
+		}
+	}
+	return targets
+}
+
+// serviceLabels returns the labels Prometheus attaches for a service, also
+// used for the endpoints backing it.
+func serviceLabels(s *Service) map[string]string {
+	lbls := make(map[string]string)
+	set(lbls, metaPrefix+"namespace", s.Metadata.Namespace)
+	set(lbls, metaPrefix+"service_name", s.Metadata.Name)
+	addObjectMeta(lbls, "service", s.Metadata)
+	return lbls
+}
+
+func serviceType(s *Service) string {
+	if s.Spec.Type == "" {
+		return "ClusterIP"
+	}
+	return s.Spec.Type
+}
+
+// seenPod tracks the endpoint ports that reference a pod, so that the
+// pod's other container ports can be added as targets.
+type seenPod struct {
+	pod   *Pod
+	ports []int32
+}
+
+// podEndpoints accumulates the pod-backed targets of an Endpoints or
+// EndpointSlice object.
+type podEndpoints struct {
+	d     *discovery
+	order []string
+	seen  map[string]*seenPod
+}
+
+func (d *discovery) newPodEndpoints() *podEndpoints {
+	return &podEndpoints{d: d, seen: make(map[string]*seenPod)}
+}
+
+// add attaches the labels of the pod referenced by ref to target t, if the
+// pod is selected, including the container exposing port.
+func (pe *podEndpoints) add(t map[string]string, ref *ObjectReference, namespace string, port int32) {
+	if ref == nil || ref.Kind != "Pod" {
+		return
+	}
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	p := pe.d.pod(namespace, ref.Name)
+	if p == nil {
+		return
+	}
+	key := namespace + "/" + ref.Name
+	sp := pe.seen[key]
+	if sp == nil {
+		sp = &seenPod{pod: p}
+		pe.seen[key] = sp
+		pe.order = append(pe.order, key)
+	}
+	sp.ports = append(sp.ports, port)
+
+	for k, v := range podLabels(p) {
+		t[k] = v
+	}
+	for i, c := range podContainers(p) {
+		for _, cport := range c.Ports {
+			if cport.ContainerPort == port {
+				for k, v := range containerLabels(p, c, i >= len(p.Spec.Containers)) {
+					t[k] = v
+				}
+				addContainerPort(t, cport)
+				return
+			}
+		}
+	}
+}
+
+// uncovered returns targets for the container ports of referenced pods that
+// no endpoint port covers.
+func (pe *podEndpoints) uncovered() []map[string]string {
+	var targets []map[string]string
+	for _, key := range pe.order {
+		sp := pe.seen[key]
+		p := sp.pod
+		for i, c := range podContainers(p) {
+			for _, cport := range c.Ports {
+				covered := false
+				for _, port := range sp.ports {
+					if port == cport.ContainerPort {
+						covered = true
+					}
+				}
+				if covered {
+					continue
+				}
+				t := containerLabels(p, c, i >= len(p.Spec.Containers))
+				addContainerPort(t, cport)
+				t["__address__"] = net.JoinHostPort(p.Status.PodIP, strconv.Itoa(int(cport.ContainerPort)))
+				targets = append(targets, merge(t, podLabels(p)))
+			}
+		}
+	}
+	return targets
+}
+
+func (d *discovery) endpointsTargets() []map[string]string {
+	var targets []map[string]string
+	for _, eps := range d.cluster.Endpoints {
+		if !d.accept(prometheus.KubernetesRoleEndpoints, eps.Metadata, nil) {
+			continue
+		}
+		ns := eps.Metadata.Namespace
+		group := make(map[string]string)
+		set(group, metaPrefix+"namespace", ns)
+		set(group, metaPrefix+"endpoints_name", eps.Metadata.Name)
+		addObjectMeta(group, "endpoints", eps.Metadata)
+		if s := d.service(ns, eps.Metadata.Name); s != nil {
+			group = merge(group, serviceLabels(s))
+		}
+
+		pods := d.newPodEndpoints()
+		add := func(addr EndpointAddress, port EndpointPort, ready bool) {
+			t := map[string]string{
+				"__address__": net.JoinHostPort(addr.IP, strconv.Itoa(int(port.Port))),
+			}
+			set(t, metaPrefix+"endpoint_port_name", port.Name)
+			set(t, metaPrefix+"endpoint_port_protocol", protocol(port.Protocol))
+			set(t, metaPrefix+"endpoint_port_app_protocol", port.AppProtocol)
+			set(t, metaPrefix+"endpoint_ready", strconv.FormatBool(ready))
+			if addr.TargetRef != nil {
+				set(t, metaPrefix+"endpoint_address_target_kind", addr.TargetRef.Kind)
+				set(t, metaPrefix+"endpoint_address_target_name", addr.TargetRef.Name)
+			}
+			set(t, metaPrefix+"endpoint_node_name", addr.NodeName)
+			set(t, metaPrefix+"endpoint_hostname", addr.Hostname)
+			pods.add(t, addr.TargetRef, ns, port.Port)
+			targets = append(targets, merge(t, group))
+		}
+		for _, ss := range eps.Subsets {
+			for _, port := range ss.Ports {
+				for _, addr := range ss.Addresses {
+					add(addr, port, true)
+				}
+				for _, addr := range ss.NotReadyAddresses {
+					add(addr, port, false)
+				}
+			}
+		}
+		for _, t := range pods.uncovered() {
+			targets = append(targets, merge(t, group))
+		}
+	}
+	return targets
+}
+
+func (d *discovery) endpointSliceTargets() []map[string]string {
+	var targets []map[string]string
+	for _, eps := range d.cluster.EndpointSlices {
+		if !d.accept(prometheus.KubernetesRoleEndpointSlice, eps.Metadata, nil) {
+			continue
+		}
+		ns := eps.Metadata.Namespace
+		group := make(map[string]string)
+		set(group, metaPrefix+"namespace", ns)
+		set(group, metaPrefix+"endpointslice_name", eps.Metadata.Name)
+		set(group, metaPrefix+"endpointslice_address_type", eps.AddressType)
+		addObjectMeta(group, "endpointslice", eps.Metadata)
+		if name := eps.Metadata.Labels["kubernetes.io/service-name"]; name != "" {
+			if s := d.service(ns, name); s != nil {
+				group = merge(group, serviceLabels(s))
+			}
+		}
+
+		pods := d.newPodEndpoints()
+		for _, port := range eps.Ports {
+			if port.Port == nil {
+				continue
+			}
+			for _, ep := range eps.Endpoints {
+				if len(ep.Addresses) == 0 {
+					continue
+				}
+				t := map[string]string{
+					"__address__": net.JoinHostPort(ep.Addresses[0], strconv.Itoa(int(*port.Port))),
+				}
+				set(t, metaPrefix+"endpointslice_port", strconv.Itoa(int(*port.Port)))
+				set(t, metaPrefix+"endpointslice_port_name", port.Name)
+				set(t, metaPrefix+"endpointslice_port_protocol", protocol(port.Protocol))
+				set(t, metaPrefix+"endpointslice_port_app_protocol", port.AppProtocol)
+				setBool(t, metaPrefix+"endpointslice_endpoint_conditions_ready", ep.Conditions.Ready)
+				setBool(t, metaPrefix+"endpointslice_endpoint_conditions_serving", ep.Conditions.Serving)
+				setBool(t, metaPrefix+"endpointslice_endpoint_conditions_terminating", ep.Conditions.Terminating)
+				set(t, metaPrefix+"endpointslice_endpoint_hostname", ep.Hostname)
+				set(t, metaPrefix+"endpointslice_endpoint_node_name", ep.NodeName)
+				set(t, metaPrefix+"endpointslice_endpoint_zone", ep.Zone)
+				if ep.TargetRef != nil {
+					set(t, metaPrefix+"endpointslice_address_target_kind", ep.TargetRef.Kind)
+					set(t, metaPrefix+"endpointslice_address_target_name", ep.TargetRef.Name)
+				}
+				pods.add(t, ep.TargetRef, ns, *port.Port)
+				targets = append(targets, merge(t, group))
+			}
+		}
+		for _, t := range pods.uncovered() {
+			targets = append(targets, merge(t, group))
+		}
+	}
+	return targets
+}
+
+func (d *discovery) ingressTargets() []map[string]string {
+	var targets []map[string]string
+	for _, ing := range d.cluster.Ingresses {
+		if !d.accept(prometheus.KubernetesRoleIngress, ing.Metadata, nil) {
+			continue
+		}
+		group := make(map[string]string)
+		set(group, metaPrefix+"namespace", ing.Metadata.Namespace)
+		set(group, metaPrefix+"ingress_name", ing.Metadata.Name)
+		class := ing.Spec.IngressClassName
+		if class == "" {
+			class = ing.Metadata.Annotations["kubernetes.io/ingress.class"]
+		}
+		set(group, metaPrefix+"ingress_class_name", class)
+		addObjectMeta(group, "ingress", ing.Metadata)
+
+		for _, rule := range ing.Spec.Rules {
+			scheme := "http"
+			if ingressTLS(ing, rule.Host) {
+				scheme = "https"
+			}
+			paths := []string{"/"}
+			if rule.HTTP != nil && len(rule.HTTP.Paths) > 0 {
+				paths = paths[:0]
+				for _, p := range rule.HTTP.Paths {
+					if p.Path == "" {
+						p.Path = "/"
+					}
+					paths = append(paths, p.Path)
+				}
+			}
+			for _, path := range paths {
+				t := map[string]string{"__address__": rule.Host}
+				set(t, metaPrefix+"ingress_scheme", scheme)
+				set(t, metaPrefix+"ingress_host", rule.Host)
+				set(t, metaPrefix+"ingress_path", path)
+				targets = append(targets, merge(t, group))
+			}
+		}
+	}
+	return targets
+}
+
+// ingressTLS reports whether host is listed, directly or by a wildcard, in
+// the ingress's TLS section.
+func ingressTLS(ing *Ingress, host string) bool {
+	for _, tls := range ing.Spec.TLS {
+		for _, h := range tls.Hosts {
+			if h == host {
+				return true
+			}
+			if suffix, ok := strings.CutPrefix(h, "*."); ok {
+				if prefix, rest, ok := strings.Cut(host, "."); ok && prefix != "" && rest == suffix {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// addObjectMeta adds the label_, labelpresent_, annotation_ and
+// annotationpresent_ labels of an object for the given role prefix.
+func addObjectMeta(lbls map[string]string, role string, meta ObjectMeta) {
+	for k, v := range meta.Labels {
+		name := sanitize(k)
+		lbls[metaPrefix+role+"_label_"+name] = v
+		lbls[metaPrefix+role+"_labelpresent_"+name] = "true"
+	}
+	for k, v := range meta.Annotations {
+		name := sanitize(k)
+		lbls[metaPrefix+role+"_annotation_"+name] = v
+		lbls[metaPrefix+role+"_annotationpresent_"+name] = "true"
+	}
+}
+
+// sanitize replaces characters that are invalid in label names with
+// underscores.
+func sanitize(name string) string {
+	b := []byte(name)
+	for i, c := range b {
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
+
+// protocol defaults an unset port protocol to TCP, as the API server does.
+func protocol(p string) string {
+	if p == "" {
+		return "TCP"
+	}
+	return p
+}
+
+// set sets a label if value is not empty; Prometheus treats empty label
+// values as absent.
+func set(lbls map[string]string, name, value string) {
+	if value != "" {
+		lbls[name] = value
+	}
+}
+
+func setBool(lbls map[string]string, name string, value *bool) {
+	if value != nil {
+		lbls[name] = strconv.FormatBool(*value)
+	}
+}
+
+// merge returns target labels combined with group labels. Target labels
+// take precedence.
+func merge(target, group map[string]string) map[string]string {
+	out := make(map[string]string, len(target)+len(group))
+	for k, v := range group {
+		out[k] = v
+	}
+	for k, v := range target {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,297 @@
+package kubesd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+func discover(t *testing.T, manifests string, sd *prometheus.KubernetesSD) []map[string]string {
+	t.Helper()
+	c := NewCluster()
+	if err := c.Load([]byte(manifests)); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	targets, err := c.Targets(sd)
+	if err != nil {
+		t.Fatalf("Targets() error = %v", err)
+	}
+	return targets
+}
+
+func TestTargets_Pod(t *testing.T) {
+	targets := discover(t, testManifests, prometheus.NewKubernetesSD(prometheus.KubernetesRolePod))
+	if len(targets) != 3 {
+		t.Fatalf("len(targets) = %d, want 3", len(targets))
+	}
+
+	want := map[string]string{
+		"__address__":                                                  "10.0.0.5:9090",
+		"__meta_kubernetes_namespace":                                  "prod",
+		"__meta_kubernetes_pod_name":                                   "api-7d9f",
+		"__meta_kubernetes_pod_ip":                                     "10.0.0.5",
+		"__meta_kubernetes_pod_ready":                                  "true",
+		"__meta_kubernetes_pod_phase":                                  "Running",
+		"__meta_kubernetes_pod_node_name":                              "node-1",
+		"__meta_kubernetes_pod_host_ip":                                "192.168.1.10",
+		"__meta_kubernetes_pod_controller_kind":                        "ReplicaSet",
+		"__meta_kubernetes_pod_controller_name":                        "api-7d9",
+		"__meta_kubernetes_pod_label_app":                              "api",
+		"__meta_kubernetes_pod_labelpresent_app":                       "true",
+		"__meta_kubernetes_pod_annotation_prometheus_io_scrape":        "true",
+		"__meta_kubernetes_pod_annotationpresent_prometheus_io_scrape": "true",
+		"__meta_kubernetes_pod_annotation_prometheus_io_port":          "9090",
+		"__meta_kubernetes_pod_annotationpresent_prometheus_io_port":   "true",
+		"__meta_kubernetes_pod_container_name":                         "api",
+		"__meta_kubernetes_pod_container_image":                        "api:1.2",
+		"__meta_kubernetes_pod_container_init":                         "false",
+		"__meta_kubernetes_pod_container_port_name":                    "metrics",
+		"__meta_kubernetes_pod_container_port_number":                  "9090",
+		"__meta_kubernetes_pod_container_port_protocol":                "TCP",
+	}
+	if !reflect.DeepEqual(targets[1], want) {
+		t.Errorf("targets[1] = %v, want %v", targets[1], want)
+	}
+
+	// Containers without ports are discovered at the pod IP.
+	if targets[2]["__address__"] != "10.0.0.6" || targets[2]["__meta_kubernetes_pod_ready"] != "unknown" {
+		t.Errorf("targets[2] = %v", targets[2])
+	}
+}
+
+func TestTargets_Namespaces(t *testing.T) {
+	sd := prometheus.NewKubernetesSD(prometheus.KubernetesRolePod).WithNamespaces("default")
+	targets := discover(t, testManifests, sd)
+	if len(targets) != 1 || targets[0]["__meta_kubernetes_pod_name"] != "worker-1" {
+		t.Errorf("targets = %v, want worker-1 only", targets)
+	}
+
+	c := loadTestCluster(t)
+	own := prometheus.NewKubernetesSD(prometheus.KubernetesRolePod).WithOwnNamespace()
+	if _, err := c.Targets(own); err == nil {
+		t.Error("Targets() with own_namespace and no OwnNamespace: expected error")
+	}
+	c.OwnNamespace = "prod"
+	targets, err := c.Targets(own)
+	if err != nil {
+		t.Fatalf("Targets() error = %v", err)
+	}
+	if len(targets) != 2 {
+		t.Errorf("len(targets) = %d, want 2", len(targets))
+	}
+}
+
+func TestTargets_Selectors(t *testing.T) {
+	sd := prometheus.NewKubernetesSD(prometheus.KubernetesRolePod).
+		WithLabelSelector(prometheus.KubernetesRolePod, "app=worker")
+	targets := discover(t, testManifests, sd)
+	if len(targets) != 1 || targets[0]["__meta_kubernetes_pod_name"] != "worker-1" {
+		t.Errorf("label selector targets = %v, want worker-1 only", targets)
+	}
+
+	sd = prometheus.NewKubernetesSD(prometheus.KubernetesRolePod).
+		WithFieldSelector(prometheus.KubernetesRolePod, "spec.nodeName=node-1")
+	targets = discover(t, testManifests, sd)
+	if len(targets) != 2 {
+		t.Errorf("field selector len(targets) = %d, want 2", len(targets))
+	}
+
+	c := loadTestCluster(t)
+	sd = prometheus.NewKubernetesSD(prometheus.KubernetesRolePod).
+		WithFieldSelector(prometheus.KubernetesRolePod, "spec.restartPolicy=Always")
+	if _, err := c.Targets(sd); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("Targets() error = %v, want unsupported field error", err)
+	}
+}
+
+func TestTargets_Service(t *testing.T) {
+	targets := discover(t, testManifests, prometheus.NewKubernetesSD(prometheus.KubernetesRoleService))
+	want := []map[string]string{{
+		"__address__":                                "api.prod.svc:80",
+		"__meta_kubernetes_namespace":                "prod",
+		"__meta_kubernetes_service_name":             "api",
+		"__meta_kubernetes_service_label_app":        "api",
+		"__meta_kubernetes_service_labelpresent_app": "true",
+		"__meta_kubernetes_service_port_name":        "http",
+		"__meta_kubernetes_service_port_number":      "80",
+		"__meta_kubernetes_service_port_protocol":    "TCP",
+		"__meta_kubernetes_service_type":             "ClusterIP",
+		"__meta_kubernetes_service_cluster_ip":       "10.96.0.10",
+	}}
+	if !reflect.DeepEqual(targets, want) {
+		t.Errorf("targets = %v, want %v", targets, want)
+	}
+}
+
+const endpointsManifests = testManifests + `---
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: api
+  namespace: prod
+subsets:
+  - addresses:
+      - ip: 10.0.0.5
+        nodeName: node-1
+        targetRef:
+          kind: Pod
+          name: api-7d9f
+    notReadyAddresses:
+      - ip: 10.0.0.9
+    ports:
+      - name: http
+        port: 8080
+---
+apiVersion: discovery.k8s.io/v1
+kind: EndpointSlice
+metadata:
+  name: api-abc12
+  namespace: prod
+  labels:
+    kubernetes.io/service-name: api
+addressType: IPv4
+endpoints:
+  - addresses: [10.0.0.5]
+    conditions:
+      ready: true
+    nodeName: node-1
+    zone: us-east-1a
+    targetRef:
+      kind: Pod
+      name: api-7d9f
+ports:
+  - name: http
+    port: 8080
+`
+
+func TestTargets_Endpoints(t *testing.T) {
+	targets := discover(t, endpointsManifests, prometheus.NewKubernetesSD(prometheus.KubernetesRoleEndpoints))
+	if len(targets) != 3 {
+		t.Fatalf("len(targets) = %d, want 3: %v", len(targets), targets)
+	}
+
+	ready := targets[0]
+	for name, want := range map[string]string{
+		"__address__":                                    "10.0.0.5:8080",
+		"__meta_kubernetes_endpoints_name":               "api",
+		"__meta_kubernetes_service_name":                 "api",
+		"__meta_kubernetes_service_label_app":            "api",
+		"__meta_kubernetes_endpoint_ready":               "true",
+		"__meta_kubernetes_endpoint_port_name":           "http",
+		"__meta_kubernetes_endpoint_node_name":           "node-1",
+		"__meta_kubernetes_endpoint_address_target_kind": "Pod",
+		"__meta_kubernetes_pod_name":                     "api-7d9f",
+		"__meta_kubernetes_pod_container_port_name":      "http",
+	} {
+		if ready[name] != want {
+			t.Errorf("targets[0][%s] = %q, want %q", name, ready[name], want)
+		}
+	}
+
+	if targets[1]["__address__"] != "10.0.0.9:8080" || targets[1]["__meta_kubernetes_endpoint_ready"] != "false" {
+		t.Errorf("targets[1] = %v, want not-ready endpoint", targets[1])
+	}
+
+	// The pod's metrics port is not covered by the endpoints.
+	uncovered := targets[2]
+	if uncovered["__address__"] != "10.0.0.5:9090" || uncovered["__meta_kubernetes_endpoints_name"] != "api" || uncovered["__meta_kubernetes_endpoint_ready"] != "" {
+		t.Errorf("targets[2] = %v, want uncovered container port", uncovered)
+	}
+}
+
+func TestTargets_EndpointSlice(t *testing.T) {
+	targets := discover(t, endpointsManifests, prometheus.NewKubernetesSD(prometheus.KubernetesRoleEndpointSlice))
+	if len(targets) != 2 {
+		t.Fatalf("len(targets) = %d, want 2: %v", len(targets), targets)
+	}
+	for name, want := range map[string]string{
+		"__address__":                                               "10.0.0.5:8080",
+		"__meta_kubernetes_endpointslice_name":                      "api-abc12",
+		"__meta_kubernetes_endpointslice_address_type":              "IPv4",
+		"__meta_kubernetes_endpointslice_port":                      "8080",
+		"__meta_kubernetes_endpointslice_port_name":                 "http",
+		"__meta_kubernetes_endpointslice_endpoint_conditions_ready": "true",
+		"__meta_kubernetes_endpointslice_endpoint_zone":             "us-east-1a",
+		"__meta_kubernetes_service_name":                            "api",
+		"__meta_kubernetes_pod_container_name":                      "api",
+	} {
+		if targets[0][name] != want {
+			t.Errorf("targets[0][%s] = %q, want %q", name, targets[0][name], want)
+		}
+	}
+	if _, ok := targets[0]["__meta_kubernetes_endpointslice_endpoint_conditions_serving"]; ok {
+		t.Error("unset serving condition should not produce a label")
+	}
+}
+
+func TestTargets_Node(t *testing.T) {
+	manifests := `apiVersion: v1
+kind: Node
+metadata:
+  name: node-1
+  labels:
+    kubernetes.io/os: linux
+spec:
+  providerID: aws:///us-east-1a/i-0abc
+status:
+  addresses:
+    - type: Hostname
+      address: ip-192-168-1-10
+    - type: InternalIP
+      address: 192.168.1.10
+  daemonEndpoints:
+    kubeletEndpoint:
+      Port: 10250
+`
+	targets := discover(t, manifests, prometheus.NewKubernetesSD(prometheus.KubernetesRoleNode))
+	want := []map[string]string{{
+		"__address__":                                          "192.168.1.10:10250",
+		"instance":                                             "node-1",
+		"__meta_kubernetes_node_name":                          "node-1",
+		"__meta_kubernetes_node_provider_id":                   "aws:///us-east-1a/i-0abc",
+		"__meta_kubernetes_node_label_kubernetes_io_os":        "linux",
+		"__meta_kubernetes_node_labelpresent_kubernetes_io_os": "true",
+		"__meta_kubernetes_node_address_Hostname":              "ip-192-168-1-10",
+		"__meta_kubernetes_node_address_InternalIP":            "192.168.1.10",
+	}}
+	if !reflect.DeepEqual(targets, want) {
+		t.Errorf("targets = %v, want %v", targets, want)
+	}
+}
+
+func TestTargets_Ingress(t *testing.T) {
+	manifests := `apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: web
+  namespace: prod
+spec:
+  ingressClassName: nginx
+  tls:
+    - hosts: ["*.example.com"]
+  rules:
+    - host: app.example.com
+      http:
+        paths:
+          - path: /api
+          - path: ""
+    - host: other.test
+`
+	targets := discover(t, manifests, prometheus.NewKubernetesSD(prometheus.KubernetesRoleIngress))
+	if len(targets) != 3 {
+		t.Fatalf("len(targets) = %d, want 3", len(targets))
+	}
+	got := make([]string, len(targets))
+	for i, target := range targets {
+		got[i] = target["__meta_kubernetes_ingress_scheme"] + "://" + target["__address__"] + target["__meta_kubernetes_ingress_path"]
+		if target["__meta_kubernetes_ingress_class_name"] != "nginx" {
+			t.Errorf("targets[%d] class = %q, want nginx", i, target["__meta_kubernetes_ingress_class_name"])
+		}
+	}
+	want := []string{"https://app.example.com/api", "https://app.example.com/", "http://other.test/"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("targets = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,259 @@
+// Package kubesd simulates Prometheus Kubernetes service discovery offline.
+//
+// A Cluster holds Kubernetes objects loaded from manifests and stands in for
+// the API server: Targets builds the __meta_kubernetes_* label sets a
+// KubernetesSD config would discover from it, and Simulate runs a scrape
+// job's relabel configs over them to show which targets the job would scrape
+// and with which labels.
+//
+//	cluster := kubesd.NewCluster()
+//	if err := cluster.LoadFile("testdata/pods.yaml"); err != nil {
+//	    t.Fatal(err)
+//	}
+//	results, err := cluster.Simulate(scrapeConfig, nil)
+package kubesd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+	"gopkg.in/yaml.v3"
+)
+
+// Cluster is a set of Kubernetes objects discovered by the simulation.
+// Objects are discovered in the order they were added.
+type Cluster struct {
+	// Pods are the cluster's pods.
+	Pods []*Pod
+
+	// Services are the cluster's services.
+	Services []*Service
+
+	// Endpoints are the cluster's Endpoints objects.
+	Endpoints []*Endpoints
+
+	// EndpointSlices are the cluster's EndpointSlices.
+	EndpointSlices []*EndpointSlice
+
+	// Nodes are the cluster's nodes.
+	Nodes []*Node
+
+	// Ingresses are the cluster's ingresses.
+	Ingresses []*Ingress
+
+	// OwnNamespace is the namespace Prometheus runs in, used by configs
+	// with namespaces.own_namespace set.
+	OwnNamespace string
+}
+
+// NewCluster creates an empty cluster.
+func NewCluster() *Cluster {
+	return &Cluster{}
+}
+
+// Load adds the objects in a YAML or JSON manifest to the cluster. The
+// manifest may contain several documents and List objects. Objects of kinds
+// that are not discovered, such as Deployments, are ignored. Objects without
+// a namespace are placed in the "default" namespace.
+func (c *Cluster) Load(data []byte) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := c.add(&doc); err != nil {
+			return err
+		}
+	}
+}
+
+// LoadFile adds the objects in a manifest file to the cluster. If path is a
+// directory, every .yaml, .yml and .json file in it is loaded.
+func (c *Cluster) LoadFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	paths := []string{path}
+	if info.IsDir() {
+		paths = nil
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			switch filepath.Ext(e.Name()) {
+			case ".yaml", ".yml", ".json":
+				if !e.IsDir() {
+					paths = append(paths, filepath.Join(path, e.Name()))
+				}
+			}
+		}
+	}
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if err := c.Load(data); err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// add decodes one manifest document into the cluster.
+func (c *Cluster) add(doc *yaml.Node) error {
+	var head struct {
+		Kind  string      `yaml:"kind"`
+		Items []yaml.Node `yaml:"items"`
+	}
+	if err := doc.Decode(&head); err != nil {
+		return err
+	}
+
+	decode := func(v any, meta *ObjectMeta) error {
+		if err := doc.Decode(v); err != nil {
+			return fmt.Errorf("%s: %w", head.Kind, err)
+		}
+		if meta.Namespace == "" {
+			meta.Namespace = "default"
+		}
+		return nil
+	}
+
+	switch head.Kind {
+	case "Pod":
+		var o Pod
+		if err := decode(&o, &o.Metadata); err != nil {
+			return err
+		}
+		c.Pods = append(c.Pods, &o)
+	case "Service":
+		var o Service
+		if err := decode(&o, &o.Metadata); err != nil {
+			return err
+		}
+		c.Services = append(c.Services, &o)
+	case "Endpoints":
+		var o Endpoints
+		if err := decode(&o, &o.Metadata); err != nil {
+			return err
+		}
+		c.Endpoints = append(c.Endpoints, &o)
+	case "EndpointSlice":
+		var o EndpointSlice
+		if err := decode(&o, &o.Metadata); err != nil {
+			return err
+		}
+		c.EndpointSlices = append(c.EndpointSlices, &o)
+	case "Node":
+		var o Node
+		if err := doc.Decode(&o); err != nil {
+			return fmt.Errorf("Node: %w", err)
+		}
+		c.Nodes = append(c.Nodes, &o)
+	case "Ingress":
+		var o Ingress
+		if err := decode(&o, &o.Metadata); err != nil {
+			return err
+		}
+		c.Ingresses = append(c.Ingresses, &o)
+	default:
+		if strings.HasSuffix(head.Kind, "List") {
+			for i := range head.Items {
+				if err := c.add(&head.Items[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Targets returns the label sets discovered by a Kubernetes SD config, as
+// Prometheus builds them for the config's role: each target has an
+// __address__ and the role's __meta_kubernetes_* labels. Namespace and
+// selector filters are applied.
+func (c *Cluster) Targets(sd *prometheus.KubernetesSD) ([]map[string]string, error) {
+	d, err := c.newDiscovery(sd)
+	if err != nil {
+		return nil, err
+	}
+	switch sd.Role {
+	case prometheus.KubernetesRoleNode:
+		return d.nodeTargets(), nil
+	case prometheus.KubernetesRolePod:
+		return d.podTargets(), nil
+	case prometheus.KubernetesRoleService:
+		return d.serviceTargets(), nil
+	case prometheus.KubernetesRoleEndpoints:
+		return d.endpointsTargets(), nil
+	case prometheus.KubernetesRoleEndpointSlice:
+		return d.endpointSliceTargets(), nil
+	case prometheus.KubernetesRoleIngress:
+		return d.ingressTargets(), nil
+	}
+	return nil, fmt.Errorf("unknown Kubernetes SD role %q", sd.Role)
+}
+
+// ScrapeTargets returns the targets discovered by all of a scrape config's
+// Kubernetes SD configs.
+func (c *Cluster) ScrapeTargets(sc *prometheus.ScrapeConfig) ([]map[string]string, error) {
+	if len(sc.KubernetesSDConfigs) == 0 {
+		return nil, fmt.Errorf("job %q has no kubernetes_sd_configs", sc.JobName)
+	}
+	var targets []map[string]string
+	for i, sd := range sc.KubernetesSDConfigs {
+		if sd == nil {
+			continue
+		}
+		t, err := c.Targets(sd)
+		if err != nil {
+			return nil, fmt.Errorf("kubernetes_sd_configs[%d]: %w", i, err)
+		}
+		targets = append(targets, t...)
+	}
+	return targets, nil
+}
+
+// Result is the outcome of discovering and relabeling one target.
+type Result struct {
+	// Discovered are the target's labels before relabeling, including the
+	// scrape config defaults such as job and __metrics_path__.
+	Discovered map[string]string
+
+	// Labels are the labels attached to series scraped from the target.
+	// They are nil if the target is dropped.
+	Labels map[string]string
+
+	// Keep is false if relabeling dropped the target.
+	Keep bool
+}
+
+// Simulate discovers a scrape config's Kubernetes targets and runs its
+// relabel configs over them, returning one result per discovered target.
+// global supplies the default scrape interval and timeout and may be nil.
+func (c *Cluster) Simulate(sc *prometheus.ScrapeConfig, global *prometheus.GlobalConfig) ([]Result, error) {
+	targets, err := c.ScrapeTargets(sc)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]Result, len(targets))
+	for i, t := range targets {
+		results[i].Discovered = sc.DiscoveredLabels(t, global)
+		results[i].Labels, results[i].Keep = sc.TargetLabels(t, global)
+	}
+	return results, nil
+}
@@ -0,0 +1,208 @@
+package kubesd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+const testManifests = `apiVersion: v1
+kind: Pod
+metadata:
+  name: api-7d9f
+  namespace: prod
+  labels:
+    app: api
+  annotations:
+    prometheus.io/scrape: "true"
+    prometheus.io/port: "9090"
+  ownerReferences:
+    - kind: ReplicaSet
+      name: api-7d9
+      controller: true
+spec:
+  nodeName: node-1
+  containers:
+    - name: api
+      image: api:1.2
+      ports:
+        - name: http
+          containerPort: 8080
+        - name: metrics
+          containerPort: 9090
+status:
+  phase: Running
+  podIP: 10.0.0.5
+  hostIP: 192.168.1.10
+  conditions:
+    - type: Ready
+      status: "True"
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: worker-1
+  labels:
+    app: worker
+spec:
+  containers:
+    - name: worker
+      image: worker:1.0
+status:
+  phase: Running
+  podIP: 10.0.0.6
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: pending
+  namespace: prod
+spec:
+  containers:
+    - name: app
+status:
+  phase: Pending
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+---
+apiVersion: v1
+kind: List
+items:
+  - apiVersion: v1
+    kind: Service
+    metadata:
+      name: api
+      namespace: prod
+      labels:
+        app: api
+    spec:
+      clusterIP: 10.96.0.10
+      ports:
+        - name: http
+          port: 80
+`
+
+func loadTestCluster(t *testing.T) *Cluster {
+	t.Helper()
+	c := NewCluster()
+	if err := c.Load([]byte(testManifests)); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return c
+}
+
+func TestCluster_Load(t *testing.T) {
+	c := loadTestCluster(t)
+	if len(c.Pods) != 3 {
+		t.Errorf("len(Pods) = %d, want 3", len(c.Pods))
+	}
+	if len(c.Services) != 1 {
+		t.Errorf("len(Services) = %d, want 1", len(c.Services))
+	}
+	if c.Pods[1].Metadata.Namespace != "default" {
+		t.Errorf("Namespace = %q, want default", c.Pods[1].Metadata.Namespace)
+	}
+	if c.Pods[0].Spec.Containers[0].Ports[1].ContainerPort != 9090 {
+		t.Errorf("ContainerPort = %d, want 9090", c.Pods[0].Spec.Containers[0].Ports[1].ContainerPort)
+	}
+
+	if err := NewCluster().Load([]byte("kind: Pod\nspec: [\n")); err == nil {
+		t.Error("Load() with invalid YAML: expected error")
+	}
+}
+
+func TestCluster_LoadFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "pods.yaml"), []byte(testManifests), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("# not a manifest"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewCluster()
+	if err := c.LoadFile(dir); err != nil {
+		t.Fatalf("LoadFile(dir) error = %v", err)
+	}
+	if len(c.Pods) != 3 {
+		t.Errorf("len(Pods) = %d, want 3", len(c.Pods))
+	}
+	if err := c.LoadFile(filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Error("LoadFile(missing) expected error")
+	}
+}
+
+func TestCluster_ScrapeTargets(t *testing.T) {
+	c := loadTestCluster(t)
+	sc := &prometheus.ScrapeConfig{
+		JobName: "k8s",
+		KubernetesSDConfigs: []*prometheus.KubernetesSD{
+			prometheus.NewKubernetesSD(prometheus.KubernetesRolePod),
+			prometheus.NewKubernetesSD(prometheus.KubernetesRoleService),
+		},
+	}
+	targets, err := c.ScrapeTargets(sc)
+	if err != nil {
+		t.Fatalf("ScrapeTargets() error = %v", err)
+	}
+	if len(targets) != 4 {
+		t.Errorf("len(targets) = %d, want 4", len(targets))
+	}
+
+	if _, err := c.ScrapeTargets(&prometheus.ScrapeConfig{JobName: "static"}); err == nil {
+		t.Error("ScrapeTargets() without kubernetes_sd_configs: expected error")
+	}
+	bad := &prometheus.ScrapeConfig{JobName: "bad", KubernetesSDConfigs: []*prometheus.KubernetesSD{{Role: "bogus"}}}
+	if _, err := c.ScrapeTargets(bad); err == nil {
+		t.Error("ScrapeTargets() with unknown role: expected error")
+	}
+}
+
+func TestCluster_Simulate(t *testing.T) {
+	c := loadTestCluster(t)
+	sc := &prometheus.ScrapeConfig{
+		JobName:             "kubernetes-pods",
+		KubernetesSDConfigs: []*prometheus.KubernetesSD{prometheus.NewKubernetesSD(prometheus.KubernetesRolePod)},
+		RelabelConfigs: []*prometheus.RelabelConfig{
+			prometheus.KeepByAnnotation("prometheus.io/scrape", "true"),
+			prometheus.KeepByLabel("__meta_kubernetes_pod_container_port_name", "metrics"),
+			prometheus.LabelMap("__meta_kubernetes_pod_label_(.+)", "$1"),
+			prometheus.RenameLabel("__meta_kubernetes_namespace", "namespace"),
+			prometheus.RenameLabel("__meta_kubernetes_pod_name", "pod"),
+		},
+	}
+
+	results, err := c.Simulate(sc, nil)
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("len(results) = %d, want 3", len(results))
+	}
+
+	var kept []map[string]string
+	for _, r := range results {
+		if r.Discovered["job"] != "kubernetes-pods" {
+			t.Errorf("Discovered job = %q, want kubernetes-pods", r.Discovered["job"])
+		}
+		if r.Keep {
+			kept = append(kept, r.Labels)
+		}
+	}
+	want := []map[string]string{{
+		"app":       "api",
+		"instance":  "10.0.0.5:9090",
+		"job":       "kubernetes-pods",
+		"namespace": "prod",
+		"pod":       "api-7d9f",
+	}}
+	if !reflect.DeepEqual(kept, want) {
+		t.Errorf("kept targets = %v, want %v", kept, want)
+	}
+}
@@ -0,0 +1,234 @@
+package kubesd
+
+// The types below mirror the subset of the Kubernetes API objects that
+// Prometheus reads when building discovery labels. Field names follow the
+// manifest (JSON) names so objects can be decoded from kubectl output or
+// hand-written YAML.
+
+// ObjectMeta is the metadata common to all objects.
+type ObjectMeta struct {
+	Name            string            `yaml:"name"`
+	Namespace       string            `yaml:"namespace,omitempty"`
+	UID             string            `yaml:"uid,omitempty"`
+	Labels          map[string]string `yaml:"labels,omitempty"`
+	Annotations     map[string]string `yaml:"annotations,omitempty"`
+	OwnerReferences []OwnerReference  `yaml:"ownerReferences,omitempty"`
+}
+
+// OwnerReference identifies an object's owner, such as a pod's ReplicaSet.
+type OwnerReference struct {
+	Kind       string `yaml:"kind"`
+	Name       string `yaml:"name"`
+	Controller *bool  `yaml:"controller,omitempty"`
+}
+
+// ObjectReference points from an endpoint to the object backing it.
+type ObjectReference struct {
+	Kind      string `yaml:"kind"`
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace,omitempty"`
+}
+
+// Pod is a Kubernetes Pod.
+type Pod struct {
+	Metadata ObjectMeta `yaml:"metadata"`
+	Spec     PodSpec    `yaml:"spec"`
+	Status   PodStatus  `yaml:"status"`
+}
+
+// PodSpec is the part of a pod's spec used for discovery.
+type PodSpec struct {
+	NodeName       string      `yaml:"nodeName,omitempty"`
+	Containers     []Container `yaml:"containers"`
+	InitContainers []Container `yaml:"initContainers,omitempty"`
+}
+
+// Container is a container in a pod.
+type Container struct {
+	Name  string          `yaml:"name"`
+	Image string          `yaml:"image,omitempty"`
+	Ports []ContainerPort `yaml:"ports,omitempty"`
+}
+
+// ContainerPort is a port exposed by a container.
+type ContainerPort struct {
+	Name          string `yaml:"name,omitempty"`
+	ContainerPort int32  `yaml:"containerPort"`
+	Protocol      string `yaml:"protocol,omitempty"`
+}
+
+// PodStatus is the observed state of a pod. Pods without a PodIP are not
+// discovered, as in a live cluster.
+type PodStatus struct {
+	Phase                 string            `yaml:"phase,omitempty"`
+	PodIP                 string            `yaml:"podIP,omitempty"`
+	HostIP                string            `yaml:"hostIP,omitempty"`
+	Conditions            []PodCondition    `yaml:"conditions,omitempty"`
+	ContainerStatuses     []ContainerStatus `yaml:"containerStatuses,omitempty"`
+	InitContainerStatuses []ContainerStatus `yaml:"initContainerStatuses,omitempty"`
+}
+
+// PodCondition is a pod condition such as Ready.
+type PodCondition struct {
+	Type   string `yaml:"type"`
+	Status string `yaml:"status"`
+}
+
+// ContainerStatus is the observed state of a container.
+type ContainerStatus struct {
+	Name        string `yaml:"name"`
+	ContainerID string `yaml:"containerID,omitempty"`
+}
+
+// Service is a Kubernetes Service.
+type Service struct {
+	Metadata ObjectMeta  `yaml:"metadata"`
+	Spec     ServiceSpec `yaml:"spec"`
+}
+
+// ServiceSpec is the part of a service's spec used for discovery.
+type ServiceSpec struct {
+	Type         string        `yaml:"type,omitempty"`
+	ClusterIP    string        `yaml:"clusterIP,omitempty"`
+	ExternalName string        `yaml:"externalName,omitempty"`
+	Ports        []ServicePort `yaml:"ports,omitempty"`
+}
+
+// ServicePort is a port exposed by a service.
+type ServicePort struct {
+	Name     string `yaml:"name,omitempty"`
+	Protocol string `yaml:"protocol,omitempty"`
+	Port     int32  `yaml:"port"`
+}
+
+// Endpoints is a Kubernetes Endpoints object.
+type Endpoints struct {
+	Metadata ObjectMeta       `yaml:"metadata"`
+	Subsets  []EndpointSubset `yaml:"subsets,omitempty"`
+}
+
+// EndpointSubset is a group of addresses sharing a set of ports.
+type EndpointSubset struct {
+	Addresses         []EndpointAddress `yaml:"addresses,omitempty"`
+	NotReadyAddresses []EndpointAddress `yaml:"notReadyAddresses,omitempty"`
+	Ports             []EndpointPort    `yaml:"ports,omitempty"`
+}
+
+// EndpointAddress is a single endpoint address.
+type EndpointAddress struct {
+	IP        string           `yaml:"ip"`
+	Hostname  string           `yaml:"hostname,omitempty"`
+	NodeName  string           `yaml:"nodeName,omitempty"`
+	TargetRef *ObjectReference `yaml:"targetRef,omitempty"`
+}
+
+// EndpointPort is a port of an endpoint subset.
+type EndpointPort struct {
+	Name        string `yaml:"name,omitempty"`
+	Protocol    string `yaml:"protocol,omitempty"`
+	AppProtocol string `yaml:"appProtocol,omitempty"`
+	Port        int32  `yaml:"port"`
+}
+
+// EndpointSlice is a discovery.k8s.io/v1 EndpointSlice.
+type EndpointSlice struct {
+	Metadata    ObjectMeta          `yaml:"metadata"`
+	AddressType string              `yaml:"addressType"`
+	Endpoints   []Endpoint          `yaml:"endpoints,omitempty"`
+	Ports       []EndpointSlicePort `yaml:"ports,omitempty"`
+}
+
+// Endpoint is an endpoint of an EndpointSlice.
+type Endpoint struct {
+	Addresses  []string           `yaml:"addresses"`
+	Conditions EndpointConditions `yaml:"conditions,omitempty"`
+	Hostname   string             `yaml:"hostname,omitempty"`
+	NodeName   string             `yaml:"nodeName,omitempty"`
+	Zone       string             `yaml:"zone,omitempty"`
+	TargetRef  *ObjectReference   `yaml:"targetRef,omitempty"`
+}
+
+// EndpointConditions are the conditions of an EndpointSlice endpoint.
+// Unset conditions produce no labels.
+type EndpointConditions struct {
+	Ready       *bool `yaml:"ready,omitempty"`
+	Serving     *bool `yaml:"serving,omitempty"`
+	Terminating *bool `yaml:"terminating,omitempty"`
+}
+
+// EndpointSlicePort is a port of an EndpointSlice.
+type EndpointSlicePort struct {
+	Name        string `yaml:"name,omitempty"`
+	Protocol    string `yaml:"protocol,omitempty"`
+	AppProtocol string `yaml:"appProtocol,omitempty"`
+	Port        *int32 `yaml:"port,omitempty"`
+}
+
+// Node is a Kubernetes Node.
+type Node struct {
+	Metadata ObjectMeta `yaml:"metadata"`
+	Spec     NodeSpec   `yaml:"spec"`
+	Status   NodeStatus `yaml:"status"`
+}
+
+// NodeSpec is the part of a node's spec used for discovery.
+type NodeSpec struct {
+	ProviderID string `yaml:"providerID,omitempty"`
+}
+
+// NodeStatus is the observed state of a node.
+type NodeStatus struct {
+	Addresses       []NodeAddress       `yaml:"addresses,omitempty"`
+	DaemonEndpoints NodeDaemonEndpoints `yaml:"daemonEndpoints,omitempty"`
+}
+
+// NodeAddress is an address of a node, such as its InternalIP.
+type NodeAddress struct {
+	Type    string `yaml:"type"`
+	Address string `yaml:"address"`
+}
+
+// NodeDaemonEndpoints holds the ports of daemons running on a node.
+type NodeDaemonEndpoints struct {
+	KubeletEndpoint DaemonEndpoint `yaml:"kubeletEndpoint,omitempty"`
+}
+
+// DaemonEndpoint is the port of a daemon. The Kubernetes API capitalizes
+// this field.
+type DaemonEndpoint struct {
+	Port int32 `yaml:"Port"`
+}
+
+// Ingress is a networking.k8s.io/v1 Ingress.
+type Ingress struct {
+	Metadata ObjectMeta  `yaml:"metadata"`
+	Spec     IngressSpec `yaml:"spec"`
+}
+
+// IngressSpec is the part of an ingress's spec used for discovery.
+type IngressSpec struct {
+	IngressClassName string        `yaml:"ingressClassName,omitempty"`
+	TLS              []IngressTLS  `yaml:"tls,omitempty"`
+	Rules            []IngressRule `yaml:"rules,omitempty"`
+}
+
+// IngressTLS lists hosts served over TLS.
+type IngressTLS struct {
+	Hosts []string `yaml:"hosts,omitempty"`
+}
+
+// IngressRule routes a host's paths.
+type IngressRule struct {
+	Host string                `yaml:"host,omitempty"`
+	HTTP *HTTPIngressRuleValue `yaml:"http,omitempty"`
+}
+
+// HTTPIngressRuleValue lists the paths of an ingress rule.
+type HTTPIngressRuleValue struct {
+	Paths []HTTPIngressPath `yaml:"paths"`
+}
+
+// HTTPIngressPath is a path of an ingress rule.
+type HTTPIngressPath struct {
+	Path string `yaml:"path,omitempty"`
+}
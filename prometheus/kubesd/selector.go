@@ -0,0 +1,166 @@
+package kubesd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// requirement is one term of a label or field selector.
+type requirement struct {
+	key    string
+	op     string // "=", "!=", "in", "notin", "exists" or "!"
+	values []string
+}
+
+// selector is a parsed label or field selector. An empty selector matches
+// everything.
+type selector []requirement
+
+// matches reports whether the value lookup satisfies every requirement.
+func (s selector) matches(lookup func(key string) (string, bool)) bool {
+	for _, r := range s {
+		value, ok := lookup(r.key)
+		switch r.op {
+		case "=":
+			if !ok || value != r.values[0] {
+				return false
+			}
+		case "!=":
+			if ok && value == r.values[0] {
+				return false
+			}
+		case "in":
+			if !ok || !contains(r.values, value) {
+				return false
+			}
+		case "notin":
+			if ok && contains(r.values, value) {
+				return false
+			}
+		case "exists":
+			if !ok {
+				return false
+			}
+		case "!":
+			if ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (s selector) matchesLabels(labels map[string]string) bool {
+	return s.matches(func(key string) (string, bool) {
+		v, ok := labels[key]
+		return v, ok
+	})
+}
+
+// parseLabelSelector parses a Kubernetes label selector such as
+// "app=api,tier in (web,worker),!canary".
+func parseLabelSelector(s string) (selector, error) {
+	var sel selector
+	for _, term := range splitSelector(s) {
+		var r requirement
+		switch {
+		case strings.HasPrefix(term, "!"):
+			r = requirement{key: strings.TrimSpace(term[1:]), op: "!"}
+		case strings.Contains(term, " notin "), strings.Contains(term, " in "):
+			op := "in"
+			key, set, _ := strings.Cut(term, " in ")
+			if k, s, ok := strings.Cut(term, " notin "); ok {
+				op, key, set = "notin", k, s
+			}
+			set = strings.TrimSpace(set)
+			if !strings.HasPrefix(set, "(") || !strings.HasSuffix(set, ")") {
+				return nil, fmt.Errorf("invalid label selector %q: expected a parenthesized value set", term)
+			}
+			r = requirement{key: strings.TrimSpace(key), op: op}
+			for _, v := range strings.Split(set[1:len(set)-1], ",") {
+				r.values = append(r.values, strings.TrimSpace(v))
+			}
+		default:
+			var err error
+			if r, err = parseEquality(term); err != nil {
+				return nil, fmt.Errorf("invalid label selector %q", term)
+			}
+			if r.op == "" {
+				r.op = "exists"
+			}
+		}
+		if r.key == "" {
+			return nil, fmt.Errorf("invalid label selector %q: missing key", term)
+		}
+		sel = append(sel, r)
+	}
+	return sel, nil
+}
+
+// parseFieldSelector parses a Kubernetes field selector such as
+// "metadata.name=api,status.phase!=Failed".
+func parseFieldSelector(s string) (selector, error) {
+	var sel selector
+	for _, term := range splitSelector(s) {
+		r, err := parseEquality(term)
+		if err != nil || r.op == "" || r.key == "" {
+			return nil, fmt.Errorf("invalid field selector %q", term)
+		}
+		sel = append(sel, r)
+	}
+	return sel, nil
+}
+
+// parseEquality parses key=value, key==value and key!=value terms. A bare
+// key is returned with an empty op.
+func parseEquality(term string) (requirement, error) {
+	for _, op := range []string{"!=", "==", "="} {
+		if key, value, ok := strings.Cut(term, op); ok {
+			if op == "==" {
+				op = "="
+			}
+			if strings.ContainsAny(value, "=!") {
+				return requirement{}, fmt.Errorf("invalid term %q", term)
+			}
+			return requirement{key: strings.TrimSpace(key), op: op, values: []string{strings.TrimSpace(value)}}, nil
+		}
+	}
+	return requirement{key: strings.TrimSpace(term)}, nil
+}
+
+// splitSelector splits a selector on commas outside parentheses.
+func splitSelector(s string) []string {
+	var terms []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	terms = append(terms, s[start:])
+
+	out := terms[:0]
+	for _, t := range terms {
+		if t = strings.TrimSpace(t); t != "" {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func contains(values []string, v string) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package kubesd
+
+import "testing"
+
+func TestParseLabelSelector(t *testing.T) {
+	labels := map[string]string{"app": "api", "tier": "web", "env": "prod"}
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"", true},
+		{"app=api", true},
+		{"app==api", true},
+		{"app=worker", false},
+		{"app!=worker", true},
+		{"missing!=x", true},
+		{"tier in (web, worker)", true},
+		{"tier in (worker)", false},
+		{"tier notin (worker)", true},
+		{"env notin (prod,staging)", false},
+		{"app", true},
+		{"missing", false},
+		{"!canary", true},
+		{"!app", false},
+		{"app=api, tier in (web,worker), !canary", true},
+		{"app=api,env=dev", false},
+	}
+	for _, tt := range tests {
+		sel, err := parseLabelSelector(tt.selector)
+		if err != nil {
+			t.Errorf("parseLabelSelector(%q) error = %v", tt.selector, err)
+			continue
+		}
+		if got := sel.matchesLabels(labels); got != tt.want {
+			t.Errorf("selector %q matches = %v, want %v", tt.selector, got, tt.want)
+		}
+	}
+}
+
+func TestParseLabelSelector_Invalid(t *testing.T) {
+	for _, s := range []string{"tier in web", "=api", "app=a=b"} {
+		if _, err := parseLabelSelector(s); err == nil {
+			t.Errorf("parseLabelSelector(%q) expected error", s)
+		}
+	}
+}
+
+func TestParseFieldSelector(t *testing.T) {
+	fields := map[string]string{"metadata.name": "api", "status.phase": "Running"}
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"metadata.name=api", true},
+		{"metadata.name==api", true},
+		{"status.phase!=Running", false},
+		{"metadata.name=api,status.phase!=Failed", true},
+	}
+	for _, tt := range tests {
+		sel, err := parseFieldSelector(tt.selector)
+		if err != nil {
+			t.Errorf("parseFieldSelector(%q) error = %v", tt.selector, err)
+			continue
+		}
+		if got := sel.matchesLabels(fields); got != tt.want {
+			t.Errorf("selector %q matches = %v, want %v", tt.selector, got, tt.want)
+		}
+	}
+
+	for _, s := range []string{"metadata.name", "!metadata.name"} {
+		if _, err := parseFieldSelector(s); err == nil {
+			t.Errorf("parseFieldSelector(%q) expected error", s)
+		}
+	}
+}
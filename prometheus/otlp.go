@@ -0,0 +1,92 @@
+package prometheus
+
+// OTLPTranslationStrategy controls how OTLP metric and attribute names are
+// translated to Prometheus names.
+type OTLPTranslationStrategy string
+
+// OTLP translation strategies.
+const (
+	// OTLPUnderscoreEscapingWithSuffixes escapes invalid characters to
+	// underscores and appends unit and type suffixes. This is the default.
+	OTLPUnderscoreEscapingWithSuffixes OTLPTranslationStrategy = "UnderscoreEscapingWithSuffixes"
+
+	// OTLPUnderscoreEscapingWithoutSuffixes escapes invalid characters to
+	// underscores without adding suffixes.
+	OTLPUnderscoreEscapingWithoutSuffixes OTLPTranslationStrategy = "UnderscoreEscapingWithoutSuffixes"
+
+	// OTLPNoUTF8EscapingWithSuffixes keeps UTF-8 names and appends suffixes.
+	OTLPNoUTF8EscapingWithSuffixes OTLPTranslationStrategy = "NoUTF8EscapingWithSuffixes"
+
+	// OTLPNoTranslation keeps names unchanged.
+	OTLPNoTranslation OTLPTranslationStrategy = "NoTranslation"
+)
+
+// OTLPConfig configures ingestion through the OTLP receiver.
+//
+// Example usage:
+//
+//	var OTLP = prometheus.NewOTLPConfig().
+//	    WithPromoteResourceAttributes("service.namespace", "deployment.environment")
+type OTLPConfig struct {
+	// PromoteResourceAttributes are resource attributes copied onto every
+	// series as labels.
+	// Mutually exclusive with PromoteAllResourceAttributes.
+	PromoteResourceAttributes []string `yaml:"promote_resource_attributes,omitempty"`
+
+	// PromoteAllResourceAttributes promotes every resource attribute except
+	// IgnoreResourceAttributes.
+	PromoteAllResourceAttributes bool `yaml:"promote_all_resource_attributes,omitempty"`
+
+	// IgnoreResourceAttributes are excluded when PromoteAllResourceAttributes
+	// is set.
+	IgnoreResourceAttributes []string `yaml:"ignore_resource_attributes,omitempty"`
+
+	// TranslationStrategy controls metric and label name translation.
+	// Defaults to UnderscoreEscapingWithSuffixes.
+	TranslationStrategy OTLPTranslationStrategy `yaml:"translation_strategy,omitempty"`
+
+	// KeepIdentifyingResourceAttributes keeps service.name,
+	// service.namespace and service.instance.id on target_info.
+	KeepIdentifyingResourceAttributes bool `yaml:"keep_identifying_resource_attributes,omitempty"`
+
+	// ConvertHistogramsToNHCB converts explicit bucket histograms to native
+	// histograms with custom buckets.
+	ConvertHistogramsToNHCB bool `yaml:"convert_histograms_to_nhcb,omitempty"`
+}
+
+// NewOTLPConfig creates a new empty OTLPConfig.
+func NewOTLPConfig() *OTLPConfig {
+	return &OTLPConfig{}
+}
+
+// WithPromoteResourceAttributes sets the resource attributes promoted to labels.
+func (o *OTLPConfig) WithPromoteResourceAttributes(attrs ...string) *OTLPConfig {
+	o.PromoteResourceAttributes = attrs
+	return o
+}
+
+// WithPromoteAllResourceAttributes promotes every resource attribute except ignored.
+func (o *OTLPConfig) WithPromoteAllResourceAttributes(ignored ...string) *OTLPConfig {
+	o.PromoteAllResourceAttributes = true
+	o.IgnoreResourceAttributes = ignored
+	return o
+}
+
+// WithTranslationStrategy sets the name translation strategy.
+func (o *OTLPConfig) WithTranslationStrategy(strategy OTLPTranslationStrategy) *OTLPConfig {
+	o.TranslationStrategy = strategy
+	return o
+}
+
+// WithKeepIdentifyingResourceAttributes keeps identifying attributes on target_info.
+func (o *OTLPConfig) WithKeepIdentifyingResourceAttributes() *OTLPConfig {
+	o.KeepIdentifyingResourceAttributes = true
+	return o
+}
+
+// WithConvertHistogramsToNHCB converts classic histograms to native histograms
+// with custom buckets.
+func (o *OTLPConfig) WithConvertHistogramsToNHCB() *OTLPConfig {
+	o.ConvertHistogramsToNHCB = true
+	return o
+}
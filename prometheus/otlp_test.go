@@ -0,0 +1,46 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestOTLPConfig_Serialize(t *testing.T) {
+	o := NewOTLPConfig().
+		WithPromoteResourceAttributes("service.namespace", "deployment.environment").
+		WithTranslationStrategy(OTLPNoUTF8EscapingWithSuffixes).
+		WithKeepIdentifyingResourceAttributes()
+
+	data, err := yaml.Marshal(o)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"promote_resource_attributes:",
+		"- service.namespace",
+		"- deployment.environment",
+		"translation_strategy: NoUTF8EscapingWithSuffixes",
+		"keep_identifying_resource_attributes: true",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestOTLPConfig_WithPromoteAllResourceAttributes(t *testing.T) {
+	o := NewOTLPConfig().WithPromoteAllResourceAttributes("process.pid")
+
+	if !o.PromoteAllResourceAttributes {
+		t.Error("PromoteAllResourceAttributes = false, want true")
+	}
+	if len(o.IgnoreResourceAttributes) != 1 || o.IgnoreResourceAttributes[0] != "process.pid" {
+		t.Errorf("IgnoreResourceAttributes = %v, want [process.pid]", o.IgnoreResourceAttributes)
+	}
+}
@@ -274,10 +274,9 @@ func SetPort(annotation string) *RelabelConfig {
 	metaLabel := "__meta_kubernetes_pod_annotation_" + sanitizeAnnotation(annotation)
 	return &RelabelConfig{
 		SourceLabels: []string{metaLabel, "__meta_kubernetes_pod_ip"},
-		Separator:    ":",
 		TargetLabel:  "__address__",
-		Regex:        "(.+):(.+);(.+)",
-		Replacement:  "$3:$1",
+		Regex:        "(.+);(.+)",
+		Replacement:  "$2:$1",
 		Action:       string(RelabelReplace),
 	}
 }
@@ -0,0 +1,266 @@
+package prometheus
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RelabelStep records the effect of one relabel config applied by
+// TraceRelabel.
+type RelabelStep struct {
+	// Index is the position of the config in the chain.
+	Index int
+
+	// Config is the relabel config applied.
+	Config *RelabelConfig
+
+	// Labels are the labels after the step. They are nil if the step
+	// dropped the label set.
+	Labels map[string]string
+
+	// Keep is false if the step dropped the label set.
+	Keep bool
+
+	// Err is set if the config is invalid; the labels are left unchanged.
+	Err error
+}
+
+// ApplyRelabel applies relabel configs to a label set in order, following
+// Prometheus's relabeling semantics, and returns the resulting labels. keep
+// is false if the label set was dropped by a keep, drop, keepequal or
+// dropequal action. The input map is not modified.
+//
+// Invalid configs (see RelabelConfig.Validate) are skipped.
+func ApplyRelabel(configs []*RelabelConfig, labels map[string]string) (result map[string]string, keep bool) {
+	steps := TraceRelabel(configs, labels)
+	if len(steps) == 0 {
+		return copyLabels(labels), true
+	}
+	last := steps[len(steps)-1]
+	return last.Labels, last.Keep
+}
+
+// TraceRelabel applies relabel configs like ApplyRelabel and returns one
+// step per config applied. Evaluation stops at the first step that drops
+// the label set.
+func TraceRelabel(configs []*RelabelConfig, labels map[string]string) []RelabelStep {
+	current := copyLabels(labels)
+	var steps []RelabelStep
+	for i, rc := range configs {
+		if rc == nil {
+			continue
+		}
+		step := RelabelStep{Index: i, Config: rc, Keep: true}
+		if err := rc.Validate(); err != nil {
+			step.Err = err
+		} else {
+			current, step.Keep = rc.apply(current)
+		}
+		if step.Keep {
+			step.Labels = copyLabels(current)
+		}
+		steps = append(steps, step)
+		if !step.Keep {
+			break
+		}
+	}
+	return steps
+}
+
+// apply applies a valid relabel config to lbls in place, returning false if
+// the label set is dropped.
+func (r *RelabelConfig) apply(lbls map[string]string) (map[string]string, bool) {
+	action := RelabelAction(r.Action)
+	if action == "" {
+		action = RelabelReplace
+	}
+	expr := r.Regex
+	if expr == "" {
+		expr = "(.*)"
+	}
+	regex := regexp.MustCompile("^(?:" + expr + ")$")
+	separator := r.Separator
+	if separator == "" {
+		separator = ";"
+	}
+	replacement := r.Replacement
+	if replacement == "" {
+		replacement = "$1"
+	}
+
+	values := make([]string, len(r.SourceLabels))
+	for i, name := range r.SourceLabels {
+		values[i] = lbls[name]
+	}
+	val := strings.Join(values, separator)
+
+	switch action {
+	case RelabelDrop:
+		if regex.MatchString(val) {
+			return nil, false
+		}
+	case RelabelKeep:
+		if !regex.MatchString(val) {
+			return nil, false
+		}
+	case RelabelDropEqual:
+		if lbls[r.TargetLabel] == val {
+			return nil, false
+		}
+	case RelabelKeepEqual:
+		if lbls[r.TargetLabel] != val {
+			return nil, false
+		}
+	case RelabelReplace:
+		indexes := regex.FindStringSubmatchIndex(val)
+		if indexes == nil {
+			break
+		}
+		target := string(regex.ExpandString(nil, r.TargetLabel, val, indexes))
+		if !IsValidLabelName(target) {
+			break
+		}
+		setLabel(lbls, target, string(regex.ExpandString(nil, replacement, val, indexes)))
+	case RelabelLowercase:
+		setLabel(lbls, r.TargetLabel, strings.ToLower(val))
+	case RelabelUppercase:
+		setLabel(lbls, r.TargetLabel, strings.ToUpper(val))
+	case RelabelHashMod:
+		// Prometheus hashes with MD5 and keeps the low 8 bytes.
+		hash := md5.Sum([]byte(val))
+		mod := binary.BigEndian.Uint64(hash[8:]) % r.Modulus
+		setLabel(lbls, r.TargetLabel, fmt.Sprintf("%d", mod))
+	case RelabelLabelMap:
+		mapped := make(map[string]string)
+		for name, value := range lbls {
+			if regex.MatchString(name) {
+				mapped[regex.ReplaceAllString(name, replacement)] = value
+			}
+		}
+		for name, value := range mapped {
+			setLabel(lbls, name, value)
+		}
+	case RelabelLabelDrop:
+		for name := range lbls {
+			if regex.MatchString(name) {
+				delete(lbls, name)
+			}
+		}
+	case RelabelLabelKeep:
+		for name := range lbls {
+			if !regex.MatchString(name) {
+				delete(lbls, name)
+			}
+		}
+	}
+	return lbls, true
+}
+
+// setLabel sets a label, deleting it if value is empty: Prometheus treats
+// empty label values as absent.
+func setLabel(lbls map[string]string, name, value string) {
+	if value == "" {
+		delete(lbls, name)
+		return
+	}
+	lbls[name] = value
+}
+
+func copyLabels(lbls map[string]string) map[string]string {
+	out := make(map[string]string, len(lbls))
+	for k, v := range lbls {
+		out[k] = v
+	}
+	return out
+}
+
+// DiscoveredLabels returns the labels Prometheus attaches to a discovered
+// target before relabeling: the target's own labels plus job,
+// __scheme__, __metrics_path__, __scrape_interval__, __scrape_timeout__ and
+// __param_<name> defaults from the scrape config. global supplies the
+// interval and timeout defaults and may be nil.
+func (s *ScrapeConfig) DiscoveredLabels(target map[string]string, global *GlobalConfig) map[string]string {
+	lbls := copyLabels(target)
+
+	interval := s.ScrapeInterval
+	if interval == 0 && global != nil {
+		interval = global.ScrapeInterval
+	}
+	if interval == 0 {
+		interval = DefaultScrapeInterval
+	}
+	timeout := s.ScrapeTimeout
+	if timeout == 0 {
+		timeout = DefaultScrapeTimeout
+		if global != nil && global.ScrapeTimeout != 0 {
+			timeout = global.ScrapeTimeout
+		}
+		if timeout > interval {
+			timeout = interval
+		}
+	}
+	metricsPath := s.MetricsPath
+	if metricsPath == "" {
+		metricsPath = "/metrics"
+	}
+	scheme := s.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+
+	defaults := map[string]string{
+		"job":                 s.JobName,
+		"__scrape_interval__": interval.String(),
+		"__scrape_timeout__":  timeout.String(),
+		"__metrics_path__":    metricsPath,
+		"__scheme__":          scheme,
+	}
+	for name, values := range s.Params {
+		if len(values) > 0 {
+			defaults["__param_"+name] = values[0]
+		}
+	}
+	for name, value := range defaults {
+		if lbls[name] == "" {
+			setLabel(lbls, name, value)
+		}
+	}
+	return lbls
+}
+
+// FinalizeTargetLabels completes relabeled target labels as Prometheus
+// does: the target is dropped if it has no __address__, __meta_* labels are
+// removed, instance defaults to __address__, and the remaining labels
+// starting with "__" are internal and not attached to scraped series.
+// keep is false if the target is dropped.
+func FinalizeTargetLabels(lbls map[string]string) (result map[string]string, keep bool) {
+	addr := lbls["__address__"]
+	if addr == "" {
+		return nil, false
+	}
+	result = make(map[string]string)
+	for name, value := range lbls {
+		if !strings.HasPrefix(name, "__") {
+			result[name] = value
+		}
+	}
+	if result["instance"] == "" {
+		result["instance"] = addr
+	}
+	return result, true
+}
+
+// TargetLabels returns the labels Prometheus attaches to series scraped
+// from a discovered target: DiscoveredLabels, then the job's
+// relabel_configs, then FinalizeTargetLabels. keep is false if the target
+// is dropped.
+func (s *ScrapeConfig) TargetLabels(target map[string]string, global *GlobalConfig) (map[string]string, bool) {
+	lbls, keep := ApplyRelabel(s.RelabelConfigs, s.DiscoveredLabels(target, global))
+	if !keep {
+		return nil, false
+	}
+	return FinalizeTargetLabels(lbls)
+}
@@ -0,0 +1,286 @@
+package prometheus
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestApplyRelabel_Actions(t *testing.T) {
+	tests := []struct {
+		name     string
+		configs  []*RelabelConfig
+		input    map[string]string
+		want     map[string]string
+		wantKeep bool
+	}{
+		{
+			name:     "replace defaults",
+			configs:  []*RelabelConfig{{SourceLabels: []string{"a"}, TargetLabel: "b"}},
+			input:    map[string]string{"a": "foo"},
+			want:     map[string]string{"a": "foo", "b": "foo"},
+			wantKeep: true,
+		},
+		{
+			name:     "replace joins source labels",
+			configs:  []*RelabelConfig{Replace([]string{"a", "b"}, "c", "(.*);(.*)", "$2-$1")},
+			input:    map[string]string{"a": "foo", "b": "bar"},
+			want:     map[string]string{"a": "foo", "b": "bar", "c": "bar-foo"},
+			wantKeep: true,
+		},
+		{
+			name:     "replace no match leaves labels",
+			configs:  []*RelabelConfig{Replace([]string{"a"}, "b", "bar", "x")},
+			input:    map[string]string{"a": "foo"},
+			want:     map[string]string{"a": "foo"},
+			wantKeep: true,
+		},
+		{
+			name:     "replace regex is anchored",
+			configs:  []*RelabelConfig{Replace([]string{"a"}, "b", "fo", "x")},
+			input:    map[string]string{"a": "foo"},
+			want:     map[string]string{"a": "foo"},
+			wantKeep: true,
+		},
+		{
+			name:     "replace expands target label",
+			configs:  []*RelabelConfig{Replace([]string{"a"}, "${1}_name", "(.*)", "v")},
+			input:    map[string]string{"a": "pod"},
+			want:     map[string]string{"a": "pod", "pod_name": "v"},
+			wantKeep: true,
+		},
+		{
+			name:     "replace with empty value deletes",
+			configs:  []*RelabelConfig{Replace([]string{"missing"}, "a", "(.*)", "$1")},
+			input:    map[string]string{"a": "foo"},
+			want:     map[string]string{},
+			wantKeep: true,
+		},
+		{
+			name:     "keep matches",
+			configs:  []*RelabelConfig{KeepByLabel("a", "f.*")},
+			input:    map[string]string{"a": "foo"},
+			want:     map[string]string{"a": "foo"},
+			wantKeep: true,
+		},
+		{
+			name:     "keep drops",
+			configs:  []*RelabelConfig{KeepByLabel("a", "bar")},
+			input:    map[string]string{"a": "foo"},
+			wantKeep: false,
+		},
+		{
+			name:     "drop",
+			configs:  []*RelabelConfig{DropByLabel("a", "foo")},
+			input:    map[string]string{"a": "foo"},
+			wantKeep: false,
+		},
+		{
+			name:     "hashmod",
+			configs:  []*RelabelConfig{HashMod("a", "d", 1000)},
+			input:    map[string]string{"a": "baz"},
+			want:     map[string]string{"a": "baz", "d": "976"},
+			wantKeep: true,
+		},
+		{
+			name:     "labelmap",
+			configs:  []*RelabelConfig{LabelMap("__meta_kubernetes_pod_label_(.+)", "$1")},
+			input:    map[string]string{"__meta_kubernetes_pod_label_app": "api", "__meta_kubernetes_pod_label_tier": "web"},
+			want:     map[string]string{"__meta_kubernetes_pod_label_app": "api", "__meta_kubernetes_pod_label_tier": "web", "app": "api", "tier": "web"},
+			wantKeep: true,
+		},
+		{
+			name:     "labeldrop",
+			configs:  []*RelabelConfig{DropLabels("tmp_.*")},
+			input:    map[string]string{"a": "foo", "tmp_x": "1", "tmp_y": "2"},
+			want:     map[string]string{"a": "foo"},
+			wantKeep: true,
+		},
+		{
+			name:     "labelkeep",
+			configs:  []*RelabelConfig{KeepLabels("a|b")},
+			input:    map[string]string{"a": "1", "b": "2", "c": "3"},
+			want:     map[string]string{"a": "1", "b": "2"},
+			wantKeep: true,
+		},
+		{
+			name:     "lowercase",
+			configs:  []*RelabelConfig{NewRelabelConfig(RelabelLowercase).WithSourceLabels("a").WithTargetLabel("b")},
+			input:    map[string]string{"a": "FooBar"},
+			want:     map[string]string{"a": "FooBar", "b": "foobar"},
+			wantKeep: true,
+		},
+		{
+			name:     "uppercase",
+			configs:  []*RelabelConfig{NewRelabelConfig(RelabelUppercase).WithSourceLabels("a").WithTargetLabel("b")},
+			input:    map[string]string{"a": "FooBar"},
+			want:     map[string]string{"a": "FooBar", "b": "FOOBAR"},
+			wantKeep: true,
+		},
+		{
+			name:     "keepequal keeps",
+			configs:  []*RelabelConfig{NewRelabelConfig(RelabelKeepEqual).WithSourceLabels("a").WithTargetLabel("b")},
+			input:    map[string]string{"a": "x", "b": "x"},
+			want:     map[string]string{"a": "x", "b": "x"},
+			wantKeep: true,
+		},
+		{
+			name:     "keepequal drops",
+			configs:  []*RelabelConfig{NewRelabelConfig(RelabelKeepEqual).WithSourceLabels("a").WithTargetLabel("b")},
+			input:    map[string]string{"a": "x", "b": "y"},
+			wantKeep: false,
+		},
+		{
+			name:     "dropequal drops",
+			configs:  []*RelabelConfig{NewRelabelConfig(RelabelDropEqual).WithSourceLabels("a").WithTargetLabel("b")},
+			input:    map[string]string{"a": "x", "b": "x"},
+			wantKeep: false,
+		},
+		{
+			name:     "dropequal keeps",
+			configs:  []*RelabelConfig{NewRelabelConfig(RelabelDropEqual).WithSourceLabels("a").WithTargetLabel("b")},
+			input:    map[string]string{"a": "x", "b": "y"},
+			want:     map[string]string{"a": "x", "b": "y"},
+			wantKeep: true,
+		},
+		{
+			name:     "invalid config skipped",
+			configs:  []*RelabelConfig{{Action: "bogus"}, {SourceLabels: []string{"a"}, TargetLabel: "b"}},
+			input:    map[string]string{"a": "foo"},
+			want:     map[string]string{"a": "foo", "b": "foo"},
+			wantKeep: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, keep := ApplyRelabel(tt.configs, tt.input)
+			if keep != tt.wantKeep {
+				t.Fatalf("keep = %v, want %v", keep, tt.wantKeep)
+			}
+			if keep && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("labels = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyRelabel_DoesNotModifyInput(t *testing.T) {
+	input := map[string]string{"a": "foo"}
+	ApplyRelabel([]*RelabelConfig{RenameLabel("a", "b"), DropLabels("a")}, input)
+	if !reflect.DeepEqual(input, map[string]string{"a": "foo"}) {
+		t.Errorf("input = %v, want unchanged", input)
+	}
+}
+
+func TestApplyRelabel_KubernetesChain(t *testing.T) {
+	configs := []*RelabelConfig{
+		KeepByAnnotation("prometheus.io/scrape", "true"),
+		SetFromAnnotation("prometheus.io/path", "__metrics_path__"),
+		SetPort("prometheus.io/port"),
+		LabelMap("__meta_kubernetes_pod_label_(.+)", "$1"),
+		RenameLabel("__meta_kubernetes_namespace", "namespace"),
+	}
+	target := map[string]string{
+		"__address__":                                           "10.0.0.5:8080",
+		"__meta_kubernetes_namespace":                           "prod",
+		"__meta_kubernetes_pod_ip":                              "10.0.0.5",
+		"__meta_kubernetes_pod_label_app":                       "api",
+		"__meta_kubernetes_pod_annotation_prometheus_io_scrape": "true",
+		"__meta_kubernetes_pod_annotation_prometheus_io_path":   "/custom",
+		"__meta_kubernetes_pod_annotation_prometheus_io_port":   "9090",
+	}
+
+	sc := &ScrapeConfig{JobName: "pods", RelabelConfigs: configs}
+	got, keep := sc.TargetLabels(target, nil)
+	if !keep {
+		t.Fatal("target dropped, want kept")
+	}
+	want := map[string]string{
+		"job":       "pods",
+		"instance":  "10.0.0.5:9090",
+		"namespace": "prod",
+		"app":       "api",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TargetLabels = %v, want %v", got, want)
+	}
+
+	lbls, _ := ApplyRelabel(configs, sc.DiscoveredLabels(target, nil))
+	if lbls["__metrics_path__"] != "/custom" {
+		t.Errorf("__metrics_path__ = %q, want /custom", lbls["__metrics_path__"])
+	}
+
+	target["__meta_kubernetes_pod_annotation_prometheus_io_scrape"] = "false"
+	if _, keep := sc.TargetLabels(target, nil); keep {
+		t.Error("target kept, want dropped by scrape annotation")
+	}
+}
+
+func TestTraceRelabel(t *testing.T) {
+	configs := []*RelabelConfig{
+		RenameLabel("a", "b"),
+		nil,
+		DropByLabel("b", "foo"),
+		DropLabels("a"),
+	}
+	steps := TraceRelabel(configs, map[string]string{"a": "foo"})
+	if len(steps) != 2 {
+		t.Fatalf("len(steps) = %d, want 2", len(steps))
+	}
+	if steps[0].Index != 0 || !steps[0].Keep || steps[0].Labels["b"] != "foo" {
+		t.Errorf("steps[0] = %+v", steps[0])
+	}
+	if steps[1].Index != 2 || steps[1].Keep || steps[1].Labels != nil {
+		t.Errorf("steps[1] = %+v", steps[1])
+	}
+
+	steps = TraceRelabel([]*RelabelConfig{{Action: "bogus"}}, map[string]string{"a": "foo"})
+	if len(steps) != 1 || steps[0].Err == nil || !steps[0].Keep {
+		t.Errorf("steps = %+v, want one step with an error", steps)
+	}
+}
+
+func TestScrapeConfig_DiscoveredLabels(t *testing.T) {
+	global := &GlobalConfig{ScrapeInterval: 30 * Second, ScrapeTimeout: 45 * Second}
+	sc := &ScrapeConfig{JobName: "api", Params: map[string][]string{"module": {"http_2xx", "tcp"}}}
+
+	got := sc.DiscoveredLabels(map[string]string{"__address__": "host:80", "__scheme__": "https"}, global)
+	want := map[string]string{
+		"__address__":         "host:80",
+		"__scheme__":          "https",
+		"__metrics_path__":    "/metrics",
+		"__scrape_interval__": "30s",
+		"__scrape_timeout__":  "30s",
+		"__param_module":      "http_2xx",
+		"job":                 "api",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiscoveredLabels = %v, want %v", got, want)
+	}
+
+	sc = &ScrapeConfig{JobName: "api", ScrapeInterval: 15 * Second, MetricsPath: "/m"}
+	got = sc.DiscoveredLabels(map[string]string{}, nil)
+	if got["__scrape_interval__"] != "15s" || got["__scrape_timeout__"] != "10s" || got["__metrics_path__"] != "/m" {
+		t.Errorf("DiscoveredLabels = %v", got)
+	}
+}
+
+func TestFinalizeTargetLabels(t *testing.T) {
+	if _, keep := FinalizeTargetLabels(map[string]string{"job": "api"}); keep {
+		t.Error("keep = true without __address__, want false")
+	}
+
+	got, keep := FinalizeTargetLabels(map[string]string{
+		"__address__":                 "host:80",
+		"__meta_kubernetes_namespace": "prod",
+		"job":                         "api",
+		"instance":                    "custom",
+	})
+	if !keep {
+		t.Fatal("keep = false, want true")
+	}
+	want := map[string]string{"job": "api", "instance": "custom"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FinalizeTargetLabels = %v, want %v", got, want)
+	}
+}
@@ -5,7 +5,8 @@ package prometheus
 type Secret string
 
 // SecretFromEnv creates a Secret reference from an environment variable.
-// The actual value should be loaded at runtime.
+// The reference is resolved at build time according to the --secrets mode;
+// see the secrets package.
 func SecretFromEnv(envVar string) Secret {
 	return Secret("${" + envVar + "}")
 }
@@ -89,6 +90,12 @@ func (r *RemoteWriteConfig) WithBasicAuth(username string, password Secret) *Rem
 	return r
 }
 
+// WithBasicAuthFile sets basic authentication with the password read from a file.
+func (r *RemoteWriteConfig) WithBasicAuthFile(username, passwordFile string) *RemoteWriteConfig {
+	r.BasicAuth = &BasicAuth{Username: username, PasswordFile: passwordFile}
+	return r
+}
+
 // WithBearerToken sets bearer token authentication.
 func (r *RemoteWriteConfig) WithBearerToken(token Secret) *RemoteWriteConfig {
 	r.BearerToken = token
@@ -125,6 +132,76 @@ func (r *RemoteWriteConfig) WithProxyURL(url string) *RemoteWriteConfig {
 	return r
 }
 
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (r *RemoteWriteConfig) WithNoProxy(hosts string) *RemoteWriteConfig {
+	r.NoProxy = hosts
+	return r
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (r *RemoteWriteConfig) WithProxyFromEnvironment() *RemoteWriteConfig {
+	r.ProxyFromEnvironment = true
+	return r
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (r *RemoteWriteConfig) WithProxyConnectHeader(name string, values ...Secret) *RemoteWriteConfig {
+	if r.ProxyConnectHeader == nil {
+		r.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	r.ProxyConnectHeader[name] = values
+	return r
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (r *RemoteWriteConfig) WithFollowRedirects(follow bool) *RemoteWriteConfig {
+	r.FollowRedirects = &follow
+	return r
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (r *RemoteWriteConfig) WithEnableHTTP2(enable bool) *RemoteWriteConfig {
+	r.EnableHTTP2 = &enable
+	return r
+}
+
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (r *RemoteWriteConfig) WithAuthorization(credentials Secret) *RemoteWriteConfig {
+	r.Authorization = NewAuthorization(credentials)
+	return r
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (r *RemoteWriteConfig) WithAuthorizationFile(path string) *RemoteWriteConfig {
+	r.Authorization = NewAuthorizationFile(path)
+	return r
+}
+
+// WithOAuth2 sets OAuth 2.0 client credentials authentication.
+func (r *RemoteWriteConfig) WithOAuth2(oauth2 *OAuth2) *RemoteWriteConfig {
+	r.OAuth2 = oauth2
+	return r
+}
+
+// WithSigV4 signs requests with AWS Signature Version 4.
+func (r *RemoteWriteConfig) WithSigV4(sigv4 *SigV4Config) *RemoteWriteConfig {
+	r.SigV4 = sigv4
+	return r
+}
+
+// WithAzureAD authenticates requests with Azure AD.
+func (r *RemoteWriteConfig) WithAzureAD(azureAD *AzureADConfig) *RemoteWriteConfig {
+	r.AzureAD = azureAD
+	return r
+}
+
+// WithGoogleIAM authenticates requests with Google Cloud IAM.
+func (r *RemoteWriteConfig) WithGoogleIAM(googleIAM *GoogleIAMConfig) *RemoteWriteConfig {
+	r.GoogleIAM = googleIAM
+	return r
+}
+
 // NewRemoteRead creates a new RemoteReadConfig with the given URL.
 func NewRemoteRead(url string) *RemoteReadConfig {
 	return &RemoteReadConfig{
@@ -165,6 +242,12 @@ func (r *RemoteReadConfig) WithBasicAuth(username string, password Secret) *Remo
 	return r
 }
 
+// WithBasicAuthFile sets basic authentication with the password read from a file.
+func (r *RemoteReadConfig) WithBasicAuthFile(username, passwordFile string) *RemoteReadConfig {
+	r.BasicAuth = &BasicAuth{Username: username, PasswordFile: passwordFile}
+	return r
+}
+
 // WithBearerToken sets bearer token authentication.
 func (r *RemoteReadConfig) WithBearerToken(token Secret) *RemoteReadConfig {
 	r.BearerToken = token
@@ -195,12 +278,64 @@ func (r *RemoteReadConfig) WithProxyURL(url string) *RemoteReadConfig {
 	return r
 }
 
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (r *RemoteReadConfig) WithNoProxy(hosts string) *RemoteReadConfig {
+	r.NoProxy = hosts
+	return r
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (r *RemoteReadConfig) WithProxyFromEnvironment() *RemoteReadConfig {
+	r.ProxyFromEnvironment = true
+	return r
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (r *RemoteReadConfig) WithProxyConnectHeader(name string, values ...Secret) *RemoteReadConfig {
+	if r.ProxyConnectHeader == nil {
+		r.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	r.ProxyConnectHeader[name] = values
+	return r
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (r *RemoteReadConfig) WithFollowRedirects(follow bool) *RemoteReadConfig {
+	r.FollowRedirects = &follow
+	return r
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (r *RemoteReadConfig) WithEnableHTTP2(enable bool) *RemoteReadConfig {
+	r.EnableHTTP2 = &enable
+	return r
+}
+
 // WithFilterExternalLabels enables filtering of external labels.
 func (r *RemoteReadConfig) WithFilterExternalLabels(filter bool) *RemoteReadConfig {
 	r.FilterExternalLabels = filter
 	return r
 }
 
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (r *RemoteReadConfig) WithAuthorization(credentials Secret) *RemoteReadConfig {
+	r.Authorization = NewAuthorization(credentials)
+	return r
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (r *RemoteReadConfig) WithAuthorizationFile(path string) *RemoteReadConfig {
+	r.Authorization = NewAuthorizationFile(path)
+	return r
+}
+
+// WithOAuth2 sets OAuth 2.0 client credentials authentication.
+func (r *RemoteReadConfig) WithOAuth2(oauth2 *OAuth2) *RemoteReadConfig {
+	r.OAuth2 = oauth2
+	return r
+}
+
 // NewQueueConfig creates a new QueueConfig with sensible defaults.
 func NewQueueConfig() *QueueConfig {
 	return &QueueConfig{}
@@ -0,0 +1,27 @@
+package prometheus
+
+// RuntimeConfig configures the Go runtime of the Prometheus server.
+//
+// The memory limit (GOMEMLIMIT) is not part of prometheus.yml: Prometheus
+// derives it from the container memory limit, tuned with the
+// --auto-gomemlimit.ratio flag.
+//
+// Example usage:
+//
+//	var Runtime = prometheus.NewRuntimeConfig().WithGOGC(50)
+type RuntimeConfig struct {
+	// GOGC is the garbage collector target percentage.
+	// Defaults to 75.
+	GOGC *int `yaml:"gogc,omitempty"`
+}
+
+// NewRuntimeConfig creates a new empty RuntimeConfig.
+func NewRuntimeConfig() *RuntimeConfig {
+	return &RuntimeConfig{}
+}
+
+// WithGOGC sets the garbage collector target percentage.
+func (r *RuntimeConfig) WithGOGC(percent int) *RuntimeConfig {
+	r.GOGC = &percent
+	return r
+}
@@ -0,0 +1,27 @@
+package prometheus
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestRuntimeConfig_Serialize(t *testing.T) {
+	data, err := yaml.Marshal(NewRuntimeConfig().WithGOGC(50))
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+	if string(data) != "gogc: 50\n" {
+		t.Errorf("yaml.Marshal() = %q, want %q", string(data), "gogc: 50\n")
+	}
+}
+
+func TestRuntimeConfig_Unset(t *testing.T) {
+	data, err := yaml.Marshal(NewRuntimeConfig())
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+	if string(data) != "{}\n" {
+		t.Errorf("Empty RuntimeConfig = %q, want {}", string(data))
+	}
+}
@@ -22,6 +22,14 @@ type ScrapeConfig struct {
 	// Defaults to 'http' if not specified.
 	Scheme string `yaml:"scheme,omitempty"`
 
+	// ScrapeProtocols is the content negotiation order for this job.
+	// Overrides the global default if set.
+	ScrapeProtocols []ScrapeProtocol `yaml:"scrape_protocols,omitempty"`
+
+	// FallbackScrapeProtocol is used when the target returns a missing or
+	// unparsable Content-Type.
+	FallbackScrapeProtocol ScrapeProtocol `yaml:"fallback_scrape_protocol,omitempty"`
+
 	// HonorLabels controls how conflicts between server and scrape labels are handled.
 	// If true, label conflicts are resolved by keeping label values from the scraped data.
 	HonorLabels bool `yaml:"honor_labels,omitempty"`
@@ -57,14 +65,28 @@ type ScrapeConfig struct {
 	// LabelValueLengthLimit is the per-scrape limit on the length of label values.
 	LabelValueLengthLimit uint `yaml:"label_value_length_limit,omitempty"`
 
-	// BasicAuth configures HTTP Basic authentication.
-	BasicAuth *BasicAuth `yaml:"basic_auth,omitempty"`
+	// ScrapeNativeHistograms enables ingestion of native histograms.
+	ScrapeNativeHistograms *bool `yaml:"scrape_native_histograms,omitempty"`
+
+	// AlwaysScrapeClassicHistograms also ingests the classic form of
+	// histograms exposed as native histograms.
+	AlwaysScrapeClassicHistograms bool `yaml:"always_scrape_classic_histograms,omitempty"`
+
+	// NativeHistogramBucketLimit is the per-histogram limit on buckets;
+	// resolution is reduced until it fits. Defaults to 0 (no limit).
+	NativeHistogramBucketLimit uint `yaml:"native_histogram_bucket_limit,omitempty"`
 
-	// TLSConfig configures TLS settings.
-	TLSConfig *TLSConfig `yaml:"tls_config,omitempty"`
+	// NativeHistogramMinBucketFactor is the minimum growth factor between
+	// buckets; resolution is reduced until it is met.
+	NativeHistogramMinBucketFactor float64 `yaml:"native_histogram_min_bucket_factor,omitempty"`
 
-	// ProxyURL is the proxy URL.
-	ProxyURL string `yaml:"proxy_url,omitempty"`
+	// ConvertClassicHistogramsToNHCB converts classic histograms to native
+	// histograms with custom buckets.
+	ConvertClassicHistogramsToNHCB bool `yaml:"convert_classic_histograms_to_nhcb,omitempty"`
+
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// scrape requests.
+	HTTPClientConfig `yaml:",inline"`
 
 	// KubernetesSDConfigs defines Kubernetes service discovery configurations.
 	KubernetesSDConfigs []*KubernetesSD `yaml:"kubernetes_sd_configs,omitempty"`
@@ -80,48 +102,85 @@ type ScrapeConfig struct {
 
 	// DNSSDConfigs defines DNS-based service discovery configurations.
 	DNSSDConfigs []*DNSSD `yaml:"dns_sd_configs,omitempty"`
-}
 
-// StaticConfig represents a static target group with an optional set of labels.
-type StaticConfig struct {
-	// Targets is the list of hosts to scrape.
-	// Each target should be of the form host:port.
-	Targets []string `yaml:"targets"`
+	// HTTPSDConfigs defines HTTP-based service discovery configurations.
+	HTTPSDConfigs []*HTTPSD `yaml:"http_sd_configs,omitempty"`
 
-	// Labels are labels assigned to all metrics scraped from the targets.
-	Labels map[string]string `yaml:"labels,omitempty"`
-}
+	// GCESDConfigs defines GCE service discovery configurations.
+	GCESDConfigs []*GCESD `yaml:"gce_sd_configs,omitempty"`
+
+	// AzureSDConfigs defines Azure service discovery configurations.
+	AzureSDConfigs []*AzureSD `yaml:"azure_sd_configs,omitempty"`
+
+	// DockerSDConfigs defines Docker service discovery configurations.
+	DockerSDConfigs []*DockerSD `yaml:"docker_sd_configs,omitempty"`
 
-// BasicAuth configures basic authentication.
-type BasicAuth struct {
-	// Username for basic authentication.
-	Username string `yaml:"username,omitempty"`
+	// DockerSwarmSDConfigs defines Docker Swarm service discovery configurations.
+	DockerSwarmSDConfigs []*DockerSwarmSD `yaml:"dockerswarm_sd_configs,omitempty"`
 
-	// Password for basic authentication.
-	// Mutually exclusive with PasswordFile.
-	Password string `yaml:"password,omitempty"`
+	// NomadSDConfigs defines Nomad service discovery configurations.
+	NomadSDConfigs []*NomadSD `yaml:"nomad_sd_configs,omitempty"`
 
-	// PasswordFile is a file containing the password for basic authentication.
-	// Mutually exclusive with Password.
-	PasswordFile string `yaml:"password_file,omitempty"`
+	// EurekaSDConfigs defines Eureka service discovery configurations.
+	EurekaSDConfigs []*EurekaSD `yaml:"eureka_sd_configs,omitempty"`
+
+	// OpenStackSDConfigs defines OpenStack service discovery configurations.
+	OpenStackSDConfigs []*OpenStackSD `yaml:"openstack_sd_configs,omitempty"`
+
+	// HetznerSDConfigs defines Hetzner service discovery configurations.
+	HetznerSDConfigs []*HetznerSD `yaml:"hetzner_sd_configs,omitempty"`
+
+	// LinodeSDConfigs defines Linode service discovery configurations.
+	LinodeSDConfigs []*LinodeSD `yaml:"linode_sd_configs,omitempty"`
 }
 
-// TLSConfig configures TLS connections.
-type TLSConfig struct {
-	// CAFile is the path to the CA certificate file.
-	CAFile string `yaml:"ca_file,omitempty"`
+// ScrapeProtocol is an exposition format Prometheus negotiates when scraping.
+type ScrapeProtocol string
+
+// Scrape protocols.
+const (
+	// ScrapeProtocolPrometheusProto is the protobuf format, required for
+	// scraping native histograms.
+	ScrapeProtocolPrometheusProto ScrapeProtocol = "PrometheusProto"
 
-	// CertFile is the path to the client certificate file.
-	CertFile string `yaml:"cert_file,omitempty"`
+	// ScrapeProtocolOpenMetricsText100 is OpenMetrics text 1.0.0.
+	ScrapeProtocolOpenMetricsText100 ScrapeProtocol = "OpenMetricsText1.0.0"
 
-	// KeyFile is the path to the client key file.
-	KeyFile string `yaml:"key_file,omitempty"`
+	// ScrapeProtocolOpenMetricsText001 is OpenMetrics text 0.0.1.
+	ScrapeProtocolOpenMetricsText001 ScrapeProtocol = "OpenMetricsText0.0.1"
 
-	// ServerName is used to verify the hostname on the returned certificates.
-	ServerName string `yaml:"server_name,omitempty"`
+	// ScrapeProtocolPrometheusText100 is Prometheus text 1.0.0.
+	ScrapeProtocolPrometheusText100 ScrapeProtocol = "PrometheusText1.0.0"
 
-	// InsecureSkipVerify skips verifying the server's certificate chain and hostname.
-	InsecureSkipVerify bool `yaml:"insecure_skip_verify,omitempty"`
+	// ScrapeProtocolPrometheusText004 is Prometheus text 0.0.4.
+	ScrapeProtocolPrometheusText004 ScrapeProtocol = "PrometheusText0.0.4"
+)
+
+var supportedScrapeProtocols = []ScrapeProtocol{
+	ScrapeProtocolPrometheusProto,
+	ScrapeProtocolOpenMetricsText100,
+	ScrapeProtocolOpenMetricsText001,
+	ScrapeProtocolPrometheusText100,
+	ScrapeProtocolPrometheusText004,
+}
+
+func (p ScrapeProtocol) valid() bool {
+	for _, s := range supportedScrapeProtocols {
+		if p == s {
+			return true
+		}
+	}
+	return false
+}
+
+// StaticConfig represents a static target group with an optional set of labels.
+type StaticConfig struct {
+	// Targets is the list of hosts to scrape.
+	// Each target should be of the form host:port.
+	Targets []string `yaml:"targets"`
+
+	// Labels are labels assigned to all metrics scraped from the targets.
+	Labels map[string]string `yaml:"labels,omitempty"`
 }
 
 // NewScrapeConfig creates a new ScrapeConfig with the given job name.
@@ -151,6 +210,140 @@ func (s *ScrapeConfig) WithStaticTargets(targets ...string) *ScrapeConfig {
 	return s
 }
 
+// WithScrapeProtocols sets the content negotiation order for this job.
+func (s *ScrapeConfig) WithScrapeProtocols(protocols ...ScrapeProtocol) *ScrapeConfig {
+	s.ScrapeProtocols = protocols
+	return s
+}
+
+// WithFallbackScrapeProtocol sets the protocol used when the Content-Type is
+// missing or unparsable.
+func (s *ScrapeConfig) WithFallbackScrapeProtocol(protocol ScrapeProtocol) *ScrapeConfig {
+	s.FallbackScrapeProtocol = protocol
+	return s
+}
+
+// WithNativeHistograms enables ingestion of native histograms.
+func (s *ScrapeConfig) WithNativeHistograms() *ScrapeConfig {
+	enabled := true
+	s.ScrapeNativeHistograms = &enabled
+	return s
+}
+
+// WithAlwaysScrapeClassicHistograms also ingests the classic form of native
+// histograms.
+func (s *ScrapeConfig) WithAlwaysScrapeClassicHistograms() *ScrapeConfig {
+	s.AlwaysScrapeClassicHistograms = true
+	return s
+}
+
+// WithNativeHistogramBucketLimit sets the per-histogram bucket limit.
+func (s *ScrapeConfig) WithNativeHistogramBucketLimit(limit uint) *ScrapeConfig {
+	s.NativeHistogramBucketLimit = limit
+	return s
+}
+
+// WithNativeHistogramMinBucketFactor sets the minimum bucket growth factor.
+func (s *ScrapeConfig) WithNativeHistogramMinBucketFactor(factor float64) *ScrapeConfig {
+	s.NativeHistogramMinBucketFactor = factor
+	return s
+}
+
+// WithConvertClassicHistogramsToNHCB converts classic histograms to native
+// histograms with custom buckets.
+func (s *ScrapeConfig) WithConvertClassicHistogramsToNHCB() *ScrapeConfig {
+	s.ConvertClassicHistogramsToNHCB = true
+	return s
+}
+
+// WithBasicAuth sets basic authentication for scrape requests.
+func (s *ScrapeConfig) WithBasicAuth(username string, password Secret) *ScrapeConfig {
+	s.BasicAuth = &BasicAuth{Username: username, Password: string(password)}
+	return s
+}
+
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (s *ScrapeConfig) WithAuthorization(credentials Secret) *ScrapeConfig {
+	s.Authorization = NewAuthorization(credentials)
+	return s
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (s *ScrapeConfig) WithAuthorizationFile(path string) *ScrapeConfig {
+	s.Authorization = NewAuthorizationFile(path)
+	return s
+}
+
+// WithOAuth2 sets OAuth 2.0 client credentials authentication.
+func (s *ScrapeConfig) WithOAuth2(oauth2 *OAuth2) *ScrapeConfig {
+	s.OAuth2 = oauth2
+	return s
+}
+
+// WithTLSConfig sets the TLS configuration for scrape requests.
+func (s *ScrapeConfig) WithTLSConfig(tls *TLSConfig) *ScrapeConfig {
+	s.TLSConfig = tls
+	return s
+}
+
+// WithBasicAuthFile sets basic authentication with the password read from a file.
+func (s *ScrapeConfig) WithBasicAuthFile(username, passwordFile string) *ScrapeConfig {
+	s.BasicAuth = &BasicAuth{Username: username, PasswordFile: passwordFile}
+	return s
+}
+
+// WithBearerTokenFile sets the file path for bearer token authentication.
+func (s *ScrapeConfig) WithBearerTokenFile(path string) *ScrapeConfig {
+	s.BearerTokenFile = path
+	return s
+}
+
+// WithProxyURL sets the proxy URL.
+func (s *ScrapeConfig) WithProxyURL(url string) *ScrapeConfig {
+	s.ProxyURL = url
+	return s
+}
+
+// WithBearerToken sets the bearer token for authentication.
+func (s *ScrapeConfig) WithBearerToken(token Secret) *ScrapeConfig {
+	s.BearerToken = token
+	return s
+}
+
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (s *ScrapeConfig) WithNoProxy(hosts string) *ScrapeConfig {
+	s.NoProxy = hosts
+	return s
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (s *ScrapeConfig) WithProxyFromEnvironment() *ScrapeConfig {
+	s.ProxyFromEnvironment = true
+	return s
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (s *ScrapeConfig) WithProxyConnectHeader(name string, values ...Secret) *ScrapeConfig {
+	if s.ProxyConnectHeader == nil {
+		s.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	s.ProxyConnectHeader[name] = values
+	return s
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (s *ScrapeConfig) WithFollowRedirects(follow bool) *ScrapeConfig {
+	s.FollowRedirects = &follow
+	return s
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (s *ScrapeConfig) WithEnableHTTP2(enable bool) *ScrapeConfig {
+	s.EnableHTTP2 = &enable
+	return s
+}
+
 // WithKubernetesSD adds a Kubernetes service discovery configuration.
 func (s *ScrapeConfig) WithKubernetesSD(sd *KubernetesSD) *ScrapeConfig {
 	s.KubernetesSDConfigs = append(s.KubernetesSDConfigs, sd)
@@ -181,6 +374,66 @@ func (s *ScrapeConfig) WithDNSSD(sd *DNSSD) *ScrapeConfig {
 	return s
 }
 
+// WithHTTPSD adds an HTTP-based service discovery configuration.
+func (s *ScrapeConfig) WithHTTPSD(sd *HTTPSD) *ScrapeConfig {
+	s.HTTPSDConfigs = append(s.HTTPSDConfigs, sd)
+	return s
+}
+
+// WithGCESD adds a GCE service discovery configuration.
+func (s *ScrapeConfig) WithGCESD(sd *GCESD) *ScrapeConfig {
+	s.GCESDConfigs = append(s.GCESDConfigs, sd)
+	return s
+}
+
+// WithAzureSD adds an Azure service discovery configuration.
+func (s *ScrapeConfig) WithAzureSD(sd *AzureSD) *ScrapeConfig {
+	s.AzureSDConfigs = append(s.AzureSDConfigs, sd)
+	return s
+}
+
+// WithDockerSD adds a Docker service discovery configuration.
+func (s *ScrapeConfig) WithDockerSD(sd *DockerSD) *ScrapeConfig {
+	s.DockerSDConfigs = append(s.DockerSDConfigs, sd)
+	return s
+}
+
+// WithDockerSwarmSD adds a Docker Swarm service discovery configuration.
+func (s *ScrapeConfig) WithDockerSwarmSD(sd *DockerSwarmSD) *ScrapeConfig {
+	s.DockerSwarmSDConfigs = append(s.DockerSwarmSDConfigs, sd)
+	return s
+}
+
+// WithNomadSD adds a Nomad service discovery configuration.
+func (s *ScrapeConfig) WithNomadSD(sd *NomadSD) *ScrapeConfig {
+	s.NomadSDConfigs = append(s.NomadSDConfigs, sd)
+	return s
+}
+
+// WithEurekaSD adds a Eureka service discovery configuration.
+func (s *ScrapeConfig) WithEurekaSD(sd *EurekaSD) *ScrapeConfig {
+	s.EurekaSDConfigs = append(s.EurekaSDConfigs, sd)
+	return s
+}
+
+// WithOpenStackSD adds an OpenStack service discovery configuration.
+func (s *ScrapeConfig) WithOpenStackSD(sd *OpenStackSD) *ScrapeConfig {
+	s.OpenStackSDConfigs = append(s.OpenStackSDConfigs, sd)
+	return s
+}
+
+// WithHetznerSD adds a Hetzner service discovery configuration.
+func (s *ScrapeConfig) WithHetznerSD(sd *HetznerSD) *ScrapeConfig {
+	s.HetznerSDConfigs = append(s.HetznerSDConfigs, sd)
+	return s
+}
+
+// WithLinodeSD adds a Linode service discovery configuration.
+func (s *ScrapeConfig) WithLinodeSD(sd *LinodeSD) *ScrapeConfig {
+	s.LinodeSDConfigs = append(s.LinodeSDConfigs, sd)
+	return s
+}
+
 // NewStaticConfig creates a new StaticConfig with the given targets.
 func NewStaticConfig(targets ...string) *StaticConfig {
 	return &StaticConfig{
@@ -1,6 +1,7 @@
 package prometheus
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -204,9 +205,11 @@ func TestScrapeConfig_FluentAPI(t *testing.T) {
 func TestBasicAuth_MarshalYAML(t *testing.T) {
 	config := &ScrapeConfig{
 		JobName: "secure",
-		BasicAuth: &BasicAuth{
-			Username: "admin",
-			Password: "secret",
+		HTTPClientConfig: HTTPClientConfig{
+			BasicAuth: &BasicAuth{
+				Username: "admin",
+				Password: "secret",
+			},
 		},
 	}
 
@@ -232,11 +235,13 @@ func TestBasicAuth_MarshalYAML(t *testing.T) {
 func TestTLSConfig_MarshalYAML(t *testing.T) {
 	config := &ScrapeConfig{
 		JobName: "secure",
-		TLSConfig: &TLSConfig{
-			CAFile:             "/etc/prom/ca.crt",
-			CertFile:           "/etc/prom/client.crt",
-			KeyFile:            "/etc/prom/client.key",
-			InsecureSkipVerify: false,
+		HTTPClientConfig: HTTPClientConfig{
+			TLSConfig: &TLSConfig{
+				CAFile:             "/etc/prom/ca.crt",
+				CertFile:           "/etc/prom/client.crt",
+				KeyFile:            "/etc/prom/client.key",
+				InsecureSkipVerify: false,
+			},
 		},
 	}
 
@@ -299,3 +304,38 @@ func TestRelabelConfig_MarshalYAML(t *testing.T) {
 		}
 	}
 }
+
+func TestScrapeConfig_NativeHistograms(t *testing.T) {
+	sc := NewScrapeConfig("histograms").
+		WithScrapeProtocols(ScrapeProtocolPrometheusProto, ScrapeProtocolOpenMetricsText100).
+		WithFallbackScrapeProtocol(ScrapeProtocolPrometheusText004).
+		WithNativeHistograms().
+		WithAlwaysScrapeClassicHistograms().
+		WithNativeHistogramBucketLimit(160).
+		WithNativeHistogramMinBucketFactor(1.1).
+		WithConvertClassicHistogramsToNHCB()
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"scrape_protocols:",
+		"- PrometheusProto",
+		"- OpenMetricsText1.0.0",
+		"fallback_scrape_protocol: PrometheusText0.0.4",
+		"scrape_native_histograms: true",
+		"always_scrape_classic_histograms: true",
+		"native_histogram_bucket_limit: 160",
+		"native_histogram_min_bucket_factor: 1.1",
+		"convert_classic_histograms_to_nhcb: true",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
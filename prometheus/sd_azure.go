@@ -0,0 +1,165 @@
+package prometheus
+
+// AzureAuthMethod is the method Azure service discovery uses to authenticate.
+type AzureAuthMethod string
+
+// Azure authentication methods.
+const (
+	// AzureAuthOAuth authenticates with a service principal's tenant ID,
+	// client ID and client secret.
+	AzureAuthOAuth AzureAuthMethod = "OAuth"
+
+	// AzureAuthManagedIdentity authenticates with the managed identity of the
+	// VM Prometheus runs on.
+	AzureAuthManagedIdentity AzureAuthMethod = "ManagedIdentity"
+
+	// AzureAuthSDK authenticates with the Azure SDK's default credential
+	// chain (environment, workload identity, CLI).
+	AzureAuthSDK AzureAuthMethod = "SDK"
+)
+
+// AzureSD configures Azure virtual machine service discovery.
+// It discovers VMs and scale set instances in a subscription.
+//
+// Example usage:
+//
+//	var AzureDiscovery = prometheus.NewAzureSD("11111111-2222-3333-4444-555555555555").
+//	    WithManagedIdentity("").
+//	    WithResourceGroup("production").
+//	    WithPort(9100)
+type AzureSD struct {
+	// Environment is the Azure cloud environment.
+	// Defaults to "AzurePublicCloud".
+	Environment string `yaml:"environment,omitempty"`
+
+	// AuthenticationMethod is the authentication method.
+	// Defaults to OAuth.
+	AuthenticationMethod AzureAuthMethod `yaml:"authentication_method,omitempty"`
+
+	// SubscriptionID is the subscription to discover VMs in.
+	SubscriptionID string `yaml:"subscription_id"`
+
+	// TenantID is the tenant of the service principal (OAuth only).
+	TenantID string `yaml:"tenant_id,omitempty"`
+
+	// ClientID is the service principal or managed identity client ID.
+	ClientID string `yaml:"client_id,omitempty"`
+
+	// ClientSecret is the service principal's secret (OAuth only).
+	ClientSecret Secret `yaml:"client_secret,omitempty"`
+
+	// ResourceGroup limits discovery to a resource group.
+	ResourceGroup string `yaml:"resource_group,omitempty"`
+
+	// RefreshInterval is the time after which the VM list is refreshed.
+	// Defaults to 300s.
+	RefreshInterval Duration `yaml:"refresh_interval,omitempty"`
+
+	// Port is the port to scrape metrics from.
+	// Defaults to 80.
+	Port int `yaml:"port,omitempty"`
+
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// the Azure API.
+	HTTPClientConfig `yaml:",inline"`
+}
+
+// NewAzureSD creates a new Azure service discovery configuration for a subscription.
+func NewAzureSD(subscriptionID string) *AzureSD {
+	return &AzureSD{
+		SubscriptionID: subscriptionID,
+	}
+}
+
+// WithOAuth authenticates with a service principal.
+func (a *AzureSD) WithOAuth(tenantID, clientID string, clientSecret Secret) *AzureSD {
+	a.AuthenticationMethod = AzureAuthOAuth
+	a.TenantID = tenantID
+	a.ClientID = clientID
+	a.ClientSecret = clientSecret
+	return a
+}
+
+// WithManagedIdentity authenticates with a managed identity. clientID selects
+// a user-assigned identity and may be empty for the system-assigned identity.
+func (a *AzureSD) WithManagedIdentity(clientID string) *AzureSD {
+	a.AuthenticationMethod = AzureAuthManagedIdentity
+	a.ClientID = clientID
+	return a
+}
+
+// WithSDKAuth authenticates with the Azure SDK's default credential chain.
+func (a *AzureSD) WithSDKAuth() *AzureSD {
+	a.AuthenticationMethod = AzureAuthSDK
+	return a
+}
+
+// WithEnvironment sets the Azure cloud environment.
+func (a *AzureSD) WithEnvironment(environment string) *AzureSD {
+	a.Environment = environment
+	return a
+}
+
+// WithResourceGroup limits discovery to a resource group.
+func (a *AzureSD) WithResourceGroup(group string) *AzureSD {
+	a.ResourceGroup = group
+	return a
+}
+
+// WithRefreshInterval sets the VM list refresh interval.
+func (a *AzureSD) WithRefreshInterval(d Duration) *AzureSD {
+	a.RefreshInterval = d
+	return a
+}
+
+// WithPort sets the port to scrape metrics from.
+func (a *AzureSD) WithPort(port int) *AzureSD {
+	a.Port = port
+	return a
+}
+
+// WithTLSConfig sets the TLS configuration.
+func (a *AzureSD) WithTLSConfig(tls *TLSConfig) *AzureSD {
+	a.TLSConfig = tls
+	return a
+}
+
+// WithProxyURL sets the proxy URL.
+func (a *AzureSD) WithProxyURL(url string) *AzureSD {
+	a.ProxyURL = url
+	return a
+}
+
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (a *AzureSD) WithNoProxy(hosts string) *AzureSD {
+	a.NoProxy = hosts
+	return a
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (a *AzureSD) WithProxyFromEnvironment() *AzureSD {
+	a.ProxyFromEnvironment = true
+	return a
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (a *AzureSD) WithProxyConnectHeader(name string, values ...Secret) *AzureSD {
+	if a.ProxyConnectHeader == nil {
+		a.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	a.ProxyConnectHeader[name] = values
+	return a
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (a *AzureSD) WithFollowRedirects(follow bool) *AzureSD {
+	a.FollowRedirects = &follow
+	return a
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (a *AzureSD) WithEnableHTTP2(enable bool) *AzureSD {
+	a.EnableHTTP2 = &enable
+	return a
+}
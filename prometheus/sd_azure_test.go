@@ -0,0 +1,81 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestAzureSD_Serialize_OAuth(t *testing.T) {
+	sd := NewAzureSD("sub-1").
+		WithOAuth("tenant-1", "client-1", "secret").
+		WithResourceGroup("production").
+		WithPort(9100)
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"authentication_method: OAuth",
+		"subscription_id: sub-1",
+		"tenant_id: tenant-1",
+		"client_id: client-1",
+		"client_secret: secret",
+		"resource_group: production",
+		"port: 9100",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestAzureSD_ManagedIdentity(t *testing.T) {
+	sd := NewAzureSD("sub-1").WithManagedIdentity("")
+
+	if sd.AuthenticationMethod != AzureAuthManagedIdentity {
+		t.Errorf("AuthenticationMethod = %v, want %v", sd.AuthenticationMethod, AzureAuthManagedIdentity)
+	}
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+	if strings.Contains(string(data), "client_id") {
+		t.Errorf("yaml.Marshal() should omit empty client_id\nGot:\n%s", data)
+	}
+}
+
+func TestAzureSD_SDKAuth(t *testing.T) {
+	sd := NewAzureSD("sub-1").WithSDKAuth()
+	if sd.AuthenticationMethod != AzureAuthSDK {
+		t.Errorf("AuthenticationMethod = %v, want %v", sd.AuthenticationMethod, AzureAuthSDK)
+	}
+}
+
+func TestScrapeConfig_WithAzureSD(t *testing.T) {
+	sc := NewScrapeConfig("azure").
+		WithAzureSD(NewAzureSD("sub-1").WithManagedIdentity(""))
+
+	if len(sc.AzureSDConfigs) != 1 {
+		t.Errorf("len(AzureSDConfigs) = %d, want 1", len(sc.AzureSDConfigs))
+	}
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{"azure_sd_configs:", "authentication_method: ManagedIdentity"} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
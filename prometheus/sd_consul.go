@@ -54,20 +54,9 @@ type ConsulSD struct {
 	// Defaults to 30s.
 	RefreshInterval Duration `yaml:"refresh_interval,omitempty"`
 
-	// TLSConfig configures TLS settings for connecting to Consul.
-	TLSConfig *TLSConfig `yaml:"tls_config,omitempty"`
-
-	// BasicAuth configures basic authentication for connecting to Consul.
-	BasicAuth *BasicAuth `yaml:"basic_auth,omitempty"`
-
-	// ProxyURL is the URL of a proxy to use for connecting to Consul.
-	ProxyURL string `yaml:"proxy_url,omitempty"`
-
-	// FollowRedirects controls whether to follow HTTP redirects.
-	FollowRedirects *bool `yaml:"follow_redirects,omitempty"`
-
-	// EnableHTTP2 controls whether to use HTTP/2.
-	EnableHTTP2 *bool `yaml:"enable_http2,omitempty"`
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// Consul.
+	HTTPClientConfig `yaml:",inline"`
 }
 
 // NewConsulSD creates a new Consul service discovery configuration.
@@ -164,3 +153,67 @@ func (c *ConsulSD) WithProxyURL(url string) *ConsulSD {
 	c.ProxyURL = url
 	return c
 }
+
+// WithBearerToken sets the bearer token for authentication.
+func (c *ConsulSD) WithBearerToken(token Secret) *ConsulSD {
+	c.BearerToken = token
+	return c
+}
+
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (c *ConsulSD) WithNoProxy(hosts string) *ConsulSD {
+	c.NoProxy = hosts
+	return c
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (c *ConsulSD) WithProxyFromEnvironment() *ConsulSD {
+	c.ProxyFromEnvironment = true
+	return c
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (c *ConsulSD) WithProxyConnectHeader(name string, values ...Secret) *ConsulSD {
+	if c.ProxyConnectHeader == nil {
+		c.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	c.ProxyConnectHeader[name] = values
+	return c
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (c *ConsulSD) WithFollowRedirects(follow bool) *ConsulSD {
+	c.FollowRedirects = &follow
+	return c
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (c *ConsulSD) WithEnableHTTP2(enable bool) *ConsulSD {
+	c.EnableHTTP2 = &enable
+	return c
+}
+
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (c *ConsulSD) WithAuthorization(credentials Secret) *ConsulSD {
+	c.Authorization = NewAuthorization(credentials)
+	return c
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (c *ConsulSD) WithAuthorizationFile(path string) *ConsulSD {
+	c.Authorization = NewAuthorizationFile(path)
+	return c
+}
+
+// WithOAuth2 sets OAuth 2.0 client credentials authentication.
+func (c *ConsulSD) WithOAuth2(oauth2 *OAuth2) *ConsulSD {
+	c.OAuth2 = oauth2
+	return c
+}
+
+// WithBearerTokenFile sets the file path for bearer token authentication.
+func (c *ConsulSD) WithBearerTokenFile(path string) *ConsulSD {
+	c.BearerTokenFile = path
+	return c
+}
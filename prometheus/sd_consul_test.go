@@ -86,10 +86,12 @@ func TestConsulSD_Serialize_WithTLS(t *testing.T) {
 	sd := &ConsulSD{
 		Server: "consul.example.com:8501",
 		Scheme: "https",
-		TLSConfig: &TLSConfig{
-			CAFile:   "/etc/consul/ca.crt",
-			CertFile: "/etc/consul/client.crt",
-			KeyFile:  "/etc/consul/client.key",
+		HTTPClientConfig: HTTPClientConfig{
+			TLSConfig: &TLSConfig{
+				CAFile:   "/etc/consul/ca.crt",
+				CertFile: "/etc/consul/client.crt",
+				KeyFile:  "/etc/consul/client.key",
+			},
 		},
 	}
 
@@ -117,9 +119,11 @@ func TestConsulSD_Serialize_WithTLS(t *testing.T) {
 func TestConsulSD_Serialize_WithBasicAuth(t *testing.T) {
 	sd := &ConsulSD{
 		Server: "localhost:8500",
-		BasicAuth: &BasicAuth{
-			Username: "admin",
-			Password: "secret",
+		HTTPClientConfig: HTTPClientConfig{
+			BasicAuth: &BasicAuth{
+				Username: "admin",
+				Password: "secret",
+			},
 		},
 	}
 
@@ -0,0 +1,325 @@
+package prometheus
+
+// DockerSD configures Docker Engine service discovery.
+// It discovers running containers, with one target per exposed port or
+// network.
+//
+// Example usage:
+//
+//	var DockerDiscovery = prometheus.NewDockerSD("unix:///var/run/docker.sock").
+//	    WithFilter("label", "prometheus.scrape=true")
+type DockerSD struct {
+	// Host is the address of the Docker daemon.
+	Host string `yaml:"host"`
+
+	// Port is the port to scrape for containers that expose no ports.
+	// Defaults to 80.
+	Port int `yaml:"port,omitempty"`
+
+	// HostNetworkingHost is the host to use for containers in host
+	// networking mode. Defaults to "localhost".
+	HostNetworkingHost string `yaml:"host_networking_host,omitempty"`
+
+	// MatchFirstNetwork restricts containers attached to several networks to
+	// the first one. Defaults to true.
+	MatchFirstNetwork *bool `yaml:"match_first_network,omitempty"`
+
+	// Filters restricts the containers discovered.
+	Filters []DockerFilter `yaml:"filters,omitempty"`
+
+	// RefreshInterval is the time after which the containers are refreshed.
+	// Defaults to 60s.
+	RefreshInterval Duration `yaml:"refresh_interval,omitempty"`
+
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// the Docker daemon.
+	HTTPClientConfig `yaml:",inline"`
+}
+
+// DockerFilter is a Docker API filter, such as name=label with values
+// ["prometheus.scrape=true"].
+type DockerFilter struct {
+	// Name is the filter name (e.g., "label", "network", "name").
+	Name string `yaml:"name"`
+
+	// Values is the list of values to filter on.
+	Values []string `yaml:"values"`
+}
+
+// NewDockerSD creates a new Docker service discovery configuration for the given daemon address.
+func NewDockerSD(host string) *DockerSD {
+	return &DockerSD{
+		Host: host,
+	}
+}
+
+// WithPort sets the port used for containers without exposed ports.
+func (d *DockerSD) WithPort(port int) *DockerSD {
+	d.Port = port
+	return d
+}
+
+// WithHostNetworkingHost sets the host used for host-networked containers.
+func (d *DockerSD) WithHostNetworkingHost(host string) *DockerSD {
+	d.HostNetworkingHost = host
+	return d
+}
+
+// WithMatchFirstNetwork sets whether only the first network of a container is used.
+func (d *DockerSD) WithMatchFirstNetwork(match bool) *DockerSD {
+	d.MatchFirstNetwork = &match
+	return d
+}
+
+// WithFilter adds a Docker API filter.
+func (d *DockerSD) WithFilter(name string, values ...string) *DockerSD {
+	d.Filters = append(d.Filters, DockerFilter{
+		Name:   name,
+		Values: values,
+	})
+	return d
+}
+
+// WithRefreshInterval sets the container refresh interval.
+func (d *DockerSD) WithRefreshInterval(dur Duration) *DockerSD {
+	d.RefreshInterval = dur
+	return d
+}
+
+// WithTLSConfig sets the TLS configuration.
+func (d *DockerSD) WithTLSConfig(tls *TLSConfig) *DockerSD {
+	d.TLSConfig = tls
+	return d
+}
+
+// WithBasicAuth sets the basic authentication configuration.
+func (d *DockerSD) WithBasicAuth(auth *BasicAuth) *DockerSD {
+	d.BasicAuth = auth
+	return d
+}
+
+// WithBearerTokenFile sets the bearer token file for authentication.
+func (d *DockerSD) WithBearerTokenFile(path string) *DockerSD {
+	d.BearerTokenFile = path
+	return d
+}
+
+// WithProxyURL sets the proxy URL.
+func (d *DockerSD) WithProxyURL(url string) *DockerSD {
+	d.ProxyURL = url
+	return d
+}
+
+// WithBearerToken sets the bearer token for authentication.
+func (d *DockerSD) WithBearerToken(token Secret) *DockerSD {
+	d.BearerToken = token
+	return d
+}
+
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (d *DockerSD) WithNoProxy(hosts string) *DockerSD {
+	d.NoProxy = hosts
+	return d
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (d *DockerSD) WithProxyFromEnvironment() *DockerSD {
+	d.ProxyFromEnvironment = true
+	return d
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (d *DockerSD) WithProxyConnectHeader(name string, values ...Secret) *DockerSD {
+	if d.ProxyConnectHeader == nil {
+		d.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	d.ProxyConnectHeader[name] = values
+	return d
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (d *DockerSD) WithFollowRedirects(follow bool) *DockerSD {
+	d.FollowRedirects = &follow
+	return d
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (d *DockerSD) WithEnableHTTP2(enable bool) *DockerSD {
+	d.EnableHTTP2 = &enable
+	return d
+}
+
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (d *DockerSD) WithAuthorization(credentials Secret) *DockerSD {
+	d.Authorization = NewAuthorization(credentials)
+	return d
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (d *DockerSD) WithAuthorizationFile(path string) *DockerSD {
+	d.Authorization = NewAuthorizationFile(path)
+	return d
+}
+
+// WithOAuth2 sets OAuth 2.0 client credentials authentication.
+func (d *DockerSD) WithOAuth2(oauth2 *OAuth2) *DockerSD {
+	d.OAuth2 = oauth2
+	return d
+}
+
+// DockerSwarmRole is the type of Swarm object to discover.
+type DockerSwarmRole string
+
+// Docker Swarm discovery roles.
+const (
+	// DockerSwarmRoleServices discovers one target per published service port.
+	DockerSwarmRoleServices DockerSwarmRole = "services"
+
+	// DockerSwarmRoleTasks discovers one target per task port or network.
+	DockerSwarmRoleTasks DockerSwarmRole = "tasks"
+
+	// DockerSwarmRoleNodes discovers one target per Swarm node.
+	DockerSwarmRoleNodes DockerSwarmRole = "nodes"
+)
+
+// DockerSwarmSD configures Docker Swarm service discovery.
+// It discovers services, tasks or nodes from a Swarm manager.
+//
+// Example usage:
+//
+//	var SwarmDiscovery = prometheus.NewDockerSwarmSD("unix:///var/run/docker.sock", prometheus.DockerSwarmRoleTasks).
+//	    WithPort(9100)
+type DockerSwarmSD struct {
+	// Host is the address of the Docker daemon of a Swarm manager.
+	Host string `yaml:"host"`
+
+	// Role is the type of Swarm object to discover.
+	Role DockerSwarmRole `yaml:"role"`
+
+	// Port is the port to scrape for services and tasks without published
+	// ports, and for nodes. Defaults to 80.
+	Port int `yaml:"port,omitempty"`
+
+	// Filters restricts the objects discovered.
+	Filters []DockerFilter `yaml:"filters,omitempty"`
+
+	// RefreshInterval is the time after which the objects are refreshed.
+	// Defaults to 60s.
+	RefreshInterval Duration `yaml:"refresh_interval,omitempty"`
+
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// the Docker daemon.
+	HTTPClientConfig `yaml:",inline"`
+}
+
+// NewDockerSwarmSD creates a new Docker Swarm service discovery configuration.
+func NewDockerSwarmSD(host string, role DockerSwarmRole) *DockerSwarmSD {
+	return &DockerSwarmSD{
+		Host: host,
+		Role: role,
+	}
+}
+
+// WithPort sets the default port to scrape.
+func (d *DockerSwarmSD) WithPort(port int) *DockerSwarmSD {
+	d.Port = port
+	return d
+}
+
+// WithFilter adds a Docker API filter.
+func (d *DockerSwarmSD) WithFilter(name string, values ...string) *DockerSwarmSD {
+	d.Filters = append(d.Filters, DockerFilter{
+		Name:   name,
+		Values: values,
+	})
+	return d
+}
+
+// WithRefreshInterval sets the refresh interval.
+func (d *DockerSwarmSD) WithRefreshInterval(dur Duration) *DockerSwarmSD {
+	d.RefreshInterval = dur
+	return d
+}
+
+// WithTLSConfig sets the TLS configuration.
+func (d *DockerSwarmSD) WithTLSConfig(tls *TLSConfig) *DockerSwarmSD {
+	d.TLSConfig = tls
+	return d
+}
+
+// WithBasicAuth sets the basic authentication configuration.
+func (d *DockerSwarmSD) WithBasicAuth(auth *BasicAuth) *DockerSwarmSD {
+	d.BasicAuth = auth
+	return d
+}
+
+// WithBearerTokenFile sets the bearer token file for authentication.
+func (d *DockerSwarmSD) WithBearerTokenFile(path string) *DockerSwarmSD {
+	d.BearerTokenFile = path
+	return d
+}
+
+// WithProxyURL sets the proxy URL.
+func (d *DockerSwarmSD) WithProxyURL(url string) *DockerSwarmSD {
+	d.ProxyURL = url
+	return d
+}
+
+// WithBearerToken sets the bearer token for authentication.
+func (d *DockerSwarmSD) WithBearerToken(token Secret) *DockerSwarmSD {
+	d.BearerToken = token
+	return d
+}
+
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (d *DockerSwarmSD) WithNoProxy(hosts string) *DockerSwarmSD {
+	d.NoProxy = hosts
+	return d
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (d *DockerSwarmSD) WithProxyFromEnvironment() *DockerSwarmSD {
+	d.ProxyFromEnvironment = true
+	return d
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (d *DockerSwarmSD) WithProxyConnectHeader(name string, values ...Secret) *DockerSwarmSD {
+	if d.ProxyConnectHeader == nil {
+		d.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	d.ProxyConnectHeader[name] = values
+	return d
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (d *DockerSwarmSD) WithFollowRedirects(follow bool) *DockerSwarmSD {
+	d.FollowRedirects = &follow
+	return d
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (d *DockerSwarmSD) WithEnableHTTP2(enable bool) *DockerSwarmSD {
+	d.EnableHTTP2 = &enable
+	return d
+}
+
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (d *DockerSwarmSD) WithAuthorization(credentials Secret) *DockerSwarmSD {
+	d.Authorization = NewAuthorization(credentials)
+	return d
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (d *DockerSwarmSD) WithAuthorizationFile(path string) *DockerSwarmSD {
+	d.Authorization = NewAuthorizationFile(path)
+	return d
+}
+
+// WithOAuth2 sets OAuth 2.0 client credentials authentication.
+func (d *DockerSwarmSD) WithOAuth2(oauth2 *OAuth2) *DockerSwarmSD {
+	d.OAuth2 = oauth2
+	return d
+}
@@ -0,0 +1,124 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestDockerSD_Serialize(t *testing.T) {
+	sd := NewDockerSD("unix:///var/run/docker.sock").
+		WithFilter("label", "prometheus.scrape=true").
+		WithMatchFirstNetwork(false).
+		WithPort(8080)
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"host: unix:///var/run/docker.sock",
+		"port: 8080",
+		"match_first_network: false",
+		"name: label",
+		"- prometheus.scrape=true",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestDockerSwarmSD_Serialize(t *testing.T) {
+	sd := NewDockerSwarmSD("tcp://manager:2375", DockerSwarmRoleTasks).
+		WithFilter("desired-state", "running").
+		WithPort(9100)
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"host: tcp://manager:2375",
+		"role: tasks",
+		"port: 9100",
+		"name: desired-state",
+		"- running",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestDockerSwarmSD_Unmarshal(t *testing.T) {
+	input := `
+host: unix:///var/run/docker.sock
+role: nodes
+filters:
+  - name: role
+    values: [manager]
+`
+	var sd DockerSwarmSD
+	if err := yaml.Unmarshal([]byte(input), &sd); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+
+	if sd.Role != DockerSwarmRoleNodes {
+		t.Errorf("Role = %v, want nodes", sd.Role)
+	}
+	if len(sd.Filters) != 1 || sd.Filters[0].Values[0] != "manager" {
+		t.Errorf("Filters = %v, want role=manager", sd.Filters)
+	}
+}
+
+func TestScrapeConfig_WithDockerSD(t *testing.T) {
+	sc := NewScrapeConfig("docker").
+		WithDockerSD(NewDockerSD("unix:///var/run/docker.sock"))
+
+	if len(sc.DockerSDConfigs) != 1 {
+		t.Errorf("len(DockerSDConfigs) = %d, want 1", len(sc.DockerSDConfigs))
+	}
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{"docker_sd_configs:", "host: unix:///var/run/docker.sock"} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestScrapeConfig_WithDockerSwarmSD(t *testing.T) {
+	sc := NewScrapeConfig("swarm").
+		WithDockerSwarmSD(NewDockerSwarmSD("unix:///var/run/docker.sock", DockerSwarmRoleServices))
+
+	if len(sc.DockerSwarmSDConfigs) != 1 {
+		t.Errorf("len(DockerSwarmSDConfigs) = %d, want 1", len(sc.DockerSwarmSDConfigs))
+	}
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{"dockerswarm_sd_configs:", "role: services"} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
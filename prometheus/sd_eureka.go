@@ -0,0 +1,115 @@
+package prometheus
+
+// EurekaSD configures Netflix Eureka service discovery.
+// It discovers application instances registered in a Eureka server.
+//
+// Example usage:
+//
+//	var EurekaDiscovery = prometheus.NewEurekaSD("http://eureka.example.com:8761/eureka")
+type EurekaSD struct {
+	// Server is the URL of the Eureka server.
+	Server string `yaml:"server"`
+
+	// RefreshInterval is the time after which the instances are refreshed.
+	// Defaults to 30s.
+	RefreshInterval Duration `yaml:"refresh_interval,omitempty"`
+
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// Eureka.
+	HTTPClientConfig `yaml:",inline"`
+}
+
+// NewEurekaSD creates a new Eureka service discovery configuration for the given server.
+func NewEurekaSD(server string) *EurekaSD {
+	return &EurekaSD{
+		Server: server,
+	}
+}
+
+// WithRefreshInterval sets the instance refresh interval.
+func (e *EurekaSD) WithRefreshInterval(d Duration) *EurekaSD {
+	e.RefreshInterval = d
+	return e
+}
+
+// WithBasicAuth sets the basic authentication configuration.
+func (e *EurekaSD) WithBasicAuth(auth *BasicAuth) *EurekaSD {
+	e.BasicAuth = auth
+	return e
+}
+
+// WithTLSConfig sets the TLS configuration.
+func (e *EurekaSD) WithTLSConfig(tls *TLSConfig) *EurekaSD {
+	e.TLSConfig = tls
+	return e
+}
+
+// WithBearerTokenFile sets the bearer token file for authentication.
+func (e *EurekaSD) WithBearerTokenFile(path string) *EurekaSD {
+	e.BearerTokenFile = path
+	return e
+}
+
+// WithProxyURL sets the proxy URL.
+func (e *EurekaSD) WithProxyURL(url string) *EurekaSD {
+	e.ProxyURL = url
+	return e
+}
+
+// WithBearerToken sets the bearer token for authentication.
+func (e *EurekaSD) WithBearerToken(token Secret) *EurekaSD {
+	e.BearerToken = token
+	return e
+}
+
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (e *EurekaSD) WithNoProxy(hosts string) *EurekaSD {
+	e.NoProxy = hosts
+	return e
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (e *EurekaSD) WithProxyFromEnvironment() *EurekaSD {
+	e.ProxyFromEnvironment = true
+	return e
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (e *EurekaSD) WithProxyConnectHeader(name string, values ...Secret) *EurekaSD {
+	if e.ProxyConnectHeader == nil {
+		e.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	e.ProxyConnectHeader[name] = values
+	return e
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (e *EurekaSD) WithFollowRedirects(follow bool) *EurekaSD {
+	e.FollowRedirects = &follow
+	return e
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (e *EurekaSD) WithEnableHTTP2(enable bool) *EurekaSD {
+	e.EnableHTTP2 = &enable
+	return e
+}
+
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (e *EurekaSD) WithAuthorization(credentials Secret) *EurekaSD {
+	e.Authorization = NewAuthorization(credentials)
+	return e
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (e *EurekaSD) WithAuthorizationFile(path string) *EurekaSD {
+	e.Authorization = NewAuthorizationFile(path)
+	return e
+}
+
+// WithOAuth2 sets OAuth 2.0 client credentials authentication.
+func (e *EurekaSD) WithOAuth2(oauth2 *OAuth2) *EurekaSD {
+	e.OAuth2 = oauth2
+	return e
+}
@@ -0,0 +1,55 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestEurekaSD_Serialize(t *testing.T) {
+	sd := NewEurekaSD("http://eureka:8761/eureka").
+		WithRefreshInterval(Duration(time.Minute)).
+		WithBasicAuth(&BasicAuth{Username: "prom", PasswordFile: "/etc/eureka-pass"})
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"server: http://eureka:8761/eureka",
+		"refresh_interval: 1m",
+		"username: prom",
+		"password_file: /etc/eureka-pass",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestScrapeConfig_WithEurekaSD(t *testing.T) {
+	sc := NewScrapeConfig("eureka").
+		WithEurekaSD(NewEurekaSD("http://eureka:8761/eureka"))
+
+	if len(sc.EurekaSDConfigs) != 1 {
+		t.Errorf("len(EurekaSDConfigs) = %d, want 1", len(sc.EurekaSDConfigs))
+	}
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{"eureka_sd_configs:", "server: http://eureka:8761/eureka"} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package prometheus
+
+// GCESD configures Google Compute Engine service discovery.
+// It discovers GCE instances in a project zone. Credentials are taken from
+// the environment (GOOGLE_APPLICATION_CREDENTIALS, gcloud defaults or the
+// instance metadata service).
+//
+// Example usage:
+//
+//	var GCEDiscovery = prometheus.NewGCESD("my-project", "us-central1-a").
+//	    WithFilter(`labels.env = "production"`).
+//	    WithPort(9100)
+type GCESD struct {
+	// Project is the GCP project to discover instances in.
+	Project string `yaml:"project"`
+
+	// Zone is the zone of the instances. Use one config per zone to discover
+	// instances in several zones.
+	Zone string `yaml:"zone"`
+
+	// Filter restricts the instances discovered, using the syntax of the
+	// Compute Engine instances.list filter parameter.
+	Filter string `yaml:"filter,omitempty"`
+
+	// RefreshInterval is the time after which the instance list is refreshed.
+	// Defaults to 60s.
+	RefreshInterval Duration `yaml:"refresh_interval,omitempty"`
+
+	// Port is the port to scrape metrics from.
+	// Defaults to 80.
+	Port int `yaml:"port,omitempty"`
+
+	// TagSeparator is the string by which GCE tags are joined into the
+	// __meta_gce_tags label. Defaults to ",".
+	TagSeparator string `yaml:"tag_separator,omitempty"`
+}
+
+// NewGCESD creates a new GCE service discovery configuration for a project zone.
+func NewGCESD(project, zone string) *GCESD {
+	return &GCESD{
+		Project: project,
+		Zone:    zone,
+	}
+}
+
+// WithFilter sets the instance filter.
+func (g *GCESD) WithFilter(filter string) *GCESD {
+	g.Filter = filter
+	return g
+}
+
+// WithRefreshInterval sets the instance list refresh interval.
+func (g *GCESD) WithRefreshInterval(d Duration) *GCESD {
+	g.RefreshInterval = d
+	return g
+}
+
+// WithPort sets the port to scrape metrics from.
+func (g *GCESD) WithPort(port int) *GCESD {
+	g.Port = port
+	return g
+}
+
+// WithTagSeparator sets the tag separator string.
+func (g *GCESD) WithTagSeparator(separator string) *GCESD {
+	g.TagSeparator = separator
+	return g
+}
@@ -0,0 +1,78 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestGCESD_Serialize(t *testing.T) {
+	sd := NewGCESD("my-project", "us-central1-a").
+		WithFilter(`labels.env = "production"`).
+		WithPort(9100).
+		WithTagSeparator(";")
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"project: my-project",
+		"zone: us-central1-a",
+		`filter: labels.env = "production"`,
+		"port: 9100",
+		`tag_separator: ;`,
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestGCESD_Unmarshal(t *testing.T) {
+	input := `
+project: infra
+zone: europe-west1-b
+port: 9100
+`
+	var sd GCESD
+	if err := yaml.Unmarshal([]byte(input), &sd); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+
+	if sd.Project != "infra" {
+		t.Errorf("Project = %v, want infra", sd.Project)
+	}
+	if sd.Zone != "europe-west1-b" {
+		t.Errorf("Zone = %v, want europe-west1-b", sd.Zone)
+	}
+	if sd.Port != 9100 {
+		t.Errorf("Port = %v, want 9100", sd.Port)
+	}
+}
+
+func TestScrapeConfig_WithGCESD(t *testing.T) {
+	sc := NewScrapeConfig("gce").
+		WithGCESD(NewGCESD("infra", "us-central1-a"))
+
+	if len(sc.GCESDConfigs) != 1 {
+		t.Errorf("len(GCESDConfigs) = %d, want 1", len(sc.GCESDConfigs))
+	}
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{"gce_sd_configs:", "project: infra"} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package prometheus
+
+// HetznerRole is the Hetzner API to discover servers from.
+type HetznerRole string
+
+// Hetzner discovery roles.
+const (
+	// HetznerRoleHcloud discovers Hetzner Cloud servers.
+	HetznerRoleHcloud HetznerRole = "hcloud"
+
+	// HetznerRoleRobot discovers dedicated servers through the Robot API.
+	HetznerRoleRobot HetznerRole = "robot"
+)
+
+// HetznerSD configures Hetzner service discovery.
+// The hcloud role authenticates with a bearer token; the robot role uses
+// basic authentication.
+//
+// Example usage:
+//
+//	var HetznerDiscovery = prometheus.NewHetznerSD(prometheus.HetznerRoleHcloud).
+//	    WithBearerTokenFile("/etc/prometheus/hcloud-token").
+//	    WithPort(9100)
+type HetznerSD struct {
+	// Role is the Hetzner API to discover servers from.
+	Role HetznerRole `yaml:"role"`
+
+	// Port is the port to scrape metrics from.
+	// Defaults to 80.
+	Port int `yaml:"port,omitempty"`
+
+	// RefreshInterval is the time after which the servers are refreshed.
+	// Defaults to 60s.
+	RefreshInterval Duration `yaml:"refresh_interval,omitempty"`
+
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// the Hetzner API.
+	HTTPClientConfig `yaml:",inline"`
+}
+
+// NewHetznerSD creates a new Hetzner service discovery configuration with the given role.
+func NewHetznerSD(role HetznerRole) *HetznerSD {
+	return &HetznerSD{
+		Role: role,
+	}
+}
+
+// WithPort sets the port to scrape metrics from.
+func (h *HetznerSD) WithPort(port int) *HetznerSD {
+	h.Port = port
+	return h
+}
+
+// WithRefreshInterval sets the refresh interval.
+func (h *HetznerSD) WithRefreshInterval(d Duration) *HetznerSD {
+	h.RefreshInterval = d
+	return h
+}
+
+// WithBasicAuth sets the basic authentication configuration.
+func (h *HetznerSD) WithBasicAuth(auth *BasicAuth) *HetznerSD {
+	h.BasicAuth = auth
+	return h
+}
+
+// WithBearerTokenFile sets the API token file.
+func (h *HetznerSD) WithBearerTokenFile(path string) *HetznerSD {
+	h.BearerTokenFile = path
+	return h
+}
+
+// WithTLSConfig sets the TLS configuration.
+func (h *HetznerSD) WithTLSConfig(tls *TLSConfig) *HetznerSD {
+	h.TLSConfig = tls
+	return h
+}
+
+// WithProxyURL sets the proxy URL.
+func (h *HetznerSD) WithProxyURL(url string) *HetznerSD {
+	h.ProxyURL = url
+	return h
+}
+
+// WithBearerToken sets the bearer token for authentication.
+func (h *HetznerSD) WithBearerToken(token Secret) *HetznerSD {
+	h.BearerToken = token
+	return h
+}
+
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (h *HetznerSD) WithNoProxy(hosts string) *HetznerSD {
+	h.NoProxy = hosts
+	return h
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (h *HetznerSD) WithProxyFromEnvironment() *HetznerSD {
+	h.ProxyFromEnvironment = true
+	return h
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (h *HetznerSD) WithProxyConnectHeader(name string, values ...Secret) *HetznerSD {
+	if h.ProxyConnectHeader == nil {
+		h.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	h.ProxyConnectHeader[name] = values
+	return h
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (h *HetznerSD) WithFollowRedirects(follow bool) *HetznerSD {
+	h.FollowRedirects = &follow
+	return h
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (h *HetznerSD) WithEnableHTTP2(enable bool) *HetznerSD {
+	h.EnableHTTP2 = &enable
+	return h
+}
+
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (h *HetznerSD) WithAuthorization(credentials Secret) *HetznerSD {
+	h.Authorization = NewAuthorization(credentials)
+	return h
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (h *HetznerSD) WithAuthorizationFile(path string) *HetznerSD {
+	h.Authorization = NewAuthorizationFile(path)
+	return h
+}
+
+// WithOAuth2 sets OAuth 2.0 client credentials authentication.
+func (h *HetznerSD) WithOAuth2(oauth2 *OAuth2) *HetznerSD {
+	h.OAuth2 = oauth2
+	return h
+}
@@ -0,0 +1,70 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestHetznerSD_Serialize_Hcloud(t *testing.T) {
+	sd := NewHetznerSD(HetznerRoleHcloud).
+		WithBearerTokenFile("/etc/prometheus/hcloud-token").
+		WithPort(9100)
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"role: hcloud",
+		"bearer_token_file: /etc/prometheus/hcloud-token",
+		"port: 9100",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestHetznerSD_Serialize_Robot(t *testing.T) {
+	sd := NewHetznerSD(HetznerRoleRobot).
+		WithBasicAuth(&BasicAuth{Username: "robot-user", PasswordFile: "/etc/robot-pass"})
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{"role: robot", "username: robot-user"} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestScrapeConfig_WithHetznerSD(t *testing.T) {
+	sc := NewScrapeConfig("hetzner").
+		WithHetznerSD(NewHetznerSD(HetznerRoleRobot))
+
+	if len(sc.HetznerSDConfigs) != 1 {
+		t.Errorf("len(HetznerSDConfigs) = %d, want 1", len(sc.HetznerSDConfigs))
+	}
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{"hetzner_sd_configs:", "role: robot"} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
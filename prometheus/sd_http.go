@@ -0,0 +1,117 @@
+package prometheus
+
+// HTTPSD configures HTTP-based service discovery.
+// It fetches target groups from an HTTP endpoint that returns the same JSON
+// format as file-based service discovery.
+//
+// Example usage:
+//
+//	var HTTPDiscovery = prometheus.NewHTTPSD("https://inventory.example.com/targets").
+//	    WithRefreshInterval(5 * prometheus.Minute)
+type HTTPSD struct {
+	// URL is the endpoint to fetch targets from.
+	URL string `yaml:"url"`
+
+	// RefreshInterval is the time after which the targets are re-fetched.
+	// Defaults to 60s.
+	RefreshInterval Duration `yaml:"refresh_interval,omitempty"`
+
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// the endpoint.
+	HTTPClientConfig `yaml:",inline"`
+}
+
+// NewHTTPSD creates a new HTTP service discovery configuration for the given URL.
+func NewHTTPSD(url string) *HTTPSD {
+	return &HTTPSD{
+		URL: url,
+	}
+}
+
+// WithRefreshInterval sets the target refresh interval.
+func (h *HTTPSD) WithRefreshInterval(d Duration) *HTTPSD {
+	h.RefreshInterval = d
+	return h
+}
+
+// WithBasicAuth sets the basic authentication configuration.
+func (h *HTTPSD) WithBasicAuth(auth *BasicAuth) *HTTPSD {
+	h.BasicAuth = auth
+	return h
+}
+
+// WithBearerTokenFile sets the bearer token file for authentication.
+func (h *HTTPSD) WithBearerTokenFile(path string) *HTTPSD {
+	h.BearerTokenFile = path
+	return h
+}
+
+// WithTLSConfig sets the TLS configuration.
+func (h *HTTPSD) WithTLSConfig(tls *TLSConfig) *HTTPSD {
+	h.TLSConfig = tls
+	return h
+}
+
+// WithProxyURL sets the proxy URL.
+func (h *HTTPSD) WithProxyURL(url string) *HTTPSD {
+	h.ProxyURL = url
+	return h
+}
+
+// WithBearerToken sets the bearer token for authentication.
+func (h *HTTPSD) WithBearerToken(token Secret) *HTTPSD {
+	h.BearerToken = token
+	return h
+}
+
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (h *HTTPSD) WithNoProxy(hosts string) *HTTPSD {
+	h.NoProxy = hosts
+	return h
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (h *HTTPSD) WithProxyFromEnvironment() *HTTPSD {
+	h.ProxyFromEnvironment = true
+	return h
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (h *HTTPSD) WithProxyConnectHeader(name string, values ...Secret) *HTTPSD {
+	if h.ProxyConnectHeader == nil {
+		h.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	h.ProxyConnectHeader[name] = values
+	return h
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (h *HTTPSD) WithFollowRedirects(follow bool) *HTTPSD {
+	h.FollowRedirects = &follow
+	return h
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (h *HTTPSD) WithEnableHTTP2(enable bool) *HTTPSD {
+	h.EnableHTTP2 = &enable
+	return h
+}
+
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (h *HTTPSD) WithAuthorization(credentials Secret) *HTTPSD {
+	h.Authorization = NewAuthorization(credentials)
+	return h
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (h *HTTPSD) WithAuthorizationFile(path string) *HTTPSD {
+	h.Authorization = NewAuthorizationFile(path)
+	return h
+}
+
+// WithOAuth2 sets OAuth 2.0 client credentials authentication.
+func (h *HTTPSD) WithOAuth2(oauth2 *OAuth2) *HTTPSD {
+	h.OAuth2 = oauth2
+	return h
+}
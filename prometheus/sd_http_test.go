@@ -0,0 +1,79 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestHTTPSD_Serialize(t *testing.T) {
+	sd := NewHTTPSD("https://inventory.example.com/targets").
+		WithRefreshInterval(Duration(5 * time.Minute)).
+		WithBearerTokenFile("/etc/prometheus/token").
+		WithProxyURL("http://proxy:3128")
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"url: https://inventory.example.com/targets",
+		"refresh_interval: 5m",
+		"bearer_token_file: /etc/prometheus/token",
+		"proxy_url: http://proxy:3128",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestHTTPSD_Unmarshal(t *testing.T) {
+	input := `
+url: http://sd.example.com/targets
+refresh_interval: 30s
+basic_auth:
+  username: prom
+`
+	var sd HTTPSD
+	if err := yaml.Unmarshal([]byte(input), &sd); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+
+	if sd.URL != "http://sd.example.com/targets" {
+		t.Errorf("URL = %v, want http://sd.example.com/targets", sd.URL)
+	}
+	if sd.RefreshInterval != Duration(30*time.Second) {
+		t.Errorf("RefreshInterval = %v, want 30s", sd.RefreshInterval)
+	}
+	if sd.BasicAuth == nil || sd.BasicAuth.Username != "prom" {
+		t.Errorf("BasicAuth = %v, want username prom", sd.BasicAuth)
+	}
+}
+
+func TestScrapeConfig_WithHTTPSD(t *testing.T) {
+	sc := NewScrapeConfig("http").
+		WithHTTPSD(NewHTTPSD("https://inventory.example.com/targets"))
+
+	if len(sc.HTTPSDConfigs) != 1 {
+		t.Errorf("len(HTTPSDConfigs) = %d, want 1", len(sc.HTTPSDConfigs))
+	}
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{"http_sd_configs:", "url: https://inventory.example.com/targets"} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
@@ -30,26 +30,9 @@ type KubernetesSD struct {
 	// If not set, the in-cluster config or KUBECONFIG env var is used.
 	KubeConfigFile string `yaml:"kubeconfig_file,omitempty"`
 
-	// TLSConfig configures TLS settings for connecting to the API server.
-	TLSConfig *TLSConfig `yaml:"tls_config,omitempty"`
-
-	// BasicAuth configures basic auth for connecting to the API server.
-	BasicAuth *BasicAuth `yaml:"basic_auth,omitempty"`
-
-	// BearerToken is the bearer token to use for authentication.
-	BearerToken string `yaml:"bearer_token,omitempty"`
-
-	// BearerTokenFile is the path to a file containing the bearer token.
-	BearerTokenFile string `yaml:"bearer_token_file,omitempty"`
-
-	// ProxyURL is the URL of a proxy to use for connecting to the API server.
-	ProxyURL string `yaml:"proxy_url,omitempty"`
-
-	// FollowRedirects controls whether to follow HTTP redirects.
-	FollowRedirects *bool `yaml:"follow_redirects,omitempty"`
-
-	// EnableHTTP2 controls whether to use HTTP/2.
-	EnableHTTP2 *bool `yaml:"enable_http2,omitempty"`
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// the API server.
+	HTTPClientConfig `yaml:",inline"`
 }
 
 // KubernetesRole represents the type of Kubernetes resource to discover.
@@ -168,3 +151,73 @@ func (k *KubernetesSD) WithTLSConfig(tls *TLSConfig) *KubernetesSD {
 	k.TLSConfig = tls
 	return k
 }
+
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (k *KubernetesSD) WithAuthorization(credentials Secret) *KubernetesSD {
+	k.Authorization = NewAuthorization(credentials)
+	return k
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (k *KubernetesSD) WithAuthorizationFile(path string) *KubernetesSD {
+	k.Authorization = NewAuthorizationFile(path)
+	return k
+}
+
+// WithOAuth2 sets OAuth 2.0 client credentials authentication.
+func (k *KubernetesSD) WithOAuth2(oauth2 *OAuth2) *KubernetesSD {
+	k.OAuth2 = oauth2
+	return k
+}
+
+// WithBasicAuth sets the basic authentication configuration.
+func (k *KubernetesSD) WithBasicAuth(auth *BasicAuth) *KubernetesSD {
+	k.BasicAuth = auth
+	return k
+}
+
+// WithProxyURL sets the proxy URL.
+func (k *KubernetesSD) WithProxyURL(url string) *KubernetesSD {
+	k.ProxyURL = url
+	return k
+}
+
+// WithBearerToken sets the bearer token for authentication.
+func (k *KubernetesSD) WithBearerToken(token Secret) *KubernetesSD {
+	k.BearerToken = token
+	return k
+}
+
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (k *KubernetesSD) WithNoProxy(hosts string) *KubernetesSD {
+	k.NoProxy = hosts
+	return k
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (k *KubernetesSD) WithProxyFromEnvironment() *KubernetesSD {
+	k.ProxyFromEnvironment = true
+	return k
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (k *KubernetesSD) WithProxyConnectHeader(name string, values ...Secret) *KubernetesSD {
+	if k.ProxyConnectHeader == nil {
+		k.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	k.ProxyConnectHeader[name] = values
+	return k
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (k *KubernetesSD) WithFollowRedirects(follow bool) *KubernetesSD {
+	k.FollowRedirects = &follow
+	return k
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (k *KubernetesSD) WithEnableHTTP2(enable bool) *KubernetesSD {
+	k.EnableHTTP2 = &enable
+	return k
+}
@@ -190,10 +190,12 @@ func TestKubernetesSD_Serialize_APIServer(t *testing.T) {
 func TestKubernetesSD_Serialize_TLS(t *testing.T) {
 	sd := &KubernetesSD{
 		Role: KubernetesRolePod,
-		TLSConfig: &TLSConfig{
-			CAFile:   "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt",
-			CertFile: "/etc/prometheus/certs/client.crt",
-			KeyFile:  "/etc/prometheus/certs/client.key",
+		HTTPClientConfig: HTTPClientConfig{
+			TLSConfig: &TLSConfig{
+				CAFile:   "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt",
+				CertFile: "/etc/prometheus/certs/client.crt",
+				KeyFile:  "/etc/prometheus/certs/client.key",
+			},
 		},
 	}
 
@@ -219,8 +221,10 @@ func TestKubernetesSD_Serialize_TLS(t *testing.T) {
 
 func TestKubernetesSD_Serialize_BearerToken(t *testing.T) {
 	sd := &KubernetesSD{
-		Role:            KubernetesRolePod,
-		BearerTokenFile: "/var/run/secrets/kubernetes.io/serviceaccount/token",
+		Role: KubernetesRolePod,
+		HTTPClientConfig: HTTPClientConfig{
+			BearerTokenFile: "/var/run/secrets/kubernetes.io/serviceaccount/token",
+		},
 	}
 
 	data, err := yaml.Marshal(sd)
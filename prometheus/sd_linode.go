@@ -0,0 +1,143 @@
+package prometheus
+
+// LinodeSD configures Linode service discovery.
+// It discovers Linode instances using a personal access token with
+// read-only Linodes scope.
+//
+// Example usage:
+//
+//	var LinodeDiscovery = prometheus.NewLinodeSD().
+//	    WithBearerTokenFile("/etc/prometheus/linode-token").
+//	    WithRegion("us-east").
+//	    WithPort(9100)
+type LinodeSD struct {
+	// Region limits discovery to instances in a region.
+	Region string `yaml:"region,omitempty"`
+
+	// Port is the port to scrape metrics from.
+	// Defaults to 80.
+	Port int `yaml:"port,omitempty"`
+
+	// TagSeparator is the string by which Linode tags are joined into the
+	// __meta_linode_tags label. Defaults to ",".
+	TagSeparator string `yaml:"tag_separator,omitempty"`
+
+	// RefreshInterval is the time after which the instances are refreshed.
+	// Defaults to 60s.
+	RefreshInterval Duration `yaml:"refresh_interval,omitempty"`
+
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// the Linode API.
+	HTTPClientConfig `yaml:",inline"`
+}
+
+// NewLinodeSD creates a new Linode service discovery configuration.
+func NewLinodeSD() *LinodeSD {
+	return &LinodeSD{}
+}
+
+// WithRegion limits discovery to a region.
+func (l *LinodeSD) WithRegion(region string) *LinodeSD {
+	l.Region = region
+	return l
+}
+
+// WithPort sets the port to scrape metrics from.
+func (l *LinodeSD) WithPort(port int) *LinodeSD {
+	l.Port = port
+	return l
+}
+
+// WithTagSeparator sets the tag separator string.
+func (l *LinodeSD) WithTagSeparator(separator string) *LinodeSD {
+	l.TagSeparator = separator
+	return l
+}
+
+// WithRefreshInterval sets the refresh interval.
+func (l *LinodeSD) WithRefreshInterval(d Duration) *LinodeSD {
+	l.RefreshInterval = d
+	return l
+}
+
+// WithBearerTokenFile sets the API token file.
+func (l *LinodeSD) WithBearerTokenFile(path string) *LinodeSD {
+	l.BearerTokenFile = path
+	return l
+}
+
+// WithBasicAuth sets the basic authentication configuration.
+func (l *LinodeSD) WithBasicAuth(auth *BasicAuth) *LinodeSD {
+	l.BasicAuth = auth
+	return l
+}
+
+// WithTLSConfig sets the TLS configuration.
+func (l *LinodeSD) WithTLSConfig(tls *TLSConfig) *LinodeSD {
+	l.TLSConfig = tls
+	return l
+}
+
+// WithProxyURL sets the proxy URL.
+func (l *LinodeSD) WithProxyURL(url string) *LinodeSD {
+	l.ProxyURL = url
+	return l
+}
+
+// WithBearerToken sets the bearer token for authentication.
+func (l *LinodeSD) WithBearerToken(token Secret) *LinodeSD {
+	l.BearerToken = token
+	return l
+}
+
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (l *LinodeSD) WithNoProxy(hosts string) *LinodeSD {
+	l.NoProxy = hosts
+	return l
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (l *LinodeSD) WithProxyFromEnvironment() *LinodeSD {
+	l.ProxyFromEnvironment = true
+	return l
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (l *LinodeSD) WithProxyConnectHeader(name string, values ...Secret) *LinodeSD {
+	if l.ProxyConnectHeader == nil {
+		l.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	l.ProxyConnectHeader[name] = values
+	return l
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (l *LinodeSD) WithFollowRedirects(follow bool) *LinodeSD {
+	l.FollowRedirects = &follow
+	return l
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (l *LinodeSD) WithEnableHTTP2(enable bool) *LinodeSD {
+	l.EnableHTTP2 = &enable
+	return l
+}
+
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (l *LinodeSD) WithAuthorization(credentials Secret) *LinodeSD {
+	l.Authorization = NewAuthorization(credentials)
+	return l
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (l *LinodeSD) WithAuthorizationFile(path string) *LinodeSD {
+	l.Authorization = NewAuthorizationFile(path)
+	return l
+}
+
+// WithOAuth2 sets OAuth 2.0 client credentials authentication.
+func (l *LinodeSD) WithOAuth2(oauth2 *OAuth2) *LinodeSD {
+	l.OAuth2 = oauth2
+	return l
+}
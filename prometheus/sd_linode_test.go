@@ -0,0 +1,67 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestLinodeSD_Serialize(t *testing.T) {
+	sd := NewLinodeSD().
+		WithBearerTokenFile("/etc/prometheus/linode-token").
+		WithRegion("us-east").
+		WithPort(9100).
+		WithRefreshInterval(Duration(2 * time.Minute))
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"bearer_token_file: /etc/prometheus/linode-token",
+		"region: us-east",
+		"port: 9100",
+		"refresh_interval: 2m",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestLinodeSD_Empty(t *testing.T) {
+	data, err := yaml.Marshal(NewLinodeSD())
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+	if strings.TrimSpace(string(data)) != "{}" {
+		t.Errorf("yaml.Marshal() = %q, want {}", data)
+	}
+}
+
+func TestScrapeConfig_WithLinodeSD(t *testing.T) {
+	sc := NewScrapeConfig("linode").
+		WithLinodeSD(NewLinodeSD().WithRegion("us-east"))
+
+	if len(sc.LinodeSDConfigs) != 1 {
+		t.Errorf("len(LinodeSDConfigs) = %d, want 1", len(sc.LinodeSDConfigs))
+	}
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{"linode_sd_configs:", "region: us-east"} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package prometheus
+
+// NomadSD configures HashiCorp Nomad service discovery.
+// It discovers services registered in the Nomad service catalog.
+//
+// Example usage:
+//
+//	var NomadDiscovery = prometheus.NewNomadSD("http://nomad.example.com:4646").
+//	    WithNamespace("platform").
+//	    WithRegion("global")
+type NomadSD struct {
+	// Server is the Nomad API address.
+	Server string `yaml:"server"`
+
+	// Namespace is the Nomad namespace to query.
+	// Defaults to "default".
+	Namespace string `yaml:"namespace,omitempty"`
+
+	// Region is the Nomad region to query.
+	// Defaults to "global".
+	Region string `yaml:"region,omitempty"`
+
+	// AllowStale allows any Nomad server (non-leader) to service a read.
+	// Defaults to true.
+	AllowStale *bool `yaml:"allow_stale,omitempty"`
+
+	// TagSeparator is the string by which service tags are joined into the
+	// __meta_nomad_tags label. Defaults to ",".
+	TagSeparator string `yaml:"tag_separator,omitempty"`
+
+	// RefreshInterval is the time after which the service list is refreshed.
+	// Defaults to 30s.
+	RefreshInterval Duration `yaml:"refresh_interval,omitempty"`
+
+	// HTTPClientConfig configures authentication, TLS and proxy settings for
+	// Nomad.
+	HTTPClientConfig `yaml:",inline"`
+}
+
+// NewNomadSD creates a new Nomad service discovery configuration for the given server.
+func NewNomadSD(server string) *NomadSD {
+	return &NomadSD{
+		Server: server,
+	}
+}
+
+// WithNamespace sets the Nomad namespace.
+func (n *NomadSD) WithNamespace(namespace string) *NomadSD {
+	n.Namespace = namespace
+	return n
+}
+
+// WithRegion sets the Nomad region.
+func (n *NomadSD) WithRegion(region string) *NomadSD {
+	n.Region = region
+	return n
+}
+
+// WithAllowStale sets whether stale reads are allowed.
+func (n *NomadSD) WithAllowStale(allow bool) *NomadSD {
+	n.AllowStale = &allow
+	return n
+}
+
+// WithTagSeparator sets the tag separator string.
+func (n *NomadSD) WithTagSeparator(separator string) *NomadSD {
+	n.TagSeparator = separator
+	return n
+}
+
+// WithRefreshInterval sets the service list refresh interval.
+func (n *NomadSD) WithRefreshInterval(d Duration) *NomadSD {
+	n.RefreshInterval = d
+	return n
+}
+
+// WithBearerTokenFile sets the bearer token file for authentication.
+func (n *NomadSD) WithBearerTokenFile(path string) *NomadSD {
+	n.BearerTokenFile = path
+	return n
+}
+
+// WithTLSConfig sets the TLS configuration.
+func (n *NomadSD) WithTLSConfig(tls *TLSConfig) *NomadSD {
+	n.TLSConfig = tls
+	return n
+}
+
+// WithBasicAuth sets the basic authentication configuration.
+func (n *NomadSD) WithBasicAuth(auth *BasicAuth) *NomadSD {
+	n.BasicAuth = auth
+	return n
+}
+
+// WithProxyURL sets the proxy URL.
+func (n *NomadSD) WithProxyURL(url string) *NomadSD {
+	n.ProxyURL = url
+	return n
+}
+
+// WithBearerToken sets the bearer token for authentication.
+func (n *NomadSD) WithBearerToken(token Secret) *NomadSD {
+	n.BearerToken = token
+	return n
+}
+
+// WithNoProxy sets the comma-separated hosts excluded from proxying.
+func (n *NomadSD) WithNoProxy(hosts string) *NomadSD {
+	n.NoProxy = hosts
+	return n
+}
+
+// WithProxyFromEnvironment uses the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (n *NomadSD) WithProxyFromEnvironment() *NomadSD {
+	n.ProxyFromEnvironment = true
+	return n
+}
+
+// WithProxyConnectHeader sets a header sent to the proxy on CONNECT requests.
+func (n *NomadSD) WithProxyConnectHeader(name string, values ...Secret) *NomadSD {
+	if n.ProxyConnectHeader == nil {
+		n.ProxyConnectHeader = make(map[string][]Secret)
+	}
+	n.ProxyConnectHeader[name] = values
+	return n
+}
+
+// WithFollowRedirects sets whether to follow HTTP redirects.
+func (n *NomadSD) WithFollowRedirects(follow bool) *NomadSD {
+	n.FollowRedirects = &follow
+	return n
+}
+
+// WithEnableHTTP2 sets whether to use HTTP/2.
+func (n *NomadSD) WithEnableHTTP2(enable bool) *NomadSD {
+	n.EnableHTTP2 = &enable
+	return n
+}
+
+// WithAuthorization sets Bearer credentials for the Authorization header.
+func (n *NomadSD) WithAuthorization(credentials Secret) *NomadSD {
+	n.Authorization = NewAuthorization(credentials)
+	return n
+}
+
+// WithAuthorizationFile sets Bearer credentials read from a file.
+func (n *NomadSD) WithAuthorizationFile(path string) *NomadSD {
+	n.Authorization = NewAuthorizationFile(path)
+	return n
+}
+
+// WithOAuth2 sets OAuth 2.0 client credentials authentication.
+func (n *NomadSD) WithOAuth2(oauth2 *OAuth2) *NomadSD {
+	n.OAuth2 = oauth2
+	return n
+}
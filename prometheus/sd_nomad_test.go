@@ -0,0 +1,67 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNomadSD_Serialize(t *testing.T) {
+	sd := NewNomadSD("http://nomad:4646").
+		WithNamespace("platform").
+		WithRegion("eu").
+		WithAllowStale(false).
+		WithTagSeparator("|")
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"server: http://nomad:4646",
+		"namespace: platform",
+		"region: eu",
+		"allow_stale: false",
+		"tag_separator: '|'",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestNomadSD_OmitsDefaults(t *testing.T) {
+	data, err := yaml.Marshal(NewNomadSD("http://nomad:4646"))
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+	if strings.Contains(string(data), "allow_stale") {
+		t.Errorf("yaml.Marshal() should omit unset allow_stale\nGot:\n%s", data)
+	}
+}
+
+func TestScrapeConfig_WithNomadSD(t *testing.T) {
+	sc := NewScrapeConfig("nomad").
+		WithNomadSD(NewNomadSD("http://nomad:4646"))
+
+	if len(sc.NomadSDConfigs) != 1 {
+		t.Errorf("len(NomadSDConfigs) = %d, want 1", len(sc.NomadSDConfigs))
+	}
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{"nomad_sd_configs:", "server: http://nomad:4646"} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
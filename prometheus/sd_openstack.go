@@ -0,0 +1,157 @@
+package prometheus
+
+// OpenStackRole is the type of OpenStack resource to discover.
+type OpenStackRole string
+
+// OpenStack discovery roles.
+const (
+	// OpenStackRoleInstance discovers one target per network interface of
+	// each Nova instance.
+	OpenStackRoleInstance OpenStackRole = "instance"
+
+	// OpenStackRoleHypervisor discovers one target per Nova hypervisor.
+	OpenStackRoleHypervisor OpenStackRole = "hypervisor"
+
+	// OpenStackRoleLoadBalancer discovers one target per Octavia load balancer.
+	OpenStackRoleLoadBalancer OpenStackRole = "loadbalancer"
+)
+
+// OpenStackSD configures OpenStack service discovery.
+// It discovers Nova instances, hypervisors or Octavia load balancers.
+//
+// Example usage:
+//
+//	var OpenStackDiscovery = prometheus.NewOpenStackSD(prometheus.OpenStackRoleInstance, "RegionOne").
+//	    WithIdentityEndpoint("https://keystone.example.com:5000/v3").
+//	    WithApplicationCredential("a1b2c3", "${env:OS_APP_CRED_SECRET}").
+//	    WithPort(9100)
+type OpenStackSD struct {
+	// Role is the type of resource to discover.
+	Role OpenStackRole `yaml:"role"`
+
+	// Region is the OpenStack region to discover resources in.
+	Region string `yaml:"region"`
+
+	// IdentityEndpoint is the Keystone URL.
+	IdentityEndpoint string `yaml:"identity_endpoint,omitempty"`
+
+	// Username is the user to authenticate as. Either Username or UserID is
+	// required for password authentication.
+	Username string `yaml:"username,omitempty"`
+
+	// UserID is the ID of the user to authenticate as.
+	UserID string `yaml:"userid,omitempty"`
+
+	// Password is the password of the user.
+	Password Secret `yaml:"password,omitempty"`
+
+	// DomainName is the name of the user's domain (Identity v3).
+	DomainName string `yaml:"domain_name,omitempty"`
+
+	// DomainID is the ID of the user's domain (Identity v3).
+	DomainID string `yaml:"domain_id,omitempty"`
+
+	// ProjectName is the project to scope the token to.
+	ProjectName string `yaml:"project_name,omitempty"`
+
+	// ProjectID is the ID of the project to scope the token to.
+	ProjectID string `yaml:"project_id,omitempty"`
+
+	// ApplicationCredentialName is the name of an application credential.
+	ApplicationCredentialName string `yaml:"application_credential_name,omitempty"`
+
+	// ApplicationCredentialID is the ID of an application credential.
+	ApplicationCredentialID string `yaml:"application_credential_id,omitempty"`
+
+	// ApplicationCredentialSecret is the secret of an application credential.
+	ApplicationCredentialSecret Secret `yaml:"application_credential_secret,omitempty"`
+
+	// AllTenants discovers instances of all projects (instance role only,
+	// requires admin rights).
+	AllTenants bool `yaml:"all_tenants,omitempty"`
+
+	// RefreshInterval is the time after which the resources are refreshed.
+	// Defaults to 60s.
+	RefreshInterval Duration `yaml:"refresh_interval,omitempty"`
+
+	// Port is the port to scrape metrics from.
+	// Defaults to 80.
+	Port int `yaml:"port,omitempty"`
+
+	// Availability is the endpoint availability to connect to: public,
+	// admin or internal. Defaults to public.
+	Availability string `yaml:"availability,omitempty"`
+
+	// TLSConfig configures TLS settings for connecting to OpenStack.
+	TLSConfig *TLSConfig `yaml:"tls_config,omitempty"`
+}
+
+// NewOpenStackSD creates a new OpenStack service discovery configuration.
+func NewOpenStackSD(role OpenStackRole, region string) *OpenStackSD {
+	return &OpenStackSD{
+		Role:   role,
+		Region: region,
+	}
+}
+
+// WithIdentityEndpoint sets the Keystone URL.
+func (o *OpenStackSD) WithIdentityEndpoint(endpoint string) *OpenStackSD {
+	o.IdentityEndpoint = endpoint
+	return o
+}
+
+// WithPassword authenticates with a username and password.
+func (o *OpenStackSD) WithPassword(username string, password Secret) *OpenStackSD {
+	o.Username = username
+	o.Password = password
+	return o
+}
+
+// WithDomainName sets the user's domain name.
+func (o *OpenStackSD) WithDomainName(domain string) *OpenStackSD {
+	o.DomainName = domain
+	return o
+}
+
+// WithProjectName sets the project to scope the token to.
+func (o *OpenStackSD) WithProjectName(project string) *OpenStackSD {
+	o.ProjectName = project
+	return o
+}
+
+// WithApplicationCredential authenticates with an application credential ID and secret.
+func (o *OpenStackSD) WithApplicationCredential(id string, secret Secret) *OpenStackSD {
+	o.ApplicationCredentialID = id
+	o.ApplicationCredentialSecret = secret
+	return o
+}
+
+// WithAllTenants discovers instances of all projects.
+func (o *OpenStackSD) WithAllTenants() *OpenStackSD {
+	o.AllTenants = true
+	return o
+}
+
+// WithRefreshInterval sets the refresh interval.
+func (o *OpenStackSD) WithRefreshInterval(d Duration) *OpenStackSD {
+	o.RefreshInterval = d
+	return o
+}
+
+// WithPort sets the port to scrape metrics from.
+func (o *OpenStackSD) WithPort(port int) *OpenStackSD {
+	o.Port = port
+	return o
+}
+
+// WithAvailability sets the endpoint availability (public, admin or internal).
+func (o *OpenStackSD) WithAvailability(availability string) *OpenStackSD {
+	o.Availability = availability
+	return o
+}
+
+// WithTLSConfig sets the TLS configuration.
+func (o *OpenStackSD) WithTLSConfig(tls *TLSConfig) *OpenStackSD {
+	o.TLSConfig = tls
+	return o
+}
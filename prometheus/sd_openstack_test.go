@@ -0,0 +1,89 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestOpenStackSD_Serialize_Password(t *testing.T) {
+	sd := NewOpenStackSD(OpenStackRoleInstance, "RegionOne").
+		WithIdentityEndpoint("https://keystone:5000/v3").
+		WithPassword("prometheus", "secret").
+		WithDomainName("Default").
+		WithProjectName("monitoring").
+		WithAvailability("internal").
+		WithAllTenants().
+		WithPort(9100)
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"role: instance",
+		"region: RegionOne",
+		"identity_endpoint: https://keystone:5000/v3",
+		"username: prometheus",
+		"password: secret",
+		"domain_name: Default",
+		"project_name: monitoring",
+		"availability: internal",
+		"all_tenants: true",
+		"port: 9100",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestOpenStackSD_ApplicationCredential(t *testing.T) {
+	sd := NewOpenStackSD(OpenStackRoleHypervisor, "RegionTwo").
+		WithApplicationCredential("cred-id", "cred-secret")
+
+	data, err := yaml.Marshal(sd)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{
+		"role: hypervisor",
+		"application_credential_id: cred-id",
+		"application_credential_secret: cred-secret",
+	} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+	if strings.Contains(yamlStr, "username") {
+		t.Errorf("yaml.Marshal() should omit empty username\nGot:\n%s", yamlStr)
+	}
+}
+
+func TestScrapeConfig_WithOpenStackSD(t *testing.T) {
+	sc := NewScrapeConfig("openstack").
+		WithOpenStackSD(NewOpenStackSD(OpenStackRoleHypervisor, "RegionOne"))
+
+	if len(sc.OpenStackSDConfigs) != 1 {
+		t.Errorf("len(OpenStackSDConfigs) = %d, want 1", len(sc.OpenStackSDConfigs))
+	}
+
+	data, err := yaml.Marshal(sc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	for _, exp := range []string{"openstack_sd_configs:", "role: hypervisor"} {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
@@ -41,3 +41,19 @@ func (c *GlobalConfig) Serialize() ([]byte, error) {
 func (c *ScrapeConfig) Serialize() ([]byte, error) {
 	return yaml.Marshal(c)
 }
+
+// Serialize converts a WebConfig to YAML bytes.
+// The output is a valid web-config.yml configuration.
+func (w *WebConfig) Serialize() ([]byte, error) {
+	return yaml.Marshal(w)
+}
+
+// SerializeToFile writes a WebConfig to a file in YAML format.
+// The file holds password hashes, so it is created with 0600 permissions.
+func (w *WebConfig) SerializeToFile(path string) error {
+	data, err := w.Serialize()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
@@ -432,9 +432,11 @@ func TestPrometheusConfig_CompleteExample(t *testing.T) {
 				JobName:     "api-servers",
 				MetricsPath: "/metrics",
 				Scheme:      "https",
-				TLSConfig: &TLSConfig{
-					CAFile:             "/etc/prometheus/ca.crt",
-					InsecureSkipVerify: false,
+				HTTPClientConfig: HTTPClientConfig{
+					TLSConfig: &TLSConfig{
+						CAFile:             "/etc/prometheus/ca.crt",
+						InsecureSkipVerify: false,
+					},
 				},
 				StaticConfigs: []*StaticConfig{
 					{
@@ -0,0 +1,79 @@
+package prometheus
+
+import "strconv"
+
+// ShardLabel is the external label identifying the shard of a sharded
+// Prometheus server.
+const ShardLabel = "shard"
+
+// shardHashLabel is the temporary label holding a target's hash. It is
+// distinct from the __tmp_hash of the Prometheus hashmod example so jobs
+// using that name do not overwrite it.
+const shardHashLabel = "__tmp_wetwire_shard"
+
+// Shard splits scrape configs across n Prometheus servers. Every target is
+// hashed on its address and kept by exactly one shard, so each returned
+// config scrapes a disjoint subset of the targets. Shard i carries the
+// external label shard="i".
+//
+// The input configs are not modified. Targets are hashed on their
+// discovered address and dropped before the existing relabel configs run,
+// so jobs rewriting __address__ to a single exporter, such as blackbox
+// probes, are still spread across shards, and labelkeep or labeldrop rules
+// cannot remove the hash label.
+//
+// Example usage:
+//
+//	for i, shard := range prometheus.Shard(scrapeConfigs, 3) {
+//	    shard.RemoteWrite = remoteWrite
+//	    shard.SerializeToFile(fmt.Sprintf("prometheus-%d.yml", i))
+//	}
+func Shard(configs []*ScrapeConfig, n int) []*PrometheusConfig {
+	return (&PrometheusConfig{ScrapeConfigs: configs}).Shard(n)
+}
+
+// Shard splits the scrape configs of c across n Prometheus servers like
+// Shard. The other sections of c are shared by every shard; the global
+// config is copied so each shard gets its own external labels.
+func (c *PrometheusConfig) Shard(n int) []*PrometheusConfig {
+	if n < 1 {
+		n = 1
+	}
+	shards := make([]*PrometheusConfig, n)
+	for i := range shards {
+		shard := *c
+		global := GlobalConfig{}
+		if c.Global != nil {
+			global = *c.Global
+		}
+		labels := make(map[string]string, len(global.ExternalLabels)+1)
+		for k, v := range global.ExternalLabels {
+			labels[k] = v
+		}
+		labels[ShardLabel] = strconv.Itoa(i)
+		global.ExternalLabels = labels
+		shard.Global = &global
+
+		shard.ScrapeConfigs = make([]*ScrapeConfig, 0, len(c.ScrapeConfigs))
+		for _, sc := range c.ScrapeConfigs {
+			if sc == nil {
+				continue
+			}
+			shard.ScrapeConfigs = append(shard.ScrapeConfigs, shardScrapeConfig(sc, n, i))
+		}
+		shards[i] = &shard
+	}
+	return shards
+}
+
+// shardScrapeConfig returns a copy of sc keeping only the targets of shard i.
+func shardScrapeConfig(sc *ScrapeConfig, n, i int) *ScrapeConfig {
+	out := *sc
+	out.RelabelConfigs = make([]*RelabelConfig, 0, len(sc.RelabelConfigs)+2)
+	out.RelabelConfigs = append(out.RelabelConfigs,
+		HashMod("__address__", shardHashLabel, uint64(n)),
+		KeepByLabel(shardHashLabel, strconv.Itoa(i)),
+	)
+	out.RelabelConfigs = append(out.RelabelConfigs, sc.RelabelConfigs...)
+	return &out
+}
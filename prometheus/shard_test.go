@@ -0,0 +1,141 @@
+package prometheus
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestShard(t *testing.T) {
+	api := NewScrapeConfig("api").WithStaticTargets("api:8080")
+	api.RelabelConfigs = []*RelabelConfig{DropLabels("tmp_.*")}
+	node := NewScrapeConfig("node").WithStaticTargets("node:9100")
+
+	shards := Shard([]*ScrapeConfig{api, node}, 3)
+	if len(shards) != 3 {
+		t.Fatalf("len(Shard()) = %d, want 3", len(shards))
+	}
+	for i, shard := range shards {
+		if got := shard.Global.ExternalLabels[ShardLabel]; got != fmt.Sprint(i) {
+			t.Errorf("shards[%d] shard label = %q, want %d", i, got, i)
+		}
+		if len(shard.ScrapeConfigs) != 2 {
+			t.Fatalf("shards[%d] len(ScrapeConfigs) = %d, want 2", i, len(shard.ScrapeConfigs))
+		}
+		relabels := shard.ScrapeConfigs[0].RelabelConfigs
+		if len(relabels) != 3 {
+			t.Fatalf("shards[%d] len(RelabelConfigs) = %d, want 3", i, len(relabels))
+		}
+		if relabels[0].Modulus != 3 {
+			t.Errorf("shards[%d] Modulus = %d, want 3", i, relabels[0].Modulus)
+		}
+		if relabels[1].Action != string(RelabelKeep) {
+			t.Errorf("shards[%d] keep should run right after hashing, got %q", i, relabels[1].Action)
+		}
+		if relabels[2].Action != string(RelabelLabelDrop) {
+			t.Errorf("shards[%d] existing relabel should run last, got %q", i, relabels[2].Action)
+		}
+	}
+
+	if len(api.RelabelConfigs) != 1 {
+		t.Errorf("input RelabelConfigs modified: len = %d, want 1", len(api.RelabelConfigs))
+	}
+}
+
+func TestShard_DisjointTargets(t *testing.T) {
+	sc := NewScrapeConfig("pods")
+	shards := Shard([]*ScrapeConfig{sc}, 4)
+
+	for n := 0; n < 100; n++ {
+		target := map[string]string{"__address__": fmt.Sprintf("10.0.0.%d:8080", n)}
+		kept := 0
+		for _, shard := range shards {
+			if _, keep := ApplyRelabel(shard.ScrapeConfigs[0].RelabelConfigs, target); keep {
+				kept++
+			}
+		}
+		if kept != 1 {
+			t.Errorf("target %s kept by %d shards, want 1", target["__address__"], kept)
+		}
+	}
+}
+
+func TestShard_ExistingTemporaryLabels(t *testing.T) {
+	// A job using the documented hashmod label and keeping only a few
+	// labels must not lose or misplace its targets.
+	sc := NewScrapeConfig("pods")
+	sc.RelabelConfigs = []*RelabelConfig{
+		HashMod("__address__", "__tmp_hash", 1),
+		KeepLabels("__address__|job"),
+	}
+	shards := Shard([]*ScrapeConfig{sc}, 3)
+
+	for n := 0; n < 50; n++ {
+		target := map[string]string{"__address__": fmt.Sprintf("10.0.0.%d:8080", n), "job": "pods"}
+		kept := 0
+		for _, shard := range shards {
+			if _, keep := ApplyRelabel(shard.ScrapeConfigs[0].RelabelConfigs, target); keep {
+				kept++
+			}
+		}
+		if kept != 1 {
+			t.Errorf("target %s kept by %d shards, want 1", target["__address__"], kept)
+		}
+	}
+}
+
+func TestPrometheusConfig_Shard(t *testing.T) {
+	config := &PrometheusConfig{
+		Global: &GlobalConfig{
+			ScrapeInterval: 30 * Second,
+			ExternalLabels: map[string]string{"cluster": "prod"},
+		},
+		ScrapeConfigs: []*ScrapeConfig{NewScrapeConfig("api")},
+		RemoteWrite:   []*RemoteWriteConfig{NewRemoteWrite("https://mimir/api/v1/push")},
+	}
+
+	shards := config.Shard(2)
+	for i, shard := range shards {
+		if shard.Global.ScrapeInterval != 30*Second {
+			t.Errorf("shards[%d] ScrapeInterval = %v, want 30s", i, shard.Global.ScrapeInterval)
+		}
+		if shard.Global.ExternalLabels["cluster"] != "prod" {
+			t.Errorf("shards[%d] cluster label = %q, want prod", i, shard.Global.ExternalLabels["cluster"])
+		}
+		if len(shard.RemoteWrite) != 1 {
+			t.Errorf("shards[%d] len(RemoteWrite) = %d, want 1", i, len(shard.RemoteWrite))
+		}
+		if errs := shard.Validate(); len(errs) > 0 {
+			t.Errorf("shards[%d] Validate() = %v", i, errs)
+		}
+	}
+	if _, ok := config.Global.ExternalLabels[ShardLabel]; ok {
+		t.Error("input external labels modified")
+	}
+
+	data, err := shards[1].Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	for _, want := range []string{
+		`shard: "1"`,
+		"action: hashmod",
+		"modulus: 2",
+		"target_label: __tmp_wetwire_shard",
+		"action: keep",
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", want, data)
+		}
+	}
+}
+
+func TestShard_Single(t *testing.T) {
+	shards := Shard([]*ScrapeConfig{NewScrapeConfig("api")}, 0)
+	if len(shards) != 1 {
+		t.Fatalf("len(Shard()) = %d, want 1", len(shards))
+	}
+	if shards[0].Global.ExternalLabels[ShardLabel] != "0" {
+		t.Errorf("shard label = %q, want 0", shards[0].Global.ExternalLabels[ShardLabel])
+	}
+}
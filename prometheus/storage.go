@@ -0,0 +1,54 @@
+package prometheus
+
+// StorageConfig configures the local TSDB and the exemplar store.
+//
+// Example usage:
+//
+//	var Storage = prometheus.NewStorageConfig().
+//	    WithOutOfOrderTimeWindow(30 * prometheus.Minute).
+//	    WithMaxExemplars(100000)
+type StorageConfig struct {
+	// TSDB configures the time series database.
+	TSDB *TSDBConfig `yaml:"tsdb,omitempty"`
+
+	// Exemplars configures the exemplar store. Requires the
+	// exemplar-storage feature flag.
+	Exemplars *ExemplarsConfig `yaml:"exemplars,omitempty"`
+}
+
+// TSDBConfig configures the time series database.
+type TSDBConfig struct {
+	// OutOfOrderTimeWindow is how far back samples may be ingested out of
+	// order. Defaults to 0 (out-of-order ingestion disabled).
+	OutOfOrderTimeWindow Duration `yaml:"out_of_order_time_window,omitempty"`
+}
+
+// ExemplarsConfig configures the exemplar store.
+type ExemplarsConfig struct {
+	// MaxExemplars is the size of the circular exemplar buffer shared by
+	// all series. Defaults to 100000.
+	MaxExemplars int64 `yaml:"max_exemplars,omitempty"`
+}
+
+// NewStorageConfig creates a new empty StorageConfig.
+func NewStorageConfig() *StorageConfig {
+	return &StorageConfig{}
+}
+
+// WithOutOfOrderTimeWindow enables out-of-order ingestion within the window.
+func (s *StorageConfig) WithOutOfOrderTimeWindow(d Duration) *StorageConfig {
+	if s.TSDB == nil {
+		s.TSDB = &TSDBConfig{}
+	}
+	s.TSDB.OutOfOrderTimeWindow = d
+	return s
+}
+
+// WithMaxExemplars sets the size of the exemplar buffer.
+func (s *StorageConfig) WithMaxExemplars(n int64) *StorageConfig {
+	if s.Exemplars == nil {
+		s.Exemplars = &ExemplarsConfig{}
+	}
+	s.Exemplars.MaxExemplars = n
+	return s
+}
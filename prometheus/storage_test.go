@@ -0,0 +1,43 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestStorageConfig_Serialize(t *testing.T) {
+	s := NewStorageConfig().
+		WithOutOfOrderTimeWindow(30 * Minute).
+		WithMaxExemplars(100000)
+
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"tsdb:",
+		"out_of_order_time_window: 30m",
+		"exemplars:",
+		"max_exemplars: 100000",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
+
+func TestStorageConfig_Empty(t *testing.T) {
+	data, err := yaml.Marshal(NewStorageConfig())
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+	if string(data) != "{}\n" {
+		t.Errorf("Empty StorageConfig = %q, want {}", string(data))
+	}
+}
@@ -0,0 +1,99 @@
+package prometheus
+
+// TracingClientType is the protocol used to export traces.
+type TracingClientType string
+
+// Tracing client types.
+const (
+	// TracingClientGRPC exports traces over OTLP/gRPC.
+	TracingClientGRPC TracingClientType = "grpc"
+
+	// TracingClientHTTP exports traces over OTLP/HTTP.
+	TracingClientHTTP TracingClientType = "http"
+)
+
+// TracingConfig configures exporting of Prometheus's own traces to an
+// OpenTelemetry collector.
+//
+// Example usage:
+//
+//	var Tracing = prometheus.NewTracingConfig("otel-collector:4317").
+//	    WithSamplingFraction(0.1).
+//	    WithInsecure()
+type TracingConfig struct {
+	// ClientType is the export protocol. Defaults to grpc.
+	ClientType TracingClientType `yaml:"client_type,omitempty"`
+
+	// Endpoint is the host:port of the collector.
+	Endpoint string `yaml:"endpoint,omitempty"`
+
+	// SamplingFraction is the probability a trace is sampled, between 0
+	// and 1. Defaults to 0.
+	SamplingFraction float64 `yaml:"sampling_fraction,omitempty"`
+
+	// Insecure disables transport security.
+	Insecure bool `yaml:"insecure,omitempty"`
+
+	// TLSConfig configures TLS for the exporter.
+	TLSConfig *TLSConfig `yaml:"tls_config,omitempty"`
+
+	// Headers are sent with every export request.
+	Headers map[string]string `yaml:"headers,omitempty"`
+
+	// Compression is the compression used for export requests. Only
+	// "gzip" is supported.
+	Compression string `yaml:"compression,omitempty"`
+
+	// Timeout is the maximum time the exporter waits for each batch.
+	// Defaults to 10s.
+	Timeout Duration `yaml:"timeout,omitempty"`
+}
+
+// NewTracingConfig creates a tracing configuration exporting to endpoint.
+func NewTracingConfig(endpoint string) *TracingConfig {
+	return &TracingConfig{
+		Endpoint: endpoint,
+	}
+}
+
+// WithClientType sets the export protocol.
+func (t *TracingConfig) WithClientType(clientType TracingClientType) *TracingConfig {
+	t.ClientType = clientType
+	return t
+}
+
+// WithSamplingFraction sets the trace sampling probability.
+func (t *TracingConfig) WithSamplingFraction(fraction float64) *TracingConfig {
+	t.SamplingFraction = fraction
+	return t
+}
+
+// WithInsecure disables transport security.
+func (t *TracingConfig) WithInsecure() *TracingConfig {
+	t.Insecure = true
+	return t
+}
+
+// WithTLSConfig sets the TLS configuration.
+func (t *TracingConfig) WithTLSConfig(tls *TLSConfig) *TracingConfig {
+	t.TLSConfig = tls
+	return t
+}
+
+// WithHeaders sets headers sent with every export request.
+func (t *TracingConfig) WithHeaders(headers map[string]string) *TracingConfig {
+	t.Headers = headers
+	return t
+}
+
+// WithCompression sets the export compression.
+func (t *TracingConfig) WithCompression(compression string) *TracingConfig {
+	t.Compression = compression
+	return t
+}
+
+// WithTimeout sets the export timeout.
+func (t *TracingConfig) WithTimeout(d Duration) *TracingConfig {
+	t.Timeout = d
+	return t
+}
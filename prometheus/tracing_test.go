@@ -0,0 +1,40 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestTracingConfig_Serialize(t *testing.T) {
+	tc := NewTracingConfig("otel-collector:4318").
+		WithClientType(TracingClientHTTP).
+		WithSamplingFraction(0.25).
+		WithInsecure().
+		WithHeaders(map[string]string{"X-Scope-OrgID": "infra"}).
+		WithCompression("gzip").
+		WithTimeout(5 * Second)
+
+	data, err := yaml.Marshal(tc)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	yamlStr := string(data)
+	expectations := []string{
+		"client_type: http",
+		"endpoint: otel-collector:4318",
+		"sampling_fraction: 0.25",
+		"insecure: true",
+		"X-Scope-OrgID: infra",
+		"compression: gzip",
+		"timeout: 5s",
+	}
+
+	for _, exp := range expectations {
+		if !strings.Contains(yamlStr, exp) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", exp, yamlStr)
+		}
+	}
+}
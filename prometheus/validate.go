@@ -0,0 +1,793 @@
+package prometheus
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/internal/yamlpath"
+	"gopkg.in/yaml.v3"
+)
+
+// Defaults applied by Prometheus when the global section omits them.
+const (
+	DefaultScrapeInterval = Minute
+	DefaultScrapeTimeout  = 10 * Second
+)
+
+// ValidationError is a problem found while validating a Prometheus
+// configuration or rules file. Path locates the offending field using YAML
+// keys, for example "scrape_configs[0].relabel_configs[1].regex". Line and
+// Column are set when the problem was found in a YAML document.
+type ValidationError struct {
+	Path    string
+	Message string
+	Line    int
+	Column  int
+}
+
+// Error implements the error interface.
+func (e ValidationError) Error() string {
+	msg := e.Message
+	if e.Path != "" {
+		msg = e.Path + ": " + msg
+	}
+	if e.Line > 0 {
+		msg = fmt.Sprintf("%d:%d: %s", e.Line, e.Column, msg)
+	}
+	return msg
+}
+
+// Locate sets Line and Column on each error from the position of its Path
+// in the YAML document root.
+func Locate(errs []ValidationError, root *yaml.Node) {
+	for i := range errs {
+		errs[i].Line, errs[i].Column = yamlpath.Position(root, errs[i].Path)
+	}
+}
+
+// ValidateYAML parses a prometheus.yml document and validates it. Errors
+// carry the line and column of the offending field. A non-nil error is
+// returned if the document cannot be parsed.
+func ValidateYAML(data []byte) ([]ValidationError, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+	var config PrometheusConfig
+	if err := root.Decode(&config); err != nil {
+		return nil, err
+	}
+	errs := config.Validate()
+	Locate(errs, &root)
+	return errs, nil
+}
+
+// Validate checks the configuration against the rules Prometheus enforces
+// when loading prometheus.yml: job names are set and unique, scrape timeouts
+// do not exceed scrape intervals, relabel configs compile and set the fields
+// their action requires, service discovery configs have their required
+// fields, and remote endpoints have valid URLs.
+//
+// Validate returns nil if the configuration is valid.
+func (c *PrometheusConfig) Validate() []ValidationError {
+	v := &configValidator{}
+	v.validate(c)
+	if c.Agent {
+		v.validateAgent(c)
+	}
+	return v.errs
+}
+
+// ValidateAgent checks that the configuration only uses sections supported
+// in agent mode: rule_files, alerting and remote_read are not allowed.
+func (c *PrometheusConfig) ValidateAgent() []ValidationError {
+	v := &configValidator{}
+	v.validateAgent(c)
+	return v.errs
+}
+
+// Validate checks a relabel config: the action is known, the regex
+// compiles, and the fields required or forbidden by the action are set
+// accordingly.
+func (r *RelabelConfig) Validate() error {
+	action := RelabelAction(r.Action)
+	if action == "" {
+		action = RelabelReplace
+	}
+	regex := r.Regex
+	if regex == "" {
+		regex = "(.*)"
+	}
+	if _, err := regexp.Compile("^(?:" + regex + ")$"); err != nil {
+		return fmt.Errorf("invalid regex %q: %w", r.Regex, err)
+	}
+	for _, l := range r.SourceLabels {
+		if !labelNameRE.MatchString(l) {
+			return fmt.Errorf("%q is not a valid label name", l)
+		}
+	}
+
+	switch action {
+	case RelabelReplace, RelabelKeep, RelabelDrop, RelabelHashMod, RelabelLabelMap,
+		RelabelLabelDrop, RelabelLabelKeep, RelabelLowercase, RelabelUppercase,
+		RelabelKeepEqual, RelabelDropEqual:
+	default:
+		return fmt.Errorf("unknown relabel action %q", r.Action)
+	}
+
+	switch action {
+	case RelabelReplace, RelabelHashMod, RelabelLowercase, RelabelUppercase, RelabelKeepEqual, RelabelDropEqual:
+		if r.TargetLabel == "" {
+			return fmt.Errorf("relabel configuration for %s action requires 'target_label' value", action)
+		}
+	}
+	switch action {
+	case RelabelReplace:
+		if !relabelTargetRE.MatchString(r.TargetLabel) {
+			return fmt.Errorf("%q is invalid 'target_label' for %s action", r.TargetLabel, action)
+		}
+	case RelabelHashMod, RelabelLowercase, RelabelUppercase, RelabelKeepEqual, RelabelDropEqual:
+		if !labelNameRE.MatchString(r.TargetLabel) {
+			return fmt.Errorf("%q is invalid 'target_label' for %s action", r.TargetLabel, action)
+		}
+	}
+
+	switch action {
+	case RelabelHashMod:
+		if r.Modulus == 0 {
+			return fmt.Errorf("relabel configuration for hashmod requires non-zero modulus")
+		}
+	case RelabelLabelMap:
+		if r.Replacement != "" && !relabelTargetRE.MatchString(r.Replacement) {
+			return fmt.Errorf("%q is invalid 'replacement' for %s action", r.Replacement, action)
+		}
+	case RelabelKeepEqual, RelabelDropEqual:
+		if r.Regex != "" || r.Modulus != 0 || r.Separator != "" || r.Replacement != "" {
+			return fmt.Errorf("%s action requires only 'source_labels' and `target_label`, and no other fields", action)
+		}
+	case RelabelLabelDrop, RelabelLabelKeep:
+		if len(r.SourceLabels) > 0 || r.TargetLabel != "" || r.Modulus != 0 || r.Separator != "" || r.Replacement != "" {
+			return fmt.Errorf("%s action requires only 'regex', and no other fields", action)
+		}
+	}
+	return nil
+}
+
+var (
+	// labelNameRE matches valid label names.
+	labelNameRE = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	// relabelTargetRE matches label names that may contain ${1}-style
+	// references to regex capture groups.
+	relabelTargetRE = regexp.MustCompile(`^(?:(?:[a-zA-Z_]|\$(?:\{\w+\}|\w+))+\w*)+$`)
+
+	// fileSDPatternRE matches valid file_sd_configs file patterns.
+	fileSDPatternRE = regexp.MustCompile(`^[^*]*(\*[^/]*)?\.(json|yml|yaml|JSON|YML|YAML)$`)
+)
+
+// IsValidLabelName reports whether name is a valid Prometheus label name.
+func IsValidLabelName(name string) bool {
+	return labelNameRE.MatchString(name)
+}
+
+// configValidator accumulates validation errors for a configuration.
+type configValidator struct {
+	errs []ValidationError
+}
+
+func (v *configValidator) add(path, format string, args ...any) {
+	v.errs = append(v.errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *configValidator) validate(c *PrometheusConfig) {
+	global := c.Global
+	if global == nil {
+		global = &GlobalConfig{}
+	}
+	interval, timeout := global.ScrapeInterval, global.ScrapeTimeout
+	if interval == 0 {
+		interval = DefaultScrapeInterval
+	}
+	if timeout == 0 {
+		timeout = DefaultScrapeTimeout
+	}
+	if timeout > interval {
+		v.add("global.scrape_timeout", "global scrape timeout greater than scrape interval")
+	}
+	v.checkLabels("global.external_labels", global.ExternalLabels)
+	v.checkScrapeProtocols("global.scrape_protocols", global.ScrapeProtocols)
+
+	jobs := make(map[string]bool)
+	for i, sc := range c.ScrapeConfigs {
+		if sc == nil {
+			continue
+		}
+		path := fmt.Sprintf("scrape_configs[%d]", i)
+		if sc.JobName == "" {
+			v.add(path+".job_name", "job_name is required")
+		} else if jobs[sc.JobName] {
+			v.add(path+".job_name", "found multiple scrape configs with job name %q", sc.JobName)
+		}
+		jobs[sc.JobName] = true
+		v.validateScrapeConfig(path, sc, interval)
+	}
+
+	for i, pattern := range c.RuleFiles {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			v.add(fmt.Sprintf("rule_files[%d]", i), "invalid rule file path %q", pattern)
+		}
+	}
+	for i, pattern := range c.ScrapeConfigFiles {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			v.add(fmt.Sprintf("scrape_config_files[%d]", i), "invalid scrape config file path %q", pattern)
+		}
+	}
+
+	if c.Alerting != nil {
+		v.validateRelabelConfigs("alerting.alert_relabel_configs", c.Alerting.AlertRelabelConfigs)
+		for i, am := range c.Alerting.Alertmanagers {
+			if am == nil {
+				continue
+			}
+			path := fmt.Sprintf("alerting.alertmanagers[%d]", i)
+			v.checkScheme(path+".scheme", am.Scheme)
+			switch am.APIVersion {
+			case "", "v1", "v2":
+			default:
+				v.add(path+".api_version", "expected Alertmanager api version to be one of [v1 v2] but got %q", am.APIVersion)
+			}
+			v.validateStaticConfigs(path+".static_configs", am.StaticConfigs)
+			v.validateHTTPClient(path, &am.HTTPClientConfig)
+			if am.SigV4 != nil && am.authMethods() > 0 {
+				v.add(path, "at most one of basic_auth, authorization, oauth2, & sigv4 must be configured")
+			}
+		}
+	}
+
+	names := make(map[string]bool)
+	for i, rw := range c.RemoteWrite {
+		if rw == nil {
+			continue
+		}
+		path := fmt.Sprintf("remote_write[%d]", i)
+		v.checkRemoteURL(path+".url", rw.URL)
+		if rw.Name != "" {
+			if names[rw.Name] {
+				v.add(path+".name", "found multiple remote write configs with name %q", rw.Name)
+			}
+			names[rw.Name] = true
+		}
+		v.validateRelabelConfigs(path+".write_relabel_configs", rw.WriteRelabelConfigs)
+		v.validateHTTPClient(path, &rw.HTTPClientConfig)
+		v.validateRemoteWriteAuth(path, rw)
+	}
+
+	names = make(map[string]bool)
+	for i, rr := range c.RemoteRead {
+		if rr == nil {
+			continue
+		}
+		path := fmt.Sprintf("remote_read[%d]", i)
+		v.checkRemoteURL(path+".url", rr.URL)
+		if rr.Name != "" {
+			if names[rr.Name] {
+				v.add(path+".name", "found multiple remote read configs with name %q", rr.Name)
+			}
+			names[rr.Name] = true
+		}
+		v.validateHTTPClient(path, &rr.HTTPClientConfig)
+	}
+
+	if c.Tracing != nil {
+		v.validateTracing("tracing", c.Tracing)
+	}
+	if c.OTLP != nil {
+		v.validateOTLP("otlp", c.OTLP)
+	}
+}
+
+func (v *configValidator) validateTracing(path string, t *TracingConfig) {
+	if t.Endpoint == "" {
+		v.add(path+".endpoint", "tracing endpoint must be set")
+	}
+	switch t.ClientType {
+	case "", TracingClientGRPC, TracingClientHTTP:
+	default:
+		v.add(path+".client_type", "expected tracing client type to be %s or %s, but got %s", TracingClientGRPC, TracingClientHTTP, t.ClientType)
+	}
+	if t.SamplingFraction < 0 || t.SamplingFraction > 1 {
+		v.add(path+".sampling_fraction", "sampling_fraction must be between 0 and 1, got %v", t.SamplingFraction)
+	}
+	if t.Compression != "" && t.Compression != "gzip" {
+		v.add(path+".compression", "invalid compression type %s provided, valid options: gzip", t.Compression)
+	}
+}
+
+func (v *configValidator) validateOTLP(path string, o *OTLPConfig) {
+	if o.PromoteAllResourceAttributes && len(o.PromoteResourceAttributes) > 0 {
+		v.add(path, "'promote_all_resource_attributes' and 'promote_resource_attributes' cannot be configured simultaneously")
+	}
+	if len(o.IgnoreResourceAttributes) > 0 && !o.PromoteAllResourceAttributes {
+		v.add(path+".ignore_resource_attributes", "'ignore_resource_attributes' cannot be configured unless 'promote_all_resource_attributes' is true")
+	}
+	seen := make(map[string]bool)
+	for i, attr := range o.PromoteResourceAttributes {
+		attr = strings.TrimSpace(attr)
+		switch {
+		case attr == "":
+			v.add(fmt.Sprintf("%s.promote_resource_attributes[%d]", path, i), "empty promoted OTel resource attribute")
+		case seen[attr]:
+			v.add(fmt.Sprintf("%s.promote_resource_attributes[%d]", path, i), "duplicated promoted OTel resource attribute %q", attr)
+		}
+		seen[attr] = true
+	}
+	switch o.TranslationStrategy {
+	case "", OTLPUnderscoreEscapingWithSuffixes, OTLPUnderscoreEscapingWithoutSuffixes,
+		OTLPNoUTF8EscapingWithSuffixes, OTLPNoTranslation:
+	default:
+		v.add(path+".translation_strategy", "unsupported OTLP translation strategy %q", o.TranslationStrategy)
+	}
+}
+
+func (v *configValidator) validateAgent(c *PrometheusConfig) {
+	if len(c.RuleFiles) > 0 {
+		v.add("rule_files", "field rule_files is not allowed in agent mode")
+	}
+	if c.Alerting != nil && (len(c.Alerting.Alertmanagers) > 0 || len(c.Alerting.AlertRelabelConfigs) > 0) {
+		v.add("alerting", "field alerting is not allowed in agent mode")
+	}
+	if len(c.RemoteRead) > 0 {
+		v.add("remote_read", "field remote_read is not allowed in agent mode")
+	}
+}
+
+func (v *configValidator) validateScrapeConfig(path string, sc *ScrapeConfig, globalInterval Duration) {
+	interval := sc.ScrapeInterval
+	if interval == 0 {
+		interval = globalInterval
+	}
+	if sc.ScrapeTimeout > interval {
+		v.add(path+".scrape_timeout", "scrape timeout greater than scrape interval for scrape config with job name %q", sc.JobName)
+	}
+	if sc.MetricsPath != "" && !strings.HasPrefix(sc.MetricsPath, "/") {
+		v.add(path+".metrics_path", "metrics_path %q must start with /", sc.MetricsPath)
+	}
+	v.checkScheme(path+".scheme", sc.Scheme)
+	v.validateHTTPClient(path, &sc.HTTPClientConfig)
+	v.checkScrapeProtocols(path+".scrape_protocols", sc.ScrapeProtocols)
+	if sc.FallbackScrapeProtocol != "" && !sc.FallbackScrapeProtocol.valid() {
+		v.add(path+".fallback_scrape_protocol", "invalid fallback_scrape_protocol for scrape config with job name %q: unknown scrape protocol %s", sc.JobName, sc.FallbackScrapeProtocol)
+	}
+	if f := sc.NativeHistogramMinBucketFactor; f != 0 && f <= 1 {
+		v.add(path+".native_histogram_min_bucket_factor", "native_histogram_min_bucket_factor must be greater than 1, got %v", f)
+	}
+
+	v.validateStaticConfigs(path+".static_configs", sc.StaticConfigs)
+	v.validateRelabelConfigs(path+".relabel_configs", sc.RelabelConfigs)
+	v.validateRelabelConfigs(path+".metric_relabel_configs", sc.MetricRelabelConfigs)
+
+	for i, sd := range sc.KubernetesSDConfigs {
+		if sd != nil {
+			v.validateKubernetesSD(fmt.Sprintf("%s.kubernetes_sd_configs[%d]", path, i), sd)
+		}
+	}
+	for i, sd := range sc.ConsulSDConfigs {
+		if sd != nil {
+			v.validateConsulSD(fmt.Sprintf("%s.consul_sd_configs[%d]", path, i), sd)
+		}
+	}
+	for i, sd := range sc.EC2SDConfigs {
+		if sd != nil && sd.Region == "" {
+			v.add(fmt.Sprintf("%s.ec2_sd_configs[%d].region", path, i), "EC2 SD configuration requires a region")
+		}
+	}
+	for i, sd := range sc.FileSDConfigs {
+		if sd != nil {
+			v.validateFileSD(fmt.Sprintf("%s.file_sd_configs[%d]", path, i), sd)
+		}
+	}
+	for i, sd := range sc.DNSSDConfigs {
+		if sd != nil {
+			v.validateDNSSD(fmt.Sprintf("%s.dns_sd_configs[%d]", path, i), sd)
+		}
+	}
+	for i, sd := range sc.HTTPSDConfigs {
+		if sd != nil {
+			v.validateHTTPSD(fmt.Sprintf("%s.http_sd_configs[%d]", path, i), sd)
+		}
+	}
+	for i, sd := range sc.GCESDConfigs {
+		if sd != nil {
+			v.validateGCESD(fmt.Sprintf("%s.gce_sd_configs[%d]", path, i), sd)
+		}
+	}
+	for i, sd := range sc.AzureSDConfigs {
+		if sd != nil {
+			v.validateAzureSD(fmt.Sprintf("%s.azure_sd_configs[%d]", path, i), sd)
+		}
+	}
+	for i, sd := range sc.DockerSDConfigs {
+		if sd != nil {
+			p := fmt.Sprintf("%s.docker_sd_configs[%d]", path, i)
+			v.checkDaemonHost(p+".host", sd.Host)
+			v.validateHTTPClient(p, &sd.HTTPClientConfig)
+		}
+	}
+	for i, sd := range sc.DockerSwarmSDConfigs {
+		if sd != nil {
+			v.validateDockerSwarmSD(fmt.Sprintf("%s.dockerswarm_sd_configs[%d]", path, i), sd)
+		}
+	}
+	for i, sd := range sc.NomadSDConfigs {
+		if sd != nil {
+			p := fmt.Sprintf("%s.nomad_sd_configs[%d]", path, i)
+			if strings.TrimSpace(sd.Server) == "" {
+				v.add(p+".server", "nomad SD configuration requires a server address")
+			}
+			v.validateHTTPClient(p, &sd.HTTPClientConfig)
+		}
+	}
+	for i, sd := range sc.EurekaSDConfigs {
+		if sd != nil {
+			v.validateEurekaSD(fmt.Sprintf("%s.eureka_sd_configs[%d]", path, i), sd)
+		}
+	}
+	for i, sd := range sc.OpenStackSDConfigs {
+		if sd != nil {
+			v.validateOpenStackSD(fmt.Sprintf("%s.openstack_sd_configs[%d]", path, i), sd)
+		}
+	}
+	for i, sd := range sc.HetznerSDConfigs {
+		if sd != nil {
+			v.validateHetznerSD(fmt.Sprintf("%s.hetzner_sd_configs[%d]", path, i), sd)
+		}
+	}
+	for i, sd := range sc.LinodeSDConfigs {
+		if sd != nil {
+			v.validateHTTPClient(fmt.Sprintf("%s.linode_sd_configs[%d]", path, i), &sd.HTTPClientConfig)
+		}
+	}
+}
+
+func (v *configValidator) validateStaticConfigs(path string, configs []*StaticConfig) {
+	for i, sc := range configs {
+		if sc == nil {
+			continue
+		}
+		p := fmt.Sprintf("%s[%d]", path, i)
+		for j, target := range sc.Targets {
+			if target == "" || strings.Contains(target, "/") {
+				v.add(fmt.Sprintf("%s.targets[%d]", p, j), "%q is not a valid hostname", target)
+			}
+		}
+		v.checkLabels(p+".labels", sc.Labels)
+	}
+}
+
+func (v *configValidator) validateRelabelConfigs(path string, configs []*RelabelConfig) {
+	for i, rc := range configs {
+		if rc == nil {
+			continue
+		}
+		if err := rc.Validate(); err != nil {
+			v.add(fmt.Sprintf("%s[%d]", path, i), "%v", err)
+		}
+	}
+}
+
+func (v *configValidator) validateKubernetesSD(path string, sd *KubernetesSD) {
+	if !validKubernetesRole(sd.Role) {
+		v.add(path+".role", "role must be one of node, pod, service, endpoints, endpointslice or ingress, got %q", sd.Role)
+	}
+	if sd.APIServer != "" && sd.KubeConfigFile != "" {
+		v.add(path, "cannot use 'kubeconfig_file' and 'api_server' simultaneously")
+	}
+	if sd.APIServer != "" {
+		if u, err := url.Parse(sd.APIServer); err != nil || u.Host == "" {
+			v.add(path+".api_server", "invalid api_server URL %q", sd.APIServer)
+		}
+	}
+	v.validateHTTPClient(path, &sd.HTTPClientConfig)
+	for i, sel := range sd.Selectors {
+		if !validKubernetesRole(sel.Role) {
+			v.add(fmt.Sprintf("%s.selectors[%d].role", path, i), "invalid selector role %q", sel.Role)
+		}
+	}
+}
+
+func validKubernetesRole(role KubernetesRole) bool {
+	switch role {
+	case KubernetesRoleNode, KubernetesRolePod, KubernetesRoleService,
+		KubernetesRoleEndpoints, KubernetesRoleEndpointSlice, KubernetesRoleIngress:
+		return true
+	}
+	return false
+}
+
+func (v *configValidator) validateConsulSD(path string, sd *ConsulSD) {
+	if sd.Server != "" && strings.TrimSpace(sd.Server) == "" {
+		v.add(path+".server", "consul SD configuration requires a server address")
+	}
+	v.checkScheme(path+".scheme", sd.Scheme)
+	v.validateHTTPClient(path, &sd.HTTPClientConfig)
+}
+
+func (v *configValidator) validateFileSD(path string, sd *FileSD) {
+	if len(sd.Files) == 0 {
+		v.add(path+".files", "file service discovery config must contain at least one path name")
+	}
+	for i, f := range sd.Files {
+		if !fileSDPatternRE.MatchString(filepath.Base(f)) {
+			v.add(fmt.Sprintf("%s.files[%d]", path, i), "path name %q is not valid for file discovery", f)
+		}
+	}
+}
+
+func (v *configValidator) validateDNSSD(path string, sd *DNSSD) {
+	if len(sd.Names) == 0 {
+		v.add(path+".names", "DNS-SD config must contain at least one SRV record name")
+	}
+	switch sd.Type {
+	case "", DNSSDTypeSRV:
+	case DNSSDTypeA, DNSSDTypeAAAA, DNSSDTypeMX, DNSSDTypeNS:
+		if sd.Port == 0 {
+			v.add(path+".port", "a port is required in DNS-SD configs for all record types except SRV")
+		}
+	default:
+		v.add(path+".type", "invalid DNS-SD records type %q", sd.Type)
+	}
+}
+
+func (v *configValidator) validateHTTPSD(path string, sd *HTTPSD) {
+	if sd.URL == "" {
+		v.add(path+".url", "URL is missing")
+	} else if !strings.Contains(sd.URL, "${") {
+		u, err := url.Parse(sd.URL)
+		switch {
+		case err != nil:
+			v.add(path+".url", "invalid URL %q: %v", sd.URL, err)
+		case u.Scheme != "http" && u.Scheme != "https":
+			v.add(path+".url", "URL scheme must be 'http' or 'https'")
+		case u.Host == "":
+			v.add(path+".url", "host is missing in URL")
+		}
+	}
+	v.validateHTTPClient(path, &sd.HTTPClientConfig)
+}
+
+func (v *configValidator) validateGCESD(path string, sd *GCESD) {
+	if sd.Project == "" {
+		v.add(path+".project", "GCE SD configuration requires a project")
+	}
+	if sd.Zone == "" {
+		v.add(path+".zone", "GCE SD configuration requires a zone")
+	}
+}
+
+func (v *configValidator) validateAzureSD(path string, sd *AzureSD) {
+	if sd.SubscriptionID == "" {
+		v.add(path+".subscription_id", "parameter subscription_id must not be empty")
+	}
+	switch sd.AuthenticationMethod {
+	case "", AzureAuthOAuth:
+		for _, p := range []struct{ key, value string }{
+			{"tenant_id", sd.TenantID},
+			{"client_id", sd.ClientID},
+			{"client_secret", string(sd.ClientSecret)},
+		} {
+			if p.value == "" {
+				v.add(path+"."+p.key, "parameter %s must not be empty", p.key)
+			}
+		}
+	case AzureAuthManagedIdentity, AzureAuthSDK:
+	default:
+		v.add(path+".authentication_method", "unknown authentication_type %q, must be OAuth, ManagedIdentity or SDK", sd.AuthenticationMethod)
+	}
+	v.validateHTTPClient(path, &sd.HTTPClientConfig)
+}
+
+func (v *configValidator) validateDockerSwarmSD(path string, sd *DockerSwarmSD) {
+	v.checkDaemonHost(path+".host", sd.Host)
+	switch sd.Role {
+	case DockerSwarmRoleServices, DockerSwarmRoleTasks, DockerSwarmRoleNodes:
+	case "":
+		v.add(path+".role", "role missing (one of: tasks, services, nodes)")
+	default:
+		v.add(path+".role", "invalid role %q, expected tasks, services, or nodes", sd.Role)
+	}
+	v.validateHTTPClient(path, &sd.HTTPClientConfig)
+}
+
+func (v *configValidator) validateEurekaSD(path string, sd *EurekaSD) {
+	if sd.Server == "" {
+		v.add(path+".server", "empty or null eureka server")
+	} else if u, err := url.Parse(sd.Server); err != nil || u.Scheme == "" || u.Host == "" {
+		v.add(path+".server", "invalid eureka server URL %q", sd.Server)
+	}
+	v.validateHTTPClient(path, &sd.HTTPClientConfig)
+}
+
+func (v *configValidator) validateOpenStackSD(path string, sd *OpenStackSD) {
+	switch sd.Role {
+	case OpenStackRoleInstance, OpenStackRoleHypervisor, OpenStackRoleLoadBalancer:
+	case "":
+		v.add(path+".role", "role missing (one of: instance, hypervisor, loadbalancer)")
+	default:
+		v.add(path+".role", "unknown OpenStack SD role %q", sd.Role)
+	}
+	if strings.TrimSpace(sd.Region) == "" {
+		v.add(path+".region", "openstack SD configuration requires a region")
+	}
+	switch sd.Availability {
+	case "", "public", "admin", "internal":
+	default:
+		v.add(path+".availability", "unknown availability %s, must be one of admin, internal or public", sd.Availability)
+	}
+}
+
+func (v *configValidator) validateHetznerSD(path string, sd *HetznerSD) {
+	switch sd.Role {
+	case HetznerRoleHcloud, HetznerRoleRobot:
+	case "":
+		v.add(path+".role", "role missing (one of: robot, hcloud)")
+	default:
+		v.add(path+".role", "unknown Hetzner service discovery role %q", sd.Role)
+	}
+	v.validateHTTPClient(path, &sd.HTTPClientConfig)
+}
+
+// validateHTTPClient checks the authentication, TLS and proxy settings shared
+// by scrape, service discovery, remote and Alertmanager endpoints.
+func (v *configValidator) validateHTTPClient(path string, c *HTTPClientConfig) {
+	if c.authMethods() > 1 {
+		v.add(path, "at most one of basic_auth, oauth2, bearer_token & bearer_token_file, authorization must be configured")
+	}
+	v.exclusive(path, "bearer_token", string(c.BearerToken), "bearer_token_file", c.BearerTokenFile)
+	v.validateBasicAuth(path+".basic_auth", c.BasicAuth)
+	if a := c.Authorization; a != nil {
+		if strings.EqualFold(a.Type, "basic") {
+			v.add(path+".authorization.type", `authorization type cannot be set to "basic", use "basic_auth" instead`)
+		}
+		v.exclusive(path+".authorization", "credentials", string(a.Credentials), "credentials_file", a.CredentialsFile)
+	}
+	if o := c.OAuth2; o != nil {
+		if o.ClientID == "" {
+			v.add(path+".oauth2.client_id", "missing client_id in oauth2 config")
+		}
+		if o.TokenURL == "" {
+			v.add(path+".oauth2.token_url", "missing token_url in oauth2 config")
+		}
+		v.exclusive(path+".oauth2", "client_secret", string(o.ClientSecret), "client_secret_file", o.ClientSecretFile)
+		v.checkProxyURL(path+".oauth2.proxy_url", o.ProxyURL)
+	}
+	v.checkProxyURL(path+".proxy_url", c.ProxyURL)
+	if c.ProxyURL != "" && c.ProxyFromEnvironment {
+		v.add(path, "if proxy_from_environment is configured, proxy_url must not be configured")
+	}
+	if len(c.ProxyConnectHeader) > 0 && c.ProxyURL == "" && !c.ProxyFromEnvironment {
+		v.add(path, "if proxy_connect_header is configured, proxy_url or proxy_from_environment must also be configured")
+	}
+}
+
+// validateRemoteWriteAuth checks the cloud authentication settings that only
+// remote write supports.
+func (v *configValidator) validateRemoteWriteAuth(path string, rw *RemoteWriteConfig) {
+	auth := rw.authMethods()
+	for _, set := range []bool{rw.SigV4 != nil, rw.AzureAD != nil, rw.GoogleIAM != nil} {
+		if set {
+			auth++
+		}
+	}
+	if auth > 1 {
+		v.add(path, "at most one of basic_auth, authorization, oauth2, sigv4, azuread or google_iam must be configured")
+	}
+	if ad := rw.AzureAD; ad != nil {
+		methods := 0
+		for _, set := range []bool{ad.ManagedIdentity != nil, ad.OAuth != nil, ad.SDK != nil} {
+			if set {
+				methods++
+			}
+		}
+		if methods != 1 {
+			v.add(path+".azuread", "exactly one of managed_identity, oauth or sdk must be configured")
+		}
+		switch ad.Cloud {
+		case "", "AzurePublic", "AzureChina", "AzureGovernment":
+		default:
+			v.add(path+".azuread.cloud", "unknown cloud %q, must be AzurePublic, AzureChina or AzureGovernment", ad.Cloud)
+		}
+		if o := ad.OAuth; o != nil && (o.ClientID == "" || o.ClientSecret == "" || o.TenantID == "") {
+			v.add(path+".azuread.oauth", "client_id, client_secret and tenant_id are required")
+		}
+	}
+}
+
+// checkDaemonHost reports an error unless host is a Docker daemon address.
+func (v *configValidator) checkDaemonHost(path, host string) {
+	if host == "" {
+		v.add(path, "host is missing")
+		return
+	}
+	if _, err := url.Parse(host); err != nil {
+		v.add(path, "invalid host %q: %v", host, err)
+	}
+}
+
+func (v *configValidator) validateBasicAuth(path string, auth *BasicAuth) {
+	if auth == nil {
+		return
+	}
+	if auth.Username == "" {
+		v.add(path+".username", "basic_auth requires a username")
+	}
+	v.exclusive(path, "password", auth.Password, "password_file", auth.PasswordFile)
+}
+
+// exclusive reports an error if both of two mutually exclusive fields are set.
+func (v *configValidator) exclusive(path, key, value, fileKey, fileValue string) {
+	if value != "" && fileValue != "" {
+		v.add(path, "at most one of %s & %s must be configured", key, fileKey)
+	}
+}
+
+func (v *configValidator) checkScrapeProtocols(path string, protocols []ScrapeProtocol) {
+	seen := make(map[ScrapeProtocol]bool)
+	for _, p := range protocols {
+		if !p.valid() {
+			v.add(path, "unknown scrape protocol %v, supported: %v", p, supportedScrapeProtocols)
+		} else if seen[p] {
+			v.add(path, "duplicated protocol in scrape_protocols, got %v", protocols)
+		}
+		seen[p] = true
+	}
+}
+
+func (v *configValidator) checkLabels(path string, labels map[string]string) {
+	for name := range labels {
+		if !labelNameRE.MatchString(name) {
+			v.add(path, "%q is not a valid label name", name)
+		}
+	}
+}
+
+func (v *configValidator) checkScheme(path, scheme string) {
+	if scheme != "" && scheme != "http" && scheme != "https" {
+		v.add(path, "unsupported scheme %q, must be http or https", scheme)
+	}
+}
+
+// checkRemoteURL reports an error unless s is an absolute http or https URL.
+// Secret references are skipped.
+func (v *configValidator) checkRemoteURL(path, s string) {
+	if s == "" {
+		v.add(path, "url for remote endpoint is required")
+		return
+	}
+	if strings.Contains(s, "${") {
+		return
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		v.add(path, "invalid URL %q: %v", s, err)
+		return
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		v.add(path, "unsupported scheme %q for URL %q", u.Scheme, s)
+		return
+	}
+	if u.Host == "" {
+		v.add(path, "missing host for URL %q", s)
+	}
+}
+
+func (v *configValidator) checkProxyURL(path, s string) {
+	if s == "" || strings.Contains(s, "${") {
+		return
+	}
+	if u, err := url.Parse(s); err != nil || u.Host == "" {
+		v.add(path, "invalid proxy_url %q", s)
+	}
+}
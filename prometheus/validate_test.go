@@ -0,0 +1,490 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestPrometheusConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want []string
+	}{
+		{
+			name: "valid config",
+			yaml: `
+global:
+  scrape_interval: 30s
+  scrape_timeout: 10s
+  external_labels: {cluster: prod}
+rule_files: [rules/*.yml]
+scrape_configs:
+  - job_name: api
+    static_configs:
+      - targets: ['api:8080']
+    relabel_configs:
+      - source_labels: [__address__]
+        regex: '(.*):\d+'
+        target_label: host
+        replacement: $1
+      - action: labeldrop
+        regex: tmp_.*
+      - action: hashmod
+        source_labels: [__address__]
+        target_label: __tmp_hash
+        modulus: 4
+  - job_name: pods
+    kubernetes_sd_configs:
+      - role: pod
+    file_sd_configs:
+      - files: [targets/*.json]
+    dns_sd_configs:
+      - names: [_api._tcp.example.com]
+remote_write:
+  - url: https://remote.example.com/api/v1/write
+`,
+		},
+		{
+			name: "duplicate and missing job names",
+			yaml: `
+scrape_configs:
+  - job_name: api
+  - job_name: api
+  - scrape_interval: 1m
+`,
+			want: []string{
+				`scrape_configs[1].job_name: found multiple scrape configs with job name "api"`,
+				`scrape_configs[2].job_name: job_name is required`,
+			},
+		},
+		{
+			name: "scrape timeout greater than interval",
+			yaml: `
+global:
+  scrape_interval: 5s
+scrape_configs:
+  - job_name: api
+    scrape_interval: 15s
+    scrape_timeout: 20s
+  - job_name: inherits
+    scrape_timeout: 6s
+`,
+			want: []string{
+				"global.scrape_timeout: global scrape timeout greater than scrape interval",
+				`scrape_configs[0].scrape_timeout: scrape timeout greater than scrape interval for scrape config with job name "api"`,
+				`scrape_configs[1].scrape_timeout: scrape timeout greater than scrape interval for scrape config with job name "inherits"`,
+			},
+		},
+		{
+			name: "relabel configs",
+			yaml: `
+scrape_configs:
+  - job_name: api
+    relabel_configs:
+      - source_labels: [job]
+        regex: '[a'
+        target_label: x
+      - action: replace
+        source_labels: [job]
+      - action: hashmod
+        source_labels: [job]
+        target_label: shard
+      - action: labeldrop
+        source_labels: [job]
+        regex: tmp
+      - action: explode
+    metric_relabel_configs:
+      - action: keepequal
+        source_labels: [a]
+        target_label: b
+        regex: x
+remote_write:
+  - url: https://remote.example.com/write
+    write_relabel_configs:
+      - action: lowercase
+        source_labels: [a]
+        target_label: 'not valid'
+`,
+			want: []string{
+				`scrape_configs[0].relabel_configs[0]: invalid regex "[a"`,
+				"scrape_configs[0].relabel_configs[1]: relabel configuration for replace action requires 'target_label' value",
+				"scrape_configs[0].relabel_configs[2]: relabel configuration for hashmod requires non-zero modulus",
+				"scrape_configs[0].relabel_configs[3]: labeldrop action requires only 'regex', and no other fields",
+				`scrape_configs[0].relabel_configs[4]: unknown relabel action "explode"`,
+				"scrape_configs[0].metric_relabel_configs[0]: keepequal action requires only 'source_labels' and `target_label`, and no other fields",
+				`remote_write[0].write_relabel_configs[0]: "not valid" is invalid 'target_label' for lowercase action`,
+			},
+		},
+		{
+			name: "service discovery required fields",
+			yaml: `
+scrape_configs:
+  - job_name: sd
+    kubernetes_sd_configs:
+      - role: deployment
+      - role: pod
+        api_server: https://k8s.example.com
+        kubeconfig_file: /etc/kubeconfig
+    ec2_sd_configs:
+      - port: 9100
+    file_sd_configs:
+      - files: []
+      - files: [targets.txt]
+    dns_sd_configs:
+      - names: [db.example.com]
+        type: A
+      - names: []
+        type: TXT
+`,
+			want: []string{
+				`scrape_configs[0].kubernetes_sd_configs[0].role: role must be one of node, pod, service, endpoints, endpointslice or ingress, got "deployment"`,
+				"scrape_configs[0].kubernetes_sd_configs[1]: cannot use 'kubeconfig_file' and 'api_server' simultaneously",
+				"scrape_configs[0].ec2_sd_configs[0].region: EC2 SD configuration requires a region",
+				"scrape_configs[0].file_sd_configs[0].files: file service discovery config must contain at least one path name",
+				`scrape_configs[0].file_sd_configs[1].files[0]: path name "targets.txt" is not valid for file discovery`,
+				"scrape_configs[0].dns_sd_configs[0].port: a port is required in DNS-SD configs for all record types except SRV",
+				"scrape_configs[0].dns_sd_configs[1].names: DNS-SD config must contain at least one SRV record name",
+				`scrape_configs[0].dns_sd_configs[1].type: invalid DNS-SD records type "TXT"`,
+			},
+		},
+		{
+			name: "targets, labels and auth",
+			yaml: `
+global:
+  external_labels: {"bad-label": x}
+scrape_configs:
+  - job_name: api
+    scheme: ftp
+    metrics_path: metrics
+    basic_auth:
+      username: admin
+      password: secret
+      password_file: /etc/password
+    static_configs:
+      - targets: ['http://api:8080/metrics']
+`,
+			want: []string{
+				`global.external_labels: "bad-label" is not a valid label name`,
+				`scrape_configs[0].metrics_path: metrics_path "metrics" must start with /`,
+				`scrape_configs[0].scheme: unsupported scheme "ftp", must be http or https`,
+				"scrape_configs[0].basic_auth: at most one of password & password_file must be configured",
+				`scrape_configs[0].static_configs[0].targets[0]: "http://api:8080/metrics" is not a valid hostname`,
+			},
+		},
+		{
+			name: "cloud and API service discovery",
+			yaml: `
+scrape_configs:
+  - job_name: discovery
+    http_sd_configs:
+      - url: https://inventory.example.com/targets
+      - url: ftp://inventory.example.com/targets
+    gce_sd_configs:
+      - project: infra
+    azure_sd_configs:
+      - subscription_id: sub
+        authentication_method: ManagedIdentity
+      - subscription_id: sub
+        tenant_id: tenant
+        client_id: client
+    docker_sd_configs:
+      - host: unix:///var/run/docker.sock
+    dockerswarm_sd_configs:
+      - host: unix:///var/run/docker.sock
+        role: containers
+    nomad_sd_configs:
+      - server: ""
+    eureka_sd_configs:
+      - server: eureka
+    openstack_sd_configs:
+      - role: instance
+        region: RegionOne
+        availability: private
+    hetzner_sd_configs:
+      - role: hcloud
+        bearer_token: a
+        bearer_token_file: /etc/token
+    linode_sd_configs:
+      - bearer_token_file: /etc/linode
+`,
+			want: []string{
+				"scrape_configs[0].http_sd_configs[1].url: URL scheme must be 'http' or 'https'",
+				"scrape_configs[0].gce_sd_configs[0].zone: GCE SD configuration requires a zone",
+				"scrape_configs[0].azure_sd_configs[1].client_secret: parameter client_secret must not be empty",
+				`scrape_configs[0].dockerswarm_sd_configs[0].role: invalid role "containers", expected tasks, services, or nodes`,
+				"scrape_configs[0].nomad_sd_configs[0].server: nomad SD configuration requires a server address",
+				`scrape_configs[0].eureka_sd_configs[0].server: invalid eureka server URL "eureka"`,
+				"scrape_configs[0].openstack_sd_configs[0].availability: unknown availability private, must be one of admin, internal or public",
+				"scrape_configs[0].hetzner_sd_configs[0]: at most one of bearer_token & bearer_token_file must be configured",
+			},
+		},
+		{
+			name: "HTTP client authentication",
+			yaml: `
+scrape_configs:
+  - job_name: api
+    basic_auth:
+      username: prom
+    authorization:
+      credentials: token
+  - job_name: basic
+    authorization:
+      type: Basic
+      credentials: token
+  - job_name: oauth
+    oauth2:
+      client_secret: secret
+  - job_name: proxy
+    proxy_connect_header:
+      Proxy-Authorization: [Basic abc]
+remote_write:
+  - url: https://aps.example.com/write
+    basic_auth:
+      username: prom
+    sigv4:
+      region: us-east-1
+  - url: https://monitor.azure.com/write
+    azuread:
+      cloud: AzureMoon
+      managed_identity:
+        client_id: mi
+      sdk: {}
+`,
+			want: []string{
+				"scrape_configs[0]: at most one of basic_auth, oauth2, bearer_token & bearer_token_file, authorization must be configured",
+				`scrape_configs[1].authorization.type: authorization type cannot be set to "basic", use "basic_auth" instead`,
+				"scrape_configs[2].oauth2.client_id: missing client_id in oauth2 config",
+				"scrape_configs[2].oauth2.token_url: missing token_url in oauth2 config",
+				"scrape_configs[3]: if proxy_connect_header is configured, proxy_url or proxy_from_environment must also be configured",
+				"remote_write[0]: at most one of basic_auth, authorization, oauth2, sigv4, azuread or google_iam must be configured",
+				"remote_write[1].azuread: exactly one of managed_identity, oauth or sdk must be configured",
+				`remote_write[1].azuread.cloud: unknown cloud "AzureMoon", must be AzurePublic, AzureChina or AzureGovernment`,
+			},
+		},
+		{
+			name: "server sections and scrape protocols",
+			yaml: `
+global:
+  scrape_protocols: [PrometheusProto, PrometheusProto]
+scrape_config_files: ['scrapes/[.yml']
+scrape_configs:
+  - job_name: histograms
+    scrape_protocols: [JSON]
+    fallback_scrape_protocol: XML
+    native_histogram_min_bucket_factor: 0.5
+alerting:
+  alert_relabel_configs:
+    - action: hashmod
+      target_label: shard
+tracing:
+  client_type: thrift
+  compression: snappy
+otlp:
+  promote_all_resource_attributes: true
+  promote_resource_attributes: [service.name, service.name]
+  translation_strategy: Legacy
+`,
+			want: []string{
+				"global.scrape_protocols: duplicated protocol in scrape_protocols, got [PrometheusProto PrometheusProto]",
+				`scrape_config_files[0]: invalid scrape config file path "scrapes/[.yml"`,
+				"scrape_configs[0].scrape_protocols: unknown scrape protocol JSON, supported: [PrometheusProto OpenMetricsText1.0.0 OpenMetricsText0.0.1 PrometheusText1.0.0 PrometheusText0.0.4]",
+				`scrape_configs[0].fallback_scrape_protocol: invalid fallback_scrape_protocol for scrape config with job name "histograms": unknown scrape protocol XML`,
+				"scrape_configs[0].native_histogram_min_bucket_factor: native_histogram_min_bucket_factor must be greater than 1, got 0.5",
+				"alerting.alert_relabel_configs[0]: relabel configuration for hashmod requires non-zero modulus",
+				"tracing.endpoint: tracing endpoint must be set",
+				"tracing.client_type: expected tracing client type to be grpc or http, but got thrift",
+				"tracing.compression: invalid compression type snappy provided, valid options: gzip",
+				"otlp: 'promote_all_resource_attributes' and 'promote_resource_attributes' cannot be configured simultaneously",
+				`otlp.promote_resource_attributes[1]: duplicated promoted OTel resource attribute "service.name"`,
+				`otlp.translation_strategy: unsupported OTLP translation strategy "Legacy"`,
+			},
+		},
+		{
+			name: "remote endpoints and rule files",
+			yaml: `
+rule_files: ['rules/[.yml']
+remote_write:
+  - url: remote.example.com/write
+    name: a
+  - url: https://remote.example.com/write
+    name: a
+remote_read:
+  - url: ""
+alerting:
+  alertmanagers:
+    - api_version: v3
+`,
+			want: []string{
+				`rule_files[0]: invalid rule file path "rules/[.yml"`,
+				`remote_write[0].url: unsupported scheme "" for URL "remote.example.com/write"`,
+				`remote_write[1].name: found multiple remote write configs with name "a"`,
+				"remote_read[0].url: url for remote endpoint is required",
+				`alerting.alertmanagers[0].api_version: expected Alertmanager api version to be one of [v1 v2] but got "v3"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var config PrometheusConfig
+			if err := yaml.Unmarshal([]byte(tt.yaml), &config); err != nil {
+				t.Fatalf("yaml.Unmarshal() error = %v", err)
+			}
+
+			errs := config.Validate()
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			joined := strings.Join(got, "\n")
+
+			if len(tt.want) == 0 && len(errs) > 0 {
+				t.Fatalf("Validate() = %v, want no errors", joined)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(joined, want) {
+					t.Errorf("Validate() missing %q\nGot:\n%s", want, joined)
+				}
+			}
+			if len(errs) != len(tt.want) {
+				t.Errorf("Validate() returned %d errors, want %d\nGot:\n%s", len(errs), len(tt.want), joined)
+			}
+		})
+	}
+}
+
+func TestPrometheusConfig_ValidateBuilders(t *testing.T) {
+	config := &PrometheusConfig{
+		Global: &GlobalConfig{ScrapeInterval: 15 * Second},
+		ScrapeConfigs: []*ScrapeConfig{
+			NewScrapeConfig("node").
+				WithKubernetesSD(NewKubernetesSD(KubernetesRoleNode)).
+				WithStaticTargets("localhost:9100"),
+		},
+	}
+	config.ScrapeConfigs[0].RelabelConfigs = []*RelabelConfig{
+		KeepByLabel("__meta_kubernetes_node_label_role", "worker"),
+		DropByLabel("__meta_kubernetes_node_name", "canary-.*"),
+		LabelFromMeta("__meta_kubernetes_node_name", "node"),
+		RenameLabel("old", "new"),
+		DropLabels("tmp_.*"),
+		KeepLabels("(job|instance|node)"),
+		HashMod("__address__", "__tmp_hash", 4),
+		Replace([]string{"__address__"}, "host", "(.*):\\d+", "$1"),
+		LabelMap("__meta_kubernetes_node_label_(.+)", "$1"),
+		KeepByAnnotation("prometheus.io/scrape", "true"),
+		SetFromAnnotation("prometheus.io/path", "__metrics_path__"),
+		KeepByPodLabel("app", "api"),
+	}
+	if errs := config.Validate(); len(errs) > 0 {
+		t.Errorf("Validate() = %v, want no errors", errs)
+	}
+}
+
+func TestPrometheusConfig_ValidateAgent(t *testing.T) {
+	config := &PrometheusConfig{
+		Agent:     true,
+		RuleFiles: []string{"rules/*.yml"},
+		Alerting: &AlertingConfig{
+			Alertmanagers: []*AlertmanagerConfig{{}},
+		},
+		RemoteWrite: []*RemoteWriteConfig{NewRemoteWrite("https://mimir/api/v1/push")},
+		RemoteRead:  []*RemoteReadConfig{NewRemoteRead("https://mimir/prometheus/api/v1/read")},
+	}
+	want := []string{
+		"rule_files: field rule_files is not allowed in agent mode",
+		"alerting: field alerting is not allowed in agent mode",
+		"remote_read: field remote_read is not allowed in agent mode",
+	}
+
+	errs := config.ValidateAgent()
+	if len(errs) != len(want) {
+		t.Fatalf("ValidateAgent() returned %d errors, want %d: %v", len(errs), len(want), errs)
+	}
+	for i, w := range want {
+		if errs[i].Error() != w {
+			t.Errorf("errs[%d] = %q, want %q", i, errs[i].Error(), w)
+		}
+	}
+	if got := config.Validate(); len(got) != len(want) {
+		t.Errorf("Validate() returned %d errors, want %d: %v", len(got), len(want), got)
+	}
+
+	config.Agent = false
+	if got := config.Validate(); len(got) != 0 {
+		t.Errorf("Validate() without agent mode = %v, want no errors", got)
+	}
+
+	agent := &PrometheusConfig{
+		Agent:       true,
+		RemoteWrite: []*RemoteWriteConfig{NewRemoteWrite("https://mimir/api/v1/push")},
+	}
+	if errs := agent.ValidateAgent(); len(errs) != 0 {
+		t.Errorf("ValidateAgent() = %v, want no errors", errs)
+	}
+}
+
+func TestValidateYAML_Positions(t *testing.T) {
+	data := []byte(`scrape_configs:
+  - job_name: api
+  - job_name: api
+    relabel_configs:
+      - action: hashmod
+        target_label: shard
+`)
+	errs, err := ValidateYAML(data)
+	if err != nil {
+		t.Fatalf("ValidateYAML() error = %v", err)
+	}
+	if len(errs) != 2 {
+		t.Fatalf("ValidateYAML() returned %d errors, want 2: %v", len(errs), errs)
+	}
+	if errs[0].Line != 3 || errs[0].Column != 15 {
+		t.Errorf("errs[0] position = %d:%d, want 3:15", errs[0].Line, errs[0].Column)
+	}
+	if errs[1].Line != 5 || errs[1].Column != 9 {
+		t.Errorf("errs[1] position = %d:%d, want 5:9", errs[1].Line, errs[1].Column)
+	}
+	if !strings.HasPrefix(errs[0].Error(), "3:15: scrape_configs[1].job_name:") {
+		t.Errorf("Error() = %v", errs[0].Error())
+	}
+}
+
+func TestValidateYAML_SyntaxError(t *testing.T) {
+	if _, err := ValidateYAML([]byte("scrape_configs: [")); err == nil {
+		t.Error("ValidateYAML() error = nil, want parse error")
+	}
+}
+
+func TestRelabelConfig_Validate(t *testing.T) {
+	tests := []struct {
+		config  *RelabelConfig
+		wantErr bool
+	}{
+		{NewRelabelConfig(RelabelKeep).WithSourceLabels("job").WithRegex("api|web"), false},
+		{NewRelabelConfig(RelabelReplace).WithTargetLabel("${1}_suffix").WithRegex("(.*)"), false},
+		{NewRelabelConfig(RelabelReplace).WithTargetLabel("1bad"), true},
+		{NewRelabelConfig(RelabelUppercase).WithSourceLabels("env").WithTargetLabel("ENV"), false},
+		{NewRelabelConfig(RelabelDropEqual).WithSourceLabels("a").WithTargetLabel("b"), false},
+		{NewRelabelConfig(RelabelLabelKeep).WithRegex("(job|instance)"), false},
+		{NewRelabelConfig(RelabelKeep).WithSourceLabels("not-valid"), true},
+		{&RelabelConfig{}, true},
+	}
+	for _, tt := range tests {
+		err := tt.config.Validate()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%+v.Validate() error = %v, wantErr %v", *tt.config, err, tt.wantErr)
+		}
+	}
+}
+
+func TestIsValidLabelName(t *testing.T) {
+	for name, want := range map[string]bool{"job": true, "__name__": true, "_x1": true, "1x": false, "a-b": false, "": false} {
+		if got := IsValidLabelName(name); got != want {
+			t.Errorf("IsValidLabelName(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package prometheus
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+)
+
+// WebConfig configures TLS and basic authentication on the Prometheus web
+// endpoints. It serializes to the web-config.yml file passed to Prometheus
+// with --web.config.file.
+//
+// Passwords are given in plaintext (or as secret references) and hashed with
+// bcrypt by HashPasswords; the build command does this before writing
+// web-config-<name>.yml alongside prometheus.yml. Declare the variable's type
+// so the build command discovers it.
+//
+// Example usage:
+//
+//	var Web *prometheus.WebConfig = prometheus.NewWebConfig().
+//	    WithTLS("/etc/prometheus/tls.crt", "/etc/prometheus/tls.key").
+//	    WithBasicAuthUser("mimir", prometheus.SecretFromEnv("PROMETHEUS_WEB_PASSWORD"))
+type WebConfig struct {
+	// TLSServerConfig enables TLS on the web endpoints.
+	TLSServerConfig *WebTLSServerConfig `yaml:"tls_server_config,omitempty"`
+
+	// HTTPServerConfig configures the HTTP server.
+	HTTPServerConfig *WebHTTPServerConfig `yaml:"http_server_config,omitempty"`
+
+	// BasicAuthUsers maps usernames to their passwords.
+	BasicAuthUsers map[string]*WebUser `yaml:"basic_auth_users,omitempty"`
+}
+
+// WebTLSServerConfig configures TLS on the web endpoints.
+type WebTLSServerConfig struct {
+	// CertFile is the path to the server certificate.
+	CertFile string `yaml:"cert_file,omitempty"`
+
+	// KeyFile is the path to the server key.
+	KeyFile string `yaml:"key_file,omitempty"`
+
+	// ClientAuthType is the policy for client certificate authentication:
+	// NoClientCert, RequestClientCert, RequireAnyClientCert,
+	// VerifyClientCertIfGiven or RequireAndVerifyClientCert.
+	// Defaults to NoClientCert.
+	ClientAuthType string `yaml:"client_auth_type,omitempty"`
+
+	// ClientCAFile is the CA certificate client certificates are verified against.
+	ClientCAFile string `yaml:"client_ca_file,omitempty"`
+
+	// ClientAllowedSANs restricts client certificates to these Subject
+	// Alternative Names.
+	ClientAllowedSANs []string `yaml:"client_allowed_sans,omitempty"`
+
+	// MinVersion is the minimum TLS version: TLS10, TLS11, TLS12 or TLS13.
+	// Defaults to TLS12.
+	MinVersion string `yaml:"min_version,omitempty"`
+
+	// MaxVersion is the maximum TLS version. Defaults to TLS13.
+	MaxVersion string `yaml:"max_version,omitempty"`
+
+	// CipherSuites restricts the TLS 1.2 cipher suites.
+	CipherSuites []string `yaml:"cipher_suites,omitempty"`
+
+	// CurvePreferences are the elliptic curves used in ECDHE handshakes.
+	CurvePreferences []string `yaml:"curve_preferences,omitempty"`
+}
+
+// WebHTTPServerConfig configures the HTTP server.
+type WebHTTPServerConfig struct {
+	// HTTP2 enables HTTP/2. Only applies with TLS. Defaults to true.
+	HTTP2 *bool `yaml:"http2,omitempty"`
+
+	// Headers are security headers added to every response.
+	Headers map[string]string `yaml:"headers,omitempty"`
+}
+
+// WebUser is a user allowed to access the web endpoints.
+// It serializes to its password, which should be a bcrypt hash.
+type WebUser struct {
+	// Password is a plaintext password, a secret reference, or a bcrypt hash.
+	Password Secret
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (u *WebUser) MarshalYAML() (interface{}, error) {
+	return string(u.Password), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (u *WebUser) UnmarshalYAML(value *yaml.Node) error {
+	var password string
+	if err := value.Decode(&password); err != nil {
+		return err
+	}
+	u.Password = Secret(password)
+	return nil
+}
+
+// webHeaders are the response headers the web config may set.
+var webHeaders = []string{
+	"Content-Security-Policy",
+	"Strict-Transport-Security",
+	"X-Content-Type-Options",
+	"X-Frame-Options",
+	"X-XSS-Protection",
+}
+
+// NewWebConfig creates a new empty WebConfig.
+func NewWebConfig() *WebConfig {
+	return &WebConfig{}
+}
+
+// WithTLS enables TLS with the given certificate and key files.
+func (w *WebConfig) WithTLS(certFile, keyFile string) *WebConfig {
+	if w.TLSServerConfig == nil {
+		w.TLSServerConfig = &WebTLSServerConfig{}
+	}
+	w.TLSServerConfig.CertFile = certFile
+	w.TLSServerConfig.KeyFile = keyFile
+	return w
+}
+
+// WithClientAuth requires client certificates signed by the CA in caFile.
+func (w *WebConfig) WithClientAuth(authType, caFile string) *WebConfig {
+	if w.TLSServerConfig == nil {
+		w.TLSServerConfig = &WebTLSServerConfig{}
+	}
+	w.TLSServerConfig.ClientAuthType = authType
+	w.TLSServerConfig.ClientCAFile = caFile
+	return w
+}
+
+// WithMinTLSVersion sets the minimum TLS version.
+func (w *WebConfig) WithMinTLSVersion(version string) *WebConfig {
+	if w.TLSServerConfig == nil {
+		w.TLSServerConfig = &WebTLSServerConfig{}
+	}
+	w.TLSServerConfig.MinVersion = version
+	return w
+}
+
+// WithHTTP2 enables or disables HTTP/2.
+func (w *WebConfig) WithHTTP2(enabled bool) *WebConfig {
+	if w.HTTPServerConfig == nil {
+		w.HTTPServerConfig = &WebHTTPServerConfig{}
+	}
+	w.HTTPServerConfig.HTTP2 = &enabled
+	return w
+}
+
+// WithHeaders sets security headers added to every response.
+func (w *WebConfig) WithHeaders(headers map[string]string) *WebConfig {
+	if w.HTTPServerConfig == nil {
+		w.HTTPServerConfig = &WebHTTPServerConfig{}
+	}
+	w.HTTPServerConfig.Headers = headers
+	return w
+}
+
+// WithBasicAuthUser adds a user with the given password. The password is
+// hashed with bcrypt by HashPasswords.
+func (w *WebConfig) WithBasicAuthUser(username string, password Secret) *WebConfig {
+	if w.BasicAuthUsers == nil {
+		w.BasicAuthUsers = make(map[string]*WebUser)
+	}
+	w.BasicAuthUsers[username] = &WebUser{Password: password}
+	return w
+}
+
+// HashPasswords replaces plaintext passwords with bcrypt hashes. Passwords
+// that are already bcrypt hashes are kept. Secret references must be resolved
+// first; an unresolved reference is an error.
+func (w *WebConfig) HashPasswords() error {
+	for _, name := range w.usernames() {
+		user := w.BasicAuthUsers[name]
+		if user == nil || isBcryptHash(string(user.Password)) {
+			continue
+		}
+		if p := string(user.Password); strings.HasPrefix(p, "${") && strings.HasSuffix(p, "}") {
+			return fmt.Errorf("basic_auth_users.%s: password %s is an unresolved secret reference", name, p)
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return fmt.Errorf("basic_auth_users.%s: %w", name, err)
+		}
+		user.Password = Secret(hash)
+	}
+	return nil
+}
+
+// Validate checks the web configuration against the rules Prometheus
+// enforces when loading it: TLS needs both a certificate and a key, client
+// authentication settings are consistent, only known headers are set, and
+// every user has a bcrypt-hashed or plaintext password to be hashed.
+//
+// Validate returns nil if the configuration is valid.
+func (w *WebConfig) Validate() []ValidationError {
+	v := &configValidator{}
+	if tls := w.TLSServerConfig; tls != nil {
+		if tls.CertFile == "" {
+			v.add("tls_server_config.cert_file", "missing cert_file")
+		}
+		if tls.KeyFile == "" {
+			v.add("tls_server_config.key_file", "missing key_file")
+		}
+		switch tls.ClientAuthType {
+		case "", "NoClientCert":
+			if tls.ClientCAFile != "" {
+				v.add("tls_server_config.client_ca_file", "client CA's have been configured without a client auth policy")
+			}
+		case "RequestClientCert", "RequireAnyClientCert", "VerifyClientCertIfGiven", "RequireAndVerifyClientCert":
+		default:
+			v.add("tls_server_config.client_auth_type", "invalid client_auth_type %q", tls.ClientAuthType)
+		}
+		if len(tls.ClientAllowedSANs) > 0 && tls.ClientCAFile == "" {
+			v.add("tls_server_config.client_allowed_sans", "client_allowed_sans requires client_ca_file")
+		}
+		for _, key := range []struct{ path, version string }{
+			{"tls_server_config.min_version", tls.MinVersion},
+			{"tls_server_config.max_version", tls.MaxVersion},
+		} {
+			switch key.version {
+			case "", "TLS10", "TLS11", "TLS12", "TLS13":
+			default:
+				v.add(key.path, "unknown TLS version: %s", key.version)
+			}
+		}
+	}
+	if hs := w.HTTPServerConfig; hs != nil {
+		names := make([]string, 0, len(hs.Headers))
+		for name := range hs.Headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if !isWebHeader(name) {
+				v.add("http_server_config.headers", "HTTP header %q can not be configured", name)
+			}
+		}
+	}
+	for _, name := range w.usernames() {
+		if name == "" {
+			v.add("basic_auth_users", "username must not be empty")
+		}
+		if user := w.BasicAuthUsers[name]; user == nil || user.Password == "" {
+			v.add("basic_auth_users."+name, "password must not be empty")
+		}
+	}
+	return v.errs
+}
+
+func (w *WebConfig) usernames() []string {
+	names := make([]string, 0, len(w.BasicAuthUsers))
+	for name := range w.BasicAuthUsers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func isWebHeader(name string) bool {
+	for _, h := range webHeaders {
+		if h == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isBcryptHash(s string) bool {
+	_, err := bcrypt.Cost([]byte(s))
+	return err == nil
+}
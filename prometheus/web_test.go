@@ -0,0 +1,176 @@
+package prometheus
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+)
+
+func TestWebConfig_Serialize(t *testing.T) {
+	w := NewWebConfig().
+		WithTLS("/etc/prometheus/tls.crt", "/etc/prometheus/tls.key").
+		WithClientAuth("RequireAndVerifyClientCert", "/etc/prometheus/ca.crt").
+		WithMinTLSVersion("TLS13").
+		WithHTTP2(false).
+		WithHeaders(map[string]string{"X-Frame-Options": "deny"}).
+		WithBasicAuthUser("admin", "$2y$10$mDwo.lAisC94iLAyP81MCesa29IzH37oigHC/42V2pdJlUprsJPze")
+
+	data, err := w.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	yamlStr := string(data)
+
+	for _, want := range []string{
+		"tls_server_config:",
+		"cert_file: /etc/prometheus/tls.crt",
+		"key_file: /etc/prometheus/tls.key",
+		"client_auth_type: RequireAndVerifyClientCert",
+		"client_ca_file: /etc/prometheus/ca.crt",
+		"min_version: TLS13",
+		"http_server_config:",
+		"http2: false",
+		"X-Frame-Options: deny",
+		"basic_auth_users:",
+		"admin: $2y$10$mDwo.lAisC94iLAyP81MCesa29IzH37oigHC/42V2pdJlUprsJPze",
+	} {
+		if !strings.Contains(yamlStr, want) {
+			t.Errorf("Serialize() missing %q\nGot:\n%s", want, yamlStr)
+		}
+	}
+
+	var parsed WebConfig
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+	if parsed.BasicAuthUsers["admin"].Password != w.BasicAuthUsers["admin"].Password {
+		t.Errorf("round-trip password = %q, want %q", parsed.BasicAuthUsers["admin"].Password, w.BasicAuthUsers["admin"].Password)
+	}
+}
+
+func TestWebConfig_SerializeToFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "web-config.yml")
+	w := NewWebConfig().WithTLS("tls.crt", "tls.key")
+	if err := w.SerializeToFile(path); err != nil {
+		t.Fatalf("SerializeToFile() error = %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("file mode = %v, want 0600", perm)
+	}
+}
+
+func TestWebConfig_HashPasswords(t *testing.T) {
+	hashed := "$2y$10$mDwo.lAisC94iLAyP81MCesa29IzH37oigHC/42V2pdJlUprsJPze"
+	w := NewWebConfig().
+		WithBasicAuthUser("alice", "s3cret").
+		WithBasicAuthUser("bob", Secret(hashed))
+
+	if err := w.HashPasswords(); err != nil {
+		t.Fatalf("HashPasswords() error = %v", err)
+	}
+
+	alice := []byte(w.BasicAuthUsers["alice"].Password)
+	if err := bcrypt.CompareHashAndPassword(alice, []byte("s3cret")); err != nil {
+		t.Errorf("alice password is not a bcrypt hash of the plaintext: %v", err)
+	}
+	if got := w.BasicAuthUsers["bob"].Password; got != Secret(hashed) {
+		t.Errorf("bob password = %q, want existing hash kept", got)
+	}
+
+	// Hashing twice keeps the first hash.
+	first := w.BasicAuthUsers["alice"].Password
+	if err := w.HashPasswords(); err != nil {
+		t.Fatalf("HashPasswords() error = %v", err)
+	}
+	if w.BasicAuthUsers["alice"].Password != first {
+		t.Error("HashPasswords() rehashed an existing hash")
+	}
+}
+
+func TestWebConfig_HashPasswords_UnresolvedReference(t *testing.T) {
+	w := NewWebConfig().WithBasicAuthUser("alice", SecretFromEnv("WEB_PASSWORD"))
+
+	err := w.HashPasswords()
+	if err == nil {
+		t.Fatal("HashPasswords() error = nil, want unresolved reference error")
+	}
+	if !strings.Contains(err.Error(), "basic_auth_users.alice") {
+		t.Errorf("HashPasswords() error = %v, want mention of basic_auth_users.alice", err)
+	}
+}
+
+func TestWebConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *WebConfig
+		want   []string
+	}{
+		{
+			name: "valid config",
+			config: NewWebConfig().
+				WithTLS("tls.crt", "tls.key").
+				WithClientAuth("VerifyClientCertIfGiven", "ca.crt").
+				WithHeaders(map[string]string{"Strict-Transport-Security": "max-age=31536000"}).
+				WithBasicAuthUser("admin", "s3cret"),
+		},
+		{
+			name: "incomplete TLS",
+			config: &WebConfig{TLSServerConfig: &WebTLSServerConfig{
+				ClientAuthType:    "Always",
+				ClientAllowedSANs: []string{"client.example.com"},
+				MaxVersion:        "TLS14",
+			}},
+			want: []string{
+				"tls_server_config.cert_file: missing cert_file",
+				"tls_server_config.key_file: missing key_file",
+				`tls_server_config.client_auth_type: invalid client_auth_type "Always"`,
+				"tls_server_config.client_allowed_sans: client_allowed_sans requires client_ca_file",
+				"tls_server_config.max_version: unknown TLS version: TLS14",
+			},
+		},
+		{
+			name:   "client CA without policy",
+			config: NewWebConfig().WithTLS("tls.crt", "tls.key").WithClientAuth("", "ca.crt"),
+			want: []string{
+				"tls_server_config.client_ca_file: client CA's have been configured without a client auth policy",
+			},
+		},
+		{
+			name:   "unsupported header",
+			config: NewWebConfig().WithHeaders(map[string]string{"Server": "prometheus"}),
+			want: []string{
+				`http_server_config.headers: HTTP header "Server" can not be configured`,
+			},
+		},
+		{
+			name:   "empty user",
+			config: NewWebConfig().WithBasicAuthUser("", ""),
+			want: []string{
+				"basic_auth_users: username must not be empty",
+				"basic_auth_users.: password must not be empty",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.config.Validate()
+			if len(errs) != len(tt.want) {
+				t.Fatalf("Validate() returned %d errors, want %d: %v", len(errs), len(tt.want), errs)
+			}
+			for i, w := range tt.want {
+				if errs[i].Error() != w {
+					t.Errorf("errs[%d] = %q, want %q", i, errs[i].Error(), w)
+				}
+			}
+		})
+	}
+}
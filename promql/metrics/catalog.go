@@ -0,0 +1,269 @@
+package metrics
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/promql"
+	"github.com/lex00/wetwire-observability-go/promql/parser"
+)
+
+// ProblemKind identifies a kind of metric misuse.
+type ProblemKind string
+
+// Problem kinds.
+const (
+	// UnknownLabel is a matcher or grouping label the metric is not exposed
+	// with.
+	UnknownLabel ProblemKind = "unknown-label"
+
+	// CounterFunctionOnGauge is rate, irate, increase or resets applied to
+	// a gauge.
+	CounterFunctionOnGauge ProblemKind = "counter-function-on-gauge"
+)
+
+// counterFunctions are the functions only meaningful on counters.
+var counterFunctions = []string{"rate", "irate", "increase", "resets"}
+
+// labelFunctions are the functions adding labels that are not exposed by
+// any metric.
+var labelFunctions = []string{"label_replace", "label_join", "info"}
+
+// Problem is a misuse of a metric in a PromQL expression.
+type Problem struct {
+	// Kind is the kind of problem.
+	Kind ProblemKind
+
+	// Metric is the metric name.
+	Metric string
+
+	// Label is the unknown label, for UnknownLabel.
+	Label string
+
+	// Function is the counter function, for CounterFunctionOnGauge.
+	Function string
+
+	// Message describes the problem.
+	Message string
+}
+
+// Error returns the problem message.
+func (p *Problem) Error() string {
+	return p.Message
+}
+
+// Catalog is a set of metric descriptors used to check PromQL expressions.
+//
+// Example usage:
+//
+//	var Catalog = metrics.NewCatalog(NodeCPUSecondsTotal, NodeMemoryMemAvailableBytes).
+//	    WithTargetLabels("namespace", "pod")
+type Catalog struct {
+	// Metrics are the descriptors by metric name.
+	Metrics map[string]*Descriptor
+
+	// TargetLabels are labels added to every series by scrape relabeling,
+	// besides job and instance, which are always accepted.
+	TargetLabels []string
+}
+
+// NewCatalog creates a catalog of the given descriptors.
+func NewCatalog(descs ...*Descriptor) *Catalog {
+	return (&Catalog{Metrics: make(map[string]*Descriptor)}).Add(descs...)
+}
+
+// Add adds descriptors to the catalog.
+func (c *Catalog) Add(descs ...*Descriptor) *Catalog {
+	if c.Metrics == nil {
+		c.Metrics = make(map[string]*Descriptor)
+	}
+	for _, d := range descs {
+		if d != nil {
+			c.Metrics[d.Name] = d
+		}
+	}
+	return c
+}
+
+// WithTargetLabels adds labels attached to every series by relabeling.
+func (c *Catalog) WithTargetLabels(labels ...string) *Catalog {
+	c.TargetLabels = append(c.TargetLabels, labels...)
+	return c
+}
+
+// Lookup returns the descriptor of a metric name, or nil if the catalog
+// does not know it. The _bucket, _sum and _count series of histograms and
+// summaries resolve to their Bucket, Sum and Count descriptors.
+func (c *Catalog) Lookup(name string) *Descriptor {
+	if d, ok := c.Metrics[name]; ok {
+		return d
+	}
+	for _, suffix := range []string{"_bucket", "_sum", "_count"} {
+		base, ok := strings.CutSuffix(name, suffix)
+		if !ok {
+			continue
+		}
+		d, ok := c.Metrics[base]
+		if !ok {
+			continue
+		}
+		switch {
+		case suffix == "_bucket" && (d.Type == Histogram || d.Type == GaugeHistogram):
+			return d.Bucket()
+		case suffix == "_sum" && (d.Type == Histogram || d.Type == Summary):
+			return d.Sum()
+		case suffix == "_count" && (d.Type == Histogram || d.Type == Summary):
+			return d.Count()
+		}
+	}
+	return nil
+}
+
+// Check parses a PromQL expression and returns the problems of the metrics
+// it selects: matchers and by/without labels the metrics are not exposed
+// with, and counter functions applied to gauges. Metrics missing from the
+// catalog are not checked.
+func (c *Catalog) Check(expr string) ([]*Problem, error) {
+	parsed, err := parser.ParseExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []*Problem
+	parser.Inspect(parsed, func(node parser.Node, _ []parser.Node) bool {
+		switch n := node.(type) {
+		case *parser.VectorSelector:
+			problems = append(problems, c.checkMatchers(n)...)
+		case *parser.Call:
+			if p := c.checkCall(n); p != nil {
+				problems = append(problems, p)
+			}
+		case *parser.AggregateExpr:
+			problems = append(problems, c.checkGrouping(n)...)
+		}
+		return true
+	})
+	return problems, nil
+}
+
+// CheckExpr checks an expression built with the promql package, see Check.
+func (c *Catalog) CheckExpr(expr promql.Expr) ([]*Problem, error) {
+	return c.Check(expr.String())
+}
+
+func (c *Catalog) checkMatchers(vs *parser.VectorSelector) []*Problem {
+	name := vs.MetricName()
+	d := c.Lookup(name)
+	if d == nil {
+		return nil
+	}
+	var problems []*Problem
+	for _, m := range vs.LabelMatchers {
+		if m.Name == "__name__" || c.known(d, m.Name) {
+			continue
+		}
+		problems = append(problems, &Problem{
+			Kind:    UnknownLabel,
+			Metric:  name,
+			Label:   m.Name,
+			Message: fmt.Sprintf("%s has no label %q%s", name, m.Name, knownLabels(d)),
+		})
+	}
+	return problems
+}
+
+func (c *Catalog) checkCall(call *parser.Call) *Problem {
+	if call.Func == nil || !slices.Contains(counterFunctions, call.Func.Name) || len(call.Args) == 0 {
+		return nil
+	}
+	ms, ok := call.Args[0].(*parser.MatrixSelector)
+	if !ok || ms.VectorSelector == nil {
+		return nil
+	}
+	name := ms.VectorSelector.MetricName()
+	d := c.Lookup(name)
+	if d == nil || d.Type != Gauge {
+		return nil
+	}
+	return &Problem{
+		Kind:     CounterFunctionOnGauge,
+		Metric:   name,
+		Function: call.Func.Name,
+		Message:  fmt.Sprintf("%s() applied to gauge %s; use deriv() or delta() for gauges", call.Func.Name, name),
+	}
+}
+
+// checkGrouping checks the by and without labels of an aggregation against
+// the labels of the metrics it aggregates. Aggregations over unknown
+// metrics or over functions creating labels are not checked.
+func (c *Catalog) checkGrouping(agg *parser.AggregateExpr) []*Problem {
+	if len(agg.Grouping) == 0 || agg.Op == "count_values" {
+		return nil
+	}
+	var descs []*Descriptor
+	var names []string
+	checkable := true
+	parser.Inspect(agg.Expr, func(node parser.Node, _ []parser.Node) bool {
+		switch n := node.(type) {
+		case *parser.VectorSelector:
+			d := c.Lookup(n.MetricName())
+			if d == nil || d.Labels == nil {
+				checkable = false
+				return false
+			}
+			descs = append(descs, d)
+			names = append(names, d.Name)
+		case *parser.Call:
+			if n.Func != nil && slices.Contains(labelFunctions, n.Func.Name) {
+				checkable = false
+			}
+		case *parser.AggregateExpr:
+			if n.Op == "count_values" {
+				checkable = false
+			}
+		}
+		return checkable
+	})
+	if !checkable || len(descs) == 0 {
+		return nil
+	}
+
+	var problems []*Problem
+	for _, label := range agg.Grouping {
+		if slices.ContainsFunc(descs, func(d *Descriptor) bool { return c.known(d, label) }) {
+			continue
+		}
+		metric := strings.Join(slices.Compact(slices.Sorted(slices.Values(names))), ", ")
+		problems = append(problems, &Problem{
+			Kind:    UnknownLabel,
+			Metric:  metric,
+			Label:   label,
+			Message: fmt.Sprintf("%s %s: %s has no label %q", agg.Op, groupingClause(agg), metric, label),
+		})
+	}
+	return problems
+}
+
+// known reports whether series of d can have the label.
+func (c *Catalog) known(d *Descriptor, label string) bool {
+	return label == "job" || label == "instance" || slices.Contains(c.TargetLabels, label) || d.HasLabel(label)
+}
+
+func knownLabels(d *Descriptor) string {
+	if len(d.Labels) == 0 {
+		return " (it has no labels)"
+	}
+	labels := slices.Clone(d.Labels)
+	sort.Strings(labels)
+	return fmt.Sprintf(" (labels: %s)", strings.Join(labels, ", "))
+}
+
+func groupingClause(agg *parser.AggregateExpr) string {
+	clause := "by"
+	if agg.Without {
+		clause = "without"
+	}
+	return clause + " (" + strings.Join(agg.Grouping, ", ") + ")"
+}
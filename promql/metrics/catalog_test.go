@@ -0,0 +1,115 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/promql"
+)
+
+func testCatalog() *Catalog {
+	return NewCatalog(
+		New("node_cpu_seconds_total", Counter).WithLabels("cpu", "mode"),
+		New("node_memory_MemAvailable_bytes", Gauge).WithLabels(),
+		New("http_request_duration_seconds", Histogram).WithLabels("method"),
+		New("go_goroutines", Gauge),
+	).WithTargetLabels("namespace")
+}
+
+func TestCatalog_Lookup(t *testing.T) {
+	c := testCatalog()
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "node_cpu_seconds_total", want: "node_cpu_seconds_total"},
+		{name: "http_request_duration_seconds_bucket", want: "http_request_duration_seconds_bucket"},
+		{name: "http_request_duration_seconds_count", want: "http_request_duration_seconds_count"},
+		{name: "node_cpu_seconds_total_count", want: ""},
+		{name: "missing", want: ""},
+	}
+	for _, tt := range tests {
+		got := ""
+		if d := c.Lookup(tt.name); d != nil {
+			got = d.Name
+		}
+		if got != tt.want {
+			t.Errorf("Lookup(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCatalog_Check(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want []ProblemKind
+	}{
+		{name: "valid", expr: `sum by (mode) (rate(node_cpu_seconds_total{mode!="idle",job="node",namespace="a"}[5m]))`},
+		{name: "unknown matcher", expr: `node_cpu_seconds_total{core="0"}`, want: []ProblemKind{UnknownLabel}},
+		{name: "unknown grouping", expr: `sum by (core) (rate(node_cpu_seconds_total[5m]))`, want: []ProblemKind{UnknownLabel}},
+		{name: "unknown without", expr: `sum without (core) (node_cpu_seconds_total)`, want: []ProblemKind{UnknownLabel}},
+		{name: "rate on gauge", expr: `rate(node_memory_MemAvailable_bytes[5m])`, want: []ProblemKind{CounterFunctionOnGauge}},
+		{name: "increase on gauge", expr: `sum(increase(go_goroutines[1h]))`, want: []ProblemKind{CounterFunctionOnGauge}},
+		{name: "deriv on gauge", expr: `deriv(node_memory_MemAvailable_bytes[5m])`},
+		{name: "histogram buckets", expr: `histogram_quantile(0.99, sum by (le, method) (rate(http_request_duration_seconds_bucket[5m])))`},
+		{name: "label_replace", expr: `sum by (core) (label_replace(node_cpu_seconds_total, "core", "$1", "cpu", "(.*)"))`},
+		{name: "unknown labels not checked", expr: `sum by (pod) (go_goroutines{pod="a"})`},
+		{name: "unknown metric", expr: `rate(other_metric{x="1"}[5m])`},
+		{name: "no labels", expr: `node_memory_MemAvailable_bytes{instance="a",device="sda"}`, want: []ProblemKind{UnknownLabel}},
+	}
+	c := testCatalog()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems, err := c.Check(tt.expr)
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if len(problems) != len(tt.want) {
+				t.Fatalf("Check() = %v, want %v", problems, tt.want)
+			}
+			for i, want := range tt.want {
+				if problems[i].Kind != want {
+					t.Errorf("problems[%d].Kind = %v, want %v", i, problems[i].Kind, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCatalog_CheckMessages(t *testing.T) {
+	c := testCatalog()
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: `node_cpu_seconds_total{core="0"}`, want: `node_cpu_seconds_total has no label "core" (labels: cpu, mode)`},
+		{expr: `sum by (core) (node_cpu_seconds_total)`, want: `sum by (core): node_cpu_seconds_total has no label "core"`},
+		{expr: `rate(go_goroutines[5m])`, want: "rate() applied to gauge go_goroutines; use deriv() or delta() for gauges"},
+	}
+	for _, tt := range tests {
+		problems, err := c.Check(tt.expr)
+		if err != nil {
+			t.Fatalf("Check() error = %v", err)
+		}
+		if len(problems) != 1 || problems[0].Error() != tt.want {
+			t.Errorf("Check(%s) = %v, want %q", tt.expr, problems, tt.want)
+		}
+	}
+}
+
+func TestCatalog_CheckExpr(t *testing.T) {
+	c := testCatalog()
+	gauge := c.Metrics["node_memory_MemAvailable_bytes"]
+
+	problems, err := c.CheckExpr(promql.Rate(gauge.Range("5m")))
+	if err != nil {
+		t.Fatalf("CheckExpr() error = %v", err)
+	}
+	if len(problems) != 1 || problems[0].Function != "rate" || problems[0].Metric != gauge.Name {
+		t.Errorf("CheckExpr() = %v, want rate on %s", problems, gauge.Name)
+	}
+
+	if _, err := c.Check("rate("); err == nil {
+		t.Error("Check() error = nil for invalid expression")
+	}
+}
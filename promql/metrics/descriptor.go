@@ -0,0 +1,137 @@
+// Package metrics provides typed metric descriptors for building and
+// checking PromQL selectors.
+//
+// Descriptors are usually generated with "wetwire-obs metrics gen" from
+// exposition samples or /api/v1/metadata dumps, so metric names are Go
+// identifiers and typos fail to compile:
+//
+//	expr := promql.Rate(nodemetrics.NodeCPUSecondsTotal.Range("5m", promql.Match("mode", "idle")))
+//
+// A Catalog of descriptors checks expressions for label names the metric
+// does not have and for counter functions applied to gauges.
+package metrics
+
+import (
+	"slices"
+
+	"github.com/lex00/wetwire-observability-go/promql"
+)
+
+// Type is the type of a metric family.
+type Type string
+
+// Metric types.
+const (
+	Counter        Type = "counter"
+	Gauge          Type = "gauge"
+	Histogram      Type = "histogram"
+	GaugeHistogram Type = "gaugehistogram"
+	Summary        Type = "summary"
+	Info           Type = "info"
+	StateSet       Type = "stateset"
+	Unknown        Type = "unknown"
+)
+
+// Descriptor describes a metric: the name used in queries, its type, help
+// text, unit and the label names it is exposed with.
+//
+// Example usage:
+//
+//	var HTTPRequestsTotal = metrics.New("http_requests_total", metrics.Counter).
+//	    WithHelp("Total HTTP requests.").
+//	    WithLabels("method", "code")
+type Descriptor struct {
+	// Name is the metric name used in queries. Counters include the _total
+	// suffix; histograms and summaries are named after the family, see
+	// Bucket, Sum and Count.
+	Name string
+
+	// Type is the metric type. Defaults to Unknown.
+	Type Type
+
+	// Help is the help text.
+	Help string
+
+	// Unit is the unit of the metric, e.g. "seconds" or "bytes".
+	Unit string
+
+	// Labels are the label names the metric is exposed with, excluding le,
+	// quantile and target labels such as job and instance. Nil means the
+	// labels are not known and are not checked.
+	Labels []string
+}
+
+// New creates a descriptor.
+func New(name string, t Type) *Descriptor {
+	return &Descriptor{Name: name, Type: t}
+}
+
+// WithHelp sets the help text.
+func (d *Descriptor) WithHelp(help string) *Descriptor {
+	d.Help = help
+	return d
+}
+
+// WithUnit sets the unit.
+func (d *Descriptor) WithUnit(unit string) *Descriptor {
+	d.Unit = unit
+	return d
+}
+
+// WithLabels sets the label names. Without arguments, it declares a metric
+// exposed without labels.
+func (d *Descriptor) WithLabels(labels ...string) *Descriptor {
+	d.Labels = append([]string{}, labels...)
+	return d
+}
+
+// Vector returns an instant vector selector for the metric.
+func (d *Descriptor) Vector(matchers ...promql.LabelMatcher) *promql.VectorExpr {
+	return promql.Vector(d.Name, matchers...)
+}
+
+// Range returns a range vector selector for the metric.
+func (d *Descriptor) Range(duration string, matchers ...promql.LabelMatcher) *promql.RangeVectorExpr {
+	return promql.RangeVector(d.Name, duration, matchers...)
+}
+
+// Bucket returns the descriptor of the _bucket series of a classic
+// histogram, with the le label.
+func (d *Descriptor) Bucket() *Descriptor {
+	t := Counter
+	if d.Type == GaugeHistogram {
+		t = Gauge
+	}
+	return d.series("_bucket", t, "le")
+}
+
+// Sum returns the descriptor of the _sum series of a histogram or summary.
+func (d *Descriptor) Sum() *Descriptor {
+	return d.series("_sum", Counter)
+}
+
+// Count returns the descriptor of the _count series of a histogram or
+// summary.
+func (d *Descriptor) Count() *Descriptor {
+	return d.series("_count", Counter)
+}
+
+// HasLabel reports whether the metric is exposed with the label. Labels of
+// a descriptor without known labels are always reported present.
+func (d *Descriptor) HasLabel(name string) bool {
+	if d.Labels == nil {
+		return true
+	}
+	if name == "quantile" && d.Type == Summary {
+		return true
+	}
+	return slices.Contains(d.Labels, name)
+}
+
+func (d *Descriptor) series(suffix string, t Type, extra ...string) *Descriptor {
+	s := &Descriptor{Name: d.Name + suffix, Type: t, Help: d.Help, Unit: d.Unit}
+	if d.Labels != nil {
+		s.Labels = append(slices.Clone(d.Labels), extra...)
+	}
+	return s
+}
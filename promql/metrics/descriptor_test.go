@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/promql"
+)
+
+func TestDescriptor_Selectors(t *testing.T) {
+	d := New("node_cpu_seconds_total", Counter).WithLabels("cpu", "mode")
+
+	if got, want := d.Vector(promql.Match("mode", "idle")).String(), `node_cpu_seconds_total{mode="idle"}`; got != want {
+		t.Errorf("Vector() = %v, want %v", got, want)
+	}
+	if got, want := promql.Rate(d.Range("5m")).String(), "rate(node_cpu_seconds_total[5m])"; got != want {
+		t.Errorf("Rate(Range()) = %v, want %v", got, want)
+	}
+}
+
+func TestDescriptor_HistogramSeries(t *testing.T) {
+	d := New("http_request_duration_seconds", Histogram).WithUnit("seconds").WithLabels("method")
+
+	bucket := d.Bucket()
+	if bucket.Name != "http_request_duration_seconds_bucket" || bucket.Type != Counter {
+		t.Errorf("Bucket() = %s %s, want http_request_duration_seconds_bucket counter", bucket.Name, bucket.Type)
+	}
+	if strings.Join(bucket.Labels, ",") != "method,le" {
+		t.Errorf("Bucket().Labels = %v, want [method le]", bucket.Labels)
+	}
+	if strings.Join(d.Labels, ",") != "method" {
+		t.Errorf("Labels = %v, want [method] after Bucket()", d.Labels)
+	}
+	if got := d.Sum().Name; got != "http_request_duration_seconds_sum" {
+		t.Errorf("Sum().Name = %v, want http_request_duration_seconds_sum", got)
+	}
+	if got := d.Count().Unit; got != "seconds" {
+		t.Errorf("Count().Unit = %v, want seconds", got)
+	}
+	if got := New("queue_size", GaugeHistogram).Bucket().Type; got != Gauge {
+		t.Errorf("GaugeHistogram Bucket().Type = %v, want gauge", got)
+	}
+}
+
+func TestDescriptor_HasLabel(t *testing.T) {
+	tests := []struct {
+		name  string
+		desc  *Descriptor
+		label string
+		want  bool
+	}{
+		{name: "known", desc: New("up", Gauge).WithLabels("job"), label: "job", want: true},
+		{name: "unknown", desc: New("up", Gauge).WithLabels(), label: "pod", want: false},
+		{name: "labels not known", desc: New("up", Gauge), label: "pod", want: true},
+		{name: "summary quantile", desc: New("rpc_seconds", Summary).WithLabels(), label: "quantile", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.desc.HasLabel(tt.label); got != tt.want {
+				t.Errorf("HasLabel(%q) = %v, want %v", tt.label, got, tt.want)
+			}
+		})
+	}
+}
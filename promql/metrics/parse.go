@@ -0,0 +1,249 @@
+package metrics
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/lex00/wetwire-observability-go/internal/exposition"
+)
+
+// units are the base units recognized as metric name suffixes when a
+// family has no UNIT line.
+var units = []string{
+	"seconds", "bytes", "ratio", "celsius", "meters", "volts", "amperes",
+	"joules", "grams", "hertz", "percent",
+}
+
+// family accumulates a metric family while parsing an exposition.
+type family struct {
+	desc   *Descriptor
+	labels map[string]bool
+
+	// suffix is appended to the family name to get the sample name, for
+	// OpenMetrics counters (_total) and info metrics (_info).
+	suffix string
+}
+
+// ParseExposition parses a scrape in the Prometheus or OpenMetrics text
+// format and returns a descriptor per metric family, sorted by name. Types,
+// help texts and units come from TYPE, HELP and UNIT lines, and label names
+// from the samples. Families without a TYPE line are Unknown.
+func ParseExposition(r io.Reader) ([]*Descriptor, error) {
+	families := make(map[string]*family)
+	get := func(name string) *family {
+		f, ok := families[name]
+		if !ok {
+			f = &family{desc: New(name, Unknown), labels: make(map[string]bool)}
+			families[name] = f
+		}
+		return f
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		if strings.HasPrefix(text, "#") {
+			keyword, name, value, ok := exposition.ParseComment(text)
+			if !ok {
+				continue
+			}
+			f := get(name)
+			switch keyword {
+			case "HELP":
+				f.desc.Help = value
+			case "TYPE":
+				f.desc.Type = parseType(value)
+			case "UNIT":
+				f.desc.Unit = value
+			}
+			continue
+		}
+
+		series, err := exposition.ParseSeries(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		name := series["__name__"]
+		f, suffix := familyOf(families, name)
+		if f == nil {
+			f = get(name)
+		}
+		if suffix == "_total" && f.desc.Type == Counter || suffix == "_info" && f.desc.Type == Info {
+			f.suffix = suffix
+		}
+		for label := range series {
+			switch {
+			case label == "__name__":
+			case label == "le" && suffix == "_bucket":
+			case label == "quantile" && f.desc.Type == Summary && suffix == "":
+			default:
+				f.labels[label] = true
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	descs := make([]*Descriptor, 0, len(families))
+	for _, f := range families {
+		d := f.desc
+		d.Name += f.suffix
+		d.Labels = make([]string, 0, len(f.labels))
+		for label := range f.labels {
+			d.Labels = append(d.Labels, label)
+		}
+		sort.Strings(d.Labels)
+		if d.Unit == "" {
+			d.Unit = unitOf(d.Name)
+		}
+		descs = append(descs, d)
+	}
+	sortDescriptors(descs)
+	return descs, nil
+}
+
+// ParseMetadata parses a /api/v1/metadata response, or its data object,
+// and returns a descriptor per metric, sorted by name. Metadata has no
+// label names, so the descriptors' Labels are nil.
+func ParseMetadata(data []byte) ([]*Descriptor, error) {
+	var resp struct {
+		Status string          `json:"status"`
+		Data   json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("parsing metadata: %w", err)
+	}
+	if resp.Status != "" {
+		if resp.Status != "success" {
+			return nil, fmt.Errorf("metadata response has status %q", resp.Status)
+		}
+		data = resp.Data
+	}
+
+	var metadata map[string][]struct {
+		Type string `json:"type"`
+		Help string `json:"help"`
+		Unit string `json:"unit"`
+	}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, fmt.Errorf("parsing metadata: %w", err)
+	}
+
+	descs := make([]*Descriptor, 0, len(metadata))
+	for name, entries := range metadata {
+		d := New(name, Unknown)
+		for _, m := range entries {
+			if d.Type == Unknown {
+				d.Type = parseType(m.Type)
+			}
+			if d.Help == "" {
+				d.Help = m.Help
+			}
+			if d.Unit == "" {
+				d.Unit = m.Unit
+			}
+		}
+		if d.Unit == "" {
+			d.Unit = unitOf(d.Name)
+		}
+		descs = append(descs, d)
+	}
+	sortDescriptors(descs)
+	return descs, nil
+}
+
+// Merge merges descriptors of the same metric from several sources. The
+// first non-empty type, help text and unit win, and label names are
+// combined. The result is sorted by name.
+func Merge(sets ...[]*Descriptor) []*Descriptor {
+	byName := make(map[string]*Descriptor)
+	for _, set := range sets {
+		for _, d := range set {
+			m, ok := byName[d.Name]
+			if !ok {
+				m = &Descriptor{Name: d.Name, Type: Unknown}
+				byName[d.Name] = m
+			}
+			if m.Type == Unknown || m.Type == "" {
+				m.Type = d.Type
+			}
+			if m.Help == "" {
+				m.Help = d.Help
+			}
+			if m.Unit == "" {
+				m.Unit = d.Unit
+			}
+			if d.Labels != nil {
+				for _, label := range d.Labels {
+					if !slices.Contains(m.Labels, label) {
+						m.Labels = append(m.Labels, label)
+					}
+				}
+				if m.Labels == nil {
+					m.Labels = []string{}
+				}
+				sort.Strings(m.Labels)
+			}
+		}
+	}
+
+	descs := make([]*Descriptor, 0, len(byName))
+	for _, d := range byName {
+		descs = append(descs, d)
+	}
+	sortDescriptors(descs)
+	return descs
+}
+
+// familyOf returns the family a sample name belongs to and the suffix of
+// the sample name, or nil if no family matches.
+func familyOf(families map[string]*family, name string) (*family, string) {
+	if f, ok := families[name]; ok {
+		return f, ""
+	}
+	for _, suffix := range []string{"_bucket", "_sum", "_count", "_gcount", "_gsum", "_total", "_created", "_info"} {
+		base, ok := strings.CutSuffix(name, suffix)
+		if !ok {
+			continue
+		}
+		if f, ok := families[base]; ok && f.desc.Type != Unknown {
+			return f, suffix
+		}
+	}
+	return nil, ""
+}
+
+func parseType(s string) Type {
+	switch t := Type(strings.ToLower(s)); t {
+	case Counter, Gauge, Histogram, GaugeHistogram, Summary, Info, StateSet:
+		return t
+	}
+	return Unknown
+}
+
+// unitOf returns the unit suffix of a metric name, if any.
+func unitOf(name string) string {
+	name = strings.TrimSuffix(name, "_total")
+	for _, unit := range units {
+		if strings.HasSuffix(name, "_"+unit) {
+			return unit
+		}
+	}
+	return ""
+}
+
+func sortDescriptors(descs []*Descriptor) {
+	sort.Slice(descs, func(i, j int) bool { return descs[i].Name < descs[j].Name })
+}
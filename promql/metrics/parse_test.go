@@ -0,0 +1,155 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+)
+
+const testExposition = `# HELP node_cpu_seconds_total Seconds the CPUs spent in each mode.
+# TYPE node_cpu_seconds_total counter
+node_cpu_seconds_total{cpu="0",mode="idle"} 100
+node_cpu_seconds_total{cpu="0",mode="user"} 10
+# HELP node_memory_MemAvailable_bytes Memory information field MemAvailable_bytes.
+# TYPE node_memory_MemAvailable_bytes gauge
+node_memory_MemAvailable_bytes 1e+09
+# TYPE http_request_duration_seconds histogram
+http_request_duration_seconds_bucket{method="GET",le="0.1"} 1
+http_request_duration_seconds_bucket{method="GET",le="+Inf"} 2
+http_request_duration_seconds_sum{method="GET"} 0.3
+http_request_duration_seconds_count{method="GET"} 2
+# TYPE rpc_duration_seconds summary
+rpc_duration_seconds{service="a",quantile="0.5"} 0.1
+rpc_duration_seconds_sum{service="a"} 1
+rpc_duration_seconds_count{service="a"} 10
+untyped_metric{x="1"} 1
+`
+
+func descriptorsByName(descs []*Descriptor) map[string]*Descriptor {
+	m := make(map[string]*Descriptor, len(descs))
+	for _, d := range descs {
+		m[d.Name] = d
+	}
+	return m
+}
+
+func TestParseExposition(t *testing.T) {
+	descs, err := ParseExposition(strings.NewReader(testExposition))
+	if err != nil {
+		t.Fatalf("ParseExposition() error = %v", err)
+	}
+	if len(descs) != 5 {
+		t.Fatalf("len(descs) = %d, want 5", len(descs))
+	}
+	if descs[0].Name != "http_request_duration_seconds" {
+		t.Errorf("descs[0].Name = %v, want sorted by name", descs[0].Name)
+	}
+
+	byName := descriptorsByName(descs)
+	tests := []struct {
+		name   string
+		typ    Type
+		unit   string
+		labels string
+		help   string
+	}{
+		{name: "node_cpu_seconds_total", typ: Counter, unit: "seconds", labels: "cpu,mode", help: "Seconds the CPUs spent in each mode."},
+		{name: "node_memory_MemAvailable_bytes", typ: Gauge, unit: "bytes", labels: ""},
+		{name: "http_request_duration_seconds", typ: Histogram, unit: "seconds", labels: "method"},
+		{name: "rpc_duration_seconds", typ: Summary, unit: "seconds", labels: "service"},
+		{name: "untyped_metric", typ: Unknown, labels: "x"},
+	}
+	for _, tt := range tests {
+		d := byName[tt.name]
+		if d == nil {
+			t.Errorf("missing descriptor %s", tt.name)
+			continue
+		}
+		if d.Type != tt.typ {
+			t.Errorf("%s Type = %v, want %v", tt.name, d.Type, tt.typ)
+		}
+		if d.Unit != tt.unit {
+			t.Errorf("%s Unit = %q, want %q", tt.name, d.Unit, tt.unit)
+		}
+		if got := strings.Join(d.Labels, ","); got != tt.labels {
+			t.Errorf("%s Labels = %q, want %q", tt.name, got, tt.labels)
+		}
+		if tt.help != "" && d.Help != tt.help {
+			t.Errorf("%s Help = %q, want %q", tt.name, d.Help, tt.help)
+		}
+	}
+}
+
+func TestParseExposition_OpenMetrics(t *testing.T) {
+	input := `# TYPE http_requests counter
+# UNIT http_requests requests
+http_requests_total{code="200"} 1
+http_requests_created{code="200"} 1.7e9
+# TYPE build info
+build_info{version="1.0"} 1
+# EOF
+`
+	descs, err := ParseExposition(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseExposition() error = %v", err)
+	}
+	byName := descriptorsByName(descs)
+	if d := byName["http_requests_total"]; d == nil || d.Unit != "requests" || strings.Join(d.Labels, ",") != "code" {
+		t.Errorf("http_requests_total = %+v, want counter with unit requests and label code", d)
+	}
+	if d := byName["build_info"]; d == nil || d.Type != Info {
+		t.Errorf("build_info = %+v, want info", d)
+	}
+}
+
+func TestParseExposition_Error(t *testing.T) {
+	_, err := ParseExposition(strings.NewReader("# TYPE up gauge\nup{job=\"a} 1\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("ParseExposition() error = %v, want line 2 error", err)
+	}
+}
+
+func TestParseMetadata(t *testing.T) {
+	for _, input := range []string{
+		`{"status":"success","data":{"go_goroutines":[{"type":"gauge","help":"Number of goroutines.","unit":""}],"process_cpu_seconds_total":[{"type":"counter","help":"","unit":""},{"type":"counter","help":"Total CPU time.","unit":""}]}}`,
+		`{"go_goroutines":[{"type":"gauge","help":"Number of goroutines.","unit":""}],"process_cpu_seconds_total":[{"type":"counter","help":"Total CPU time.","unit":""}]}`,
+	} {
+		descs, err := ParseMetadata([]byte(input))
+		if err != nil {
+			t.Fatalf("ParseMetadata() error = %v", err)
+		}
+		if len(descs) != 2 {
+			t.Fatalf("len(descs) = %d, want 2", len(descs))
+		}
+		if descs[0].Name != "go_goroutines" || descs[0].Type != Gauge || descs[0].Labels != nil {
+			t.Errorf("descs[0] = %+v, want gauge go_goroutines without labels", descs[0])
+		}
+		if descs[1].Help != "Total CPU time." || descs[1].Unit != "seconds" {
+			t.Errorf("descs[1] = %+v, want help and unit seconds", descs[1])
+		}
+	}
+
+	if _, err := ParseMetadata([]byte(`{"status":"error"}`)); err == nil {
+		t.Error("ParseMetadata() error = nil for error status")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	fromExposition := []*Descriptor{New("up", Unknown).WithLabels("a"), New("x", Gauge).WithLabels()}
+	fromMetadata := []*Descriptor{New("up", Gauge).WithHelp("Up."), New("y", Counter)}
+	more := []*Descriptor{New("up", Unknown).WithLabels("b", "a")}
+
+	descs := Merge(fromExposition, fromMetadata, more)
+	if len(descs) != 3 {
+		t.Fatalf("len(Merge()) = %d, want 3", len(descs))
+	}
+	up := descs[0]
+	if up.Type != Gauge || up.Help != "Up." || strings.Join(up.Labels, ",") != "a,b" {
+		t.Errorf("up = %+v, want gauge with help and labels a,b", up)
+	}
+	if descs[1].Labels == nil {
+		t.Error("x Labels = nil, want empty known labels")
+	}
+	if descs[2].Labels != nil {
+		t.Errorf("y Labels = %v, want nil", descs[2].Labels)
+	}
+}
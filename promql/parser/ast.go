@@ -0,0 +1,528 @@
+// Package parser parses PromQL expressions into a typed syntax tree and
+// type-checks them the way the Prometheus query engine does before
+// evaluation, without depending on Prometheus itself.
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+// ValueType is the type an expression evaluates to.
+type ValueType string
+
+// Expression value types.
+const (
+	ValueTypeNone   ValueType = "none"
+	ValueTypeScalar ValueType = "scalar"
+	ValueTypeVector ValueType = "instant vector"
+	ValueTypeMatrix ValueType = "range vector"
+	ValueTypeString ValueType = "string"
+)
+
+// PositionRange is the byte range [Start, End) of a node in the input.
+type PositionRange struct {
+	Start int
+	End   int
+}
+
+// Node is a node of the PromQL syntax tree.
+type Node interface {
+	// String returns the node as PromQL.
+	String() string
+
+	// PositionRange returns where the node appears in the input.
+	PositionRange() PositionRange
+}
+
+// Expr is a PromQL expression.
+type Expr interface {
+	Node
+
+	// Type returns the type the expression evaluates to.
+	Type() ValueType
+}
+
+// MatchType is a label matcher operator.
+type MatchType string
+
+// Label matcher operators.
+const (
+	MatchEqual     MatchType = "="
+	MatchNotEqual  MatchType = "!="
+	MatchRegexp    MatchType = "=~"
+	MatchNotRegexp MatchType = "!~"
+)
+
+// LabelMatcher matches a label value in a vector selector.
+type LabelMatcher struct {
+	Name  string
+	Type  MatchType
+	Value string
+
+	re *regexp.Regexp
+}
+
+// NewLabelMatcher creates a label matcher, compiling the value as a fully
+// anchored regular expression for =~ and !~.
+func NewLabelMatcher(t MatchType, name, value string) (*LabelMatcher, error) {
+	m := &LabelMatcher{Name: name, Type: t, Value: value}
+	if t == MatchRegexp || t == MatchNotRegexp {
+		if _, err := regexp.Compile(value); err != nil {
+			return nil, err
+		}
+		m.re = regexp.MustCompile("^(?:" + value + ")$")
+	}
+	return m, nil
+}
+
+// Matches reports whether the matcher matches the label value v. A missing
+// label has the empty value.
+func (m *LabelMatcher) Matches(v string) bool {
+	switch m.Type {
+	case MatchEqual:
+		return v == m.Value
+	case MatchNotEqual:
+		return v != m.Value
+	case MatchRegexp:
+		return m.re.MatchString(v)
+	case MatchNotRegexp:
+		return !m.re.MatchString(v)
+	}
+	return false
+}
+
+// String returns the matcher as PromQL.
+func (m *LabelMatcher) String() string {
+	return formatLabelName(m.Name) + string(m.Type) + strconv.Quote(m.Value)
+}
+
+// VectorSelector selects an instant vector of series.
+type VectorSelector struct {
+	// Name is the metric name, if given outside the braces.
+	Name string
+
+	// LabelMatchers are the matchers inside the braces.
+	LabelMatchers []*LabelMatcher
+
+	// Offset is the offset modifier, if any.
+	Offset time.Duration
+
+	// At is the @ modifier: a Unix timestamp, "start()" or "end()".
+	At string
+
+	PosRange PositionRange
+}
+
+// MetricName returns the selected metric name from Name or an equality
+// matcher on __name__, or "" if the selector does not name a metric.
+func (v *VectorSelector) MetricName() string {
+	if v.Name != "" {
+		return v.Name
+	}
+	for _, m := range v.LabelMatchers {
+		if m.Name == "__name__" && m.Type == MatchEqual {
+			return m.Value
+		}
+	}
+	return ""
+}
+
+// MatrixSelector selects a range of samples for each series of a vector
+// selector.
+type MatrixSelector struct {
+	VectorSelector *VectorSelector
+	Range          time.Duration
+	EndPos         int
+}
+
+// SubqueryExpr evaluates an instant vector expression over a range.
+type SubqueryExpr struct {
+	Expr   Expr
+	Range  time.Duration
+	Step   time.Duration
+	Offset time.Duration
+	At     string
+	EndPos int
+}
+
+// Call is a function call.
+type Call struct {
+	Func     *Function
+	Args     []Expr
+	PosRange PositionRange
+}
+
+// AggregateExpr is an aggregation such as sum or topk.
+type AggregateExpr struct {
+	// Op is the aggregation operator, e.g. "sum".
+	Op string
+
+	// Expr is the aggregated expression.
+	Expr Expr
+
+	// Param is the parameter of topk, bottomk, quantile, count_values,
+	// limitk and limit_ratio.
+	Param Expr
+
+	// Grouping are the by or without labels.
+	Grouping []string
+
+	// Without reports whether Grouping is a without clause.
+	Without bool
+
+	PosRange PositionRange
+}
+
+// VectorMatchCardinality is the cardinality of a binary vector operation.
+type VectorMatchCardinality string
+
+// Vector matching cardinalities.
+const (
+	CardOneToOne   VectorMatchCardinality = "one-to-one"
+	CardManyToOne  VectorMatchCardinality = "many-to-one"
+	CardOneToMany  VectorMatchCardinality = "one-to-many"
+	CardManyToMany VectorMatchCardinality = "many-to-many"
+)
+
+// VectorMatching describes how series are matched in a binary operation
+// between two vectors.
+type VectorMatching struct {
+	Card VectorMatchCardinality
+
+	// MatchingLabels are the on or ignoring labels.
+	MatchingLabels []string
+
+	// On reports whether MatchingLabels is an on clause.
+	On bool
+
+	// Include are the group_left or group_right labels.
+	Include []string
+}
+
+// BinaryExpr is a binary operation.
+type BinaryExpr struct {
+	Op  string
+	LHS Expr
+	RHS Expr
+
+	// VectorMatching is set when on, ignoring, group_left or group_right
+	// is given.
+	VectorMatching *VectorMatching
+
+	// ReturnBool reports whether the bool modifier is set.
+	ReturnBool bool
+}
+
+// UnaryExpr is a unary plus or minus.
+type UnaryExpr struct {
+	Op       string
+	Expr     Expr
+	StartPos int
+}
+
+// ParenExpr is a parenthesized expression.
+type ParenExpr struct {
+	Expr     Expr
+	PosRange PositionRange
+}
+
+// NumberLiteral is a number.
+type NumberLiteral struct {
+	Val      float64
+	PosRange PositionRange
+}
+
+// StringLiteral is a string.
+type StringLiteral struct {
+	Val      string
+	PosRange PositionRange
+}
+
+// Type implementations.
+
+func (e *VectorSelector) Type() ValueType { return ValueTypeVector }
+func (e *MatrixSelector) Type() ValueType { return ValueTypeMatrix }
+func (e *SubqueryExpr) Type() ValueType   { return ValueTypeMatrix }
+func (e *Call) Type() ValueType           { return e.Func.ReturnType }
+func (e *AggregateExpr) Type() ValueType  { return ValueTypeVector }
+func (e *UnaryExpr) Type() ValueType      { return e.Expr.Type() }
+func (e *ParenExpr) Type() ValueType      { return e.Expr.Type() }
+func (e *NumberLiteral) Type() ValueType  { return ValueTypeScalar }
+func (e *StringLiteral) Type() ValueType  { return ValueTypeString }
+
+func (e *BinaryExpr) Type() ValueType {
+	if e.LHS.Type() == ValueTypeScalar && e.RHS.Type() == ValueTypeScalar {
+		return ValueTypeScalar
+	}
+	return ValueTypeVector
+}
+
+// PositionRange implementations.
+
+func (e *VectorSelector) PositionRange() PositionRange { return e.PosRange }
+func (e *Call) PositionRange() PositionRange           { return e.PosRange }
+func (e *AggregateExpr) PositionRange() PositionRange  { return e.PosRange }
+func (e *ParenExpr) PositionRange() PositionRange      { return e.PosRange }
+func (e *NumberLiteral) PositionRange() PositionRange  { return e.PosRange }
+func (e *StringLiteral) PositionRange() PositionRange  { return e.PosRange }
+
+func (e *MatrixSelector) PositionRange() PositionRange {
+	return PositionRange{Start: e.VectorSelector.PosRange.Start, End: e.EndPos}
+}
+
+func (e *SubqueryExpr) PositionRange() PositionRange {
+	return PositionRange{Start: e.Expr.PositionRange().Start, End: e.EndPos}
+}
+
+func (e *BinaryExpr) PositionRange() PositionRange {
+	return PositionRange{Start: e.LHS.PositionRange().Start, End: e.RHS.PositionRange().End}
+}
+
+func (e *UnaryExpr) PositionRange() PositionRange {
+	return PositionRange{Start: e.StartPos, End: e.Expr.PositionRange().End}
+}
+
+// String implementations.
+
+func (e *VectorSelector) String() string {
+	var sb strings.Builder
+	sb.WriteString(formatMetricName(e.Name))
+	if len(e.LabelMatchers) > 0 || e.Name == "" {
+		strs := make([]string, len(e.LabelMatchers))
+		for i, m := range e.LabelMatchers {
+			strs[i] = m.String()
+		}
+		sb.WriteString("{" + strings.Join(strs, ", ") + "}")
+	}
+	sb.WriteString(modifiers(e.Offset, e.At))
+	return sb.String()
+}
+
+func (e *MatrixSelector) String() string {
+	vs := *e.VectorSelector
+	vs.Offset, vs.At = 0, ""
+	return vs.String() + "[" + formatDuration(e.Range) + "]" +
+		modifiers(e.VectorSelector.Offset, e.VectorSelector.At)
+}
+
+func (e *SubqueryExpr) String() string {
+	step := ""
+	if e.Step != 0 {
+		step = formatDuration(e.Step)
+	}
+	return e.Expr.String() + "[" + formatDuration(e.Range) + ":" + step + "]" + modifiers(e.Offset, e.At)
+}
+
+func (e *Call) String() string {
+	args := make([]string, len(e.Args))
+	for i, a := range e.Args {
+		args[i] = a.String()
+	}
+	return e.Func.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func (e *AggregateExpr) String() string {
+	var sb strings.Builder
+	sb.WriteString(e.Op)
+	if e.Without {
+		sb.WriteString(" without (" + strings.Join(e.Grouping, ", ") + ") ")
+	} else if len(e.Grouping) > 0 {
+		sb.WriteString(" by (" + strings.Join(e.Grouping, ", ") + ") ")
+	}
+	sb.WriteByte('(')
+	if e.Param != nil {
+		sb.WriteString(e.Param.String() + ", ")
+	}
+	sb.WriteString(e.Expr.String() + ")")
+	return sb.String()
+}
+
+func (e *BinaryExpr) String() string {
+	var sb strings.Builder
+	sb.WriteString(e.LHS.String() + " " + e.Op)
+	if e.ReturnBool {
+		sb.WriteString(" bool")
+	}
+	if vm := e.VectorMatching; vm != nil {
+		if vm.On {
+			sb.WriteString(" on (" + strings.Join(vm.MatchingLabels, ", ") + ")")
+		} else if len(vm.MatchingLabels) > 0 {
+			sb.WriteString(" ignoring (" + strings.Join(vm.MatchingLabels, ", ") + ")")
+		}
+		switch vm.Card {
+		case CardManyToOne:
+			sb.WriteString(" group_left (" + strings.Join(vm.Include, ", ") + ")")
+		case CardOneToMany:
+			sb.WriteString(" group_right (" + strings.Join(vm.Include, ", ") + ")")
+		}
+	}
+	sb.WriteString(" " + e.RHS.String())
+	return sb.String()
+}
+
+func (e *UnaryExpr) String() string     { return e.Op + e.Expr.String() }
+func (e *ParenExpr) String() string     { return "(" + e.Expr.String() + ")" }
+func (e *StringLiteral) String() string { return strconv.Quote(e.Val) }
+
+func (e *NumberLiteral) String() string {
+	return strconv.FormatFloat(e.Val, 'g', -1, 64)
+}
+
+// modifiers formats offset and @ modifiers.
+func modifiers(offset time.Duration, at string) string {
+	var s string
+	if at != "" {
+		s += " @ " + at
+	}
+	if offset > 0 {
+		s += " offset " + formatDuration(offset)
+	} else if offset < 0 {
+		s += " offset -" + formatDuration(-offset)
+	}
+	return s
+}
+
+// formatDuration formats a duration in Prometheus notation, e.g. "5m".
+func formatDuration(d time.Duration) string {
+	return prometheus.Duration(d).String()
+}
+
+// formatMetricName quotes metric names that are not valid identifiers.
+func formatMetricName(name string) string {
+	if name == "" || isMetricName(name) {
+		return name
+	}
+	return "{" + strconv.Quote(name) + "}"
+}
+
+// formatLabelName quotes label names that are not valid identifiers.
+func formatLabelName(name string) string {
+	if isLabelName(name) {
+		return name
+	}
+	return strconv.Quote(name)
+}
+
+// isMetricName reports whether s is a legacy metric name.
+func isMetricName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if !(isAlpha(r) || r == ':' || (i > 0 && isDigit(r))) {
+			return false
+		}
+	}
+	return true
+}
+
+// isLabelName reports whether s is a legacy label name.
+func isLabelName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if !(isAlpha(r) || (i > 0 && isDigit(r))) {
+			return false
+		}
+	}
+	return true
+}
+
+// Children returns the direct child nodes of node.
+func Children(node Node) []Node {
+	switch n := node.(type) {
+	case *MatrixSelector:
+		return []Node{n.VectorSelector}
+	case *SubqueryExpr:
+		return []Node{n.Expr}
+	case *Call:
+		children := make([]Node, len(n.Args))
+		for i, a := range n.Args {
+			children[i] = a
+		}
+		return children
+	case *AggregateExpr:
+		if n.Param != nil {
+			return []Node{n.Param, n.Expr}
+		}
+		return []Node{n.Expr}
+	case *BinaryExpr:
+		return []Node{n.LHS, n.RHS}
+	case *UnaryExpr:
+		return []Node{n.Expr}
+	case *ParenExpr:
+		return []Node{n.Expr}
+	}
+	return nil
+}
+
+// Inspect traverses the tree rooted at node in depth-first order, calling
+// f for each node with its ancestors, nearest last. If f returns false,
+// the node's children are skipped.
+func Inspect(node Node, f func(node Node, path []Node) bool) {
+	inspect(node, nil, f)
+}
+
+func inspect(node Node, path []Node, f func(Node, []Node) bool) {
+	if !f(node, path) {
+		return
+	}
+	path = append(path, node)
+	for _, child := range Children(node) {
+		inspect(child, path[:len(path):len(path)], f)
+	}
+}
+
+// VectorSelectors returns the vector selectors in expr, in order.
+func VectorSelectors(expr Expr) []*VectorSelector {
+	var selectors []*VectorSelector
+	Inspect(expr, func(node Node, _ []Node) bool {
+		if vs, ok := node.(*VectorSelector); ok {
+			selectors = append(selectors, vs)
+		}
+		return true
+	})
+	return selectors
+}
+
+// ParseError is a syntax or type error in a PromQL expression.
+type ParseError struct {
+	PositionRange PositionRange
+	Err           error
+	Query         string
+}
+
+// Error returns the error prefixed with its "line:column" position.
+func (e *ParseError) Error() string {
+	line, col := e.Position()
+	return fmt.Sprintf("%d:%d: parse error: %v", line, col, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Position returns the 1-based line and column of the start of the error.
+func (e *ParseError) Position() (line, col int) {
+	line, col = 1, 1
+	for i, r := range e.Query {
+		if i >= e.PositionRange.Start {
+			break
+		}
+		if r == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
+	}
+	return line, col
+}
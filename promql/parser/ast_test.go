@@ -0,0 +1,97 @@
+package parser
+
+import (
+	"testing"
+)
+
+func TestVectorSelector_MetricName(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{`up`, "up"},
+		{`{__name__="up", job="x"}`, "up"},
+		{`{__name__=~"up|down"}`, ""},
+		{`{"utf8.metric"}`, "utf8.metric"},
+	}
+	for _, tt := range tests {
+		vs := MustParseExpr(tt.input).(*VectorSelector)
+		if got := vs.MetricName(); got != tt.want {
+			t.Errorf("MetricName(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestVectorSelectors(t *testing.T) {
+	expr := MustParseExpr(`sum(rate(a[5m])) / on(job) sum(rate(b{x="y"}[5m])) > bool scalar(c)`)
+	var names []string
+	for _, vs := range VectorSelectors(expr) {
+		names = append(names, vs.Name)
+	}
+	if len(names) != 3 || names[0] != "a" || names[1] != "b" || names[2] != "c" {
+		t.Errorf("VectorSelectors() = %v, want [a b c]", names)
+	}
+}
+
+func TestInspect_Path(t *testing.T) {
+	expr := MustParseExpr(`sum(rate(a[5m]))`)
+	var path []Node
+	Inspect(expr, func(node Node, p []Node) bool {
+		if _, ok := node.(*VectorSelector); ok {
+			path = p
+		}
+		return true
+	})
+	if len(path) != 3 {
+		t.Fatalf("len(path) = %d, want 3", len(path))
+	}
+	if _, ok := path[0].(*AggregateExpr); !ok {
+		t.Errorf("path[0] = %T, want *AggregateExpr", path[0])
+	}
+	if _, ok := path[2].(*MatrixSelector); !ok {
+		t.Errorf("path[2] = %T, want *MatrixSelector", path[2])
+	}
+}
+
+func TestInspect_SkipChildren(t *testing.T) {
+	expr := MustParseExpr(`rate(a[5m]) + b`)
+	count := 0
+	Inspect(expr, func(node Node, _ []Node) bool {
+		count++
+		_, isCall := node.(*Call)
+		return !isCall
+	})
+	// BinaryExpr, Call, VectorSelector b.
+	if count != 3 {
+		t.Errorf("visited %d nodes, want 3", count)
+	}
+}
+
+func TestPositionRange(t *testing.T) {
+	input := `sum(rate(a[5m])) by (job)`
+	expr := MustParseExpr(input)
+	if got := expr.PositionRange(); got.Start != 0 || got.End != len(input) {
+		t.Errorf("PositionRange() = %+v, want {0 %d}", got, len(input))
+	}
+	vs := VectorSelectors(expr)[0]
+	if got := input[vs.PosRange.Start:vs.PosRange.End]; got != "a" {
+		t.Errorf("selector range covers %q, want %q", got, "a")
+	}
+}
+
+func TestString_RoundTrip(t *testing.T) {
+	inputs := []string{
+		`sum by (job) (rate(http_requests_total{code=~"5.."}[5m])) / sum by (job) (rate(http_requests_total[5m])) > 0.05`,
+		`histogram_quantile(0.99, sum by (le) (rate(h_bucket[5m])))`,
+		`max_over_time(x[1h:5m] offset 1d)`,
+		`a unless on (instance) b`,
+		`x @ 1609746000`,
+	}
+	for _, input := range inputs {
+		first := MustParseExpr(input).String()
+		second := MustParseExpr(first).String()
+		if first != second {
+			t.Errorf("String() not stable:\n%s\n%s", first, second)
+		}
+	}
+}
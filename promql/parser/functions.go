@@ -0,0 +1,136 @@
+package parser
+
+// Function describes a PromQL function signature.
+type Function struct {
+	Name string
+
+	// ArgTypes are the argument types.
+	ArgTypes []ValueType
+
+	// Variadic is 0 if exactly len(ArgTypes) arguments are required.
+	// Otherwise the last argument is optional and, for N > 0, may be given
+	// up to N times in total; -1 allows any number.
+	Variadic int
+
+	// ReturnType is the type of the result.
+	ReturnType ValueType
+}
+
+const (
+	scalar = ValueTypeScalar
+	vector = ValueTypeVector
+	matrix = ValueTypeMatrix
+	str    = ValueTypeString
+)
+
+// Functions are the PromQL functions, keyed by name.
+var Functions = map[string]*Function{}
+
+func init() {
+	for _, f := range []*Function{
+		{Name: "abs", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "absent", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "absent_over_time", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "acos", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "acosh", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "asin", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "asinh", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "atan", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "atanh", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "avg_over_time", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "ceil", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "changes", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "clamp", ArgTypes: []ValueType{vector, scalar, scalar}, ReturnType: vector},
+		{Name: "clamp_max", ArgTypes: []ValueType{vector, scalar}, ReturnType: vector},
+		{Name: "clamp_min", ArgTypes: []ValueType{vector, scalar}, ReturnType: vector},
+		{Name: "cos", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "cosh", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "count_over_time", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "day_of_month", ArgTypes: []ValueType{vector}, Variadic: 1, ReturnType: vector},
+		{Name: "day_of_week", ArgTypes: []ValueType{vector}, Variadic: 1, ReturnType: vector},
+		{Name: "day_of_year", ArgTypes: []ValueType{vector}, Variadic: 1, ReturnType: vector},
+		{Name: "days_in_month", ArgTypes: []ValueType{vector}, Variadic: 1, ReturnType: vector},
+		{Name: "deg", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "delta", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "deriv", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "double_exponential_smoothing", ArgTypes: []ValueType{matrix, scalar, scalar}, ReturnType: vector},
+		{Name: "exp", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "floor", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "histogram_avg", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "histogram_count", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "histogram_fraction", ArgTypes: []ValueType{scalar, scalar, vector}, ReturnType: vector},
+		{Name: "histogram_quantile", ArgTypes: []ValueType{scalar, vector}, ReturnType: vector},
+		{Name: "histogram_stddev", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "histogram_stdvar", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "histogram_sum", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "holt_winters", ArgTypes: []ValueType{matrix, scalar, scalar}, ReturnType: vector},
+		{Name: "hour", ArgTypes: []ValueType{vector}, Variadic: 1, ReturnType: vector},
+		{Name: "idelta", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "increase", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "irate", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "label_join", ArgTypes: []ValueType{vector, str, str, str}, Variadic: -1, ReturnType: vector},
+		{Name: "label_replace", ArgTypes: []ValueType{vector, str, str, str, str}, ReturnType: vector},
+		{Name: "last_over_time", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "ln", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "log10", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "log2", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "mad_over_time", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "max_over_time", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "min_over_time", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "minute", ArgTypes: []ValueType{vector}, Variadic: 1, ReturnType: vector},
+		{Name: "month", ArgTypes: []ValueType{vector}, Variadic: 1, ReturnType: vector},
+		{Name: "pi", ReturnType: scalar},
+		{Name: "predict_linear", ArgTypes: []ValueType{matrix, scalar}, ReturnType: vector},
+		{Name: "present_over_time", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "quantile_over_time", ArgTypes: []ValueType{scalar, matrix}, ReturnType: vector},
+		{Name: "rad", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "rate", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "resets", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "round", ArgTypes: []ValueType{vector, scalar}, Variadic: 1, ReturnType: vector},
+		{Name: "scalar", ArgTypes: []ValueType{vector}, ReturnType: scalar},
+		{Name: "sgn", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "sin", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "sinh", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "sort", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "sort_by_label", ArgTypes: []ValueType{vector, str}, Variadic: -1, ReturnType: vector},
+		{Name: "sort_by_label_desc", ArgTypes: []ValueType{vector, str}, Variadic: -1, ReturnType: vector},
+		{Name: "sort_desc", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "sqrt", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "stddev_over_time", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "stdvar_over_time", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "sum_over_time", ArgTypes: []ValueType{matrix}, ReturnType: vector},
+		{Name: "tan", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "tanh", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "time", ReturnType: scalar},
+		{Name: "timestamp", ArgTypes: []ValueType{vector}, ReturnType: vector},
+		{Name: "vector", ArgTypes: []ValueType{scalar}, ReturnType: vector},
+		{Name: "year", ArgTypes: []ValueType{vector}, Variadic: 1, ReturnType: vector},
+	} {
+		Functions[f.Name] = f
+	}
+}
+
+// aggregators are the aggregation operators. The value reports the type
+// of the operator's parameter, or ValueTypeNone if it takes none.
+var aggregators = map[string]ValueType{
+	"avg":          ValueTypeNone,
+	"bottomk":      scalar,
+	"count":        ValueTypeNone,
+	"count_values": str,
+	"group":        ValueTypeNone,
+	"limit_ratio":  scalar,
+	"limitk":       scalar,
+	"max":          ValueTypeNone,
+	"min":          ValueTypeNone,
+	"quantile":     scalar,
+	"stddev":       ValueTypeNone,
+	"stdvar":       ValueTypeNone,
+	"sum":          ValueTypeNone,
+	"topk":         scalar,
+}
+
+// IsAggregator reports whether name is an aggregation operator.
+func IsAggregator(name string) bool {
+	_, ok := aggregators[name]
+	return ok
+}
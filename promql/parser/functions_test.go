@@ -0,0 +1,28 @@
+package parser
+
+import "testing"
+
+func TestFunctions(t *testing.T) {
+	for name, fn := range Functions {
+		if fn.Name != name {
+			t.Errorf("Functions[%q].Name = %v", name, fn.Name)
+		}
+		if fn.Variadic != 0 && len(fn.ArgTypes) == 0 {
+			t.Errorf("%s: variadic function without arguments", name)
+		}
+	}
+	if _, ok := Functions["rate"]; !ok {
+		t.Error("rate is not registered")
+	}
+}
+
+func TestIsAggregator(t *testing.T) {
+	for _, name := range []string{"sum", "topk", "count_values", "quantile"} {
+		if !IsAggregator(name) {
+			t.Errorf("IsAggregator(%q) = false, want true", name)
+		}
+	}
+	if IsAggregator("rate") {
+		t.Error("IsAggregator(\"rate\") = true, want false")
+	}
+}
@@ -0,0 +1,279 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// itemType identifies the type of a lexical item.
+type itemType int
+
+const (
+	itemEOF itemType = iota
+	itemIdentifier
+	itemNumber
+	itemDuration
+	itemString
+	itemLeftParen
+	itemRightParen
+	itemLeftBrace
+	itemRightBrace
+	itemLeftBracket
+	itemRightBracket
+	itemComma
+	itemColon
+	itemAt
+	itemAssign
+	itemEqlRegex
+	itemNeqRegex
+	itemAdd
+	itemSub
+	itemMul
+	itemDiv
+	itemMod
+	itemPow
+	itemEqlc
+	itemNeq
+	itemLss
+	itemLte
+	itemGtr
+	itemGte
+)
+
+// item is a lexical token with its byte offset in the input.
+type item struct {
+	typ itemType
+	pos int
+	val string
+}
+
+// describe returns a description of the item for error messages.
+func (i item) describe() string {
+	switch i.typ {
+	case itemEOF:
+		return "end of input"
+	case itemIdentifier:
+		return fmt.Sprintf("identifier %q", i.val)
+	case itemNumber:
+		return fmt.Sprintf("number %q", i.val)
+	case itemDuration:
+		return fmt.Sprintf("duration %q", i.val)
+	case itemString:
+		return fmt.Sprintf("string %s", i.val)
+	}
+	return fmt.Sprintf("%q", i.val)
+}
+
+// operators maps operator and punctuation strings to item types, longest
+// first so that "==" is preferred over "=".
+var operators = []struct {
+	s   string
+	typ itemType
+}{
+	{"==", itemEqlc},
+	{"!=", itemNeq},
+	{"<=", itemLte},
+	{">=", itemGte},
+	{"=~", itemEqlRegex},
+	{"!~", itemNeqRegex},
+	{"(", itemLeftParen},
+	{")", itemRightParen},
+	{"{", itemLeftBrace},
+	{"}", itemRightBrace},
+	{"[", itemLeftBracket},
+	{"]", itemRightBracket},
+	{",", itemComma},
+	{":", itemColon},
+	{"@", itemAt},
+	{"=", itemAssign},
+	{"+", itemAdd},
+	{"-", itemSub},
+	{"*", itemMul},
+	{"/", itemDiv},
+	{"%", itemMod},
+	{"^", itemPow},
+	{"<", itemLss},
+	{">", itemGtr},
+}
+
+// lex splits input into items. Comments starting with # run to the end of
+// the line and are skipped.
+func lex(input string) ([]item, error) {
+	var items []item
+	pos := 0
+	// brackets tracks nesting inside [...], where ":" separates a subquery
+	// range from its step rather than starting a metric name.
+	brackets := 0
+	for pos < len(input) {
+		r, size := utf8.DecodeRuneInString(input[pos:])
+		switch {
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			pos += size
+		case r == '#':
+			for pos < len(input) && input[pos] != '\n' {
+				pos++
+			}
+		case r == '"' || r == '\'' || r == '`':
+			end, err := scanString(input, pos)
+			if err != nil {
+				return nil, &ParseError{PositionRange{pos, len(input)}, err, input}
+			}
+			items = append(items, item{itemString, pos, input[pos:end]})
+			pos = end
+		case isDigit(r) || (r == '.' && pos+1 < len(input) && isDigit(rune(input[pos+1]))):
+			typ, end := scanNumber(input, pos)
+			if end < len(input) {
+				if next, _ := utf8.DecodeRuneInString(input[end:]); isAlpha(next) {
+					bad := end
+					for bad < len(input) && (isAlpha(rune(input[bad])) || isDigit(rune(input[bad]))) {
+						bad++
+					}
+					return nil, &ParseError{PositionRange{pos, bad}, fmt.Errorf("bad number or duration syntax: %q", input[pos:bad]), input}
+				}
+			}
+			items = append(items, item{typ, pos, input[pos:end]})
+			pos = end
+		case isAlpha(r) || (r == ':' && brackets == 0):
+			end := pos
+			for end < len(input) {
+				c, n := utf8.DecodeRuneInString(input[end:])
+				if !isAlpha(c) && !isDigit(c) && c != ':' {
+					break
+				}
+				end += n
+			}
+			items = append(items, item{itemIdentifier, pos, input[pos:end]})
+			pos = end
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(input[pos:], op.s) {
+					switch op.typ {
+					case itemLeftBracket:
+						brackets++
+					case itemRightBracket:
+						if brackets > 0 {
+							brackets--
+						}
+					}
+					items = append(items, item{op.typ, pos, op.s})
+					pos += len(op.s)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, &ParseError{PositionRange{pos, pos + size}, fmt.Errorf("unexpected character: %q", r), input}
+			}
+		}
+	}
+	items = append(items, item{itemEOF, len(input), ""})
+	return items, nil
+}
+
+// scanString returns the end offset of the quoted string starting at pos.
+// Backquoted strings are raw; the others support backslash escapes.
+func scanString(input string, pos int) (int, error) {
+	quote := input[pos]
+	i := pos + 1
+	for i < len(input) {
+		switch c := input[i]; {
+		case c == quote:
+			return i + 1, nil
+		case c == '\\' && quote != '`':
+			i += 2
+		case c == '\n' && quote != '`':
+			return 0, fmt.Errorf("unterminated quoted string")
+		default:
+			i++
+		}
+	}
+	return 0, fmt.Errorf("unterminated quoted string")
+}
+
+// scanNumber scans a number or duration starting at pos. Durations are
+// integers followed by units, optionally compound (e.g. "1h30m").
+func scanNumber(input string, pos int) (itemType, int) {
+	// Hexadecimal numbers.
+	if strings.HasPrefix(input[pos:], "0x") || strings.HasPrefix(input[pos:], "0X") {
+		i := pos + 2
+		for i < len(input) && strings.ContainsRune("0123456789abcdefABCDEF", rune(input[i])) {
+			i++
+		}
+		return itemNumber, i
+	}
+
+	if end, ok := scanDuration(input, pos); ok {
+		return itemDuration, end
+	}
+
+	i := pos
+	for i < len(input) && isDigit(rune(input[i])) {
+		i++
+	}
+	if i < len(input) && input[i] == '.' {
+		i++
+		for i < len(input) && isDigit(rune(input[i])) {
+			i++
+		}
+	}
+	if i < len(input) && (input[i] == 'e' || input[i] == 'E') {
+		j := i + 1
+		if j < len(input) && (input[j] == '+' || input[j] == '-') {
+			j++
+		}
+		if j < len(input) && isDigit(rune(input[j])) {
+			i = j
+			for i < len(input) && isDigit(rune(input[i])) {
+				i++
+			}
+		}
+	}
+	return itemNumber, i
+}
+
+// durationUnits are the duration units, longest first.
+var durationUnits = []string{"ms", "s", "m", "h", "d", "w", "y"}
+
+// scanDuration scans a duration such as "5m" or "1h30m" starting at pos.
+func scanDuration(input string, pos int) (int, bool) {
+	i := pos
+	matched := false
+	for i < len(input) && isDigit(rune(input[i])) {
+		j := i
+		for j < len(input) && isDigit(rune(input[j])) {
+			j++
+		}
+		unit := ""
+		for _, u := range durationUnits {
+			if strings.HasPrefix(input[j:], u) {
+				unit = u
+				break
+			}
+		}
+		if unit == "" {
+			break
+		}
+		i = j + len(unit)
+		matched = true
+	}
+	if !matched {
+		return 0, false
+	}
+	// A unit must not run into further letters, e.g. "5min".
+	if i < len(input) {
+		if r, _ := utf8.DecodeRuneInString(input[i:]); isAlpha(r) || isDigit(r) {
+			return 0, false
+		}
+	}
+	return i, true
+}
+
+func isAlpha(r rune) bool {
+	return r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
+
+func isDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
@@ -0,0 +1,44 @@
+package parser
+
+import "testing"
+
+func TestLex(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []itemType
+	}{
+		{`up`, []itemType{itemIdentifier, itemEOF}},
+		{`x[5m]`, []itemType{itemIdentifier, itemLeftBracket, itemDuration, itemRightBracket, itemEOF}},
+		{`x[1h:30s]`, []itemType{itemIdentifier, itemLeftBracket, itemDuration, itemColon, itemDuration, itemRightBracket, itemEOF}},
+		{`a:b:rate5m`, []itemType{itemIdentifier, itemEOF}},
+		{`1.5e3 0x1F .5`, []itemType{itemNumber, itemNumber, itemNumber, itemEOF}},
+		{`1h30m`, []itemType{itemDuration, itemEOF}},
+		{`a == b != c <= d >= e`, []itemType{itemIdentifier, itemEqlc, itemIdentifier, itemNeq, itemIdentifier, itemLte, itemIdentifier, itemGte, itemIdentifier, itemEOF}},
+		{`{a=~"x", b!~'y'}`, []itemType{itemLeftBrace, itemIdentifier, itemEqlRegex, itemString, itemComma, itemIdentifier, itemNeqRegex, itemString, itemRightBrace, itemEOF}},
+		{"up # comment", []itemType{itemIdentifier, itemEOF}},
+	}
+	for _, tt := range tests {
+		items, err := lex(tt.input)
+		if err != nil {
+			t.Errorf("lex(%q) error = %v", tt.input, err)
+			continue
+		}
+		if len(items) != len(tt.want) {
+			t.Errorf("lex(%q) = %d items, want %d", tt.input, len(items), len(tt.want))
+			continue
+		}
+		for i, it := range items {
+			if it.typ != tt.want[i] {
+				t.Errorf("lex(%q)[%d] = %s, want type %d", tt.input, i, it.describe(), tt.want[i])
+			}
+		}
+	}
+}
+
+func TestLex_Errors(t *testing.T) {
+	for _, input := range []string{`"abc`, "'a\nb'", `5min`, `a $ b`} {
+		if _, err := lex(input); err == nil {
+			t.Errorf("lex(%q) succeeded, want error", input)
+		}
+	}
+}
@@ -0,0 +1,741 @@
+package parser
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+// binaryPrecedence maps binary operators to their precedence; higher binds
+// tighter.
+var binaryPrecedence = map[string]int{
+	"or":     1,
+	"and":    2,
+	"unless": 2,
+	"==":     3,
+	"!=":     3,
+	"<":      3,
+	"<=":     3,
+	">":      3,
+	">=":     3,
+	"+":      4,
+	"-":      4,
+	"*":      5,
+	"/":      5,
+	"%":      5,
+	"atan2":  5,
+	"^":      6,
+}
+
+// comparisonOps are the comparison binary operators.
+var comparisonOps = map[string]bool{"==": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true}
+
+// setOps are the set binary operators.
+var setOps = map[string]bool{"and": true, "or": true, "unless": true}
+
+// ParseExpr parses a PromQL expression and type-checks it. Errors are
+// returned as *ParseError.
+func ParseExpr(input string) (expr Expr, err error) {
+	items, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{input: input, items: items}
+
+	defer func() {
+		if r := recover(); r != nil {
+			perr, ok := r.(*ParseError)
+			if !ok {
+				panic(r)
+			}
+			expr, err = nil, perr
+		}
+	}()
+
+	if p.peek().typ == itemEOF {
+		p.errorf(PositionRange{0, len(input)}, "no expression found in input")
+	}
+	expr = p.parseBinary(1)
+	if it := p.peek(); it.typ != itemEOF {
+		p.errorf(p.itemRange(it), "unexpected %s", it.describe())
+	}
+	p.check(expr)
+	return expr, nil
+}
+
+// MustParseExpr is like ParseExpr but panics on error.
+func MustParseExpr(input string) Expr {
+	expr, err := ParseExpr(input)
+	if err != nil {
+		panic(err)
+	}
+	return expr
+}
+
+// parser is a recursive descent PromQL parser. Errors are raised by
+// panicking with a *ParseError, recovered in ParseExpr.
+type parser struct {
+	input string
+	items []item
+	pos   int
+}
+
+func (p *parser) peek() item {
+	return p.items[p.pos]
+}
+
+func (p *parser) peekAt(n int) item {
+	if p.pos+n >= len(p.items) {
+		return p.items[len(p.items)-1]
+	}
+	return p.items[p.pos+n]
+}
+
+func (p *parser) next() item {
+	it := p.items[p.pos]
+	if it.typ != itemEOF {
+		p.pos++
+	}
+	return it
+}
+
+func (p *parser) itemRange(it item) PositionRange {
+	return PositionRange{Start: it.pos, End: it.pos + len(it.val)}
+}
+
+func (p *parser) errorf(pr PositionRange, format string, args ...any) {
+	panic(&ParseError{PositionRange: pr, Err: fmt.Errorf(format, args...), Query: p.input})
+}
+
+// expect consumes an item of type typ or fails with a message naming ctx.
+func (p *parser) expect(typ itemType, want, ctx string) item {
+	it := p.next()
+	if it.typ != typ {
+		p.errorf(p.itemRange(it), "unexpected %s in %s, expected %s", it.describe(), ctx, want)
+	}
+	return it
+}
+
+// isKeyword reports whether it is the identifier keyword.
+func isKeyword(it item, keyword string) bool {
+	return it.typ == itemIdentifier && strings.EqualFold(it.val, keyword)
+}
+
+// binaryOp returns the binary operator for it, if any.
+func binaryOp(it item) (string, bool) {
+	switch it.typ {
+	case itemIdentifier:
+		op := strings.ToLower(it.val)
+		switch op {
+		case "and", "or", "unless", "atan2":
+			return op, true
+		}
+		return "", false
+	case itemAdd, itemSub, itemMul, itemDiv, itemMod, itemPow,
+		itemEqlc, itemNeq, itemLss, itemLte, itemGtr, itemGte:
+		return it.val, true
+	}
+	return "", false
+}
+
+// parseBinary parses a binary expression whose operators have at least
+// minPrec precedence, using precedence climbing.
+func (p *parser) parseBinary(minPrec int) Expr {
+	lhs := p.parseUnary()
+	for {
+		op, ok := binaryOp(p.peek())
+		if !ok || binaryPrecedence[op] < minPrec {
+			return lhs
+		}
+		opItem := p.next()
+		bin := &BinaryExpr{Op: op, LHS: lhs}
+		p.parseBinaryModifiers(bin, opItem)
+
+		nextPrec := binaryPrecedence[op] + 1
+		if op == "^" {
+			// Exponentiation is right-associative.
+			nextPrec = binaryPrecedence[op]
+		}
+		bin.RHS = p.parseBinary(nextPrec)
+		lhs = bin
+	}
+}
+
+// parseBinaryModifiers parses bool, on/ignoring and group_left/group_right.
+func (p *parser) parseBinaryModifiers(bin *BinaryExpr, opItem item) {
+	if isKeyword(p.peek(), "bool") {
+		it := p.next()
+		if !comparisonOps[bin.Op] {
+			p.errorf(p.itemRange(it), "bool modifier can only be used on comparison operators")
+		}
+		bin.ReturnBool = true
+	}
+
+	if it := p.peek(); isKeyword(it, "on") || isKeyword(it, "ignoring") {
+		p.next()
+		bin.VectorMatching = &VectorMatching{
+			Card:           CardOneToOne,
+			On:             isKeyword(it, "on"),
+			MatchingLabels: p.parseLabelList(it.val + " clause"),
+		}
+
+		if it := p.peek(); isKeyword(it, "group_left") || isKeyword(it, "group_right") {
+			p.next()
+			bin.VectorMatching.Card = CardManyToOne
+			if isKeyword(it, "group_right") {
+				bin.VectorMatching.Card = CardOneToMany
+			}
+			if p.peek().typ == itemLeftParen {
+				bin.VectorMatching.Include = p.parseLabelList(it.val + " clause")
+			}
+		}
+	}
+	if setOps[bin.Op] {
+		if bin.VectorMatching == nil {
+			bin.VectorMatching = &VectorMatching{Card: CardManyToMany}
+		} else if bin.VectorMatching.Card == CardOneToOne {
+			bin.VectorMatching.Card = CardManyToMany
+		}
+	}
+}
+
+// parseUnary parses a unary expression. Unary operators bind more loosely
+// than ^, so -2^2 is -(2^2).
+func (p *parser) parseUnary() Expr {
+	if it := p.peek(); it.typ == itemAdd || it.typ == itemSub {
+		p.next()
+		operand := p.parseBinary(binaryPrecedence["^"])
+		// Fold signs into number literals as Prometheus does.
+		if n, ok := operand.(*NumberLiteral); ok {
+			if it.typ == itemSub {
+				n.Val = -n.Val
+			}
+			n.PosRange.Start = it.pos
+			return n
+		}
+		return &UnaryExpr{Op: it.val, Expr: operand, StartPos: it.pos}
+	}
+	return p.parsePostfix(p.parsePrimary())
+}
+
+// parsePrimary parses a literal, selector, call, aggregation or
+// parenthesized expression.
+func (p *parser) parsePrimary() Expr {
+	it := p.peek()
+	switch it.typ {
+	case itemNumber:
+		p.next()
+		return &NumberLiteral{Val: p.parseNumber(it), PosRange: p.itemRange(it)}
+	case itemString:
+		p.next()
+		return &StringLiteral{Val: p.unquote(it), PosRange: p.itemRange(it)}
+	case itemLeftParen:
+		p.next()
+		inner := p.parseBinary(1)
+		end := p.expect(itemRightParen, `")"`, "parenthesized expression")
+		return &ParenExpr{Expr: inner, PosRange: PositionRange{it.pos, end.pos + 1}}
+	case itemLeftBrace:
+		return p.parseVectorSelector("", it.pos)
+	case itemIdentifier:
+		name := it.val
+		lower := strings.ToLower(name)
+		next := p.peekAt(1)
+		switch {
+		case IsAggregator(lower) && (next.typ == itemLeftParen || isKeyword(next, "by") || isKeyword(next, "without")):
+			return p.parseAggregation()
+		case next.typ == itemLeftParen:
+			return p.parseCall()
+		case lower == "inf" || lower == "nan":
+			p.next()
+			return &NumberLiteral{Val: p.parseNumber(it), PosRange: p.itemRange(it)}
+		}
+		if _, isOp := binaryOp(it); isOp || isKeyword(it, "bool") || isKeyword(it, "offset") {
+			p.errorf(p.itemRange(it), "unexpected %s", it.describe())
+		}
+		p.next()
+		return p.parseVectorSelector(name, it.pos)
+	case itemDuration:
+		p.errorf(p.itemRange(it), "unexpected %s; durations are only allowed in ranges, subqueries and offsets", it.describe())
+	}
+	p.errorf(p.itemRange(it), "unexpected %s", it.describe())
+	return nil
+}
+
+// parsePostfix parses range, subquery, offset and @ modifiers following
+// an expression.
+func (p *parser) parsePostfix(expr Expr) Expr {
+	for {
+		it := p.peek()
+		switch {
+		case it.typ == itemLeftBracket:
+			expr = p.parseRange(expr)
+		case isKeyword(it, "offset"):
+			p.next()
+			neg := false
+			if p.peek().typ == itemSub {
+				p.next()
+				neg = true
+			}
+			d := p.parseDuration(p.expect(itemDuration, "duration", "offset"))
+			if neg {
+				d = -d
+			}
+			p.setOffset(expr, it, d)
+		case it.typ == itemAt:
+			p.next()
+			p.setAt(expr, it, p.parseAtValue())
+		default:
+			return expr
+		}
+	}
+}
+
+// parseRange parses "[range]" after a vector selector or "[range:step]"
+// after any expression.
+func (p *parser) parseRange(expr Expr) Expr {
+	open := p.next()
+	rng := p.parseDuration(p.expect(itemDuration, "duration", "range"))
+	if rng <= 0 {
+		p.errorf(PositionRange{open.pos, open.pos + 1}, "range must be greater than 0")
+	}
+
+	if p.peek().typ == itemColon {
+		p.next()
+		var step time.Duration
+		if p.peek().typ == itemDuration {
+			step = p.parseDuration(p.next())
+		}
+		end := p.expect(itemRightBracket, `"]"`, "subquery")
+		return &SubqueryExpr{Expr: expr, Range: rng, Step: step, EndPos: end.pos + 1}
+	}
+
+	end := p.expect(itemRightBracket, `"]"`, "range")
+	vs, ok := expr.(*VectorSelector)
+	if !ok {
+		p.errorf(PositionRange{expr.PositionRange().Start, end.pos + 1}, "ranges only allowed for vector selectors")
+	}
+	if vs.Offset != 0 || vs.At != "" {
+		p.errorf(PositionRange{vs.PosRange.Start, end.pos + 1}, "no offset or @ modifiers allowed before range")
+	}
+	return &MatrixSelector{VectorSelector: vs, Range: rng, EndPos: end.pos + 1}
+}
+
+func (p *parser) setOffset(expr Expr, at item, d time.Duration) {
+	var offset *time.Duration
+	switch e := expr.(type) {
+	case *VectorSelector:
+		offset = &e.Offset
+	case *MatrixSelector:
+		offset = &e.VectorSelector.Offset
+	case *SubqueryExpr:
+		offset = &e.Offset
+	default:
+		p.errorf(p.itemRange(at), "offset modifier must be preceded by an instant vector selector or range vector selector or a subquery")
+	}
+	if *offset != 0 {
+		p.errorf(p.itemRange(at), "offset may not be set multiple times")
+	}
+	*offset = d
+}
+
+func (p *parser) setAt(expr Expr, at item, value string) {
+	var target *string
+	switch e := expr.(type) {
+	case *VectorSelector:
+		target = &e.At
+	case *MatrixSelector:
+		target = &e.VectorSelector.At
+	case *SubqueryExpr:
+		target = &e.At
+	default:
+		p.errorf(p.itemRange(at), "@ modifier must be preceded by an instant vector selector or range vector selector or a subquery")
+	}
+	if *target != "" {
+		p.errorf(p.itemRange(at), "@ <timestamp> may not be set multiple times")
+	}
+	*target = value
+}
+
+// parseAtValue parses the value of an @ modifier.
+func (p *parser) parseAtValue() string {
+	it := p.next()
+	switch {
+	case isKeyword(it, "start") || isKeyword(it, "end"):
+		p.expect(itemLeftParen, `"("`, "@ modifier")
+		p.expect(itemRightParen, `")"`, "@ modifier")
+		return strings.ToLower(it.val) + "()"
+	case it.typ == itemNumber:
+		v := p.parseNumber(it)
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			p.errorf(p.itemRange(it), "timestamp out of bounds for @ modifier: %s", it.val)
+		}
+		return it.val
+	case it.typ == itemSub:
+		num := p.expect(itemNumber, "number", "@ modifier")
+		p.parseNumber(num)
+		return "-" + num.val
+	}
+	p.errorf(p.itemRange(it), "unexpected %s in @ modifier, expected timestamp, start() or end()", it.describe())
+	return ""
+}
+
+// parseVectorSelector parses the optional braces of a vector selector
+// whose metric name, if any, has been consumed.
+func (p *parser) parseVectorSelector(name string, start int) Expr {
+	vs := &VectorSelector{Name: name, PosRange: PositionRange{start, start + len(name)}}
+	if p.peek().typ != itemLeftBrace {
+		return vs
+	}
+	p.next()
+	for p.peek().typ != itemRightBrace {
+		it := p.next()
+		var label string
+		switch it.typ {
+		case itemIdentifier:
+			label = it.val
+			if !isLabelName(label) {
+				p.errorf(p.itemRange(it), "invalid label name %q", label)
+			}
+		case itemString:
+			label = p.unquote(it)
+			// A bare quoted string is a UTF-8 metric name.
+			if t := p.peek().typ; t == itemComma || t == itemRightBrace {
+				if vs.Name != "" {
+					p.errorf(p.itemRange(it), "metric name must not be set twice: %q or %q", vs.Name, label)
+				}
+				vs.Name = label
+				if p.peek().typ == itemComma {
+					p.next()
+				}
+				continue
+			}
+		default:
+			p.errorf(p.itemRange(it), "unexpected %s in label matching, expected label name", it.describe())
+		}
+
+		opItem := p.next()
+		var op MatchType
+		switch opItem.typ {
+		case itemAssign:
+			op = MatchEqual
+		case itemNeq:
+			op = MatchNotEqual
+		case itemEqlRegex:
+			op = MatchRegexp
+		case itemNeqRegex:
+			op = MatchNotRegexp
+		default:
+			p.errorf(p.itemRange(opItem), "unexpected %s in label matching, expected one of \"=\", \"!=\", \"=~\" or \"!~\"", opItem.describe())
+		}
+
+		valItem := p.expect(itemString, "string", "label matching")
+		m, err := NewLabelMatcher(op, label, p.unquote(valItem))
+		if err != nil {
+			p.errorf(p.itemRange(valItem), "invalid regular expression in label matcher: %v", err)
+		}
+		vs.LabelMatchers = append(vs.LabelMatchers, m)
+
+		if p.peek().typ == itemComma {
+			p.next()
+		} else if p.peek().typ != itemRightBrace {
+			it := p.peek()
+			p.errorf(p.itemRange(it), "unexpected %s in label matching, expected \",\" or \"}\"", it.describe())
+		}
+	}
+	end := p.next()
+	vs.PosRange.End = end.pos + 1
+	return vs
+}
+
+// parseCall parses a function call.
+func (p *parser) parseCall() Expr {
+	nameItem := p.next()
+	fn, ok := Functions[nameItem.val]
+	if !ok {
+		p.errorf(p.itemRange(nameItem), "unknown function with name %q", nameItem.val)
+	}
+	p.next() // (
+	var args []Expr
+	for p.peek().typ != itemRightParen {
+		args = append(args, p.parseBinary(1))
+		if p.peek().typ != itemComma {
+			break
+		}
+		p.next()
+	}
+	end := p.expect(itemRightParen, `")"`, "function call")
+	return &Call{Func: fn, Args: args, PosRange: PositionRange{nameItem.pos, end.pos + 1}}
+}
+
+// parseAggregation parses an aggregation with its grouping clause before
+// or after the arguments.
+func (p *parser) parseAggregation() Expr {
+	opItem := p.next()
+	agg := &AggregateExpr{Op: strings.ToLower(opItem.val)}
+
+	parseGrouping := func() {
+		it := p.next()
+		agg.Without = isKeyword(it, "without")
+		agg.Grouping = p.parseLabelList(strings.ToLower(it.val) + " clause")
+	}
+
+	if it := p.peek(); isKeyword(it, "by") || isKeyword(it, "without") {
+		parseGrouping()
+	}
+
+	p.expect(itemLeftParen, `"("`, "aggregation")
+	var args []Expr
+	for p.peek().typ != itemRightParen {
+		args = append(args, p.parseBinary(1))
+		if p.peek().typ != itemComma {
+			break
+		}
+		p.next()
+	}
+	end := p.expect(itemRightParen, `")"`, "aggregation")
+	agg.PosRange = PositionRange{opItem.pos, end.pos + 1}
+
+	if agg.Grouping == nil && !agg.Without {
+		if it := p.peek(); isKeyword(it, "by") || isKeyword(it, "without") {
+			parseGrouping()
+			agg.PosRange.End = p.items[p.pos-1].pos + 1
+		}
+	}
+
+	wantArgs := 1
+	if aggregators[agg.Op] != ValueTypeNone {
+		wantArgs = 2
+	}
+	if len(args) != wantArgs {
+		p.errorf(agg.PosRange, "wrong number of arguments for aggregate expression provided, expected %d, got %d", wantArgs, len(args))
+	}
+	if wantArgs == 2 {
+		agg.Param = args[0]
+	}
+	agg.Expr = args[len(args)-1]
+	return agg
+}
+
+// parseLabelList parses a parenthesized, comma-separated list of label
+// names.
+func (p *parser) parseLabelList(ctx string) []string {
+	p.expect(itemLeftParen, `"("`, ctx)
+	labels := []string{}
+	for p.peek().typ != itemRightParen {
+		it := p.next()
+		var label string
+		switch it.typ {
+		case itemIdentifier:
+			label = it.val
+			if !isLabelName(label) {
+				p.errorf(p.itemRange(it), "invalid label name %q in %s", label, ctx)
+			}
+		case itemString:
+			label = p.unquote(it)
+		default:
+			p.errorf(p.itemRange(it), "unexpected %s in %s, expected label", it.describe(), ctx)
+		}
+		labels = append(labels, label)
+		if p.peek().typ != itemComma {
+			break
+		}
+		p.next()
+	}
+	p.expect(itemRightParen, `")"`, ctx)
+	return labels
+}
+
+func (p *parser) parseNumber(it item) float64 {
+	s := strings.ToLower(it.val)
+	switch s {
+	case "inf":
+		return math.Inf(1)
+	case "nan":
+		return math.NaN()
+	}
+	if strings.HasPrefix(s, "0x") {
+		v, err := strconv.ParseInt(s[2:], 16, 64)
+		if err != nil {
+			p.errorf(p.itemRange(it), "invalid number %q", it.val)
+		}
+		return float64(v)
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		p.errorf(p.itemRange(it), "invalid number %q", it.val)
+	}
+	return v
+}
+
+func (p *parser) parseDuration(it item) time.Duration {
+	d, err := prometheus.ParseDuration(it.val)
+	if err != nil {
+		p.errorf(p.itemRange(it), "%v", err)
+	}
+	return time.Duration(d)
+}
+
+func (p *parser) unquote(it item) string {
+	if strings.HasPrefix(it.val, "`") {
+		return it.val[1 : len(it.val)-1]
+	}
+	s := it.val
+	if strings.HasPrefix(s, "'") {
+		// strconv.Unquote only accepts single characters in single quotes.
+		s = `"` + strings.ReplaceAll(strings.ReplaceAll(s[1:len(s)-1], `\'`, `'`), `"`, `\"`) + `"`
+	}
+	v, err := strconv.Unquote(s)
+	if err != nil {
+		p.errorf(p.itemRange(it), "invalid string %s: %v", it.val, err)
+	}
+	return v
+}
+
+// check type-checks the tree rooted at expr.
+func (p *parser) check(node Node) {
+	switch n := node.(type) {
+	case *VectorSelector:
+		p.checkVectorSelector(n)
+	case *MatrixSelector:
+		p.checkVectorSelector(n.VectorSelector)
+	case *SubqueryExpr:
+		p.check(n.Expr)
+		if t := n.Expr.Type(); t != ValueTypeVector {
+			p.errorf(n.PositionRange(), "subquery is only allowed on instant vector, got %s instead", t)
+		}
+	case *Call:
+		p.checkCall(n)
+	case *AggregateExpr:
+		p.check(n.Expr)
+		if t := n.Expr.Type(); t != ValueTypeVector {
+			p.errorf(n.PosRange, "expected type %s in aggregation expression, got %s", ValueTypeVector, t)
+		}
+		if n.Param != nil {
+			p.check(n.Param)
+			if want, got := aggregators[n.Op], n.Param.Type(); got != want {
+				p.errorf(n.Param.PositionRange(), "expected type %s in aggregation parameter, got %s", want, got)
+			}
+		}
+	case *BinaryExpr:
+		p.check(n.LHS)
+		p.check(n.RHS)
+		p.checkBinary(n)
+	case *UnaryExpr:
+		p.check(n.Expr)
+		if t := n.Expr.Type(); t != ValueTypeScalar && t != ValueTypeVector {
+			p.errorf(n.PositionRange(), "unary expression only allowed on expressions of type scalar or instant vector, got %q", t)
+		}
+	case *ParenExpr:
+		p.check(n.Expr)
+	}
+}
+
+func (p *parser) checkVectorSelector(vs *VectorSelector) {
+	if vs.Name != "" {
+		for _, m := range vs.LabelMatchers {
+			if m.Name == "__name__" {
+				p.errorf(vs.PosRange, "metric name must not be set twice: %q or %q", vs.Name, m.Value)
+			}
+		}
+	}
+	if vs.Name != "" {
+		return
+	}
+	for _, m := range vs.LabelMatchers {
+		if !m.Matches("") {
+			return
+		}
+	}
+	p.errorf(vs.PosRange, "vector selector must contain at least one non-empty matcher")
+}
+
+func (p *parser) checkCall(c *Call) {
+	for _, arg := range c.Args {
+		p.check(arg)
+	}
+
+	fn := c.Func
+	nargs := len(fn.ArgTypes)
+	if fn.Variadic == 0 {
+		if nargs != len(c.Args) {
+			p.errorf(c.PosRange, "expected %d argument(s) in call to %q, got %d", nargs, fn.Name, len(c.Args))
+		}
+	} else {
+		if min := nargs - 1; min > len(c.Args) {
+			p.errorf(c.PosRange, "expected at least %d argument(s) in call to %q, got %d", min, fn.Name, len(c.Args))
+		}
+		if max := nargs - 1 + fn.Variadic; fn.Variadic > 0 && max < len(c.Args) {
+			p.errorf(c.PosRange, "expected at most %d argument(s) in call to %q, got %d", max, fn.Name, len(c.Args))
+		}
+	}
+
+	for i, arg := range c.Args {
+		idx := i
+		if idx >= nargs {
+			idx = nargs - 1
+		}
+		want := fn.ArgTypes[idx]
+		if got := arg.Type(); got != want {
+			p.errorf(arg.PositionRange(), "expected type %s in call to function %q, got %s", want, fn.Name, got)
+		}
+	}
+
+	if fn.Name == "label_replace" || fn.Name == "label_join" {
+		for i := 1; i < len(c.Args) && i < 3; i += 2 {
+			// The destination label must be a valid label name.
+			if s, ok := c.Args[i].(*StringLiteral); ok && i == 1 && !isLabelName(s.Val) {
+				p.errorf(s.PosRange, "invalid destination label name in %s(): %s", fn.Name, s.Val)
+			}
+		}
+	}
+	if fn.Name == "label_replace" && len(c.Args) == 5 {
+		if s, ok := c.Args[4].(*StringLiteral); ok {
+			if _, err := NewLabelMatcher(MatchRegexp, "", s.Val); err != nil {
+				p.errorf(s.PosRange, "invalid regular expression in label_replace(): %s", s.Val)
+			}
+		}
+	}
+}
+
+func (p *parser) checkBinary(n *BinaryExpr) {
+	lt, rt := n.LHS.Type(), n.RHS.Type()
+	for _, t := range []ValueType{lt, rt} {
+		if t != ValueTypeScalar && t != ValueTypeVector {
+			p.errorf(n.PositionRange(), "binary expression must contain only scalar and instant vector types")
+		}
+	}
+
+	bothVectors := lt == ValueTypeVector && rt == ValueTypeVector
+	if comparisonOps[n.Op] && lt == ValueTypeScalar && rt == ValueTypeScalar && !n.ReturnBool {
+		p.errorf(n.PositionRange(), "comparisons between scalars must use BOOL modifier")
+	}
+	if setOps[n.Op] && !bothVectors {
+		p.errorf(n.PositionRange(), "set operator %q not allowed in binary scalar expression", n.Op)
+	}
+
+	vm := n.VectorMatching
+	if vm == nil {
+		return
+	}
+	if !bothVectors && (len(vm.MatchingLabels) > 0 || vm.On || vm.Card == CardManyToOne || vm.Card == CardOneToMany) {
+		p.errorf(n.PositionRange(), "vector matching only allowed between instant vectors")
+	}
+	if setOps[n.Op] && (vm.Card == CardManyToOne || vm.Card == CardOneToMany) {
+		p.errorf(n.PositionRange(), "no grouping allowed for %q operation", n.Op)
+	}
+	if vm.On {
+		on := make(map[string]bool, len(vm.MatchingLabels))
+		for _, l := range vm.MatchingLabels {
+			on[l] = true
+		}
+		for _, l := range vm.Include {
+			if on[l] {
+				p.errorf(n.PositionRange(), "label %q must not occur in ON and GROUP clause at once", l)
+			}
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lex00/wetwire-observability-go/promql"
+)
+
+func TestParseExpr_Valid(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		typ   ValueType
+	}{
+		{`up`, `up`, ValueTypeVector},
+		{`rate(http_requests_total{job="api",code=~"5.."}[5m])`, `rate(http_requests_total{job="api", code=~"5.."}[5m])`, ValueTypeVector},
+		{`sum(rate(x[1m])) by (job)`, `sum by (job) (rate(x[1m]))`, ValueTypeVector},
+		{`sum without (instance) (x)`, `sum without (instance) (x)`, ValueTypeVector},
+		{`a / on(job) group_left(instance) b`, `a / on (job) group_left (instance) b`, ValueTypeVector},
+		{`a * ignoring(code) b`, `a * ignoring (code) b`, ValueTypeVector},
+		{`histogram_quantile(0.99, sum by (le) (rate(h_bucket[5m])))`, `histogram_quantile(0.99, sum by (le) (rate(h_bucket[5m])))`, ValueTypeVector},
+		{`1 > bool 2`, `1 > bool 2`, ValueTypeScalar},
+		{`time() - 5`, `time() - 5`, ValueTypeScalar},
+		{`-x`, `-x`, ValueTypeVector},
+		{`-1`, `-1`, ValueTypeScalar},
+		{`x offset 5m`, `x offset 5m`, ValueTypeVector},
+		{`x offset -1h`, `x offset -1h`, ValueTypeVector},
+		{`x[5m] offset 1h`, `x[5m] offset 1h`, ValueTypeMatrix},
+		{`max_over_time(rate(x[5m])[1h:1m])`, `max_over_time(rate(x[5m])[1h:1m])`, ValueTypeVector},
+		{`rate(x[5m])[30m:]`, `rate(x[5m])[30m:]`, ValueTypeMatrix},
+		{`topk(5, x)`, `topk(5, x)`, ValueTypeVector},
+		{`count_values("v", x)`, `count_values("v", x)`, ValueTypeVector},
+		{`{__name__="x"}`, `{__name__="x"}`, ValueTypeVector},
+		{`{"utf8.metric"}`, `{"utf8.metric"}`, ValueTypeVector},
+		{`a and b or c unless d`, `a and b or c unless d`, ValueTypeVector},
+		{`label_replace(up, "foo", "$1", "job", "(.*)")`, `label_replace(up, "foo", "$1", "job", "(.*)")`, ValueTypeVector},
+		{`round(x)`, `round(x)`, ValueTypeVector},
+		{`round(x, 0.5)`, `round(x, 0.5)`, ValueTypeVector},
+		{`label_join(x, "dst", ",", "a", "b", "c")`, `label_join(x, "dst", ",", "a", "b", "c")`, ValueTypeVector},
+		{`(x)`, `(x)`, ValueTypeVector},
+		{"# leading comment\nup", `up`, ValueTypeVector},
+		{`"hello"`, `"hello"`, ValueTypeString},
+		{`SUM(x) BY (job)`, `sum by (job) (x)`, ValueTypeVector},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			expr, err := ParseExpr(tt.input)
+			if err != nil {
+				t.Fatalf("ParseExpr(%q) error = %v", tt.input, err)
+			}
+			if got := expr.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+			if got := expr.Type(); got != tt.typ {
+				t.Errorf("Type() = %v, want %v", got, tt.typ)
+			}
+		})
+	}
+}
+
+func TestParseExpr_Invalid(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{``, "no expression found in input"},
+		{`rate(x)`, `expected type range vector in call to function "rate", got instant vector`},
+		{`rate(x[5m], 1)`, `expected 1 argument(s) in call to "rate", got 2`},
+		{`round()`, `expected at least 1 argument(s) in call to "round", got 0`},
+		{`round(x, 1, 2)`, `expected at most 2 argument(s) in call to "round", got 3`},
+		{`unknownfn(x)`, `unknown function with name "unknownfn"`},
+		{`1 > 2`, "comparisons between scalars must use BOOL modifier"},
+		{`a + bool b`, "bool modifier can only be used on comparison operators"},
+		{`sum(rate(x[5m])[1m])`, "ranges only allowed for vector selectors"},
+		{`{}`, "vector selector must contain at least one non-empty matcher"},
+		{`{job=""}`, "vector selector must contain at least one non-empty matcher"},
+		{`up{__name__="x"}`, "metric name must not be set twice"},
+		{`up{job=~"[a"}`, "invalid regular expression in label matcher"},
+		{`x[0s]`, "range must be greater than 0"},
+		{`a and 1`, `set operator "and" not allowed in binary scalar expression`},
+		{`a and on(job) group_left b`, `no grouping allowed for "and" operation`},
+		{`1 + on(job) 2`, "vector matching only allowed between instant vectors"},
+		{`a / on(job) group_left(job) b`, `label "job" must not occur in ON and GROUP clause at once`},
+		{`"s" + 1`, "binary expression must contain only scalar and instant vector types"},
+		{`-"s"`, "unary expression only allowed on expressions of type scalar or instant vector"},
+		{`sum(x[5m])`, "expected type instant vector in aggregation expression, got range vector"},
+		{`topk(x)`, "wrong number of arguments for aggregate expression provided, expected 2, got 1"},
+		{`topk("a", x)`, "expected type scalar in aggregation parameter, got string"},
+		{`x[5m][1m:]`, "subquery is only allowed on instant vector, got range vector instead"},
+		{`rate(x[5min])`, `bad number or duration syntax: "5min"`},
+		{`sum(x`, `unexpected end of input in aggregation, expected ")"`},
+		{`x{job="a"`, `unexpected end of input in label matching, expected "," or "}"`},
+		{`x offset 5m offset 1m`, "offset may not be set multiple times"},
+		{`x y`, `unexpected identifier "y"`},
+		{`"unterminated`, "unterminated quoted string"},
+		{`label_replace(x, "1bad", "$1", "job", "(.*)")`, "invalid destination label name in label_replace(): 1bad"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseExpr(tt.input)
+			if err == nil {
+				t.Fatalf("ParseExpr(%q) succeeded, want error containing %q", tt.input, tt.want)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want containing %q", err, tt.want)
+			}
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Errorf("error type = %T, want *ParseError", err)
+			}
+		})
+	}
+}
+
+func TestParseExpr_Precedence(t *testing.T) {
+	expr := MustParseExpr(`a + b * c ^ d ^ e`)
+	bin, ok := expr.(*BinaryExpr)
+	if !ok || bin.Op != "+" {
+		t.Fatalf("root = %s, want + expression", expr)
+	}
+	mul := bin.RHS.(*BinaryExpr)
+	if mul.Op != "*" {
+		t.Errorf("RHS op = %v, want *", mul.Op)
+	}
+	pow := mul.RHS.(*BinaryExpr)
+	if _, ok := pow.RHS.(*BinaryExpr); !ok {
+		t.Errorf("^ is not right-associative: %s", pow)
+	}
+
+	unary, ok := MustParseExpr(`-a ^ 2`).(*UnaryExpr)
+	if !ok {
+		t.Fatal("-a ^ 2 should parse as -(a ^ 2)")
+	}
+	if _, ok := unary.Expr.(*BinaryExpr); !ok {
+		t.Errorf("unary operand = %T, want *BinaryExpr", unary.Expr)
+	}
+}
+
+func TestParseExpr_Selectors(t *testing.T) {
+	expr := MustParseExpr(`rate(http_requests_total{job="api", code!~"2.."}[5m] offset 1m)`)
+	call := expr.(*Call)
+	ms := call.Args[0].(*MatrixSelector)
+	if ms.Range != 5*time.Minute {
+		t.Errorf("Range = %v, want 5m", ms.Range)
+	}
+	vs := ms.VectorSelector
+	if vs.Name != "http_requests_total" {
+		t.Errorf("Name = %v, want http_requests_total", vs.Name)
+	}
+	if vs.Offset != time.Minute {
+		t.Errorf("Offset = %v, want 1m", vs.Offset)
+	}
+	if len(vs.LabelMatchers) != 2 {
+		t.Fatalf("len(LabelMatchers) = %d, want 2", len(vs.LabelMatchers))
+	}
+	m := vs.LabelMatchers[1]
+	if m.Type != MatchNotRegexp || m.Matches("200") || !m.Matches("500") {
+		t.Errorf("matcher %s matched incorrectly", m)
+	}
+}
+
+func TestParseError_Position(t *testing.T) {
+	_, err := ParseExpr("sum(\n  rate(x)\n)")
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("error = %v, want *ParseError", err)
+	}
+	line, col := perr.Position()
+	if line != 2 || col != 8 {
+		t.Errorf("Position() = %d:%d, want 2:8", line, col)
+	}
+	if !strings.HasPrefix(err.Error(), "2:8: parse error:") {
+		t.Errorf("Error() = %v", err)
+	}
+}
+
+// TestParseExpr_Builders checks that expressions produced by the promql
+// builders parse.
+func TestParseExpr_Builders(t *testing.T) {
+	exprs := []promql.Expr{
+		promql.Rate(promql.RangeVector("http_requests_total", "5m", promql.Match("job", "api"))),
+		promql.Sum(promql.Rate(promql.RangeVector("x", "1m"))).By("job"),
+		promql.P99(promql.Sum(promql.Rate(promql.RangeVector("h_bucket", "5m"))).By("le")),
+		promql.GT(promql.Vector("up"), promql.Scalar(0)),
+	}
+	for _, e := range exprs {
+		if _, err := ParseExpr(e.String()); err != nil {
+			t.Errorf("ParseExpr(%q) error = %v", e.String(), err)
+		}
+	}
+}
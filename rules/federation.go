@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+// Federation builds a scrape config pulling the series produced by
+// recording rules from other Prometheus servers through /federate.
+//
+// The match[] parameters are computed from the recording rule names of the
+// given rules files and groups, so the global server federates exactly the
+// aggregated series and nothing else.
+//
+// Example usage:
+//
+//	var Global = prometheus.PrometheusConfig{
+//	    ScrapeConfigs: []*prometheus.ScrapeConfig{
+//	        rules.NewFederation("federate", "prometheus-eu:9090", "prometheus-us:9090").
+//	            WithRulesFiles(&APIRules, &NodeRules).
+//	            ScrapeConfig(),
+//	    },
+//	}
+type Federation struct {
+	// JobName is the job name of the federation scrape config.
+	JobName string
+
+	// Targets are the host:port addresses of the federated servers.
+	Targets []string
+
+	// RulesFiles are the rules files whose recording rules are federated.
+	RulesFiles []*RulesFile
+
+	// RuleGroups are rule groups whose recording rules are federated.
+	RuleGroups []*RuleGroup
+
+	// Matchers are additional series selectors to federate.
+	Matchers []string
+
+	// Interval is the scrape interval. Defaults to the global scrape interval.
+	Interval Duration
+}
+
+// NewFederation creates a federation job scraping the given servers.
+func NewFederation(jobName string, targets ...string) *Federation {
+	return &Federation{
+		JobName: jobName,
+		Targets: targets,
+	}
+}
+
+// WithRulesFiles adds rules files whose recording rules are federated.
+func (f *Federation) WithRulesFiles(files ...*RulesFile) *Federation {
+	f.RulesFiles = append(f.RulesFiles, files...)
+	return f
+}
+
+// WithRuleGroups adds rule groups whose recording rules are federated.
+func (f *Federation) WithRuleGroups(groups ...*RuleGroup) *Federation {
+	f.RuleGroups = append(f.RuleGroups, groups...)
+	return f
+}
+
+// WithMatchers adds series selectors federated in addition to the
+// recording rules.
+func (f *Federation) WithMatchers(selectors ...string) *Federation {
+	f.Matchers = append(f.Matchers, selectors...)
+	return f
+}
+
+// WithInterval sets the scrape interval.
+func (f *Federation) WithInterval(d Duration) *Federation {
+	f.Interval = d
+	return f
+}
+
+// Match returns the match[] selectors: one {__name__="..."} selector per
+// distinct recording rule name, sorted, followed by the extra matchers.
+func (f *Federation) Match() []string {
+	groups := append([]*RuleGroup{}, f.RuleGroups...)
+	for _, file := range f.RulesFiles {
+		if file != nil {
+			groups = append(groups, file.Groups...)
+		}
+	}
+	names := RecordNames(groups...)
+
+	match := make([]string, 0, len(names)+len(f.Matchers))
+	for _, name := range names {
+		match = append(match, fmt.Sprintf("{__name__=%q}", name))
+	}
+	return append(match, f.Matchers...)
+}
+
+// ScrapeConfig returns the /federate scrape config. Labels of the federated
+// series are kept as-is (honor_labels), so the external labels of the
+// source servers identify where each series came from.
+func (f *Federation) ScrapeConfig() *prometheus.ScrapeConfig {
+	sc := prometheus.NewScrapeConfig(f.JobName).
+		WithInterval(f.Interval).
+		WithStaticTargets(f.Targets...)
+	sc.MetricsPath = "/federate"
+	sc.HonorLabels = true
+	sc.Params = map[string][]string{"match[]": f.Match()}
+	return sc
+}
+
+// RecordNames returns the distinct recording rule names of the groups,
+// sorted. Rules may be in any form accepted by RulesFile.Validate.
+func RecordNames(groups ...*RuleGroup) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, r := range RecordingRules(groups...) {
+		if seen[r.Record] {
+			continue
+		}
+		seen[r.Record] = true
+		names = append(names, r.Record)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RecordingRules returns the recording rules of the groups in order,
+// converted from any form accepted by RulesFile.Validate. Alerting rules
+// and rules that cannot be converted are skipped.
+func RecordingRules(groups ...*RuleGroup) []*RecordingRule {
+	var out []*RecordingRule
+	for _, g := range groups {
+		if g == nil {
+			continue
+		}
+		for _, r := range g.Rules {
+			rule, err := ruleOf(r)
+			if err != nil || rule.Record == "" {
+				continue
+			}
+			out = append(out, &RecordingRule{Record: rule.Record, Expr: rule.Expr, Labels: rule.Labels})
+		}
+	}
+	return out
+}
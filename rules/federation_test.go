@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRecordNames(t *testing.T) {
+	g1 := NewRuleGroup("api").WithRules(
+		NewRecordingRule("job:http_requests:rate5m").WithExpr("sum by (job) (rate(http_requests_total[5m]))"),
+		NewAlertingRule("HighErrorRate").WithExpr("job:http_errors:ratio5m > 0.05"),
+		RecordingRule{Record: "job:http_errors:ratio5m", Expr: "vector(0)"},
+	)
+	g2 := NewRuleGroup("decoded").WithRules(
+		map[string]any{"record": "job:http_requests:rate5m", "expr": "vector(1)"},
+		map[string]any{"record": "instance:cpu:avg", "expr": "vector(1)"},
+	)
+
+	got := RecordNames(g1, nil, g2)
+	want := []string{"instance:cpu:avg", "job:http_errors:ratio5m", "job:http_requests:rate5m"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("RecordNames() = %v, want %v", got, want)
+	}
+}
+
+func TestFederation_Match(t *testing.T) {
+	file := NewRulesFile().AddGroup(NewRuleGroup("api").WithRules(
+		NewRecordingRule("job:http_requests:rate5m").WithExpr("vector(1)"),
+	))
+	group := NewRuleGroup("node").WithRules(
+		NewRecordingRule("instance:node_cpu:ratio").WithExpr("vector(1)"),
+	)
+
+	f := NewFederation("federate", "prometheus-eu:9090").
+		WithRulesFiles(file).
+		WithRuleGroups(group).
+		WithMatchers(`{job="prometheus"}`)
+
+	got := f.Match()
+	want := []string{
+		`{__name__="instance:node_cpu:ratio"}`,
+		`{__name__="job:http_requests:rate5m"}`,
+		`{job="prometheus"}`,
+	}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("Match() = %v, want %v", got, want)
+	}
+}
+
+func TestFederation_ScrapeConfig(t *testing.T) {
+	file := NewRulesFile().AddGroup(NewRuleGroup("api").WithRules(
+		NewRecordingRule("job:http_requests:rate5m").WithExpr("vector(1)"),
+	))
+
+	sc := NewFederation("federate", "prometheus-eu:9090", "prometheus-us:9090").
+		WithRulesFiles(file).
+		WithInterval(Minute).
+		ScrapeConfig()
+
+	if sc.MetricsPath != "/federate" {
+		t.Errorf("MetricsPath = %q, want /federate", sc.MetricsPath)
+	}
+	if !sc.HonorLabels {
+		t.Error("HonorLabels should be true")
+	}
+	if sc.ScrapeInterval != Minute {
+		t.Errorf("ScrapeInterval = %v, want 1m", sc.ScrapeInterval)
+	}
+
+	data, err := sc.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	yamlStr := string(data)
+	for _, want := range []string{
+		"job_name: federate",
+		"metrics_path: /federate",
+		"honor_labels: true",
+		"match[]:",
+		`{__name__="job:http_requests:rate5m"}`,
+		"- prometheus-eu:9090",
+		"- prometheus-us:9090",
+	} {
+		if !strings.Contains(yamlStr, want) {
+			t.Errorf("yaml.Marshal() missing %q\nGot:\n%s", want, yamlStr)
+		}
+	}
+}
+
+func TestRecordingRules(t *testing.T) {
+	g := NewRuleGroup("api").WithRules(
+		NewRecordingRule("job:http_requests:rate5m").WithExpr("sum by (job) (rate(http_requests_total[5m]))"),
+		NewAlertingRule("HighErrorRate").WithExpr("job:http_errors:ratio5m > 0.05"),
+		map[string]any{"record": "instance:cpu:avg", "expr": "avg by (instance) (cpu)"},
+	)
+
+	got := RecordingRules(g)
+	if len(got) != 2 {
+		t.Fatalf("RecordingRules() returned %d rules, want 2", len(got))
+	}
+	if got[0].Record != "job:http_requests:rate5m" {
+		t.Errorf("got[0].Record = %q, want job:http_requests:rate5m", got[0].Record)
+	}
+	if got[1].Expr != "avg by (instance) (cpu)" {
+		t.Errorf("got[1].Expr = %q, want avg by (instance) (cpu)", got[1].Expr)
+	}
+}
@@ -0,0 +1,192 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/lex00/wetwire-observability-go/prometheus"
+	"github.com/lex00/wetwire-observability-go/promql/parser"
+	"gopkg.in/yaml.v3"
+)
+
+// ValidationError is a problem found while validating a rules file.
+type ValidationError = prometheus.ValidationError
+
+// metricNameRE matches valid recording rule names.
+var metricNameRE = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
+// ValidateYAML parses a rules file and validates it. Errors carry the line
+// and column of the offending field. A non-nil error is returned if the
+// document cannot be parsed.
+func ValidateYAML(data []byte) ([]ValidationError, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+	var f RulesFile
+	if err := root.Decode(&f); err != nil {
+		return nil, err
+	}
+	errs := f.Validate()
+	prometheus.Locate(errs, &root)
+	return errs, nil
+}
+
+// Validate checks the rules file against the rules Prometheus enforces when
+// loading it: group names are set and unique, each rule is either an alert
+// or a recording rule, expressions parse as PromQL, recording rule names are
+// valid metric names, durations are not negative, and label names are
+// valid.
+//
+// Rules may be *AlertingRule, *RecordingRule, their value forms, or the
+// maps produced by decoding YAML. Validate returns nil if the file is valid.
+func (f *RulesFile) Validate() []ValidationError {
+	var errs []ValidationError
+	add := func(path, format string, args ...any) {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	names := make(map[string]bool)
+	for i, g := range f.Groups {
+		if g == nil {
+			continue
+		}
+		path := fmt.Sprintf("groups[%d]", i)
+		switch {
+		case g.Name == "":
+			add(path+".name", "rulegroup name must not be empty")
+		case names[g.Name]:
+			add(path+".name", "groupname: %q is repeated in the same file", g.Name)
+		}
+		names[g.Name] = true
+
+		if g.Interval < 0 {
+			add(path+".interval", "interval must not be negative")
+		}
+		if g.Limit < 0 {
+			add(path+".limit", "limit must not be negative")
+		}
+		for j, r := range g.Rules {
+			rulePath := fmt.Sprintf("%s.rules[%d]", path, j)
+			rule, err := ruleOf(r)
+			if err != nil {
+				add(rulePath, "%v", err)
+				continue
+			}
+			for _, e := range rule.validate() {
+				p := rulePath
+				if e.Path != "" {
+					p += "." + e.Path
+				}
+				add(p, "%s", e.Message)
+			}
+		}
+	}
+	return errs
+}
+
+// rule is the common form of alerting and recording rules used for
+// validation.
+type rule struct {
+	Alert         string            `yaml:"alert"`
+	Record        string            `yaml:"record"`
+	Expr          string            `yaml:"expr"`
+	For           Duration          `yaml:"for"`
+	KeepFiringFor Duration          `yaml:"keep_firing_for"`
+	Labels        map[string]string `yaml:"labels"`
+	Annotations   map[string]string `yaml:"annotations"`
+}
+
+// ruleOf converts a rule value to its common form.
+func ruleOf(r any) (*rule, error) {
+	switch v := r.(type) {
+	case *AlertingRule:
+		return &rule{Alert: v.Alert, Expr: v.Expr, For: v.For, KeepFiringFor: v.KeepFiringFor, Labels: v.Labels, Annotations: v.Annotations}, nil
+	case AlertingRule:
+		return ruleOf(&v)
+	case *RecordingRule:
+		return &rule{Record: v.Record, Expr: v.Expr, Labels: v.Labels}, nil
+	case RecordingRule:
+		return ruleOf(&v)
+	case map[string]any:
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		var out rule
+		if err := yaml.Unmarshal(data, &out); err != nil {
+			return nil, err
+		}
+		return &out, nil
+	case nil:
+		return nil, errors.New("rule must not be empty")
+	}
+	return nil, fmt.Errorf("unsupported rule type %T", r)
+}
+
+// validate returns the rule's errors with paths relative to the rule.
+func (r *rule) validate() []ValidationError {
+	var errs []ValidationError
+	add := func(path, format string, args ...any) {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	switch {
+	case r.Alert == "" && r.Record == "":
+		add("", "one of 'record' or 'alert' must be set")
+	case r.Alert != "" && r.Record != "":
+		add("", "only one of 'record' and 'alert' must be set")
+	}
+
+	if r.Expr == "" {
+		add("expr", "field 'expr' must be set in rule")
+	} else if expr, err := parser.ParseExpr(r.Expr); err != nil {
+		add("expr", "could not parse expression: %v", err)
+	} else if t := expr.Type(); t != parser.ValueTypeVector && t != parser.ValueTypeScalar {
+		add("expr", "expression must evaluate to an instant vector or scalar, got %s", t)
+	}
+
+	if r.Record != "" {
+		if !metricNameRE.MatchString(r.Record) {
+			add("record", "invalid recording rule name: %s", r.Record)
+		}
+		if len(r.Annotations) > 0 {
+			add("annotations", "invalid field 'annotations' in recording rule")
+		}
+		if r.For != 0 {
+			add("for", "invalid field 'for' in recording rule")
+		}
+		if r.KeepFiringFor != 0 {
+			add("keep_firing_for", "invalid field 'keep_firing_for' in recording rule")
+		}
+	}
+	if r.For < 0 {
+		add("for", "for must not be negative, got %s", r.For)
+	}
+	if r.KeepFiringFor < 0 {
+		add("keep_firing_for", "keep_firing_for must not be negative, got %s", r.KeepFiringFor)
+	}
+
+	for _, name := range sortedKeys(r.Labels) {
+		if !prometheus.IsValidLabelName(name) {
+			add("labels", "invalid label name: %s", name)
+		}
+	}
+	for _, name := range sortedKeys(r.Annotations) {
+		if !prometheus.IsValidLabelName(name) {
+			add("annotations", "invalid annotation name: %s", name)
+		}
+	}
+	return errs
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,174 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestRulesFile_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want []string
+	}{
+		{
+			name: "valid rules",
+			yaml: `
+groups:
+  - name: api
+    interval: 30s
+    rules:
+      - record: job:http_requests:rate5m
+        expr: sum by (job) (rate(http_requests_total[5m]))
+      - alert: HighErrorRate
+        expr: job:http_errors:rate5m / job:http_requests:rate5m > 0.05
+        for: 10m
+        labels: {severity: critical}
+        annotations: {summary: High error rate}
+`,
+		},
+		{
+			name: "group names",
+			yaml: `
+groups:
+  - name: api
+  - name: api
+  - name: ""
+`,
+			want: []string{
+				`groups[1].name: groupname: "api" is repeated in the same file`,
+				"groups[2].name: rulegroup name must not be empty",
+			},
+		},
+		{
+			name: "rule kinds and expressions",
+			yaml: `
+groups:
+  - name: api
+    rules:
+      - expr: up
+      - alert: Both
+        record: both
+        expr: up
+      - alert: NoExpr
+      - alert: BadExpr
+        expr: rate(http_requests_total)
+      - record: job:up:range
+        expr: up[5m]
+`,
+			want: []string{
+				"groups[0].rules[0]: one of 'record' or 'alert' must be set",
+				"groups[0].rules[1]: only one of 'record' and 'alert' must be set",
+				"groups[0].rules[2].expr: field 'expr' must be set in rule",
+				`groups[0].rules[3].expr: could not parse expression: 1:6: parse error: expected type range vector in call to function "rate", got instant vector`,
+				"groups[0].rules[4].expr: expression must evaluate to an instant vector or scalar, got range vector",
+			},
+		},
+		{
+			name: "recording rule fields",
+			yaml: `
+groups:
+  - name: api
+    rules:
+      - record: job-requests
+        expr: up
+        for: 5m
+        annotations: {summary: x}
+      - alert: Labels
+        expr: up == 0
+        labels: {"bad-label": x}
+`,
+			want: []string{
+				"groups[0].rules[0].record: invalid recording rule name: job-requests",
+				"groups[0].rules[0].annotations: invalid field 'annotations' in recording rule",
+				"groups[0].rules[0].for: invalid field 'for' in recording rule",
+				"groups[0].rules[1].labels: invalid label name: bad-label",
+			},
+		},
+		{
+			name: "invalid for duration",
+			yaml: `
+groups:
+  - name: api
+    rules:
+      - alert: Down
+        expr: up == 0
+        for: 5min
+`,
+			want: []string{"groups[0].rules[0]: invalid"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var f RulesFile
+			if err := yaml.Unmarshal([]byte(tt.yaml), &f); err != nil {
+				t.Fatalf("yaml.Unmarshal() error = %v", err)
+			}
+
+			errs := f.Validate()
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			joined := strings.Join(got, "\n")
+
+			if len(tt.want) == 0 && len(errs) > 0 {
+				t.Fatalf("Validate() = %v, want no errors", joined)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(joined, want) {
+					t.Errorf("Validate() missing %q\nGot:\n%s", want, joined)
+				}
+			}
+			if len(errs) != len(tt.want) {
+				t.Errorf("Validate() returned %d errors, want %d\nGot:\n%s", len(errs), len(tt.want), joined)
+			}
+		})
+	}
+}
+
+func TestRulesFile_ValidateBuilders(t *testing.T) {
+	f := NewRulesFile().WithGroups(
+		NewRuleGroup("api").WithRules(
+			NewRecordingRule("job:up:sum").WithExpr("sum by (job) (up)"),
+			NewAlertingRule("InstanceDown").WithExpr("up == 0").WithFor(5*Minute).Critical(),
+		),
+		NewRuleGroup("broken").WithRules(
+			NewAlertingRule("Negative").WithExpr("up == 0").WithFor(-Minute),
+			RecordingRule{Record: "x", Expr: "sum("},
+		),
+	)
+
+	errs := f.Validate()
+	if len(errs) != 2 {
+		t.Fatalf("Validate() returned %d errors, want 2: %v", len(errs), errs)
+	}
+	if errs[0].Path != "groups[1].rules[0].for" {
+		t.Errorf("errs[0].Path = %v, want groups[1].rules[0].for", errs[0].Path)
+	}
+	if errs[1].Path != "groups[1].rules[1].expr" {
+		t.Errorf("errs[1].Path = %v, want groups[1].rules[1].expr", errs[1].Path)
+	}
+}
+
+func TestValidateYAML_Positions(t *testing.T) {
+	data := []byte(`groups:
+  - name: api
+    rules:
+      - alert: Down
+        expr: up ==
+`)
+	errs, err := ValidateYAML(data)
+	if err != nil {
+		t.Fatalf("ValidateYAML() error = %v", err)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("ValidateYAML() returned %d errors, want 1: %v", len(errs), errs)
+	}
+	if errs[0].Line != 5 || errs[0].Column != 15 {
+		t.Errorf("position = %d:%d, want 5:15", errs[0].Line, errs[0].Column)
+	}
+}
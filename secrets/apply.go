@@ -0,0 +1,218 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+)
+
+// Mode selects how Apply handles secret references.
+type Mode string
+
+const (
+	// ModePlaceholder leaves references in the generated config.
+	ModePlaceholder Mode = "placeholder"
+
+	// ModeResolve replaces references with their resolved values.
+	ModeResolve Mode = "resolve"
+
+	// ModeFileRef clears references and sets the matching *_file field to
+	// the path of the secret in a mounted Kubernetes Secret.
+	ModeFileRef Mode = "file-ref"
+)
+
+// ParseMode parses a --secrets flag value.
+func ParseMode(s string) (Mode, error) {
+	switch m := Mode(s); m {
+	case ModePlaceholder, ModeResolve, ModeFileRef:
+		return m, nil
+	case "":
+		return ModePlaceholder, nil
+	default:
+		return "", fmt.Errorf("invalid secrets mode %q, must be placeholder, resolve, or file-ref", s)
+	}
+}
+
+// DefaultMountDir is where ModeFileRef expects secrets to be mounted.
+const DefaultMountDir = "/etc/secrets"
+
+// Options configures Apply.
+type Options struct {
+	// Mode selects how references are handled.
+	Mode Mode
+
+	// Resolver resolves references in ModeResolve.
+	Resolver *Resolver
+
+	// MountDir is the directory secrets are mounted at in ModeFileRef
+	// (default: DefaultMountDir).
+	MountDir string
+}
+
+// Apply rewrites secret references in config, which must be a pointer to a
+// config struct such as *alertmanager.AlertmanagerConfig or
+// *prometheus.PrometheusConfig.
+//
+// Secret-typed fields, and string fields with a *_file counterpart such as
+// password/password_file, are considered. Literal values are left untouched.
+// All failures are returned together, each prefixed with the field's YAML path.
+func Apply(config any, opts Options) error {
+	if opts.Mode == "" || opts.Mode == ModePlaceholder {
+		return nil
+	}
+	if opts.Mode == ModeResolve && opts.Resolver == nil {
+		return fmt.Errorf("secrets mode %q requires a resolver", opts.Mode)
+	}
+	if opts.MountDir == "" {
+		opts.MountDir = DefaultMountDir
+	}
+
+	v := reflect.ValueOf(config)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return fmt.Errorf("secrets.Apply requires a non-nil pointer, got %T", config)
+	}
+
+	if opts.Mode == ModeFileRef {
+		// Fail before rewriting anything if two references share a file.
+		if _, err := MountedKeys(config); err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+	walk(v, "", func(field reflect.Value, fileField reflect.Value, fieldPath string) {
+		ref, ok := ParseRef(field.String())
+		if !ok {
+			return
+		}
+		switch opts.Mode {
+		case ModeResolve:
+			value, err := opts.Resolver.Resolve(ref)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", fieldPath, err))
+				return
+			}
+			field.SetString(value)
+		case ModeFileRef:
+			if !fileField.IsValid() {
+				errs = append(errs, fmt.Errorf("%s: no %s_file field to reference a mounted secret", fieldPath, lastSegment(fieldPath)))
+				return
+			}
+			filePath := path.Join(opts.MountDir, ref.FileName())
+			if ref.Provider == ProviderFile && strings.HasPrefix(ref.Key, "/") {
+				filePath = ref.Key
+			}
+			field.SetString("")
+			fileField.SetString(filePath)
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// MountedKeys returns the keys a mounted Kubernetes Secret must contain for
+// config under ModeFileRef, mapped to the reference whose value they hold.
+// Different references mapping to the same key, such as the same SOPS key
+// path in two files, are reported as errors since their *_file fields would
+// point at the same file.
+func MountedKeys(config any) (map[string]string, error) {
+	keys := make(map[string]string)
+	var errs []error
+	walk(reflect.ValueOf(config), "", func(field reflect.Value, fileField reflect.Value, fieldPath string) {
+		ref, ok := ParseRef(field.String())
+		if !ok || !fileField.IsValid() || (ref.Provider == ProviderFile && strings.HasPrefix(ref.Key, "/")) {
+			return
+		}
+		key := ref.FileName()
+		if existing, ok := keys[key]; ok && existing != ref.String() {
+			errs = append(errs, fmt.Errorf("%s: %s and %s both map to mounted secret key %q", fieldPath, existing, ref, key))
+			return
+		}
+		keys[key] = ref.String()
+	})
+	return keys, errors.Join(errs...)
+}
+
+// visitFunc is called for each candidate secret field. fileField is the
+// matching *_file field, or the zero Value if there is none.
+type visitFunc func(field, fileField reflect.Value, fieldPath string)
+
+// walk visits secret fields in v in declaration order.
+func walk(v reflect.Value, prefix string, visit visitFunc) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			walk(v.Elem(), prefix, visit)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			walk(v.Index(i), fmt.Sprintf("%s[%d]", prefix, i), visit)
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			// Map values are not addressable; only descend into pointers.
+			if elem := v.MapIndex(k); elem.Kind() == reflect.Pointer {
+				walk(elem, joinPath(prefix, fmt.Sprint(k.Interface())), visit)
+			}
+		}
+	case reflect.Struct:
+		t := v.Type()
+		files := make(map[string]reflect.Value)
+		for i := 0; i < t.NumField(); i++ {
+			if name := yamlName(t.Field(i)); strings.HasSuffix(name, "_file") && v.Field(i).Kind() == reflect.String {
+				files[strings.TrimSuffix(name, "_file")] = v.Field(i)
+			}
+		}
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+			field := v.Field(i)
+			if isInline(sf) {
+				// Inline structs serialize at the parent level.
+				walk(field, prefix, visit)
+				continue
+			}
+			name := yamlName(sf)
+			if field.Kind() != reflect.String {
+				walk(field, joinPath(prefix, name), visit)
+				continue
+			}
+			fileField, hasFile := files[name]
+			if (sf.Type.Name() == "Secret" || hasFile) && field.CanSet() {
+				visit(field, fileField, joinPath(prefix, name))
+			}
+		}
+	}
+}
+
+func isInline(sf reflect.StructField) bool {
+	_, opts, _ := strings.Cut(sf.Tag.Get("yaml"), ",")
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "inline" {
+			return true
+		}
+	}
+	return false
+}
+
+func yamlName(sf reflect.StructField) string {
+	name, _, _ := strings.Cut(sf.Tag.Get("yaml"), ",")
+	if name == "" {
+		return strings.ToLower(sf.Name)
+	}
+	return name
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+func lastSegment(fieldPath string) string {
+	return fieldPath[strings.LastIndex(fieldPath, ".")+1:]
+}
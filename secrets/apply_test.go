@@ -0,0 +1,238 @@
+package secrets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lex00/wetwire-observability-go/alertmanager"
+	"github.com/lex00/wetwire-observability-go/prometheus"
+)
+
+func testAlertmanagerConfig() *alertmanager.AlertmanagerConfig {
+	return alertmanager.NewAlertmanagerConfig().
+		WithReceivers(
+			alertmanager.NewReceiver("slack").WithSlackConfigs(
+				alertmanager.NewSlackConfig().
+					WithAPIURL(alertmanager.Secret(SOPS("secrets.enc.yaml", "slack.webhook"))).
+					WithChannel("#alerts"),
+			),
+			alertmanager.NewReceiver("pagerduty").WithPagerDutyConfigs(
+				alertmanager.NewPagerDutyConfig().WithRoutingKey(alertmanager.SecretFromEnv("PD_KEY")),
+			),
+			alertmanager.NewReceiver("literal").WithOpsGenieConfigs(
+				alertmanager.NewOpsGenieConfig().WithAPIKey(alertmanager.NewSecret("literal-key")),
+			),
+		)
+}
+
+func TestParseMode(t *testing.T) {
+	for _, s := range []string{"placeholder", "resolve", "file-ref"} {
+		if m, err := ParseMode(s); err != nil || string(m) != s {
+			t.Errorf("ParseMode(%q) = %q, %v", s, m, err)
+		}
+	}
+	if m, _ := ParseMode(""); m != ModePlaceholder {
+		t.Errorf("ParseMode(\"\") = %q, want placeholder", m)
+	}
+	if _, err := ParseMode("vault"); err == nil {
+		t.Error("ParseMode(\"vault\") should fail")
+	}
+}
+
+func TestApply_Placeholder(t *testing.T) {
+	config := testAlertmanagerConfig()
+	if err := Apply(config, Options{Mode: ModePlaceholder}); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if got := config.Receivers[1].PagerDutyConfigs[0].RoutingKey; got != "${PD_KEY}" {
+		t.Errorf("RoutingKey = %q, want placeholder", string(got))
+	}
+}
+
+func TestApply_Resolve(t *testing.T) {
+	t.Setenv("PD_KEY", "pd-routing-key")
+	sops := NewSOPSProvider(".", "")
+	sops.decrypt = func(string) ([]byte, error) {
+		return []byte(`{"slack": {"webhook": "https://hooks.slack.com/services/T/B/X"}}`), nil
+	}
+	resolver := NewResolver(".").WithProvider(ProviderSOPS, sops)
+
+	config := testAlertmanagerConfig()
+	if err := Apply(config, Options{Mode: ModeResolve, Resolver: resolver}); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	if got := string(config.Receivers[0].SlackConfigs[0].APIURL); got != "https://hooks.slack.com/services/T/B/X" {
+		t.Errorf("APIURL = %q", got)
+	}
+	if got := string(config.Receivers[1].PagerDutyConfigs[0].RoutingKey); got != "pd-routing-key" {
+		t.Errorf("RoutingKey = %q", got)
+	}
+	if got := string(config.Receivers[2].OpsGenieConfigs[0].APIKey); got != "literal-key" {
+		t.Errorf("literal APIKey = %q, want unchanged", got)
+	}
+}
+
+func TestApply_ResolveErrors(t *testing.T) {
+	config := testAlertmanagerConfig()
+	err := Apply(config, Options{Mode: ModeResolve, Resolver: NewResolver(".")})
+	if err == nil {
+		t.Fatal("Apply() should fail for unresolvable references")
+	}
+	for _, want := range []string{
+		`receivers[0].slack_configs[0].api_url: no secret provider registered for "sops"`,
+		"receivers[1].pagerduty_configs[0].routing_key: resolving ${PD_KEY}",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Apply() error missing %q\nGot: %v", want, err)
+		}
+	}
+
+	if err := Apply(config, Options{Mode: ModeResolve}); err == nil {
+		t.Error("Apply() should require a resolver in resolve mode")
+	}
+}
+
+func TestApply_FileRef(t *testing.T) {
+	config := testAlertmanagerConfig()
+	if err := Apply(config, Options{Mode: ModeFileRef, MountDir: "/etc/alertmanager/secrets/alerting"}); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	slack := config.Receivers[0].SlackConfigs[0]
+	if slack.APIURL != "" || slack.APIURLFile != "/etc/alertmanager/secrets/alerting/slack.webhook" {
+		t.Errorf("slack api_url = %q, api_url_file = %q", string(slack.APIURL), slack.APIURLFile)
+	}
+	pd := config.Receivers[1].PagerDutyConfigs[0]
+	if pd.RoutingKey != "" || pd.RoutingKeyFile != "/etc/alertmanager/secrets/alerting/PD_KEY" {
+		t.Errorf("pagerduty routing_key = %q, routing_key_file = %q", string(pd.RoutingKey), pd.RoutingKeyFile)
+	}
+	if got := string(config.Receivers[2].OpsGenieConfigs[0].APIKey); got != "literal-key" {
+		t.Errorf("literal APIKey = %q, want unchanged", got)
+	}
+}
+
+func TestApply_FileRefMissingFileField(t *testing.T) {
+	config := alertmanager.NewAlertmanagerConfig().WithReceivers(
+		alertmanager.NewReceiver("sns").WithSNSConfigs(
+			alertmanager.NewSNSConfig().WithSigV4(
+				alertmanager.NewSigV4Config("us-east-1").WithAccessKey("AKIA", alertmanager.SecretFromEnv("AWS_SECRET")),
+			),
+		),
+	)
+	err := Apply(config, Options{Mode: ModeFileRef})
+	if err == nil || !strings.Contains(err.Error(), "sigv4.secret_key: no secret_key_file field") {
+		t.Errorf("Apply() error = %v, want missing file field error", err)
+	}
+}
+
+func TestApply_FileRefPrometheus(t *testing.T) {
+	sc := prometheus.NewScrapeConfig("api")
+	sc.BasicAuth = &prometheus.BasicAuth{Username: "admin", Password: Env("API_PASSWORD")}
+	config := &prometheus.PrometheusConfig{ScrapeConfigs: []*prometheus.ScrapeConfig{sc}}
+
+	keys, err := MountedKeys(config)
+	if err != nil || keys["API_PASSWORD"] != "${API_PASSWORD}" {
+		t.Errorf("MountedKeys() = %v, %v", keys, err)
+	}
+
+	if err := Apply(config, Options{Mode: ModeFileRef}); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if sc.BasicAuth.Password != "" || sc.BasicAuth.PasswordFile != "/etc/secrets/API_PASSWORD" {
+		t.Errorf("basic_auth = %+v", sc.BasicAuth)
+	}
+}
+
+func TestApply_FileRefHTTPClientConfig(t *testing.T) {
+	rw := prometheus.NewRemoteWrite("https://remote.example.com/api/v1/write").
+		WithAuthorization(prometheus.Secret(Env("REMOTE_TOKEN"))).
+		WithSigV4(prometheus.NewSigV4("us-east-1").WithAccessKey("AKIA", prometheus.Secret(Env("AWS_SECRET"))))
+	config := &prometheus.PrometheusConfig{RemoteWrite: []*prometheus.RemoteWriteConfig{rw}}
+
+	err := Apply(config, Options{Mode: ModeFileRef})
+	if err == nil || !strings.Contains(err.Error(), "remote_write[0].sigv4.secret_key") {
+		t.Errorf("Apply() error = %v, want missing file field error for sigv4.secret_key", err)
+	}
+	if rw.Authorization.Credentials != "" || rw.Authorization.CredentialsFile != "/etc/secrets/REMOTE_TOKEN" {
+		t.Errorf("authorization = %+v", rw.Authorization)
+	}
+}
+
+func TestApply_ServiceDiscoveryCredentials(t *testing.T) {
+	t.Setenv("AZURE_SECRET", "azure-secret")
+	t.Setenv("OS_PASSWORD", "os-password")
+	t.Setenv("OS_APP_SECRET", "os-app-secret")
+
+	azure := prometheus.NewAzureSD("sub").WithOAuth("tenant", "client", prometheus.Secret(Env("AZURE_SECRET")))
+	openstack := prometheus.NewOpenStackSD(prometheus.OpenStackRoleInstance, "RegionOne").
+		WithPassword("admin", prometheus.Secret(Env("OS_PASSWORD"))).
+		WithApplicationCredential("app", prometheus.Secret(Env("OS_APP_SECRET")))
+	config := &prometheus.PrometheusConfig{ScrapeConfigs: []*prometheus.ScrapeConfig{
+		prometheus.NewScrapeConfig("vms").WithAzureSD(azure).WithOpenStackSD(openstack),
+	}}
+
+	err := Apply(config, Options{Mode: ModeFileRef})
+	for _, want := range []string{
+		"scrape_configs[0].azure_sd_configs[0].client_secret: no client_secret_file field",
+		"scrape_configs[0].openstack_sd_configs[0].password: no password_file field",
+		"scrape_configs[0].openstack_sd_configs[0].application_credential_secret: no application_credential_secret_file field",
+	} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Apply() error = %v, want %q", err, want)
+		}
+	}
+
+	if err := Apply(config, Options{Mode: ModeResolve, Resolver: NewResolver(".")}); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if got := string(azure.ClientSecret); got != "azure-secret" {
+		t.Errorf("azure client_secret = %q", got)
+	}
+	if got := string(openstack.Password); got != "os-password" {
+		t.Errorf("openstack password = %q", got)
+	}
+	if got := string(openstack.ApplicationCredentialSecret); got != "os-app-secret" {
+		t.Errorf("openstack application_credential_secret = %q", got)
+	}
+}
+
+func TestApply_FileRefKeyCollision(t *testing.T) {
+	prod := alertmanager.NewSlackConfig().WithAPIURL(alertmanager.Secret(SOPS("prod.yaml", "slack.api_url")))
+	staging := alertmanager.NewSlackConfig().WithAPIURL(alertmanager.Secret(SOPS("staging.yaml", "slack.api_url")))
+	shared := alertmanager.NewSlackConfig().WithAPIURL(alertmanager.Secret(SOPS("prod.yaml", "slack.api_url")))
+	config := alertmanager.NewAlertmanagerConfig().WithReceivers(
+		alertmanager.NewReceiver("prod").WithSlackConfigs(prod, shared),
+		alertmanager.NewReceiver("staging").WithSlackConfigs(staging),
+	)
+
+	want := `receivers[1].slack_configs[0].api_url: ${sops:prod.yaml#slack.api_url} and ${sops:staging.yaml#slack.api_url} both map to mounted secret key "slack.api_url"`
+	if _, err := MountedKeys(config); err == nil || err.Error() != want {
+		t.Errorf("MountedKeys() error = %v, want %q", err, want)
+	}
+	if err := Apply(config, Options{Mode: ModeFileRef}); err == nil || err.Error() != want {
+		t.Errorf("Apply() error = %v, want %q", err, want)
+	}
+	if staging.APIURLFile != "" || prod.APIURLFile != "" {
+		t.Error("Apply() should not rewrite fields when keys collide")
+	}
+}
+
+func TestApply_FileRefAbsoluteFile(t *testing.T) {
+	pd := alertmanager.NewPagerDutyConfig().WithRoutingKey(alertmanager.Secret(File("/run/secrets/pd")))
+	config := alertmanager.NewAlertmanagerConfig().WithReceivers(
+		alertmanager.NewReceiver("pd").WithPagerDutyConfigs(pd),
+	)
+	if err := Apply(config, Options{Mode: ModeFileRef}); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if pd.RoutingKeyFile != "/run/secrets/pd" {
+		t.Errorf("RoutingKeyFile = %q, want the referenced path", pd.RoutingKeyFile)
+	}
+}
+
+func TestApply_NonPointer(t *testing.T) {
+	if err := Apply(alertmanager.AlertmanagerConfig{}, Options{Mode: ModeFileRef}); err == nil {
+		t.Error("Apply() should reject non-pointer configs")
+	}
+}
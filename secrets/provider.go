@@ -0,0 +1,64 @@
+package secrets
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Provider resolves secret keys to values. Implement it to read secrets from
+// external stores such as Vault or a cloud secret manager.
+type Provider interface {
+	Resolve(key string) (string, error)
+}
+
+// ProviderFunc adapts a function to the Provider interface.
+type ProviderFunc func(key string) (string, error)
+
+// Resolve calls f(key).
+func (f ProviderFunc) Resolve(key string) (string, error) {
+	return f(key)
+}
+
+// EnvProvider resolves keys from environment variables.
+type EnvProvider struct{}
+
+// NewEnvProvider creates an EnvProvider.
+func NewEnvProvider() *EnvProvider {
+	return &EnvProvider{}
+}
+
+// Resolve returns the value of the environment variable key.
+func (p *EnvProvider) Resolve(key string) (string, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", key)
+	}
+	return value, nil
+}
+
+// FileProvider resolves keys as file paths and returns the file content
+// without trailing newlines.
+type FileProvider struct {
+	// BaseDir is the directory relative paths are resolved against.
+	BaseDir string
+}
+
+// NewFileProvider creates a FileProvider resolving relative paths against baseDir.
+func NewFileProvider(baseDir string) *FileProvider {
+	return &FileProvider{BaseDir: baseDir}
+}
+
+// Resolve reads the file at key.
+func (p *FileProvider) Resolve(key string) (string, error) {
+	path := key
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(p.BaseDir, path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading secret file: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
@@ -0,0 +1,57 @@
+package secrets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEnvProvider(t *testing.T) {
+	t.Setenv("WETWIRE_TEST_SECRET", "s3cret")
+	p := NewEnvProvider()
+
+	got, err := p.Resolve("WETWIRE_TEST_SECRET")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if got != "s3cret" {
+		t.Errorf("Resolve() = %q, want %q", got, "s3cret")
+	}
+
+	if _, err := p.Resolve("WETWIRE_TEST_UNSET"); err == nil {
+		t.Error("Resolve() should fail for unset variables")
+	}
+}
+
+func TestFileProvider(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "token"), []byte("abc123\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	p := NewFileProvider(dir)
+
+	got, err := p.Resolve("token")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if got != "abc123" {
+		t.Errorf("Resolve() = %q, want %q", got, "abc123")
+	}
+
+	if got, _ := p.Resolve(filepath.Join(dir, "token")); got != "abc123" {
+		t.Errorf("Resolve(absolute) = %q, want %q", got, "abc123")
+	}
+
+	if _, err := p.Resolve("missing"); err == nil {
+		t.Error("Resolve() should fail for missing files")
+	}
+}
+
+func TestProviderFunc(t *testing.T) {
+	p := ProviderFunc(func(key string) (string, error) {
+		return "value-of-" + key, nil
+	})
+	if got, _ := p.Resolve("k"); got != "value-of-k" {
+		t.Errorf("Resolve() = %q", got)
+	}
+}
@@ -0,0 +1,97 @@
+// Package secrets resolves secret references in generated configs at build time.
+//
+// Secret fields such as alertmanager.Secret and prometheus.Secret hold either a
+// literal value or a reference of the form ${provider:key}. A bare ${VAR}, as
+// produced by SecretFromEnv, refers to an environment variable:
+//
+//	var Slack = alertmanager.NewSlackConfig().
+//		WithAPIURL(alertmanager.Secret(secrets.SOPS("secrets.enc.yaml", "slack.webhook")))
+//
+// Apply rewrites references before serialization according to a Mode: keep the
+// placeholders, resolve them to their values, or replace them with the matching
+// *_file field pointing at a mounted Kubernetes Secret.
+package secrets
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Built-in provider names.
+const (
+	ProviderEnv  = "env"
+	ProviderFile = "file"
+	ProviderSOPS = "sops"
+)
+
+// Ref is a parsed secret reference.
+type Ref struct {
+	// Provider is the name of the provider that resolves the reference.
+	Provider string
+
+	// Key identifies the secret within the provider.
+	Key string
+}
+
+var refPattern = regexp.MustCompile(`^\$\{([^}]+)\}$`)
+
+var providerPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// ParseRef parses a ${provider:key} or ${VAR} reference.
+// It reports false if value is not a reference.
+func ParseRef(value string) (Ref, bool) {
+	m := refPattern.FindStringSubmatch(value)
+	if m == nil {
+		return Ref{}, false
+	}
+	if provider, key, ok := strings.Cut(m[1], ":"); ok && providerPattern.MatchString(provider) && key != "" {
+		return Ref{Provider: provider, Key: key}, true
+	}
+	return Ref{Provider: ProviderEnv, Key: m[1]}, true
+}
+
+// String returns the reference in ${provider:key} form. Environment references
+// use the bare ${VAR} form understood by SecretFromEnv.
+func (r Ref) String() string {
+	if r.Provider == ProviderEnv {
+		return "${" + r.Key + "}"
+	}
+	return "${" + r.Provider + ":" + r.Key + "}"
+}
+
+// FileName returns the key under which the secret is expected in a mounted
+// Kubernetes Secret. Characters not allowed in Secret keys are replaced
+// with underscores.
+func (r Ref) FileName() string {
+	key := r.Key
+	if _, fragment, ok := strings.Cut(key, "#"); ok {
+		key = fragment
+	} else if r.Provider == ProviderFile {
+		key = key[strings.LastIndex(key, "/")+1:]
+	}
+	return invalidKeyChars.ReplaceAllString(key, "_")
+}
+
+var invalidKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+
+// Env returns a reference to an environment variable.
+func Env(name string) string {
+	return Ref{Provider: ProviderEnv, Key: name}.String()
+}
+
+// File returns a reference to a file containing the secret.
+func File(path string) string {
+	return Ref{Provider: ProviderFile, Key: path}.String()
+}
+
+// SOPS returns a reference to a value in a SOPS-encrypted YAML or JSON file.
+// keyPath is a dot-separated path, e.g. "slack.webhook".
+func SOPS(path, keyPath string) string {
+	return Ref{Provider: ProviderSOPS, Key: path + "#" + keyPath}.String()
+}
+
+// External returns a reference resolved by a custom provider registered
+// with Resolver.WithProvider.
+func External(provider, key string) string {
+	return Ref{Provider: provider, Key: key}.String()
+}
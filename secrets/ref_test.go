@@ -0,0 +1,60 @@
+package secrets
+
+import "testing"
+
+func TestParseRef(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Ref
+		ok    bool
+	}{
+		{"${SLACK_URL}", Ref{Provider: ProviderEnv, Key: "SLACK_URL"}, true},
+		{"${env:SLACK_URL}", Ref{Provider: ProviderEnv, Key: "SLACK_URL"}, true},
+		{"${file:/run/secrets/token}", Ref{Provider: ProviderFile, Key: "/run/secrets/token"}, true},
+		{"${sops:secrets.enc.yaml#slack.webhook}", Ref{Provider: ProviderSOPS, Key: "secrets.enc.yaml#slack.webhook"}, true},
+		{"${vault:kv/data/alerting#pagerduty}", Ref{Provider: "vault", Key: "kv/data/alerting#pagerduty"}, true},
+		{"https://hooks.slack.com/services/x", Ref{}, false},
+		{"prefix-${VAR}", Ref{}, false},
+		{"", Ref{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseRef(tt.value)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("ParseRef(%q) = %+v, %v, want %+v, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRefConstructors(t *testing.T) {
+	tests := []struct {
+		got  string
+		want string
+	}{
+		{Env("TOKEN"), "${TOKEN}"},
+		{File("/run/secrets/token"), "${file:/run/secrets/token}"},
+		{SOPS("secrets.enc.yaml", "slack.webhook"), "${sops:secrets.enc.yaml#slack.webhook}"},
+		{External("vault", "kv/alerting#key"), "${vault:kv/alerting#key}"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %q, want %q", tt.got, tt.want)
+		}
+	}
+}
+
+func TestRef_FileName(t *testing.T) {
+	tests := []struct {
+		ref  Ref
+		want string
+	}{
+		{Ref{Provider: ProviderEnv, Key: "SLACK_URL"}, "SLACK_URL"},
+		{Ref{Provider: ProviderFile, Key: "/run/secrets/token"}, "token"},
+		{Ref{Provider: ProviderSOPS, Key: "secrets.enc.yaml#slack.webhook"}, "slack.webhook"},
+		{Ref{Provider: "vault", Key: "kv/data/alerting"}, "kv_data_alerting"},
+	}
+	for _, tt := range tests {
+		if got := tt.ref.FileName(); got != tt.want {
+			t.Errorf("%+v.FileName() = %q, want %q", tt.ref, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package secrets
+
+import "fmt"
+
+// Resolver dispatches secret references to providers by name.
+type Resolver struct {
+	providers map[string]Provider
+}
+
+// NewResolver creates a Resolver with the env and file providers.
+// File paths are resolved against baseDir.
+func NewResolver(baseDir string) *Resolver {
+	return &Resolver{providers: map[string]Provider{
+		ProviderEnv:  NewEnvProvider(),
+		ProviderFile: NewFileProvider(baseDir),
+	}}
+}
+
+// WithProvider registers a provider under name, replacing any existing one.
+func (r *Resolver) WithProvider(name string, p Provider) *Resolver {
+	r.providers[name] = p
+	return r
+}
+
+// WithSOPS registers a SOPS provider using the given age key file.
+func (r *Resolver) WithSOPS(baseDir, ageKeyFile string) *Resolver {
+	return r.WithProvider(ProviderSOPS, NewSOPSProvider(baseDir, ageKeyFile))
+}
+
+// Resolve returns the value of a secret reference.
+func (r *Resolver) Resolve(ref Ref) (string, error) {
+	p, ok := r.providers[ref.Provider]
+	if !ok {
+		return "", fmt.Errorf("no secret provider registered for %q", ref.Provider)
+	}
+	value, err := p.Resolve(ref.Key)
+	if err != nil {
+		return "", fmt.Errorf("resolving %s: %w", ref, err)
+	}
+	return value, nil
+}
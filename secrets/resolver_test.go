@@ -0,0 +1,37 @@
+package secrets
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResolver(t *testing.T) {
+	t.Setenv("WETWIRE_TEST_TOKEN", "env-token")
+	r := NewResolver(t.TempDir()).
+		WithProvider("vault", ProviderFunc(func(key string) (string, error) {
+			return "vault:" + key, nil
+		}))
+
+	tests := []struct {
+		ref  Ref
+		want string
+	}{
+		{Ref{Provider: ProviderEnv, Key: "WETWIRE_TEST_TOKEN"}, "env-token"},
+		{Ref{Provider: "vault", Key: "kv/alerting"}, "vault:kv/alerting"},
+	}
+	for _, tt := range tests {
+		got, err := r.Resolve(tt.ref)
+		if err != nil {
+			t.Errorf("Resolve(%v) error = %v", tt.ref, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Resolve(%v) = %q, want %q", tt.ref, got, tt.want)
+		}
+	}
+
+	_, err := r.Resolve(Ref{Provider: "aws", Key: "x"})
+	if err == nil || !strings.Contains(err.Error(), `no secret provider registered for "aws"`) {
+		t.Errorf("Resolve(unknown provider) error = %v", err)
+	}
+}
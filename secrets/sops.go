@@ -0,0 +1,119 @@
+package secrets
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// SOPSProvider resolves keys from SOPS-encrypted YAML or JSON files.
+// Keys have the form "path#dotted.key". Files are decrypted with the sops
+// binary using a local age key, and cached for the provider's lifetime.
+type SOPSProvider struct {
+	// BaseDir is the directory relative file paths are resolved against.
+	BaseDir string
+
+	// AgeKeyFile is the age identity file passed to sops as SOPS_AGE_KEY_FILE.
+	// If empty, sops uses its default key location.
+	AgeKeyFile string
+
+	// Command is the sops executable (default: "sops").
+	Command string
+
+	decrypt func(path string) ([]byte, error)
+	cache   map[string]map[string]any
+}
+
+// NewSOPSProvider creates a SOPSProvider using the given age key file.
+func NewSOPSProvider(baseDir, ageKeyFile string) *SOPSProvider {
+	return &SOPSProvider{BaseDir: baseDir, AgeKeyFile: ageKeyFile}
+}
+
+// Resolve decrypts the referenced file and returns the value at the key path.
+func (p *SOPSProvider) Resolve(key string) (string, error) {
+	path, keyPath, ok := strings.Cut(key, "#")
+	if !ok || keyPath == "" {
+		return "", fmt.Errorf("sops reference %q must have the form path#key", key)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(p.BaseDir, path)
+	}
+
+	doc, err := p.load(path)
+	if err != nil {
+		return "", err
+	}
+
+	var value any = doc
+	for _, part := range strings.Split(keyPath, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("key %q not found in %s", keyPath, path)
+		}
+		if value, ok = m[part]; !ok {
+			return "", fmt.Errorf("key %q not found in %s", keyPath, path)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case map[string]any, []any:
+		return "", fmt.Errorf("key %q in %s is not a scalar", keyPath, path)
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+func (p *SOPSProvider) load(path string) (map[string]any, error) {
+	if doc, ok := p.cache[path]; ok {
+		return doc, nil
+	}
+
+	decrypt := p.decrypt
+	if decrypt == nil {
+		decrypt = p.runSOPS
+	}
+	data, err := decrypt(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("parsing decrypted %s: %w", path, err)
+	}
+	delete(doc, "sops")
+
+	if p.cache == nil {
+		p.cache = make(map[string]map[string]any)
+	}
+	p.cache[path] = doc
+	return doc, nil
+}
+
+// runSOPS decrypts path to JSON with the sops binary.
+func (p *SOPSProvider) runSOPS(path string) ([]byte, error) {
+	command := p.Command
+	if command == "" {
+		command = "sops"
+	}
+	cmd := exec.Command(command, "--decrypt", "--output-type", "json", path)
+	cmd.Env = os.Environ()
+	if p.AgeKeyFile != "" {
+		cmd.Env = append(cmd.Env, "SOPS_AGE_KEY_FILE="+p.AgeKeyFile)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("decrypting %s: %w: %s", path, err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
@@ -0,0 +1,85 @@
+package secrets
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+const decryptedSOPS = `{
+  "slack": {"webhook": "https://hooks.slack.com/services/T/B/X"},
+  "pagerduty": {"key": "pd-key", "retries": 3},
+  "sops": {"age": [{"recipient": "age1..."}]}
+}`
+
+func TestSOPSProvider_Resolve(t *testing.T) {
+	calls := 0
+	p := NewSOPSProvider("/repo", "/keys/age.txt")
+	p.decrypt = func(path string) ([]byte, error) {
+		calls++
+		if path != "/repo/secrets.enc.yaml" {
+			t.Errorf("decrypt path = %q", path)
+		}
+		return []byte(decryptedSOPS), nil
+	}
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"secrets.enc.yaml#slack.webhook", "https://hooks.slack.com/services/T/B/X"},
+		{"secrets.enc.yaml#pagerduty.key", "pd-key"},
+		{"secrets.enc.yaml#pagerduty.retries", "3"},
+	}
+	for _, tt := range tests {
+		got, err := p.Resolve(tt.key)
+		if err != nil {
+			t.Errorf("Resolve(%q) error = %v", tt.key, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Resolve(%q) = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("decrypt called %d times, want 1", calls)
+	}
+
+	for _, key := range []string{"secrets.enc.yaml", "secrets.enc.yaml#missing", "secrets.enc.yaml#slack", "secrets.enc.yaml#sops.age"} {
+		if _, err := p.Resolve(key); err == nil {
+			t.Errorf("Resolve(%q) should fail", key)
+		}
+	}
+}
+
+func TestSOPSProvider_Command(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script")
+	}
+	dir := t.TempDir()
+	script := filepath.Join(dir, "sops")
+	content := "#!/bin/sh\n" +
+		"[ \"$SOPS_AGE_KEY_FILE\" = \"" + dir + "/age.txt\" ] || { echo 'missing age key' >&2; exit 1; }\n" +
+		"echo '{\"token\": \"from-sops\"}'\n"
+	if err := os.WriteFile(script, []byte(content), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewSOPSProvider(dir, filepath.Join(dir, "age.txt"))
+	p.Command = script
+
+	got, err := p.Resolve("secrets.enc.json#token")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if got != "from-sops" {
+		t.Errorf("Resolve() = %q, want %q", got, "from-sops")
+	}
+
+	p = NewSOPSProvider(dir, "")
+	p.Command = script
+	if _, err := p.Resolve("secrets.enc.json#token"); err == nil {
+		t.Error("Resolve() should surface sops errors")
+	}
+}